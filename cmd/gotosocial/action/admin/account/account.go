@@ -331,3 +331,42 @@ var Password action.GTSAction = func(ctx context.Context) error {
 
 	return stopState(ctx, state)
 }
+
+// ResetTwoFactor disables two-factor authentication for the
+// given local account, for when the user has lost both their
+// authenticator and their recovery codes.
+var ResetTwoFactor action.GTSAction = func(ctx context.Context) error {
+	state, err := initState(ctx)
+	if err != nil {
+		return err
+	}
+
+	username := config.GetAdminAccountUsername()
+	if err := validate.Username(username); err != nil {
+		return err
+	}
+
+	account, err := state.DB.GetAccountByUsernameDomain(ctx, username, "")
+	if err != nil {
+		return err
+	}
+
+	user, err := state.DB.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+
+	user.TwoFactorSecret = ""
+	user.TwoFactorEnabledAt = time.Time{}
+	user.TwoFactorBackups = nil
+	if err := state.DB.UpdateUser(
+		ctx, user,
+		"two_factor_secret",
+		"two_factor_enabled_at",
+		"two_factor_backups",
+	); err != nil {
+		return err
+	}
+
+	return stopState(ctx, state)
+}
@@ -0,0 +1,84 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package invite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/invite"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+)
+
+// Create creates a new invite in the database using
+// the provided flags, and prints a link to it.
+//
+// Invites created from the cli are attributed
+// to the instance account, since there's no
+// particular user creating them.
+var Create action.GTSAction = func(ctx context.Context) error {
+	var state state.State
+	state.Caches.Init()
+	state.Caches.Start()
+	defer state.Caches.Stop()
+
+	dbConn, err := bundb.NewBunDBService(ctx, &state)
+	if err != nil {
+		return fmt.Errorf("error creating dbConn: %w", err)
+	}
+	state.DB = dbConn
+
+	maxUses := config.GetAdminInviteMaxUses()
+	if maxUses < 0 {
+		return errors.New("max-uses must not be negative")
+	}
+
+	expiresIn := config.GetAdminInviteExpiresIn()
+	if expiresIn < 0 {
+		return errors.New("expires-in must not be negative")
+	}
+
+	// Make sure the instance account exists, in
+	// case the server has never been started.
+	if err := state.DB.CreateInstanceAccount(ctx); err != nil {
+		return fmt.Errorf("error creating instance account: %w", err)
+	}
+
+	instanceAccount, err := state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		return fmt.Errorf("error getting instance account: %w", err)
+	}
+
+	newInvite, err := invite.NewInvite(instanceAccount.ID, maxUses, expiresIn)
+	if err != nil {
+		return fmt.Errorf("error generating invite: %w", err)
+	}
+
+	if err := state.DB.PutInvite(ctx, newInvite); err != nil {
+		return fmt.Errorf("error putting invite: %w", err)
+	}
+
+	fmt.Printf("invite code: %s\n", newInvite.Code)
+	fmt.Printf("invite link: %s\n", uris.GenerateURIForInvite(newInvite.Code))
+
+	return dbConn.Stop(ctx)
+}
@@ -56,6 +56,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/web"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
 
 	// Inherit memory limit if set from cgroup
 	_ "github.com/KimMachineGun/automemlimit"
@@ -96,6 +97,10 @@ var Start action.GTSAction = func(ctx context.Context) error {
 		return fmt.Errorf("error creating instance instance: %s", err)
 	}
 
+	if err := dbService.CreateVAPIDKeyPair(ctx); err != nil {
+		return fmt.Errorf("error creating VAPID key pair: %s", err)
+	}
+
 	// Open the storage backend
 	storage, err := gtsstorage.AutoConfig()
 	if err != nil {
@@ -142,6 +147,10 @@ var Start action.GTSAction = func(ctx context.Context) error {
 		}
 	}
 
+	// Create a web push sender which delivers
+	// notifications to subscribed devices.
+	webPushSender := webpush.NewSender(&state, client)
+
 	// Initialize timelines.
 	state.Timelines.Home = timeline.NewManager(
 		tlprocessor.HomeTimelineGrab(&state),
@@ -164,7 +173,7 @@ var Start action.GTSAction = func(ctx context.Context) error {
 	}
 
 	// Create the processor using all the other services we've created so far.
-	processor := processing.NewProcessor(typeConverter, federator, oauthServer, mediaManager, &state, emailSender)
+	processor := processing.NewProcessor(typeConverter, federator, oauthServer, mediaManager, &state, emailSender, webPushSender)
 
 	// Set state client / federator worker enqueue functions
 	state.Workers.EnqueueClientAPI = processor.EnqueueClientAPI
@@ -189,6 +198,7 @@ var Start action.GTSAction = func(ctx context.Context) error {
 		// note: hooks adding ctx fields must be ABOVE
 		// the logger, otherwise won't be accessible.
 		middleware.Logger(config.GetLogClientIP()),
+		middleware.IPBlock(dbService),
 		middleware.UserAgent(),
 		middleware.CORS(),
 		middleware.ExtraHeaders(),
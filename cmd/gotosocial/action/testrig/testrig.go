@@ -131,6 +131,7 @@ var Start action.GTSAction = func(ctx context.Context) error {
 	}
 	middlewares = append(middlewares, []gin.HandlerFunc{
 		middleware.Logger(config.GetLogClientIP()),
+		middleware.IPBlock(state.DB),
 		middleware.UserAgent(),
 		middleware.CORS(),
 		middleware.ExtraHeaders(),
@@ -20,6 +20,7 @@ package main
 import (
 	"github.com/spf13/cobra"
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/account"
+	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/invite"
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/media"
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/media/prune"
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/trans"
@@ -133,8 +134,45 @@ func adminCommands() *cobra.Command {
 	config.AddAdminAccountPassword(adminAccountPasswordCmd)
 	adminAccountCmd.AddCommand(adminAccountPasswordCmd)
 
+	adminAccountResetTwoFactorCmd := &cobra.Command{
+		Use:   "reset-2fa",
+		Short: "disable two-factor authentication for the given local account, so they can sign in with just their password",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), account.ResetTwoFactor)
+		},
+	}
+	config.AddAdminAccount(adminAccountResetTwoFactorCmd)
+	adminAccountCmd.AddCommand(adminAccountResetTwoFactorCmd)
+
 	adminCmd.AddCommand(adminAccountCmd)
 
+	/*
+	   ADMIN INVITE COMMANDS
+	*/
+
+	adminInviteCmd := &cobra.Command{
+		Use:   "invite",
+		Short: "admin commands related to invites for signing up on this instance",
+	}
+
+	adminInviteCreateCmd := &cobra.Command{
+		Use:   "create",
+		Short: "create a new invite, and print its code and link",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), invite.Create)
+		},
+	}
+	config.AddAdminInviteCreate(adminInviteCreateCmd)
+	adminInviteCmd.AddCommand(adminInviteCreateCmd)
+
+	adminCmd.AddCommand(adminInviteCmd)
+
 	/*
 	   ADMIN IMPORT/EXPORT COMMANDS
 	*/
@@ -121,6 +121,15 @@ type Likeable interface {
 	WithObject
 }
 
+// Reactable represents the minimum interface for an emoji reaction,
+// ie., an activitystreams 'like' activity with the emoji as content.
+type Reactable interface {
+	Likeable
+
+	WithContent
+	WithTag
+}
+
 // Blockable represents the minimum interface for an activitystreams 'block' activity.
 type Blockable interface {
 	WithJSONLDId
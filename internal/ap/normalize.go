@@ -20,6 +20,7 @@ package ap
 import (
 	"github.com/superseriousbusiness/activity/pub"
 	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/activity/streams/vocab"
 )
 
 /*
@@ -35,8 +36,15 @@ import (
 //
 // The rawActivity map should the freshly deserialized json representation of the Activity.
 //
-// This function is a noop if the type passed in is anything except a Create or Update with a Statusable or Accountable as its Object.
+// The 'content' (ie., reaction emoji) of a Like is normalized too.
+//
+// This function is a noop if the type passed in is anything except a Like, or a Create or Update with a Statusable or Accountable as its Object.
 func NormalizeIncomingActivityObject(activity pub.Activity, rawJSON map[string]interface{}) {
+	if like, ok := activity.(vocab.ActivityStreamsLike); ok {
+		NormalizeIncomingContent(like, rawJSON)
+		return
+	}
+
 	if typeName := activity.GetTypeName(); typeName != ActivityCreate && typeName != ActivityUpdate {
 		// Only interested in Create or Update right now.
 		return
@@ -260,3 +268,42 @@ func NormalizeIncomingName(item WithSetName, rawJSON map[string]interface{}) {
 	nameProp.AppendXMLSchemaString(name)
 	item.SetActivityStreamsName(nameProp)
 }
+
+// NormalizeIncomingEmojiReact rewrites an incoming EmojiReact, or an Undo
+// wrapping an EmojiReact, into a Like with the reaction emoji as its
+// 'content', so that it can be parsed by go-fed, which knows nothing of
+// EmojiReact. The emoji of a Misskey-style Like is likewise moved from
+// '_misskey_reaction' to 'content'.
+//
+// This function must be called on the raw json map before it is
+// resolved to an ActivityStreams type; it is a noop for anything else.
+func NormalizeIncomingEmojiReact(rawJSON map[string]interface{}) {
+	switch rawJSON["type"] {
+	case ActivityEmojiReact, ActivityLike:
+		normalizeEmojiReact(rawJSON)
+	case ActivityUndo:
+		if rawObject, ok := rawJSON["object"].(map[string]interface{}); ok {
+			normalizeEmojiReact(rawObject)
+		}
+	}
+}
+
+func normalizeEmojiReact(rawJSON map[string]interface{}) {
+	switch rawJSON["type"] {
+	case ActivityEmojiReact:
+		rawJSON["type"] = ActivityLike
+	case ActivityLike:
+	default:
+		// Not a reaction.
+		return
+	}
+
+	if content, _ := rawJSON["content"].(string); content != "" {
+		// Emoji already set.
+		return
+	}
+
+	if reaction, _ := rawJSON[PropMisskeyReaction].(string); reaction != "" {
+		rawJSON["content"] = reaction
+	}
+}
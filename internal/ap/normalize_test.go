@@ -18,9 +18,12 @@
 package ap_test
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/testrig"
@@ -391,6 +394,78 @@ func (suite *NormalizeTestSuite) TestNormalizeStatusableName() {
 	suite.Equal(`WARNING: #WEIRD #nameEE ;;;;a;;a;asv    khop8273987(*^&^)`, ap.ExtractName(statusable))
 }
 
+func (suite *NormalizeTestSuite) TestNormalizeEmojiReact() {
+	raw := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(`{
+		"@context": [
+		  "https://www.w3.org/ns/activitystreams",
+		  {
+			"Emoji": "toot:Emoji",
+			"toot": "http://joinmastodon.org/ns#"
+		  }
+		],
+		"actor": "https://example.org/users/someone",
+		"content": ":blobcat:",
+		"id": "https://example.org/activities/01H7WZ0E9R0D8AWXCYQ0M5Y8Q6",
+		"object": "http://localhost:8080/users/the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY",
+		"tag": [
+		  {
+			"icon": {
+			  "type": "Image",
+			  "url": "https://example.org/emoji/blobcat.png"
+			},
+			"id": "https://example.org/emoji/blobcat",
+			"name": ":blobcat:",
+			"type": "Emoji"
+		  }
+		],
+		"type": "EmojiReact"
+	  }`), &raw); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	ap.NormalizeIncomingEmojiReact(raw)
+	suite.Equal(ap.ActivityLike, raw["type"])
+
+	t, err := streams.ToType(context.Background(), raw)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	like, ok := t.(vocab.ActivityStreamsLike)
+	if !ok {
+		suite.FailNow("", "expected Like, got %T", t)
+	}
+
+	ap.NormalizeIncomingActivityObject(like, raw)
+	suite.Equal(":blobcat:", ap.ExtractContent(like))
+
+	emojis, err := ap.ExtractEmojis(like)
+	suite.NoError(err)
+	suite.Len(emojis, 1)
+
+	// Serializing the Like should give us an EmojiReact again.
+	data, err := ap.Serialize(like)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(ap.ActivityEmojiReact, data["type"])
+	suite.Equal(":blobcat:", data[ap.PropMisskeyReaction])
+}
+
+func (suite *NormalizeTestSuite) TestNormalizeMisskeyReaction() {
+	raw := map[string]interface{}{
+		"type": ap.ActivityUndo,
+		"object": map[string]interface{}{
+			"type":                 ap.ActivityLike,
+			ap.PropMisskeyReaction: "🐕",
+		},
+	}
+
+	ap.NormalizeIncomingEmojiReact(raw)
+	suite.Equal("🐕", raw["object"].(map[string]interface{})["content"])
+}
+
 func TestNormalizeTestSuite(t *testing.T) {
 	suite.Run(t, new(NormalizeTestSuite))
 }
@@ -38,6 +38,8 @@ import (
 //   - OrderedCollection: 'orderedItems' property will always be made into an array.
 //   - Any Accountable type: 'attachment' property will always be made into an array.
 //   - Update: any Accountable 'object's set on an update will be custom serialized as above.
+//   - Note, Create, Update: the GoToSocial namespace is added to '@context' if a Note's interactionPolicy is set.
+//   - Like, Undo: a Like with 'content' set (ie., an emoji reaction) is serialized as an EmojiReact.
 func Serialize(t vocab.Type) (m map[string]interface{}, e error) {
 	switch t.GetTypeName() {
 	case ObjectOrderedCollection:
@@ -45,7 +47,33 @@ func Serialize(t vocab.Type) (m map[string]interface{}, e error) {
 	case ActorApplication, ActorGroup, ActorOrganization, ActorPerson, ActorService:
 		return serializeAccountable(t, true)
 	case ActivityUpdate:
-		return serializeWithObject(t)
+		data, err := serializeWithObject(t)
+		if err != nil {
+			return nil, err
+		}
+		return withInteractionPolicyContext(data), nil
+	case ObjectNote, ActivityCreate:
+		data, err := streams.Serialize(t)
+		if err != nil {
+			return nil, err
+		}
+		return withInteractionPolicyContext(data), nil
+	case ActivityLike:
+		data, err := streams.Serialize(t)
+		if err != nil {
+			return nil, err
+		}
+		return withEmojiReact(data), nil
+	case ActivityUndo:
+		data, err := streams.Serialize(t)
+		if err != nil {
+			return nil, err
+		}
+		if object, ok := data["object"].(map[string]interface{}); ok {
+			// Check wrapped Like.
+			withEmojiReact(object)
+		}
+		return data, nil
 	default:
 		// No custom serializer necessary.
 		return streams.Serialize(t)
@@ -204,3 +232,61 @@ func serializeWithObject(t vocab.Type) (map[string]interface{}, error) {
 
 	return data, nil
 }
+
+// withInteractionPolicyContext takes the serialized data of a Note,
+// or of a Create or Update wrapping a Note, and adds the GoToSocial
+// json-ld namespace to the top level '@context' if the Note has an
+// 'interactionPolicy' set on it.
+func withInteractionPolicyContext(data map[string]interface{}) map[string]interface{} {
+	note := data
+	if object, ok := data["object"].(map[string]interface{}); ok {
+		// Check wrapped Note.
+		note = object
+	}
+
+	if _, ok := note[PropInteractionPolicy]; !ok {
+		// No policy, nothing to change.
+		return data
+	}
+
+	gtsContext := map[string]interface{}{
+		"gts":                 NamespaceGTS,
+		PropInteractionPolicy: map[string]string{"@id": "gts:" + PropInteractionPolicy, "@type": "@id"},
+		PropCanReply:          map[string]string{"@id": "gts:" + PropCanReply, "@type": "@id"},
+		PropCanLike:           map[string]string{"@id": "gts:" + PropCanLike, "@type": "@id"},
+		PropCanAnnounce:       map[string]string{"@id": "gts:" + PropCanAnnounce, "@type": "@id"},
+		PropAlways:            map[string]string{"@id": "gts:" + PropAlways, "@type": "@id"},
+	}
+
+	// Append to existing context,
+	// coercing it to a slice.
+	switch c := data["@context"].(type) {
+	case []interface{}:
+		data["@context"] = append(c, gtsContext)
+	case nil:
+		data["@context"] = gtsContext
+	default:
+		data["@context"] = []interface{}{c, gtsContext}
+	}
+
+	return data
+}
+
+// withEmojiReact takes the serialized data of a Like, and turns it into
+// an EmojiReact if the Like has an emoji set as its 'content'. The emoji
+// is also set as '_misskey_reaction', which is what Misskey looks for.
+func withEmojiReact(data map[string]interface{}) map[string]interface{} {
+	if data["type"] != ActivityLike {
+		return data
+	}
+
+	content, _ := data["content"].(string)
+	if content == "" {
+		// Plain old fave.
+		return data
+	}
+
+	data["type"] = ActivityEmojiReact
+	data[PropMisskeyReaction] = content
+	return data
+}
@@ -0,0 +1,152 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package users_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/api/activitypub/users"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type FollowersGetTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *FollowersGetTestSuite) getCollection(handler gin.HandlerFunc, requestURI string, signatureKey string) (string, []byte) {
+	derefRequests := testrig.NewTestDereferenceRequests(suite.testAccounts)
+	signedRequest := derefRequests[signatureKey]
+	targetAccount := suite.testAccounts["local_account_1"]
+
+	// setup request
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Request = httptest.NewRequest(http.MethodGet, requestURI, nil) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/activity+json")
+	ctx.Request.Header.Set("Signature", signedRequest.SignatureHeader)
+	ctx.Request.Header.Set("Date", signedRequest.DateHeader)
+
+	// we need to pass the context through signature check first to set appropriate values on it
+	suite.signatureCheck(ctx)
+
+	// normally the router would populate these params from the path values,
+	// but because we're calling the function directly, we need to set them manually.
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   users.UsernameKey,
+			Value: targetAccount.Username,
+		},
+	}
+
+	// trigger the function being tested
+	handler(ctx)
+
+	// check response
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := io.ReadAll(result.Body)
+	suite.NoError(err)
+	dst := new(bytes.Buffer)
+	err = json.Indent(dst, b, "", "  ")
+	suite.NoError(err)
+
+	return dst.String(), b
+}
+
+func (suite *FollowersGetTestSuite) TestGetFollowers() {
+	targetAccount := suite.testAccounts["local_account_1"]
+	indented, b := suite.getCollection(suite.userModule.FollowersGETHandler, targetAccount.FollowersURI, "foss_satan_dereference_zork_followers")
+
+	suite.Equal(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "first": "http://localhost:8080/users/the_mighty_zork/followers?page=true",
+  "id": "http://localhost:8080/users/the_mighty_zork/followers",
+  "totalItems": 2,
+  "type": "OrderedCollection"
+}`, indented)
+
+	m := make(map[string]interface{})
+	err := json.Unmarshal(b, &m)
+	suite.NoError(err)
+
+	t, err := streams.ToType(context.Background(), m)
+	suite.NoError(err)
+
+	_, ok := t.(vocab.ActivityStreamsOrderedCollection)
+	suite.True(ok)
+}
+
+func (suite *FollowersGetTestSuite) TestGetFollowersFirstPage() {
+	targetAccount := suite.testAccounts["local_account_1"]
+	indented, b := suite.getCollection(suite.userModule.FollowersGETHandler, targetAccount.FollowersURI+"?page=true", "foss_satan_dereference_zork_followers_first")
+
+	suite.Equal(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "http://localhost:8080/users/the_mighty_zork/followers?page=true",
+  "orderedItems": [
+    "http://localhost:8080/users/admin",
+    "http://localhost:8080/users/1happyturtle"
+  ],
+  "partOf": "http://localhost:8080/users/the_mighty_zork/followers",
+  "totalItems": 2,
+  "type": "OrderedCollectionPage"
+}`, indented)
+
+	m := make(map[string]interface{})
+	err := json.Unmarshal(b, &m)
+	suite.NoError(err)
+
+	t, err := streams.ToType(context.Background(), m)
+	suite.NoError(err)
+
+	_, ok := t.(vocab.ActivityStreamsOrderedCollectionPage)
+	suite.True(ok)
+}
+
+func (suite *FollowersGetTestSuite) TestGetFollowingFirstPage() {
+	targetAccount := suite.testAccounts["local_account_1"]
+	indented, _ := suite.getCollection(suite.userModule.FollowingGETHandler, targetAccount.FollowingURI+"?page=true", "foss_satan_dereference_zork_following_first")
+
+	suite.Equal(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "http://localhost:8080/users/the_mighty_zork/following?page=true",
+  "orderedItems": [
+    "http://localhost:8080/users/1happyturtle",
+    "http://localhost:8080/users/admin"
+  ],
+  "partOf": "http://localhost:8080/users/the_mighty_zork/following",
+  "totalItems": 2,
+  "type": "OrderedCollectionPage"
+}`, indented)
+}
+
+func TestFollowersGetTestSuite(t *testing.T) {
+	suite.Run(t, new(FollowersGetTestSuite))
+}
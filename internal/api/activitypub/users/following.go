@@ -20,7 +20,9 @@ package users
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -29,6 +31,7 @@ import (
 )
 
 // FollowingGETHandler returns a collection of URIs for accounts that the target user follows, formatted so that other AP servers can understand it.
+// Pages of the collection can be requested with the page and max_id query parameters.
 func (m *Module) FollowingGETHandler(c *gin.Context) {
 	// usernames on our instance are always lowercase
 	requestedUsername := strings.ToLower(c.Param(UsernameKey))
@@ -51,7 +54,20 @@ func (m *Module) FollowingGETHandler(c *gin.Context) {
 		return
 	}
 
-	resp, errWithCode := m.processor.Fedi().FollowingGet(c.Request.Context(), requestedUsername)
+	var page bool
+	if pageString := c.Query(PageKey); pageString != "" {
+		i, err := strconv.ParseBool(pageString)
+		if err != nil {
+			err := fmt.Errorf("error parsing %s: %s", PageKey, err)
+			apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+			return
+		}
+		page = i
+	}
+
+	maxID := c.Query(MaxIDKey)
+
+	resp, errWithCode := m.processor.Fedi().FollowingGet(c.Request.Context(), requestedUsername, page, maxID)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
@@ -31,6 +31,7 @@ import (
 	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/api/activitypub/users"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -169,6 +170,60 @@ func (suite *OutboxGetTestSuite) TestGetOutboxFirstPage() {
 	suite.True(ok)
 }
 
+func (suite *OutboxGetTestSuite) TestGetOutboxFirstPageLocalOnly() {
+	// the dereference we're gonna use
+	derefRequests := testrig.NewTestDereferenceRequests(suite.testAccounts)
+	signedRequest := derefRequests["foss_satan_dereference_zork_outbox_first"]
+	targetAccount := suite.testAccounts["local_account_1"]
+
+	// Make zork's public status local-only;
+	// it should no longer appear in the outbox.
+	localOnlyStatus := new(gtsmodel.Status)
+	*localOnlyStatus = *suite.testStatuses["local_account_1_status_1"]
+	localOnlyStatus.Federated = testrig.FalseBool()
+	if err := suite.db.UpdateStatus(context.Background(), localOnlyStatus, "federated"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	tc := testrig.NewTestTransportController(&suite.state, testrig.NewMockHTTPClient(nil, "../../../../testrig/media"))
+	federator := testrig.NewTestFederator(&suite.state, tc, suite.mediaManager)
+	emailSender := testrig.NewEmailSender("../../../../web/template/", nil)
+	processor := testrig.NewTestProcessor(&suite.state, federator, emailSender, suite.mediaManager)
+	userModule := users.New(processor)
+
+	// setup request
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Request = httptest.NewRequest(http.MethodGet, targetAccount.OutboxURI+"?page=true", nil) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/activity+json")
+	ctx.Request.Header.Set("Signature", signedRequest.SignatureHeader)
+	ctx.Request.Header.Set("Date", signedRequest.DateHeader)
+
+	// we need to pass the context through signature check first to set appropriate values on it
+	suite.signatureCheck(ctx)
+
+	// normally the router would populate these params from the path values,
+	// but because we're calling the function directly, we need to set them manually.
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   users.UsernameKey,
+			Value: targetAccount.Username,
+		},
+	}
+
+	// trigger the function being tested
+	userModule.OutboxGETHandler(ctx)
+
+	// check response
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+	suite.NotContains(string(b), localOnlyStatus.URI)
+}
+
 func (suite *OutboxGetTestSuite) TestGetOutboxNextPage() {
 	// the dereference we're gonna use
 	derefRequests := testrig.NewTestDereferenceRequests(suite.testAccounts)
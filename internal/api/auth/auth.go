@@ -22,7 +22,9 @@ import (
 
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
 	"github.com/superseriousbusiness/gotosocial/internal/oidc"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
@@ -43,6 +45,12 @@ const (
 	AuthAccountDisabledPath = "/account_disabled"
 	// AuthCallbackPath is the API path for receiving callback tokens from external OIDC providers
 	AuthCallbackPath = "/callback"
+	// AuthForgotPasswordPath is the API path for users to request a password reset email
+	AuthForgotPasswordPath = "/forgot_password"
+	// AuthResetPasswordPath is the API path for users to set a new password using a reset token
+	AuthResetPasswordPath = "/reset_password"
+	// AuthTwoFactorPath is the API path for users with two-factor auth enabled to enter their code after their password
+	AuthTwoFactorPath = "/2fa"
 
 	/*
 		paths prefixed with 'oauth'
@@ -56,6 +64,8 @@ const (
 	OauthFinalizePath = "/finalize"
 	// OauthOobTokenPath is the path for serving an html representation of an oob token page.
 	OauthOobTokenPath = "/oob" // #nosec G101 else we get a hardcoded credentials warning
+	// OauthRevokePath is the API path for revoking access or refresh tokens
+	OauthRevokePath = "/revoke"
 
 	/*
 		params / session keys
@@ -73,22 +83,60 @@ const (
 	sessionClientState   = "client_state"
 	sessionClaims        = "claims"
 	sessionAppID         = "app_id"
+
+	sessionCodeChallenge       = "code_challenge"
+	sessionCodeChallengeMethod = "code_challenge_method"
+
+	sessionTwoFactorUserID   = "2fa_userid"
+	sessionTwoFactorAttempts = "2fa_attempts"
+
+	// Form field names understood by the
+	// oauth server for authorize requests.
+	formForceLogin          = "force_login"
+	formResponseType        = "response_type"
+	formClientID            = "client_id"
+	formRedirectURI         = "redirect_uri"
+	formScope               = "scope"
+	formUserID              = "userid"
+	formState               = "state"
+	formCodeChallenge       = "code_challenge"
+	formCodeChallengeMethod = "code_challenge_method"
+
+	// twoFactorMaxAttempts is the number of wrong two-factor
+	// codes that can be entered before the user has to start
+	// over and sign in with their password again.
+	twoFactorMaxAttempts = 5
+
+	resetPasswordTokenParam = "token"
+
+	// passwordResetRateLimit is the number of password reset
+	// requests allowed per IP address per rate limit period;
+	// deliberately far stricter than the general rate limit.
+	passwordResetRateLimit = 5
 )
 
 type Module struct {
-	db        db.DB
-	processor *processing.Processor
-	idp       oidc.IDP
+	db         db.DB
+	processor  *processing.Processor
+	idp        oidc.IDP
+	resetLimit gin.HandlerFunc
 }
 
 // New returns an Auth module which provides both 'oauth' and 'auth' endpoints.
 //
 // It is safe to pass a nil idp if oidc is disabled.
 func New(db db.DB, processor *processing.Processor, idp oidc.IDP) *Module {
+	resetLimit := passwordResetRateLimit
+	if config.GetAdvancedRateLimitRequests() <= 0 {
+		// Rate limiting disabled.
+		resetLimit = 0
+	}
+
 	return &Module{
-		db:        db,
-		processor: processor,
-		idp:       idp,
+		db:         db,
+		processor:  processor,
+		idp:        idp,
+		resetLimit: middleware.RateLimit(resetLimit),
 	}
 }
 
@@ -96,12 +144,18 @@ func New(db db.DB, processor *processing.Processor, idp oidc.IDP) *Module {
 func (m *Module) RouteAuth(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	attachHandler(http.MethodGet, AuthSignInPath, m.SignInGETHandler)
 	attachHandler(http.MethodPost, AuthSignInPath, m.SignInPOSTHandler)
+	attachHandler(http.MethodGet, AuthTwoFactorPath, m.TwoFactorGETHandler)
+	attachHandler(http.MethodPost, AuthTwoFactorPath, m.TwoFactorPOSTHandler)
 	attachHandler(http.MethodGet, AuthCallbackPath, m.CallbackGETHandler)
+	attachHandler(http.MethodPost, AuthForgotPasswordPath, m.resetLimit, m.ForgotPasswordPOSTHandler)
+	attachHandler(http.MethodGet, AuthResetPasswordPath, m.ResetPasswordGETHandler)
+	attachHandler(http.MethodPost, AuthResetPasswordPath, m.resetLimit, m.ResetPasswordPOSTHandler)
 }
 
 // RouteOauth routes all paths that should have an 'oauth' prefix
 func (m *Module) RouteOauth(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	attachHandler(http.MethodPost, OauthTokenPath, m.TokenPOSTHandler)
+	attachHandler(http.MethodPost, OauthRevokePath, m.RevokePOSTHandler)
 	attachHandler(http.MethodGet, OauthAuthorizePath, m.AuthorizeGETHandler)
 	attachHandler(http.MethodPost, OauthAuthorizePath, m.AuthorizePOSTHandler)
 	attachHandler(http.MethodPost, OauthFinalizePath, m.FinalizePOSTHandler)
@@ -64,8 +64,14 @@ type AuthStandardTestSuite struct {
 }
 
 const (
-	sessionUserID   = "userid"
-	sessionClientID = "client_id"
+	sessionUserID      = "userid"
+	sessionClientID    = "client_id"
+	sessionRedirectURI = "redirect_uri"
+	sessionScope       = "scope"
+
+	sessionResponseType        = "response_type"
+	sessionCodeChallenge       = "code_challenge"
+	sessionCodeChallengeMethod = "code_challenge_method"
 )
 
 func (suite *AuthStandardTestSuite) SetupSuite() {
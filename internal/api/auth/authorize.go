@@ -32,6 +32,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/oauth2/v4"
 )
 
 // AuthorizeGETHandler should be served as GET at https://example.org/oauth/authorize
@@ -138,6 +139,12 @@ func (m *Module) AuthorizeGETHandler(c *gin.Context) {
 		return
 	}
 
+	if errWithCode := checkScope(scope, app); errWithCode != nil {
+		m.clearSession(s)
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
 	instance, errWithCode := m.processor.InstanceGetV1(c.Request.Context())
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
@@ -197,6 +204,14 @@ func (m *Module) AuthorizePOSTHandler(c *gin.Context) {
 		clientState = s
 	}
 
+	var codeChallenge, codeChallengeMethod string
+	if s, ok := s.Get(sessionCodeChallenge).(string); ok {
+		codeChallenge = s
+	}
+	if s, ok := s.Get(sessionCodeChallengeMethod).(string); ok {
+		codeChallengeMethod = s
+	}
+
 	userID, ok := s.Get(sessionUserID).(string)
 	if !ok {
 		errs = append(errs, fmt.Sprintf("key %s was not found in session", sessionUserID))
@@ -248,16 +263,21 @@ func (m *Module) AuthorizePOSTHandler(c *gin.Context) {
 	// we have to set the values on the request form
 	// so that they're picked up by the oauth server
 	c.Request.Form = url.Values{
-		sessionForceLogin:   {forceLogin},
-		sessionResponseType: {responseType},
-		sessionClientID:     {clientID},
-		sessionRedirectURI:  {redirectURI},
-		sessionScope:        {scope},
-		sessionUserID:       {userID},
+		formForceLogin:   {forceLogin},
+		formResponseType: {responseType},
+		formClientID:     {clientID},
+		formRedirectURI:  {redirectURI},
+		formScope:        {scope},
+		formUserID:       {userID},
 	}
 
 	if clientState != "" {
-		c.Request.Form.Set("state", clientState)
+		c.Request.Form.Set(formState, clientState)
+	}
+
+	if codeChallenge != "" {
+		c.Request.Form.Set(formCodeChallenge, codeChallenge)
+		c.Request.Form.Set(formCodeChallengeMethod, codeChallengeMethod)
 	}
 
 	if errWithCode := m.processor.OAuthHandleAuthorizeRequest(c.Writer, c.Request); errWithCode != nil {
@@ -293,6 +313,10 @@ func saveAuthFormToSession(s sessions.Session, form *apimodel.OAuthAuthorize) gt
 		form.Scope = "read"
 	}
 
+	if errWithCode := checkCodeChallenge(form); errWithCode != nil {
+		return errWithCode
+	}
+
 	// save these values from the form so we can use them elsewhere in the session
 	s.Set(sessionForceLogin, form.ForceLogin)
 	s.Set(sessionResponseType, form.ResponseType)
@@ -301,6 +325,8 @@ func saveAuthFormToSession(s sessions.Session, form *apimodel.OAuthAuthorize) gt
 	s.Set(sessionScope, form.Scope)
 	s.Set(sessionInternalState, uuid.NewString())
 	s.Set(sessionClientState, form.State)
+	s.Set(sessionCodeChallenge, form.CodeChallenge)
+	s.Set(sessionCodeChallengeMethod, form.CodeChallengeMethod)
 
 	if err := s.Save(); err != nil {
 		err := fmt.Errorf("error saving form values onto session: %s", err)
@@ -310,6 +336,55 @@ func saveAuthFormToSession(s sessions.Session, form *apimodel.OAuthAuthorize) gt
 	return nil
 }
 
+// checkScope checks that the given scope requested during
+// an authorize flow can be parsed, and is the same as or
+// narrower than the scopes registered by the application.
+func checkScope(scope string, app *gtsmodel.Application) gtserror.WithCode {
+	requested, err := oauth.ParseScopes(scope)
+	if err != nil {
+		return gtserror.NewErrorBadRequest(err, err.Error(), oauth.HelpfulAdvice)
+	}
+
+	registered := oauth.ParseStoredScopes(app.Scopes)
+	if !requested.Narrows(registered) {
+		err := fmt.Errorf("requested scope %s is not permitted by application scope %s", scope, app.Scopes)
+		return gtserror.NewErrorBadRequest(err, err.Error(), oauth.HelpfulAdvice)
+	}
+
+	return nil
+}
+
+// checkCodeChallenge checks that the PKCE code challenge and
+// code challenge method on the given form, if set, are valid
+// as per RFC 7636, setting the method to the default (plain)
+// if a challenge was provided without one.
+func checkCodeChallenge(form *apimodel.OAuthAuthorize) gtserror.WithCode {
+	if form.CodeChallenge == "" {
+		if form.CodeChallengeMethod != "" {
+			err := errors.New("field code_challenge_method was set on OAuthAuthorize form, but code_challenge was not")
+			return gtserror.NewErrorBadRequest(err, err.Error(), oauth.HelpfulAdvice)
+		}
+		return nil
+	}
+
+	if l := len(form.CodeChallenge); l < 43 || l > 128 {
+		err := fmt.Errorf("field code_challenge on OAuthAuthorize form must be between 43 and 128 characters long, was %d", l)
+		return gtserror.NewErrorBadRequest(err, err.Error(), oauth.HelpfulAdvice)
+	}
+
+	switch oauth2.CodeChallengeMethod(form.CodeChallengeMethod) {
+	case "":
+		form.CodeChallengeMethod = oauth2.CodeChallengePlain.String()
+	case oauth2.CodeChallengePlain, oauth2.CodeChallengeS256:
+		// all good
+	default:
+		err := fmt.Errorf("field code_challenge_method on OAuthAuthorize form must be S256 or plain, was %s", form.CodeChallengeMethod)
+		return gtserror.NewErrorBadRequest(err, err.Error(), oauth.HelpfulAdvice)
+	}
+
+	return nil
+}
+
 func ensureUserIsAuthorizedOrRedirect(ctx *gin.Context, user *gtsmodel.User, account *gtsmodel.Account) (redirected bool) {
 	if user.ConfirmedAt.IsZero() {
 		ctx.Redirect(http.StatusSeeOther, "/auth"+AuthCheckYourEmailPath)
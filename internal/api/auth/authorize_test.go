@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 
@@ -113,6 +115,84 @@ func (suite *AuthAuthorizeTestSuite) TestAccountAuthorizeHandler() {
 	}
 }
 
+func (suite *AuthAuthorizeTestSuite) authorizeWithScope(scope string) *httptest.ResponseRecorder {
+	ctx, recorder := suite.newContext(http.MethodGet, auth.OauthAuthorizePath, nil, "")
+
+	testSession := sessions.Default(ctx)
+	testSession.Set(sessionUserID, suite.testUsers["local_account_1"].ID)
+	testSession.Set(sessionClientID, suite.testApplications["application_1"].ClientID)
+	testSession.Set(sessionRedirectURI, suite.testApplications["application_1"].RedirectURI)
+	testSession.Set(sessionScope, scope)
+	if err := testSession.Save(); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.authModule.AuthorizeGETHandler(ctx)
+	return recorder
+}
+
+func (suite *AuthAuthorizeTestSuite) TestAuthorizeNarrowedScope() {
+	// Application has "read write follow push",
+	// so granular scopes should be fine.
+	recorder := suite.authorizeWithScope("read:statuses write:media")
+	suite.Equal(http.StatusOK, recorder.Code)
+}
+
+func (suite *AuthAuthorizeTestSuite) TestAuthorizeBroaderScope() {
+	// Application doesn't have admin scope.
+	recorder := suite.authorizeWithScope("read admin:read")
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+	suite.Contains(recorder.Body.String(), "requested scope read admin:read is not permitted by application scope read write follow push")
+}
+
+func (suite *AuthAuthorizeTestSuite) TestAuthorizeUnknownScope() {
+	recorder := suite.authorizeWithScope("read fly:to_the_moon")
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+	suite.Contains(recorder.Body.String(), "scope fly:to_the_moon not recognized")
+}
+
+func (suite *AuthAuthorizeTestSuite) TestAuthorizeCodeChallenge() {
+	app := suite.testApplications["application_1"]
+
+	for _, test := range []struct {
+		challenge      string
+		method         string
+		expectedStatus int
+	}{
+		{"E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", "S256", http.StatusSeeOther},
+		{"E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", "plain", http.StatusSeeOther},
+		{"E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", "", http.StatusSeeOther},
+		{"E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", "S512", http.StatusBadRequest},
+		{"too-short", "S256", http.StatusBadRequest},
+		{"", "S256", http.StatusBadRequest},
+	} {
+		query := url.Values{
+			"response_type":         {"code"},
+			"client_id":             {app.ClientID},
+			"redirect_uri":          {app.RedirectURI},
+			"code_challenge":        {test.challenge},
+			"code_challenge_method": {test.method},
+		}
+		ctx, recorder := suite.newContext(http.MethodGet, auth.OauthAuthorizePath+"?"+query.Encode(), nil, "")
+
+		suite.authModule.AuthorizeGETHandler(ctx)
+		suite.Equal(test.expectedStatus, recorder.Code, "%s %s", test.challenge, test.method)
+
+		if test.expectedStatus != http.StatusSeeOther {
+			continue
+		}
+
+		// Challenge should be stored for AuthorizePOSTHandler.
+		expectedMethod := test.method
+		if expectedMethod == "" {
+			expectedMethod = "plain"
+		}
+		testSession := sessions.Default(ctx)
+		suite.Equal(test.challenge, testSession.Get(sessionCodeChallenge))
+		suite.Equal(expectedMethod, testSession.Get(sessionCodeChallengeMethod))
+	}
+}
+
 func TestAccountUpdateTestSuite(t *testing.T) {
 	suite.Run(t, new(AuthAuthorizeTestSuite))
 }
@@ -0,0 +1,136 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package auth
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// forgotPassword wraps a form-submitted
+// email address for a password reset request.
+type forgotPassword struct {
+	Email string `form:"email"`
+}
+
+// resetPassword wraps a form-submitted reset
+// password token, and the new password to set.
+type resetPassword struct {
+	Token    string `form:"token"`
+	Password string `form:"password"`
+}
+
+// ForgotPasswordPOSTHandler should be served at https://example.org/auth/forgot_password.
+// It receives the 'forgot password' form from the sign in page, and emails a reset
+// link to the given address if it belongs to a user on this instance.
+//
+// The same page is shown whether or not the address is known,
+// so that this can't be used to find out who's registered here.
+func (m *Module) ForgotPasswordPOSTHandler(c *gin.Context) {
+	if config.GetOIDCEnabled() {
+		// Passwords are managed by the idp.
+		err := errors.New(http.StatusText(http.StatusNotFound))
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotFound(err), m.processor.InstanceGetV1)
+		return
+	}
+
+	form := &forgotPassword{}
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, oauth.HelpfulAdvice), m.processor.InstanceGetV1)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	if errWithCode := m.processor.User().PasswordResetRequest(ctx, form.Email); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	instance, errWithCode := m.processor.InstanceGetV1(ctx)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	c.HTML(http.StatusOK, "reset-password-sent.tmpl", gin.H{
+		"instance": instance,
+		"email":    form.Email,
+	})
+}
+
+// ResetPasswordGETHandler should be served at https://example.org/auth/reset_password.
+// It presents a form for the user to enter a new password, carrying
+// along the reset token from the link that was emailed to them.
+func (m *Module) ResetPasswordGETHandler(c *gin.Context) {
+	if _, err := apiutil.NegotiateAccept(c, apiutil.HTMLAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	token := c.Query(resetPasswordTokenParam)
+	if config.GetOIDCEnabled() || token == "" {
+		err := errors.New(http.StatusText(http.StatusNotFound))
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotFound(err), m.processor.InstanceGetV1)
+		return
+	}
+
+	instance, errWithCode := m.processor.InstanceGetV1(c.Request.Context())
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	c.HTML(http.StatusOK, "reset-password.tmpl", gin.H{
+		"instance": instance,
+		"token":    token,
+	})
+}
+
+// ResetPasswordPOSTHandler should be served at https://example.org/auth/reset_password.
+// It sets the user's new password using the submitted reset token, then
+// redirects to the sign in page so the user can sign in with it.
+func (m *Module) ResetPasswordPOSTHandler(c *gin.Context) {
+	if config.GetOIDCEnabled() {
+		err := errors.New(http.StatusText(http.StatusNotFound))
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotFound(err), m.processor.InstanceGetV1)
+		return
+	}
+
+	form := &resetPassword{}
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, oauth.HelpfulAdvice), m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, errWithCode := m.processor.User().PasswordReset(
+		c.Request.Context(),
+		form.Token,
+		form.Password,
+	); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	c.Redirect(http.StatusSeeOther, "/auth"+AuthSignInPath)
+}
@@ -0,0 +1,96 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package auth_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type ResetPasswordTestSuite struct {
+	AuthStandardTestSuite
+}
+
+func (suite *ResetPasswordTestSuite) TestForgotPasswordUnknownEmail() {
+	form := url.Values{"email": {"nobody@example.org"}}
+	ctx, recorder := suite.newContext(http.MethodPost, "auth/forgot_password", []byte(form.Encode()), "application/x-www-form-urlencoded")
+
+	suite.authModule.ForgotPasswordPOSTHandler(ctx)
+
+	// Same response as for a known address.
+	suite.Equal(http.StatusOK, recorder.Code)
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Contains(string(b), "If <b>nobody@example.org</b> belongs to an account on this instance")
+}
+
+func (suite *ResetPasswordTestSuite) TestResetPasswordGETNoToken() {
+	ctx, recorder := suite.newContext(http.MethodGet, "auth/reset_password", nil, "")
+
+	suite.authModule.ResetPasswordGETHandler(ctx)
+
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func (suite *ResetPasswordTestSuite) TestResetPassword() {
+	user := suite.testUsers["local_account_1"]
+	user.ResetPasswordToken = "c4d41e8b-8c8d-4b7e-a0a8-a0c5b0a5e6d2"
+	user.ResetPasswordSentAt = time.Now()
+	if err := suite.db.UpdateUser(context.Background(), user, "reset_password_token", "reset_password_sent_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	form := url.Values{
+		"token":    {"c4d41e8b-8c8d-4b7e-a0a8-a0c5b0a5e6d2"},
+		"password": {"verygoodnewpassword!!!!"},
+	}
+	ctx, recorder := suite.newContext(http.MethodPost, "auth/reset_password", []byte(form.Encode()), "application/x-www-form-urlencoded")
+
+	suite.authModule.ResetPasswordPOSTHandler(ctx)
+
+	// Should be sent back to sign in with the new password.
+	suite.Equal(http.StatusSeeOther, ctx.Writer.Status())
+	suite.Equal("/auth/sign_in", recorder.Header().Get("Location"))
+
+	dbUser, err := suite.db.GetUserByID(context.Background(), user.ID)
+	suite.NoError(err)
+	suite.NoError(bcrypt.CompareHashAndPassword([]byte(dbUser.EncryptedPassword), []byte("verygoodnewpassword!!!!")))
+	suite.Empty(dbUser.ResetPasswordToken)
+}
+
+func (suite *ResetPasswordTestSuite) TestResetPasswordBadToken() {
+	form := url.Values{
+		"token":    {"not-a-real-token"},
+		"password": {"verygoodnewpassword!!!!"},
+	}
+	ctx, recorder := suite.newContext(http.MethodPost, "auth/reset_password", []byte(form.Encode()), "application/x-www-form-urlencoded")
+
+	suite.authModule.ResetPasswordPOSTHandler(ctx)
+
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func TestResetPasswordTestSuite(t *testing.T) {
+	suite.Run(t, &ResetPasswordTestSuite{})
+}
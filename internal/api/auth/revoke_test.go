@@ -0,0 +1,198 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package auth_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type RevokeTestSuite struct {
+	AuthStandardTestSuite
+}
+
+func (suite *RevokeTestSuite) revoke(fields map[string]string, basicID string, basicSecret string) (int, string) {
+	requestBody, w, err := testrig.CreateMultipartFormData("", "", fields)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	ctx, recorder := suite.newContext(http.MethodPost, "oauth/revoke", requestBody.Bytes(), w.FormDataContentType())
+	ctx.Request.Header.Set("accept", "application/json")
+	if basicID != "" {
+		ctx.Request.SetBasicAuth(basicID, basicSecret)
+	}
+
+	suite.authModule.RevokePOSTHandler(ctx)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return recorder.Code, string(b)
+}
+
+func (suite *RevokeTestSuite) TestRevokeAccessToken() {
+	testClient := suite.testClients["local_account_1"]
+	testToken := suite.testTokens["local_account_1"]
+
+	// Subscribe to web push with the token.
+	sub := &gtsmodel.WebPushSubscription{
+		ID:        "01HA3BSGQWB6NBWF4SXKJ3WRZN",
+		AccountID: suite.testAccounts["local_account_1"].ID,
+		TokenID:   testToken.ID,
+		Endpoint:  "https://push.example.org/push/zork",
+		Auth:      "ZnJlc2hseSBnZW5lcmF0ZWQ",
+		P256dh:    "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4",
+		Policy:    gtsmodel.WebPushNotificationPolicyAll,
+	}
+	if err := suite.db.PutWebPushSubscription(context.Background(), sub); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	code, body := suite.revoke(map[string]string{
+		"token":           testToken.Access,
+		"token_type_hint": "access_token",
+		"client_id":       testClient.ID,
+		"client_secret":   testClient.Secret,
+	}, "", "")
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(`{}`, body)
+
+	// Token should be gone now.
+	err := suite.db.GetByID(context.Background(), testToken.ID, &gtsmodel.Token{})
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// And so should its web push subscription.
+	_, err = suite.db.GetWebPushSubscriptionByID(context.Background(), sub.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *RevokeTestSuite) TestRevokeAccessTokenBasicAuth() {
+	testClient := suite.testClients["local_account_1"]
+	testToken := suite.testTokens["local_account_1"]
+
+	code, _ := suite.revoke(map[string]string{
+		"token": testToken.Access,
+	}, testClient.ID, testClient.Secret)
+	suite.Equal(http.StatusOK, code)
+
+	err := suite.db.GetByID(context.Background(), testToken.ID, &gtsmodel.Token{})
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *RevokeTestSuite) TestRevokeAccessTokenMissingClientSecret() {
+	testClient := suite.testClients["local_account_1"]
+	testToken := suite.testTokens["local_account_1"]
+
+	// No secret, just the client ID, but
+	// this client was registered with one.
+	code, body := suite.revoke(map[string]string{
+		"token":     testToken.Access,
+		"client_id": testClient.ID,
+	}, "", "")
+	suite.Equal(http.StatusUnauthorized, code)
+	suite.Equal(`{"error":"invalid_client","error_description":"Unauthorized: client secret was not provided"}`, body)
+
+	// Token should still be there.
+	err := suite.db.GetByID(context.Background(), testToken.ID, &gtsmodel.Token{})
+	suite.NoError(err)
+}
+
+func (suite *RevokeTestSuite) TestRevokeOtherClientsTokenMissingClientSecret() {
+	testClient := suite.testClients["local_account_2"]
+	testToken := suite.testTokens["local_account_1"]
+
+	code, _ := suite.revoke(map[string]string{
+		"token":     testToken.Access,
+		"client_id": testClient.ID,
+	}, "", "")
+	suite.Equal(http.StatusUnauthorized, code)
+
+	err := suite.db.GetByID(context.Background(), testToken.ID, &gtsmodel.Token{})
+	suite.NoError(err)
+}
+
+func (suite *RevokeTestSuite) TestRevokeUnknownToken() {
+	testClient := suite.testClients["local_account_1"]
+
+	// Unknown tokens are not an error, as per RFC 7009.
+	code, body := suite.revoke(map[string]string{
+		"token":         "not a real token",
+		"client_id":     testClient.ID,
+		"client_secret": testClient.Secret,
+	}, "", "")
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(`{}`, body)
+}
+
+func (suite *RevokeTestSuite) TestRevokeBadClientSecret() {
+	testClient := suite.testClients["local_account_1"]
+	testToken := suite.testTokens["local_account_1"]
+
+	code, _ := suite.revoke(map[string]string{
+		"token":         testToken.Access,
+		"client_id":     testClient.ID,
+		"client_secret": "nope",
+	}, "", "")
+	suite.Equal(http.StatusUnauthorized, code)
+
+	// Token should still be there.
+	err := suite.db.GetByID(context.Background(), testToken.ID, &gtsmodel.Token{})
+	suite.NoError(err)
+}
+
+func (suite *RevokeTestSuite) TestRevokeOtherClientsToken() {
+	testClient := suite.testClients["local_account_2"]
+	testToken := suite.testTokens["local_account_1"]
+
+	code, _ := suite.revoke(map[string]string{
+		"token":         testToken.Access,
+		"client_id":     testClient.ID,
+		"client_secret": testClient.Secret,
+	}, "", "")
+	suite.Equal(http.StatusForbidden, code)
+
+	err := suite.db.GetByID(context.Background(), testToken.ID, &gtsmodel.Token{})
+	suite.NoError(err)
+}
+
+func (suite *RevokeTestSuite) TestRevokeMissingToken() {
+	testClient := suite.testClients["local_account_1"]
+
+	code, body := suite.revoke(map[string]string{
+		"client_id":     testClient.ID,
+		"client_secret": testClient.Secret,
+	}, "", "")
+	suite.Equal(http.StatusBadRequest, code)
+	suite.Equal(`{"error":"invalid_request","error_description":"Bad Request: token was not set in the revoke request form"}`, body)
+}
+
+func TestRevokeTestSuite(t *testing.T) {
+	suite.Run(t, &RevokeTestSuite{})
+}
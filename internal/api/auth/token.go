@@ -35,6 +35,8 @@ type tokenRequestForm struct {
 	ClientID     *string `form:"client_id" json:"client_id" xml:"client_id"`
 	ClientSecret *string `form:"client_secret" json:"client_secret" xml:"client_secret"`
 	Scope        *string `form:"scope" json:"scope" xml:"scope"`
+	RefreshToken *string `form:"refresh_token" json:"refresh_token" xml:"refresh_token"`
+	CodeVerifier *string `form:"code_verifier" json:"code_verifier" xml:"code_verifier"`
 }
 
 // TokenPOSTHandler should be served as a POST at https://example.org/oauth/token
@@ -71,13 +73,15 @@ func (m *Module) TokenPOSTHandler(c *gin.Context) {
 
 	if form.ClientSecret != nil {
 		c.Request.Form.Set("client_secret", *form.ClientSecret)
-	} else {
+	} else if form.CodeVerifier == nil || grantType != "authorization_code" {
+		// Public clients using PKCE don't need to provide a secret,
+		// since the code verifier proves they started the flow.
 		help = append(help, "client_secret was not set in the token request form")
 	}
 
 	if form.RedirectURI != nil {
 		c.Request.Form.Set("redirect_uri", *form.RedirectURI)
-	} else {
+	} else if grantType != "refresh_token" {
 		help = append(help, "redirect_uri was not set in the token request form")
 	}
 
@@ -93,8 +97,29 @@ func (m *Module) TokenPOSTHandler(c *gin.Context) {
 		help = append(help, "code was not set in the token request form, but must be set since grant_type is authorization_code")
 	}
 
+	if form.CodeVerifier != nil {
+		if grantType != "authorization_code" {
+			help = append(help, "a code_verifier was provided in the token request form, but grant_type was not set to authorization_code")
+		} else {
+			c.Request.Form.Set("code_verifier", *form.CodeVerifier)
+		}
+	}
+
+	if form.RefreshToken != nil {
+		if grantType != "refresh_token" {
+			help = append(help, "a refresh_token was provided in the token request form, but grant_type was not set to refresh_token")
+		} else {
+			c.Request.Form.Set("refresh_token", *form.RefreshToken)
+		}
+	} else if grantType == "refresh_token" {
+		help = append(help, "refresh_token was not set in the token request form, but must be set since grant_type is refresh_token")
+	}
+
 	if form.Scope != nil {
 		c.Request.Form.Set("scope", *form.Scope)
+	} else if grantType == "client_credentials" {
+		// Default to read scope, as with authorization codes.
+		c.Request.Form.Set("scope", string(oauth.ScopeRead))
 	}
 
 	if len(help) != 0 {
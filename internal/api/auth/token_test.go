@@ -22,11 +22,15 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"testing"
 	"time"
 
+	"github.com/gin-contrib/sessions"
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/auth"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/testrig"
@@ -209,6 +213,230 @@ func (suite *TokenTestSuite) TestRetrieveAuthorizationCodeWrongGrantType() {
 	suite.Equal(`{"error":"invalid_request","error_description":"Bad Request: a code was provided in the token request form, but grant_type was not set to authorization_code"}`, string(b))
 }
 
+func (suite *TokenTestSuite) TestRefreshTokenOK() {
+	// Enable refresh tokens and rebuild
+	// the oauth server to pick up the config.
+	config.SetOAuthRefreshTokens(true)
+	config.SetOAuthAccessTokenExpiry(time.Hour)
+	suite.processor = testrig.NewTestProcessor(&suite.state, suite.federator, suite.emailSender, suite.mediaManager)
+	suite.authModule = auth.New(suite.db, suite.processor, suite.idp)
+
+	testClient := suite.testClients["local_account_1"]
+	testUserAuthorizationToken := suite.testTokens["local_account_1_user_authorization_token"]
+
+	requestToken := func(fields map[string]string) (int, *apimodel.Token) {
+		requestBody, w, err := testrig.CreateMultipartFormData("", "", fields)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		ctx, recorder := suite.newContext(http.MethodPost, "oauth/token", requestBody.Bytes(), w.FormDataContentType())
+		ctx.Request.Header.Set("accept", "application/json")
+		suite.authModule.TokenPOSTHandler(ctx)
+
+		t := &apimodel.Token{}
+		if recorder.Code == http.StatusOK {
+			suite.NoError(json.Unmarshal(recorder.Body.Bytes(), t))
+		}
+		return recorder.Code, t
+	}
+
+	// Swap authorization code for an access token + refresh token.
+	code, t := requestToken(map[string]string{
+		"grant_type":    "authorization_code",
+		"client_id":     testClient.ID,
+		"client_secret": testClient.Secret,
+		"redirect_uri":  "http://localhost:8080",
+		"code":          testUserAuthorizationToken.Code,
+	})
+	suite.Equal(http.StatusOK, code)
+	suite.NotEmpty(t.AccessToken)
+	suite.NotEmpty(t.RefreshToken)
+	suite.Equal(int64(3600), t.ExpiresIn)
+
+	// Use the refresh token to get a new access token.
+	code, refreshed := requestToken(map[string]string{
+		"grant_type":    "refresh_token",
+		"client_id":     testClient.ID,
+		"client_secret": testClient.Secret,
+		"refresh_token": t.RefreshToken,
+	})
+	suite.Equal(http.StatusOK, code)
+	suite.NotEmpty(refreshed.AccessToken)
+	suite.NotEqual(t.AccessToken, refreshed.AccessToken)
+	suite.NotEmpty(refreshed.RefreshToken)
+	suite.NotEqual(t.RefreshToken, refreshed.RefreshToken)
+
+	// The old access token should be gone.
+	err := suite.db.GetWhere(context.Background(), []db.Where{{Key: "access", Value: t.AccessToken}}, &gtsmodel.Token{})
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Refresh tokens are rotated, so the old one can't be reused.
+	code, _ = requestToken(map[string]string{
+		"grant_type":    "refresh_token",
+		"client_id":     testClient.ID,
+		"client_secret": testClient.Secret,
+		"refresh_token": t.RefreshToken,
+	})
+	suite.Equal(http.StatusBadRequest, code)
+}
+
+// authorizeWithPKCE runs through the authorize POST handler with the
+// given PKCE code challenge set on the session, and returns the code.
+func (suite *TokenTestSuite) authorizeWithPKCE(codeChallenge string, codeChallengeMethod string) string {
+	app := suite.testApplications["application_1"]
+
+	ctx, recorder := suite.newContext(http.MethodPost, auth.OauthAuthorizePath, nil, "")
+
+	testSession := sessions.Default(ctx)
+	testSession.Set(sessionUserID, suite.testUsers["local_account_1"].ID)
+	testSession.Set(sessionClientID, app.ClientID)
+	testSession.Set(sessionRedirectURI, app.RedirectURI)
+	testSession.Set(sessionResponseType, "code")
+	testSession.Set(sessionScope, "read")
+	testSession.Set(sessionCodeChallenge, codeChallenge)
+	testSession.Set(sessionCodeChallengeMethod, codeChallengeMethod)
+	if err := testSession.Save(); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.authModule.AuthorizePOSTHandler(ctx)
+
+	location, err := url.Parse(recorder.Header().Get("Location"))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	code := location.Query().Get("code")
+	if code == "" {
+		suite.FailNow("no code in redirect location " + location.String())
+	}
+
+	// The challenge should be stored alongside the code.
+	dbToken := &gtsmodel.Token{}
+	err = suite.db.GetWhere(context.Background(), []db.Where{{Key: "code", Value: code}}, dbToken)
+	suite.NoError(err)
+	suite.Equal(codeChallenge, dbToken.CodeChallenge)
+	suite.Equal(codeChallengeMethod, dbToken.CodeChallengeMethod)
+
+	return code
+}
+
+func (suite *TokenTestSuite) exchangeCode(fields map[string]string) (int, string) {
+	requestBody, w, err := testrig.CreateMultipartFormData("", "", fields)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	ctx, recorder := suite.newContext(http.MethodPost, "oauth/token", requestBody.Bytes(), w.FormDataContentType())
+	ctx.Request.Header.Set("accept", "application/json")
+	suite.authModule.TokenPOSTHandler(ctx)
+
+	return recorder.Code, recorder.Body.String()
+}
+
+func (suite *TokenTestSuite) TestPKCES256PublicClient() {
+	// Example verifier + challenge from RFC 7636 appendix B.
+	var (
+		verifier  = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+		challenge = "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+		app       = suite.testApplications["application_1"]
+	)
+
+	code := suite.authorizeWithPKCE(challenge, "S256")
+
+	// No client secret, but the code verifier proves the client's identity.
+	status, body := suite.exchangeCode(map[string]string{
+		"grant_type":    "authorization_code",
+		"client_id":     app.ClientID,
+		"redirect_uri":  app.RedirectURI,
+		"code":          code,
+		"code_verifier": verifier,
+	})
+	suite.Equal(http.StatusOK, status, body)
+
+	t := &apimodel.Token{}
+	suite.NoError(json.Unmarshal([]byte(body), t))
+	suite.NotEmpty(t.AccessToken)
+	suite.Equal("read", t.Scope)
+}
+
+func (suite *TokenTestSuite) TestPKCEPlainWithSecret() {
+	var (
+		verifier = "a-plain-code-verifier-which-is-at-least-43-characters-long"
+		app      = suite.testApplications["application_1"]
+	)
+
+	code := suite.authorizeWithPKCE(verifier, "plain")
+
+	status, body := suite.exchangeCode(map[string]string{
+		"grant_type":    "authorization_code",
+		"client_id":     app.ClientID,
+		"client_secret": app.ClientSecret,
+		"redirect_uri":  app.RedirectURI,
+		"code":          code,
+		"code_verifier": verifier,
+	})
+	suite.Equal(http.StatusOK, status, body)
+}
+
+func (suite *TokenTestSuite) TestPKCEWrongVerifier() {
+	app := suite.testApplications["application_1"]
+
+	code := suite.authorizeWithPKCE("E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", "S256")
+
+	status, _ := suite.exchangeCode(map[string]string{
+		"grant_type":    "authorization_code",
+		"client_id":     app.ClientID,
+		"redirect_uri":  app.RedirectURI,
+		"code":          code,
+		"code_verifier": "this-is-not-the-verifier-that-produced-the-challenge",
+	})
+	suite.Equal(http.StatusBadRequest, status)
+}
+
+func (suite *TokenTestSuite) TestPKCEMissingVerifier() {
+	app := suite.testApplications["application_1"]
+
+	code := suite.authorizeWithPKCE("E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", "S256")
+
+	// Even with the client secret, a code issued
+	// with a challenge needs the matching verifier.
+	status, _ := suite.exchangeCode(map[string]string{
+		"grant_type":    "authorization_code",
+		"client_id":     app.ClientID,
+		"client_secret": app.ClientSecret,
+		"redirect_uri":  app.RedirectURI,
+		"code":          code,
+	})
+	suite.Equal(http.StatusBadRequest, status)
+}
+
+func (suite *TokenTestSuite) TestPublicClientWithoutPKCE() {
+	app := suite.testApplications["application_1"]
+	code := suite.testTokens["local_account_1_user_authorization_token"].Code
+
+	// A code issued without a challenge can't be
+	// swapped using a verifier instead of a secret.
+	status, _ := suite.exchangeCode(map[string]string{
+		"grant_type":    "authorization_code",
+		"client_id":     app.ClientID,
+		"redirect_uri":  app.RedirectURI,
+		"code":          code,
+		"code_verifier": "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk",
+	})
+	suite.Equal(http.StatusBadRequest, status)
+
+	// Nor without either.
+	status, _ = suite.exchangeCode(map[string]string{
+		"grant_type":   "authorization_code",
+		"client_id":    app.ClientID,
+		"redirect_uri": app.RedirectURI,
+		"code":         code,
+	})
+	suite.Equal(http.StatusBadRequest, status)
+}
+
 func TestTokenTestSuite(t *testing.T) {
 	suite.Run(t, &TokenTestSuite{})
 }
@@ -0,0 +1,166 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/totp"
+)
+
+const (
+	sessionTwoFactorUserID   = "2fa_userid"
+	sessionTwoFactorAttempts = "2fa_attempts"
+	testTwoFactorSecret      = "JBSWY3DPEHPK3PXP"
+)
+
+type TwoFactorTestSuite struct {
+	AuthStandardTestSuite
+}
+
+func (suite *TwoFactorTestSuite) enableTwoFactor(user *gtsmodel.User) {
+	user.TwoFactorSecret = testTwoFactorSecret
+	user.TwoFactorEnabledAt = time.Now()
+	if err := suite.db.UpdateUser(context.Background(), user, "two_factor_secret", "two_factor_enabled_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *TwoFactorTestSuite) TestSignInRedirectsToTwoFactor() {
+	user := suite.testUsers["local_account_1"]
+	suite.enableTwoFactor(user)
+
+	form := url.Values{"username": {user.Email}, "password": {"password"}}
+	ctx, recorder := suite.newContext(http.MethodPost, "auth/sign_in", []byte(form.Encode()), "application/x-www-form-urlencoded")
+
+	suite.authModule.SignInPOSTHandler(ctx)
+
+	suite.Equal(http.StatusFound, ctx.Writer.Status())
+	suite.Equal("/auth/2fa", recorder.Header().Get("Location"))
+
+	// User should not be signed in yet.
+	s := sessions.Default(ctx)
+	suite.Nil(s.Get(sessionUserID))
+	suite.Equal(user.ID, s.Get(sessionTwoFactorUserID))
+}
+
+func (suite *TwoFactorTestSuite) TestSignInWithoutTwoFactor() {
+	user := suite.testUsers["local_account_1"]
+
+	form := url.Values{"username": {user.Email}, "password": {"password"}}
+	ctx, recorder := suite.newContext(http.MethodPost, "auth/sign_in", []byte(form.Encode()), "application/x-www-form-urlencoded")
+
+	suite.authModule.SignInPOSTHandler(ctx)
+
+	suite.Equal(http.StatusFound, ctx.Writer.Status())
+	suite.Equal("/oauth/authorize", recorder.Header().Get("Location"))
+	suite.Equal(user.ID, sessions.Default(ctx).Get(sessionUserID))
+}
+
+func (suite *TwoFactorTestSuite) TestTwoFactorPOST() {
+	user := suite.testUsers["local_account_1"]
+	suite.enableTwoFactor(user)
+
+	code, err := totp.Code(testTwoFactorSecret, time.Now())
+	suite.NoError(err)
+
+	form := url.Values{"code": {code}}
+	ctx, recorder := suite.newContext(http.MethodPost, "auth/2fa", []byte(form.Encode()), "application/x-www-form-urlencoded")
+	s := sessions.Default(ctx)
+	s.Set(sessionTwoFactorUserID, user.ID)
+
+	suite.authModule.TwoFactorPOSTHandler(ctx)
+
+	suite.Equal(http.StatusFound, ctx.Writer.Status())
+	suite.Equal("/oauth/authorize", recorder.Header().Get("Location"))
+	suite.Equal(user.ID, s.Get(sessionUserID))
+	suite.Nil(s.Get(sessionTwoFactorUserID))
+}
+
+func (suite *TwoFactorTestSuite) TestTwoFactorPOSTWrongCode() {
+	user := suite.testUsers["local_account_1"]
+	suite.enableTwoFactor(user)
+
+	form := url.Values{"code": {"123456"}}
+	ctx, recorder := suite.newContext(http.MethodPost, "auth/2fa", []byte(form.Encode()), "application/x-www-form-urlencoded")
+	s := sessions.Default(ctx)
+	s.Set(sessionTwoFactorUserID, user.ID)
+
+	suite.authModule.TwoFactorPOSTHandler(ctx)
+
+	// Can try again.
+	suite.Equal(http.StatusUnauthorized, recorder.Code)
+	suite.Nil(s.Get(sessionUserID))
+	suite.Equal(user.ID, s.Get(sessionTwoFactorUserID))
+	suite.Equal(1, s.Get(sessionTwoFactorAttempts))
+}
+
+func (suite *TwoFactorTestSuite) TestTwoFactorPOSTTooManyAttempts() {
+	user := suite.testUsers["local_account_1"]
+	suite.enableTwoFactor(user)
+
+	form := url.Values{"code": {"123456"}}
+	ctx, recorder := suite.newContext(http.MethodPost, "auth/2fa", []byte(form.Encode()), "application/x-www-form-urlencoded")
+	s := sessions.Default(ctx)
+	s.Set(sessionTwoFactorUserID, user.ID)
+	s.Set(sessionTwoFactorAttempts, 4)
+
+	suite.authModule.TwoFactorPOSTHandler(ctx)
+
+	// Has to start over.
+	suite.Equal(http.StatusUnauthorized, recorder.Code)
+	suite.Nil(s.Get(sessionUserID))
+	suite.Nil(s.Get(sessionTwoFactorUserID))
+}
+
+func (suite *TwoFactorTestSuite) TestTwoFactorPOSTLockedOut() {
+	user := new(gtsmodel.User)
+	*user = *suite.testUsers["local_account_1"]
+	suite.enableTwoFactor(user)
+
+	user.TwoFactorLockedUntil = time.Now().Add(time.Hour)
+	if err := suite.db.UpdateUser(context.Background(), user, "two_factor_locked_until"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Even a correct code shouldn't work.
+	code, err := totp.Code(testTwoFactorSecret, time.Now())
+	suite.NoError(err)
+
+	form := url.Values{"code": {code}}
+	ctx, recorder := suite.newContext(http.MethodPost, "auth/2fa", []byte(form.Encode()), "application/x-www-form-urlencoded")
+	s := sessions.Default(ctx)
+	s.Set(sessionTwoFactorUserID, user.ID)
+
+	suite.authModule.TwoFactorPOSTHandler(ctx)
+
+	// Has to start over.
+	suite.Equal(http.StatusTooManyRequests, recorder.Code)
+	suite.Nil(s.Get(sessionUserID))
+	suite.Nil(s.Get(sessionTwoFactorUserID))
+}
+
+func TestTwoFactorTestSuite(t *testing.T) {
+	suite.Run(t, &TwoFactorTestSuite{})
+}
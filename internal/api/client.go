@@ -23,25 +23,32 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/accounts"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/announcements"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/apps"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/blocks"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/bookmarks"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/customemojis"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/directory"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/domainblocks"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/favourites"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/featuredtags"
 	filter "github.com/superseriousbusiness/gotosocial/internal/api/client/filters"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/followrequests"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/instance"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/invites"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/lists"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/markers"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/media"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/notifications"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/preferences"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/push"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/reports"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/search"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/statuses"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/streaming"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/suggestions"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/timelines"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/trends"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/user"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/middleware"
@@ -55,25 +62,32 @@ type Client struct {
 
 	accounts       *accounts.Module       // api/v1/accounts
 	admin          *admin.Module          // api/v1/admin
+	announcements  *announcements.Module  // api/v1/announcements
 	apps           *apps.Module           // api/v1/apps
 	blocks         *blocks.Module         // api/v1/blocks
 	bookmarks      *bookmarks.Module      // api/v1/bookmarks
 	customEmojis   *customemojis.Module   // api/v1/custom_emojis
+	directory      *directory.Module      // api/v1/directory
+	domainBlocks   *domainblocks.Module   // api/v1/domain_blocks
 	favourites     *favourites.Module     // api/v1/favourites
 	featuredTags   *featuredtags.Module   // api/v1/featured_tags
 	filters        *filter.Module         // api/v1/filters
 	followRequests *followrequests.Module // api/v1/follow_requests
 	instance       *instance.Module       // api/v1/instance
+	invites        *invites.Module        // api/v1/invites
 	lists          *lists.Module          // api/v1/lists
 	markers        *markers.Module        // api/v1/markers
 	media          *media.Module          // api/v1/media, api/v2/media
 	notifications  *notifications.Module  // api/v1/notifications
 	preferences    *preferences.Module    // api/v1/preferences
+	push           *push.Module           // api/v1/push
 	reports        *reports.Module        // api/v1/reports
 	search         *search.Module         // api/v1/search, api/v2/search
 	statuses       *statuses.Module       // api/v1/statuses
 	streaming      *streaming.Module      // api/v1/streaming
+	suggestions    *suggestions.Module    // api/v1/suggestions, api/v2/suggestions
 	timelines      *timelines.Module      // api/v1/timelines
+	trends         *trends.Module         // api/v1/trends
 	user           *user.Module           // api/v1/user
 }
 
@@ -96,25 +110,32 @@ func (c *Client) Route(r router.Router, m ...gin.HandlerFunc) {
 	h := apiGroup.Handle
 	c.accounts.Route(h)
 	c.admin.Route(h)
+	c.announcements.Route(h)
 	c.apps.Route(h)
 	c.blocks.Route(h)
 	c.bookmarks.Route(h)
 	c.customEmojis.Route(h)
+	c.directory.Route(h)
+	c.domainBlocks.Route(h)
 	c.favourites.Route(h)
 	c.featuredTags.Route(h)
 	c.filters.Route(h)
 	c.followRequests.Route(h)
 	c.instance.Route(h)
+	c.invites.Route(h)
 	c.lists.Route(h)
 	c.markers.Route(h)
 	c.media.Route(h)
 	c.notifications.Route(h)
 	c.preferences.Route(h)
+	c.push.Route(h)
 	c.reports.Route(h)
 	c.search.Route(h)
 	c.statuses.Route(h)
 	c.streaming.Route(h)
+	c.suggestions.Route(h)
 	c.timelines.Route(h)
+	c.trends.Route(h)
 	c.user.Route(h)
 }
 
@@ -125,25 +146,32 @@ func NewClient(db db.DB, p *processing.Processor) *Client {
 
 		accounts:       accounts.New(p),
 		admin:          admin.New(p),
+		announcements:  announcements.New(p),
 		apps:           apps.New(p),
 		blocks:         blocks.New(p),
 		bookmarks:      bookmarks.New(p),
 		customEmojis:   customemojis.New(p),
+		directory:      directory.New(p),
+		domainBlocks:   domainblocks.New(p),
 		favourites:     favourites.New(p),
 		featuredTags:   featuredtags.New(p),
 		filters:        filter.New(p),
 		followRequests: followrequests.New(p),
 		instance:       instance.New(p),
+		invites:        invites.New(p),
 		lists:          lists.New(p),
 		markers:        markers.New(p),
 		media:          media.New(p),
 		notifications:  notifications.New(p),
 		preferences:    preferences.New(p),
+		push:           push.New(p),
 		reports:        reports.New(p),
 		search:         search.New(p),
 		statuses:       statuses.New(p),
 		streaming:      streaming.New(p, time.Second*30, 4096),
+		suggestions:    suggestions.New(p),
 		timelines:      timelines.New(p),
+		trends:         trends.New(p),
 		user:           user.New(p),
 	}
 }
@@ -117,7 +117,10 @@ func validateCreateAccount(form *apimodel.AccountCreateRequest) error {
 		return errors.New("form was nil")
 	}
 
-	if !config.GetAccountsRegistrationOpen() {
+	// An invite lets people sign up even when
+	// registration is otherwise closed; the
+	// invite itself is checked by the processor.
+	if !config.GetAccountsRegistrationOpen() && form.InviteCode == "" {
 		return errors.New("registration is not open for this server")
 	}
 
@@ -21,6 +21,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -67,45 +69,45 @@ func New(processor *processing.Processor) *Module {
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	// create account
-	attachHandler(http.MethodPost, BasePath, m.AccountCreatePOSTHandler)
+	attachHandler(http.MethodPost, BasePath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteAccounts), m.AccountCreatePOSTHandler)
 
 	// get account
-	attachHandler(http.MethodGet, BasePathWithID, m.AccountGETHandler)
+	attachHandler(http.MethodGet, BasePathWithID, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadAccounts), m.AccountGETHandler)
 
 	// delete account
-	attachHandler(http.MethodPost, DeletePath, m.AccountDeletePOSTHandler)
+	attachHandler(http.MethodPost, DeletePath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteAccounts), m.AccountDeletePOSTHandler)
 
 	// verify account
-	attachHandler(http.MethodGet, VerifyPath, m.AccountVerifyGETHandler)
+	attachHandler(http.MethodGet, VerifyPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadAccounts, oauth.ScopeProfile), m.AccountVerifyGETHandler)
 
 	// modify account
-	attachHandler(http.MethodPatch, UpdatePath, m.AccountUpdateCredentialsPATCHHandler)
+	attachHandler(http.MethodPatch, UpdatePath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteAccounts), m.AccountUpdateCredentialsPATCHHandler)
 
 	// get account's statuses
-	attachHandler(http.MethodGet, StatusesPath, m.AccountStatusesGETHandler)
+	attachHandler(http.MethodGet, StatusesPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadStatuses), m.AccountStatusesGETHandler)
 
 	// get following or followers
-	attachHandler(http.MethodGet, FollowersPath, m.AccountFollowersGETHandler)
-	attachHandler(http.MethodGet, FollowingPath, m.AccountFollowingGETHandler)
+	attachHandler(http.MethodGet, FollowersPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadAccounts), m.AccountFollowersGETHandler)
+	attachHandler(http.MethodGet, FollowingPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadAccounts), m.AccountFollowingGETHandler)
 
 	// get relationship with account
-	attachHandler(http.MethodGet, RelationshipsPath, m.AccountRelationshipsGETHandler)
+	attachHandler(http.MethodGet, RelationshipsPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadFollows), m.AccountRelationshipsGETHandler)
 
 	// follow or unfollow account
-	attachHandler(http.MethodPost, FollowPath, m.AccountFollowPOSTHandler)
-	attachHandler(http.MethodPost, UnfollowPath, m.AccountUnfollowPOSTHandler)
+	attachHandler(http.MethodPost, FollowPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteFollows), m.AccountFollowPOSTHandler)
+	attachHandler(http.MethodPost, UnfollowPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteFollows), m.AccountUnfollowPOSTHandler)
 
 	// block or unblock account
-	attachHandler(http.MethodPost, BlockPath, m.AccountBlockPOSTHandler)
-	attachHandler(http.MethodPost, UnblockPath, m.AccountUnblockPOSTHandler)
+	attachHandler(http.MethodPost, BlockPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteBlocks), m.AccountBlockPOSTHandler)
+	attachHandler(http.MethodPost, UnblockPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteBlocks), m.AccountUnblockPOSTHandler)
 
 	// account lists
-	attachHandler(http.MethodGet, ListsPath, m.AccountListsGETHandler)
+	attachHandler(http.MethodGet, ListsPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadLists), m.AccountListsGETHandler)
 
 	// account note
-	attachHandler(http.MethodPost, NotePath, m.AccountNotePOSTHandler)
+	attachHandler(http.MethodPost, NotePath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteAccounts), m.AccountNotePOSTHandler)
 
 	// search for accounts
-	attachHandler(http.MethodGet, SearchPath, m.AccountSearchGETHandler)
-	attachHandler(http.MethodGet, LookupPath, m.AccountLookupGETHandler)
+	attachHandler(http.MethodGet, SearchPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadAccounts), m.AccountSearchGETHandler)
+	attachHandler(http.MethodGet, LookupPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadAccounts), m.AccountLookupGETHandler)
 }
@@ -21,26 +21,48 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
 const (
-	BasePath               = "/v1/admin"
-	EmojiPath              = BasePath + "/custom_emojis"
-	EmojiPathWithID        = EmojiPath + "/:" + IDKey
-	EmojiCategoriesPath    = EmojiPath + "/categories"
-	DomainBlocksPath       = BasePath + "/domain_blocks"
-	DomainBlocksPathWithID = DomainBlocksPath + "/:" + IDKey
-	AccountsPath           = BasePath + "/accounts"
-	AccountsPathWithID     = AccountsPath + "/:" + IDKey
-	AccountsActionPath     = AccountsPathWithID + "/action"
-	MediaCleanupPath       = BasePath + "/media_cleanup"
-	MediaRefetchPath       = BasePath + "/media_refetch"
-	ReportsPath            = BasePath + "/reports"
-	ReportsPathWithID      = ReportsPath + "/:" + IDKey
-	ReportsResolvePath     = ReportsPathWithID + "/resolve"
-	EmailPath              = BasePath + "/email"
-	EmailTestPath          = EmailPath + "/test"
+	BasePath                    = "/v1/admin"
+	AnnouncementsPath           = BasePath + "/announcements"
+	AnnouncementsPathWithID     = AnnouncementsPath + "/:" + IDKey
+	EmojiPath                   = BasePath + "/custom_emojis"
+	EmojiPathWithID             = EmojiPath + "/:" + IDKey
+	EmojiCategoriesPath         = EmojiPath + "/categories"
+	DomainBlocksPath            = BasePath + "/domain_blocks"
+	DomainBlocksPathWithID      = DomainBlocksPath + "/:" + IDKey
+	EmailDomainBlocksPath       = BasePath + "/email_domain_blocks"
+	EmailDomainBlocksPathWithID = EmailDomainBlocksPath + "/:" + IDKey
+	IPBlocksPath                = BasePath + "/ip_blocks"
+	IPBlocksPathWithID          = IPBlocksPath + "/:" + IDKey
+	AccountsPath                = BasePath + "/accounts"
+	AccountsPathWithID          = AccountsPath + "/:" + IDKey
+	AccountsActionPath          = AccountsPathWithID + "/action"
+	MediaCleanupPath            = BasePath + "/media_cleanup"
+	MediaRefetchPath            = BasePath + "/media_refetch"
+	ReportsPath                 = BasePath + "/reports"
+	ReportsPathWithID           = ReportsPath + "/:" + IDKey
+	ReportsResolvePath          = ReportsPathWithID + "/resolve"
+	EmailPath                   = BasePath + "/email"
+	EmailTestPath               = EmailPath + "/test"
+	RulesPath                   = BasePath + "/rules"
+	RulesPathWithID             = RulesPath + "/:" + IDKey
+	SuggestionsPath             = BasePath + "/suggestions"
+	SuggestionsPathWithID       = SuggestionsPath + "/:" + IDKey
+	TrendsPath                  = BasePath + "/trends"
+	TrendsTagsPath              = TrendsPath + "/tags"
+	TrendsTagApprovePath        = TrendsTagsPath + "/:" + IDKey + "/approve"
+	TrendsTagRejectPath         = TrendsTagsPath + "/:" + IDKey + "/reject"
+	TrendsStatusesPath          = TrendsPath + "/statuses"
+	TrendsStatusApprovePath     = TrendsStatusesPath + "/:" + IDKey + "/approve"
+	TrendsStatusRejectPath      = TrendsStatusesPath + "/:" + IDKey + "/reject"
+	TrendsLinksPath             = TrendsPath + "/links"
+	TrendsLinkApprovePath       = TrendsLinksPath + "/:" + IDKey + "/approve"
+	TrendsLinkRejectPath        = TrendsLinksPath + "/:" + IDKey + "/reject"
 
 	IDKey                 = "id"
 	FilterQueryKey        = "filter"
@@ -54,6 +76,8 @@ const (
 	MaxIDKey              = "max_id"
 	SinceIDKey            = "since_id"
 	MinIDKey              = "min_id"
+	OffsetKey             = "offset"
+	StateKey              = "state"
 )
 
 type Module struct {
@@ -67,32 +91,74 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	// announcements stuff
+	attachHandler(http.MethodGet, AnnouncementsPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminRead), m.AnnouncementsGETHandler)
+	attachHandler(http.MethodGet, AnnouncementsPathWithID, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminRead), m.AnnouncementGETHandler)
+	attachHandler(http.MethodPost, AnnouncementsPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWrite), m.AnnouncementPOSTHandler)
+	attachHandler(http.MethodPatch, AnnouncementsPathWithID, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWrite), m.AnnouncementPATCHHandler)
+	attachHandler(http.MethodDelete, AnnouncementsPathWithID, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWrite), m.AnnouncementDELETEHandler)
+
 	// emoji stuff
-	attachHandler(http.MethodPost, EmojiPath, m.EmojiCreatePOSTHandler)
-	attachHandler(http.MethodGet, EmojiPath, m.EmojisGETHandler)
-	attachHandler(http.MethodDelete, EmojiPathWithID, m.EmojiDELETEHandler)
-	attachHandler(http.MethodGet, EmojiPathWithID, m.EmojiGETHandler)
-	attachHandler(http.MethodPatch, EmojiPathWithID, m.EmojiPATCHHandler)
-	attachHandler(http.MethodGet, EmojiCategoriesPath, m.EmojiCategoriesGETHandler)
+	attachHandler(http.MethodPost, EmojiPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWrite), m.EmojiCreatePOSTHandler)
+	attachHandler(http.MethodGet, EmojiPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminRead), m.EmojisGETHandler)
+	attachHandler(http.MethodDelete, EmojiPathWithID, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWrite), m.EmojiDELETEHandler)
+	attachHandler(http.MethodGet, EmojiPathWithID, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminRead), m.EmojiGETHandler)
+	attachHandler(http.MethodPatch, EmojiPathWithID, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWrite), m.EmojiPATCHHandler)
+	attachHandler(http.MethodGet, EmojiCategoriesPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminRead), m.EmojiCategoriesGETHandler)
 
 	// domain block stuff
-	attachHandler(http.MethodPost, DomainBlocksPath, m.DomainBlocksPOSTHandler)
-	attachHandler(http.MethodGet, DomainBlocksPath, m.DomainBlocksGETHandler)
-	attachHandler(http.MethodGet, DomainBlocksPathWithID, m.DomainBlockGETHandler)
-	attachHandler(http.MethodDelete, DomainBlocksPathWithID, m.DomainBlockDELETEHandler)
+	attachHandler(http.MethodPost, DomainBlocksPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWriteDomainBlocks), m.DomainBlocksPOSTHandler)
+	attachHandler(http.MethodGet, DomainBlocksPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminReadDomainBlocks), m.DomainBlocksGETHandler)
+	attachHandler(http.MethodGet, DomainBlocksPathWithID, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminReadDomainBlocks), m.DomainBlockGETHandler)
+	attachHandler(http.MethodDelete, DomainBlocksPathWithID, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWriteDomainBlocks), m.DomainBlockDELETEHandler)
+
+	// email domain block stuff
+	attachHandler(http.MethodPost, EmailDomainBlocksPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWriteEmailDomainBlocks), m.EmailDomainBlocksPOSTHandler)
+	attachHandler(http.MethodGet, EmailDomainBlocksPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminReadEmailDomainBlocks), m.EmailDomainBlocksGETHandler)
+	attachHandler(http.MethodGet, EmailDomainBlocksPathWithID, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminReadEmailDomainBlocks), m.EmailDomainBlockGETHandler)
+	attachHandler(http.MethodDelete, EmailDomainBlocksPathWithID, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWriteEmailDomainBlocks), m.EmailDomainBlockDELETEHandler)
+
+	// ip block stuff
+	attachHandler(http.MethodPost, IPBlocksPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWriteIPBlocks), m.IPBlocksPOSTHandler)
+	attachHandler(http.MethodGet, IPBlocksPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminReadIPBlocks), m.IPBlocksGETHandler)
+	attachHandler(http.MethodGet, IPBlocksPathWithID, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminReadIPBlocks), m.IPBlockGETHandler)
+	attachHandler(http.MethodDelete, IPBlocksPathWithID, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWriteIPBlocks), m.IPBlockDELETEHandler)
 
 	// accounts stuff
-	attachHandler(http.MethodPost, AccountsActionPath, m.AccountActionPOSTHandler)
+	attachHandler(http.MethodPost, AccountsActionPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWriteAccounts), m.AccountActionPOSTHandler)
 
 	// media stuff
-	attachHandler(http.MethodPost, MediaCleanupPath, m.MediaCleanupPOSTHandler)
-	attachHandler(http.MethodPost, MediaRefetchPath, m.MediaRefetchPOSTHandler)
+	attachHandler(http.MethodPost, MediaCleanupPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWrite), m.MediaCleanupPOSTHandler)
+	attachHandler(http.MethodPost, MediaRefetchPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWrite), m.MediaRefetchPOSTHandler)
 
 	// reports stuff
-	attachHandler(http.MethodGet, ReportsPath, m.ReportsGETHandler)
-	attachHandler(http.MethodGet, ReportsPathWithID, m.ReportGETHandler)
-	attachHandler(http.MethodPost, ReportsResolvePath, m.ReportResolvePOSTHandler)
+	attachHandler(http.MethodGet, ReportsPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminReadReports), m.ReportsGETHandler)
+	attachHandler(http.MethodGet, ReportsPathWithID, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminReadReports), m.ReportGETHandler)
+	attachHandler(http.MethodPost, ReportsResolvePath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWriteReports), m.ReportResolvePOSTHandler)
 
 	// email stuff
-	attachHandler(http.MethodPost, EmailTestPath, m.EmailTestPOSTHandler)
+	attachHandler(http.MethodPost, EmailTestPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWrite), m.EmailTestPOSTHandler)
+
+	// rules stuff
+	attachHandler(http.MethodGet, RulesPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminRead), m.RulesGETHandler)
+	attachHandler(http.MethodGet, RulesPathWithID, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminRead), m.RuleGETHandler)
+	attachHandler(http.MethodPost, RulesPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWrite), m.RulePOSTHandler)
+	attachHandler(http.MethodPatch, RulesPathWithID, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWrite), m.RulePATCHHandler)
+	attachHandler(http.MethodDelete, RulesPathWithID, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWrite), m.RuleDELETEHandler)
+
+	// suggestions stuff
+	attachHandler(http.MethodGet, SuggestionsPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminRead), m.SuggestionsGETHandler)
+	attachHandler(http.MethodPost, SuggestionsPathWithID, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWrite), m.SuggestionPOSTHandler)
+	attachHandler(http.MethodDelete, SuggestionsPathWithID, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWrite), m.SuggestionDELETEHandler)
+
+	// trends stuff
+	attachHandler(http.MethodGet, TrendsTagsPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminRead), m.TrendsTagsGETHandler)
+	attachHandler(http.MethodPost, TrendsTagApprovePath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWrite), m.TrendsTagApprovePOSTHandler)
+	attachHandler(http.MethodPost, TrendsTagRejectPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWrite), m.TrendsTagRejectPOSTHandler)
+	attachHandler(http.MethodGet, TrendsStatusesPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminRead), m.TrendsStatusesGETHandler)
+	attachHandler(http.MethodPost, TrendsStatusApprovePath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWrite), m.TrendsStatusApprovePOSTHandler)
+	attachHandler(http.MethodPost, TrendsStatusRejectPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWrite), m.TrendsStatusRejectPOSTHandler)
+	attachHandler(http.MethodGet, TrendsLinksPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminRead), m.TrendsLinksGETHandler)
+	attachHandler(http.MethodPost, TrendsLinkApprovePath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWrite), m.TrendsLinkApprovePOSTHandler)
+	attachHandler(http.MethodPost, TrendsLinkRejectPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeAdminWrite), m.TrendsLinkRejectPOSTHandler)
 }
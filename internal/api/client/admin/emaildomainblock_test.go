@@ -0,0 +1,141 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type EmailDomainBlockTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *EmailDomainBlockTestSuite) createEmailDomainBlock(domain string) (*apimodel.EmailDomainBlock, int, string) {
+	recorder := httptest.NewRecorder()
+	body, err := json.Marshal(&apimodel.EmailDomainBlockCreateRequest{Domain: domain})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	ctx := suite.newContext(recorder, http.MethodPost, body, admin.EmailDomainBlocksPath, "application/json")
+	suite.adminModule.EmailDomainBlocksPOSTHandler(ctx)
+
+	b, err := io.ReadAll(recorder.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if recorder.Code != http.StatusOK {
+		return nil, recorder.Code, string(b)
+	}
+
+	emailDomainBlock := &apimodel.EmailDomainBlock{}
+	if err := json.Unmarshal(b, emailDomainBlock); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return emailDomainBlock, recorder.Code, string(b)
+}
+
+func (suite *EmailDomainBlockTestSuite) TestCreateEmailDomainBlock() {
+	emailDomainBlock, code, _ := suite.createEmailDomainBlock("@Spam.Example.org")
+	suite.Equal(http.StatusOK, code)
+	suite.NotEmpty(emailDomainBlock.ID)
+	suite.Equal("spam.example.org", emailDomainBlock.Domain)
+	suite.Equal(suite.testAccounts["admin_account"].ID, emailDomainBlock.CreatedBy)
+
+	// Subdomains are blocked too, parent domains are not.
+	for domain, expected := range map[string]bool{
+		"spam.example.org":      true,
+		"mail.spam.example.org": true,
+		"example.org":           false,
+		"notspam.example.org":   false,
+	} {
+		blocked, err := suite.db.IsEmailDomainBlocked(context.Background(), domain)
+		suite.NoError(err)
+		suite.Equal(expected, blocked, domain)
+	}
+
+	// Creating the same block again gives back the existing one.
+	again, code, _ := suite.createEmailDomainBlock("spam.example.org")
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(emailDomainBlock.ID, again.ID)
+}
+
+func (suite *EmailDomainBlockTestSuite) TestCreateEmailDomainBlockInvalid() {
+	for _, domain := range []string{"", "someone@example.org", "https://example.org"} {
+		_, code, b := suite.createEmailDomainBlock(domain)
+		suite.Equal(http.StatusBadRequest, code, domain)
+		suite.Contains(b, "invalid email domain", domain)
+	}
+}
+
+func (suite *EmailDomainBlockTestSuite) TestGetAndDeleteEmailDomainBlock() {
+	emailDomainBlock, code, _ := suite.createEmailDomainBlock("spam.example.org")
+	suite.Equal(http.StatusOK, code)
+
+	// List.
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.EmailDomainBlocksPath, "")
+	suite.adminModule.EmailDomainBlocksGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	emailDomainBlocks := []*apimodel.EmailDomainBlock{}
+	if err := json.NewDecoder(recorder.Body).Decode(&emailDomainBlocks); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(emailDomainBlocks, 1)
+	suite.Equal(emailDomainBlock.ID, emailDomainBlocks[0].ID)
+
+	// Get by ID.
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodGet, nil, admin.EmailDomainBlocksPathWithID, "")
+	ctx.AddParam(admin.IDKey, emailDomainBlock.ID)
+	suite.adminModule.EmailDomainBlockGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	// Delete.
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodDelete, nil, admin.EmailDomainBlocksPathWithID, "")
+	ctx.AddParam(admin.IDKey, emailDomainBlock.ID)
+	suite.adminModule.EmailDomainBlockDELETEHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	blocked, err := suite.db.IsEmailDomainBlocked(context.Background(), "spam.example.org")
+	suite.NoError(err)
+	suite.False(blocked)
+
+	// Gone now.
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodGet, nil, admin.EmailDomainBlocksPathWithID, "")
+	ctx.AddParam(admin.IDKey, emailDomainBlock.ID)
+	suite.adminModule.EmailDomainBlockGETHandler(ctx)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func TestEmailDomainBlockTestSuite(t *testing.T) {
+	suite.Run(t, &EmailDomainBlockTestSuite{})
+}
@@ -0,0 +1,179 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type IPBlockTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *IPBlockTestSuite) createIPBlock(body string) (*apimodel.IPBlock, int, string) {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, []byte(body), admin.IPBlocksPath, "application/json")
+
+	suite.adminModule.IPBlocksPOSTHandler(ctx)
+
+	b, err := io.ReadAll(recorder.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if recorder.Code != http.StatusOK {
+		return nil, recorder.Code, string(b)
+	}
+
+	ipBlock := &apimodel.IPBlock{}
+	if err := json.Unmarshal(b, ipBlock); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return ipBlock, recorder.Code, string(b)
+}
+
+func (suite *IPBlockTestSuite) TestCreateIPBlockSingleAddress() {
+	ipBlock, code, _ := suite.createIPBlock(`{"ip":"192.0.2.7","severity":"sign_up_block","comment":"spam sign-ups"}`)
+	suite.Equal(http.StatusOK, code)
+	suite.NotEmpty(ipBlock.ID)
+	suite.Equal("192.0.2.7/32", ipBlock.IP)
+	suite.Equal("sign_up_block", ipBlock.Severity)
+	suite.Equal("spam sign-ups", ipBlock.Comment)
+	suite.Nil(ipBlock.ExpiresAt)
+
+	// Sign-ups from the address should now be blocked, but not access.
+	addr := netip.MustParseAddr("192.0.2.7")
+	blocked, err := suite.db.IsIPBlocked(context.Background(), addr, gtsmodel.IPBlockSeveritySignUpBlock)
+	suite.NoError(err)
+	suite.True(blocked)
+
+	blocked, err = suite.db.IsIPBlocked(context.Background(), addr, gtsmodel.IPBlockSeverityNoAccess)
+	suite.NoError(err)
+	suite.False(blocked)
+}
+
+func (suite *IPBlockTestSuite) TestCreateIPBlockRange() {
+	ipBlock, code, _ := suite.createIPBlock(`{"ip":"198.51.100.77/24","severity":"no_access","expires_in":3600}`)
+	suite.Equal(http.StatusOK, code)
+	suite.Equal("198.51.100.0/24", ipBlock.IP)
+	suite.Equal("no_access", ipBlock.Severity)
+	suite.NotNil(ipBlock.ExpiresAt)
+
+	// No access implies no sign-ups either.
+	for _, severity := range []gtsmodel.IPBlockSeverity{
+		gtsmodel.IPBlockSeveritySignUpBlock,
+		gtsmodel.IPBlockSeverityNoAccess,
+	} {
+		blocked, err := suite.db.IsIPBlocked(context.Background(), netip.MustParseAddr("198.51.100.200"), severity)
+		suite.NoError(err)
+		suite.True(blocked)
+	}
+
+	blocked, err := suite.db.IsIPBlocked(context.Background(), netip.MustParseAddr("198.51.101.1"), gtsmodel.IPBlockSeverityNoAccess)
+	suite.NoError(err)
+	suite.False(blocked)
+}
+
+func (suite *IPBlockTestSuite) TestCreateIPBlockIPv6() {
+	ipBlock, code, _ := suite.createIPBlock(`{"ip":"2001:db8::1/32","severity":"no_access"}`)
+	suite.Equal(http.StatusOK, code)
+	suite.Equal("2001:db8::/32", ipBlock.IP)
+
+	blocked, err := suite.db.IsIPBlocked(context.Background(), netip.MustParseAddr("2001:db8:ffff::1"), gtsmodel.IPBlockSeverityNoAccess)
+	suite.NoError(err)
+	suite.True(blocked)
+}
+
+func (suite *IPBlockTestSuite) TestCreateIPBlockInvalid() {
+	for body, expected := range map[string]string{
+		`{"ip":"not an ip","severity":"no_access"}`:                    `invalid ip`,
+		`{"ip":"192.0.2.0/24","severity":"block_it_all"}`:              `severity must be one of`,
+		`{"ip":"192.0.2.0/24","severity":"no_access","expires_in":-1}`: `expires_in must not be negative`,
+	} {
+		_, code, b := suite.createIPBlock(body)
+		suite.Equal(http.StatusBadRequest, code, body)
+		suite.Contains(b, expected, body)
+	}
+}
+
+func (suite *IPBlockTestSuite) TestCreateIPBlockConflict() {
+	_, code, _ := suite.createIPBlock(`{"ip":"192.0.2.0/24","severity":"sign_up_block"}`)
+	suite.Equal(http.StatusOK, code)
+
+	// Same range written differently.
+	_, code, _ = suite.createIPBlock(`{"ip":"192.0.2.128/24","severity":"no_access"}`)
+	suite.Equal(http.StatusConflict, code)
+}
+
+func (suite *IPBlockTestSuite) TestGetAndDeleteIPBlock() {
+	ipBlock, code, _ := suite.createIPBlock(`{"ip":"192.0.2.0/24","severity":"no_access"}`)
+	suite.Equal(http.StatusOK, code)
+
+	// List.
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.IPBlocksPath, "")
+	suite.adminModule.IPBlocksGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	ipBlocks := []*apimodel.IPBlock{}
+	if err := json.NewDecoder(recorder.Body).Decode(&ipBlocks); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(ipBlocks, 1)
+	suite.Equal(ipBlock.ID, ipBlocks[0].ID)
+
+	// Get by ID.
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodGet, nil, admin.IPBlocksPathWithID, "")
+	ctx.AddParam(admin.IDKey, ipBlock.ID)
+	suite.adminModule.IPBlockGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	// Delete.
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodDelete, nil, admin.IPBlocksPathWithID, "")
+	ctx.AddParam(admin.IDKey, ipBlock.ID)
+	suite.adminModule.IPBlockDELETEHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	blocked, err := suite.db.IsIPBlocked(context.Background(), netip.MustParseAddr("192.0.2.1"), gtsmodel.IPBlockSeverityNoAccess)
+	suite.NoError(err)
+	suite.False(blocked)
+
+	// Gone now.
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodGet, nil, admin.IPBlocksPathWithID, "")
+	ctx.AddParam(admin.IDKey, ipBlock.ID)
+	suite.adminModule.IPBlockGETHandler(ctx)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func TestIPBlockTestSuite(t *testing.T) {
+	suite.Run(t, &IPBlockTestSuite{})
+}
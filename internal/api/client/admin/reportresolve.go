@@ -31,7 +31,7 @@ import (
 
 // ReportResolvePOSTHandler swagger:operation POST /api/v1/admin/reports/{id}/resolve adminReportResolve
 //
-// Mark a report as resolved.
+// Mark a report as resolved, optionally taking moderation action against the reported account.
 //
 //	---
 //	tags:
@@ -62,10 +62,44 @@ import (
 //			that created the report!
 //		type: string
 //		example: The reported account was suspended.
+//	-
+//		name: delete_statuses
+//		in: formData
+//		description: Delete the statuses attached to the report.
+//		type: boolean
+//		default: false
+//	-
+//		name: mark_statuses_sensitive
+//		in: formData
+//		description: >-
+//			Mark media attached to the statuses of the report as sensitive.
+//			Cannot be used together with delete_statuses. If the statuses
+//			are local, the change is sent out to followers of their author.
+//		type: boolean
+//		default: false
+//	-
+//		name: account_action
+//		in: formData
+//		description: Action to take against the reported account.
+//		type: string
+//		enum:
+//		- none
+//		- silence
+//		- suspend
+//		default: none
+//	-
+//		name: send_warning
+//		in: formData
+//		description: >-
+//			Send a warning to the reported account, even if no other action is taken.
+//			If the reported account is local, it will receive a moderation_warning
+//			notification whenever any action is taken against it.
+//		type: boolean
+//		default: false
 //
 //	security:
 //	- OAuth2 Bearer:
-//		- admin
+//		- admin:write:reports
 //
 //	responses:
 //		'200':
@@ -81,6 +115,8 @@ import (
 //			description: not found
 //		'406':
 //			description: not acceptable
+//		'409':
+//			description: conflict (report has already been resolved)
 //		'500':
 //			description: internal server error
 func (m *Module) ReportResolvePOSTHandler(c *gin.Context) {
@@ -114,7 +150,7 @@ func (m *Module) ReportResolvePOSTHandler(c *gin.Context) {
 		return
 	}
 
-	report, errWithCode := m.processor.Admin().ReportResolve(c.Request.Context(), authed.Account, reportID, form.ActionTakenComment)
+	report, errWithCode := m.processor.Admin().ReportResolve(c.Request.Context(), authed.Account, reportID, form)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
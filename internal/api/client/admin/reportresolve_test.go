@@ -18,6 +18,7 @@
 package admin_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -31,9 +32,11 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
@@ -49,7 +52,7 @@ func (suite *ReportResolveTestSuite) resolveReport(
 	targetReportID string,
 	expectedHTTPStatus int,
 	expectedBody string,
-	actionTakenComment *string,
+	form url.Values,
 ) (*apimodel.AdminReport, error) {
 	// instantiate recorder + test context
 	recorder := httptest.NewRecorder()
@@ -68,9 +71,7 @@ func (suite *ReportResolveTestSuite) resolveReport(
 	ctx.Request = httptest.NewRequest(http.MethodPost, requestURI, nil)
 	ctx.AddParam(admin.IDKey, targetReportID)
 	ctx.Request.Header.Set("accept", "application/json")
-	if actionTakenComment != nil {
-		ctx.Request.Form = url.Values{"action_taken_comment": {*actionTakenComment}}
-	}
+	ctx.Request.Form = form
 
 	// trigger the handler
 	suite.adminModule.ReportResolvePOSTHandler(ctx)
@@ -106,6 +107,28 @@ func (suite *ReportResolveTestSuite) resolveReport(
 	return resp, nil
 }
 
+// unresolveReport marks the given report as unresolved
+// in the db, so that it can be resolved by a test.
+func (suite *ReportResolveTestSuite) unresolveReport(report *gtsmodel.Report) *gtsmodel.Report {
+	unresolved := new(gtsmodel.Report)
+	*unresolved = *report
+	unresolved.ActionTaken = ""
+	unresolved.ActionTakenAt = time.Time{}
+	unresolved.ActionTakenByAccountID = ""
+
+	if _, err := suite.db.UpdateReport(
+		context.Background(),
+		unresolved,
+		"action_taken",
+		"action_taken_at",
+		"action_taken_by_account_id",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return unresolved
+}
+
 func (suite *ReportResolveTestSuite) TestReportResolve1() {
 	testAccount := suite.testAccounts["admin_account"]
 	testToken := suite.testTokens["admin_account"]
@@ -113,7 +136,7 @@ func (suite *ReportResolveTestSuite) TestReportResolve1() {
 	testReportID := suite.testReports["local_account_2_report_remote_account_1"].ID
 	var actionTakenComment *string = nil
 
-	report, err := suite.resolveReport(testAccount, testToken, testUser, testReportID, http.StatusOK, "", actionTakenComment)
+	report, err := suite.resolveReport(testAccount, testToken, testUser, testReportID, http.StatusOK, "", nil)
 	suite.NoError(err)
 	suite.NotEmpty(report)
 
@@ -141,7 +164,9 @@ func (suite *ReportResolveTestSuite) TestReportResolve2() {
 	testReportID := suite.testReports["local_account_2_report_remote_account_1"].ID
 	var actionTakenComment *string = testrig.StringPtr("no action was taken, this is a frivolous report you boob")
 
-	report, err := suite.resolveReport(testAccount, testToken, testUser, testReportID, http.StatusOK, "", actionTakenComment)
+	report, err := suite.resolveReport(testAccount, testToken, testUser, testReportID, http.StatusOK, "", url.Values{
+		"action_taken_comment": {*actionTakenComment},
+	})
 	suite.NoError(err)
 	suite.NotEmpty(report)
 
@@ -162,6 +187,127 @@ func (suite *ReportResolveTestSuite) TestReportResolve2() {
 	suite.EqualValues(report.AssignedAccount.ID, testAccount.ID)
 }
 
+func (suite *ReportResolveTestSuite) TestReportResolveMarkStatusesSensitive() {
+	testAccount := suite.testAccounts["admin_account"]
+	testToken := suite.testTokens["admin_account"]
+	testUser := suite.testUsers["admin_account"]
+	testReport := suite.testReports["local_account_2_report_remote_account_1"]
+
+	report, err := suite.resolveReport(testAccount, testToken, testUser, testReport.ID, http.StatusOK, "", url.Values{
+		"action_taken_comment":    {"media marked as sensitive"},
+		"mark_statuses_sensitive": {"true"},
+	})
+	suite.NoError(err)
+	suite.True(report.ActionTaken)
+
+	// reported status should now be sensitive
+	status, err := suite.db.GetStatusByID(context.Background(), testReport.StatusIDs[0])
+	suite.NoError(err)
+	suite.True(*status.Sensitive)
+
+	// action should be recorded and linked to the report
+	adminActions := []*gtsmodel.AdminAccountAction{}
+	err = suite.db.GetWhere(context.Background(), []db.Where{{Key: "report_id", Value: testReport.ID}}, &adminActions)
+	suite.NoError(err)
+	suite.Len(adminActions, 1)
+	suite.Equal(gtsmodel.AdminActionSensitive, adminActions[0].Type)
+	suite.Equal(testAccount.ID, adminActions[0].AccountID)
+	suite.Equal(testReport.TargetAccountID, adminActions[0].TargetAccountID)
+	suite.Equal(testReport.StatusIDs, adminActions[0].StatusIDs)
+	suite.Equal("media marked as sensitive", adminActions[0].Text)
+}
+
+func (suite *ReportResolveTestSuite) TestReportResolveSilenceLocalAccount() {
+	testAccount := suite.testAccounts["admin_account"]
+	testToken := suite.testTokens["admin_account"]
+	testUser := suite.testUsers["admin_account"]
+	testReport := suite.unresolveReport(suite.testReports["remote_account_1_report_local_account_2"])
+
+	report, err := suite.resolveReport(testAccount, testToken, testUser, testReport.ID, http.StatusOK, "", url.Values{
+		"action_taken_comment": {"you've been silenced for being a turtle"},
+		"account_action":       {"silence"},
+		"send_warning":         {"true"},
+	})
+	suite.NoError(err)
+	suite.True(report.ActionTaken)
+
+	// reported account should now be silenced
+	targetAccount, err := suite.db.GetAccountByID(context.Background(), testReport.TargetAccountID)
+	suite.NoError(err)
+	suite.WithinDuration(time.Now(), targetAccount.SilencedAt, 1*time.Minute)
+
+	// both the warning and the silence should be recorded
+	adminActions := []*gtsmodel.AdminAccountAction{}
+	err = suite.db.GetWhere(context.Background(), []db.Where{{Key: "report_id", Value: testReport.ID}}, &adminActions)
+	suite.NoError(err)
+	suite.Len(adminActions, 2)
+
+	// reported account should be warned about the silence
+	var notif *gtsmodel.Notification
+	if !testrig.WaitFor(func() bool {
+		notifs, err := suite.db.GetAccountNotifications(context.Background(), testReport.TargetAccountID, &paging.Pager{Limit: 20}, nil, nil, "")
+		if err != nil {
+			return false
+		}
+		for _, n := range notifs {
+			if n.NotificationType == gtsmodel.NotificationModerationWarning {
+				notif = n
+				return true
+			}
+		}
+		return false
+	}) {
+		suite.FailNow("timed out waiting for moderation warning notification")
+	}
+
+	adminAction := &gtsmodel.AdminAccountAction{}
+	err = suite.db.GetByID(context.Background(), notif.AdminActionID, adminAction)
+	suite.NoError(err)
+	suite.Equal(gtsmodel.AdminActionSilence, adminAction.Type)
+	suite.Equal(testReport.ID, adminAction.ReportID)
+}
+
+func (suite *ReportResolveTestSuite) TestReportResolveInvalidAccountAction() {
+	testAccount := suite.testAccounts["admin_account"]
+	testToken := suite.testTokens["admin_account"]
+	testUser := suite.testUsers["admin_account"]
+	testReportID := suite.testReports["local_account_2_report_remote_account_1"].ID
+
+	_, err := suite.resolveReport(testAccount, testToken, testUser, testReportID, http.StatusBadRequest, `{"error":"Bad Request: account_action yeet not recognized, valid options are 'none', 'silence', 'suspend'"}`, url.Values{
+		"account_action": {"yeet"},
+	})
+	suite.NoError(err)
+}
+
+func (suite *ReportResolveTestSuite) TestReportResolveDeleteNoStatuses() {
+	testAccount := suite.testAccounts["admin_account"]
+	testToken := suite.testTokens["admin_account"]
+	testUser := suite.testUsers["admin_account"]
+	testReportID := suite.unresolveReport(suite.testReports["remote_account_1_report_local_account_2"]).ID
+
+	_, err := suite.resolveReport(testAccount, testToken, testUser, testReportID, http.StatusBadRequest, `{"error":"Bad Request: report does not contain any statuses to delete or mark as sensitive"}`, url.Values{
+		"delete_statuses": {"true"},
+	})
+	suite.NoError(err)
+}
+
+func (suite *ReportResolveTestSuite) TestReportResolveAlreadyResolved() {
+	testAccount := suite.testAccounts["admin_account"]
+	testToken := suite.testTokens["admin_account"]
+	testUser := suite.testUsers["admin_account"]
+	testReport := suite.testReports["remote_account_1_report_local_account_2"]
+
+	_, err := suite.resolveReport(testAccount, testToken, testUser, testReport.ID, http.StatusConflict, `{"error":"Conflict: report has already been resolved"}`, url.Values{
+		"account_action": {"silence"},
+	})
+	suite.NoError(err)
+
+	// reported account should not have been silenced
+	targetAccount, err := suite.db.GetAccountByID(context.Background(), testReport.TargetAccountID)
+	suite.NoError(err)
+	suite.Zero(targetAccount.SilencedAt)
+}
+
 func TestReportResolveTestSuite(t *testing.T) {
 	suite.Run(t, &ReportResolveTestSuite{})
 }
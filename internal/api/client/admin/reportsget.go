@@ -102,7 +102,7 @@ import (
 //
 //	security:
 //	- OAuth2 Bearer:
-//		- admin
+//		- read:reports
 //
 //	responses:
 //		'200':
@@ -0,0 +1,333 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// TrendsTagApprovePOSTHandler swagger:operation POST /api/v1/admin/trends/tags/{id}/approve adminTrendsTagApprove
+//
+// Approve a trending tag, so that it is shown to users while it is trending.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		type: string
+//		description: The id of the trend.
+//		in: path
+//		required: true
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:write
+//
+//	responses:
+//		'200':
+//			description: The approved trend.
+//			schema:
+//				"$ref": "#/definitions/adminTrend"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) TrendsTagApprovePOSTHandler(c *gin.Context) {
+	m.trendReviewPOST(c, gtsmodel.TrendTypeTag, gtsmodel.TrendStateApproved)
+}
+
+// TrendsTagRejectPOSTHandler swagger:operation POST /api/v1/admin/trends/tags/{id}/reject adminTrendsTagReject
+//
+// Reject a trending tag, so that it is never shown to users.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		type: string
+//		description: The id of the trend.
+//		in: path
+//		required: true
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:write
+//
+//	responses:
+//		'200':
+//			description: The rejected trend.
+//			schema:
+//				"$ref": "#/definitions/adminTrend"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) TrendsTagRejectPOSTHandler(c *gin.Context) {
+	m.trendReviewPOST(c, gtsmodel.TrendTypeTag, gtsmodel.TrendStateRejected)
+}
+
+// TrendsStatusApprovePOSTHandler swagger:operation POST /api/v1/admin/trends/statuses/{id}/approve adminTrendsStatusApprove
+//
+// Approve a trending status, so that it is shown to users while it is trending.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		type: string
+//		description: The id of the trend.
+//		in: path
+//		required: true
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:write
+//
+//	responses:
+//		'200':
+//			description: The approved trend.
+//			schema:
+//				"$ref": "#/definitions/adminTrend"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) TrendsStatusApprovePOSTHandler(c *gin.Context) {
+	m.trendReviewPOST(c, gtsmodel.TrendTypeStatus, gtsmodel.TrendStateApproved)
+}
+
+// TrendsStatusRejectPOSTHandler swagger:operation POST /api/v1/admin/trends/statuses/{id}/reject adminTrendsStatusReject
+//
+// Reject a trending status, so that it is never shown to users.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		type: string
+//		description: The id of the trend.
+//		in: path
+//		required: true
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:write
+//
+//	responses:
+//		'200':
+//			description: The rejected trend.
+//			schema:
+//				"$ref": "#/definitions/adminTrend"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) TrendsStatusRejectPOSTHandler(c *gin.Context) {
+	m.trendReviewPOST(c, gtsmodel.TrendTypeStatus, gtsmodel.TrendStateRejected)
+}
+
+// TrendsLinkApprovePOSTHandler swagger:operation POST /api/v1/admin/trends/links/{id}/approve adminTrendsLinkApprove
+//
+// Approve a trending link, so that it is shown to users while it is trending.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		type: string
+//		description: The id of the trend.
+//		in: path
+//		required: true
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:write
+//
+//	responses:
+//		'200':
+//			description: The approved trend.
+//			schema:
+//				"$ref": "#/definitions/adminTrend"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) TrendsLinkApprovePOSTHandler(c *gin.Context) {
+	m.trendReviewPOST(c, gtsmodel.TrendTypeLink, gtsmodel.TrendStateApproved)
+}
+
+// TrendsLinkRejectPOSTHandler swagger:operation POST /api/v1/admin/trends/links/{id}/reject adminTrendsLinkReject
+//
+// Reject a trending link, so that it is never shown to users.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		type: string
+//		description: The id of the trend.
+//		in: path
+//		required: true
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:write
+//
+//	responses:
+//		'200':
+//			description: The rejected trend.
+//			schema:
+//				"$ref": "#/definitions/adminTrend"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) TrendsLinkRejectPOSTHandler(c *gin.Context) {
+	m.trendReviewPOST(c, gtsmodel.TrendTypeLink, gtsmodel.TrendStateRejected)
+}
+
+func (m *Module) trendReviewPOST(c *gin.Context, trendType gtsmodel.TrendType, state gtsmodel.TrendState) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	trendID := c.Param(IDKey)
+	if trendID == "" {
+		err := errors.New("no trend id specified")
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	review := m.processor.Admin().TrendReject
+	if state == gtsmodel.TrendStateApproved {
+		review = m.processor.Admin().TrendApprove
+	}
+
+	trend, errWithCode := review(c.Request.Context(), authed.Account, trendType, trendID)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	c.JSON(http.StatusOK, trend)
+}
@@ -0,0 +1,276 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// TrendsTagsGETHandler swagger:operation GET /api/v1/admin/trends/tags adminTrendsTagsGet
+//
+// View currently trending tags, most trending first, for review.
+//
+// Trending tags must be approved by an admin before they are shown to users.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: state
+//		type: string
+//		description: Only show trends in the given review state.
+//		enum:
+//		- pending
+//		- approved
+//		- rejected
+//		in: query
+//	-
+//		name: limit
+//		type: integer
+//		description: Number of trends to return.
+//		default: 20
+//		minimum: 1
+//		maximum: 100
+//		in: query
+//	-
+//		name: offset
+//		type: integer
+//		description: Skip the first n trends.
+//		default: 0
+//		minimum: 0
+//		in: query
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:read
+//
+//	responses:
+//		'200':
+//			description: Array of trending tags.
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/adminTrend"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) TrendsTagsGETHandler(c *gin.Context) {
+	m.trendsGET(c, gtsmodel.TrendTypeTag)
+}
+
+// TrendsStatusesGETHandler swagger:operation GET /api/v1/admin/trends/statuses adminTrendsStatusesGet
+//
+// View currently trending statuses, most trending first, for review.
+//
+// Trending statuses must be approved by an admin before they are shown to users.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: state
+//		type: string
+//		description: Only show trends in the given review state.
+//		enum:
+//		- pending
+//		- approved
+//		- rejected
+//		in: query
+//	-
+//		name: limit
+//		type: integer
+//		description: Number of trends to return.
+//		default: 20
+//		minimum: 1
+//		maximum: 100
+//		in: query
+//	-
+//		name: offset
+//		type: integer
+//		description: Skip the first n trends.
+//		default: 0
+//		minimum: 0
+//		in: query
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:read
+//
+//	responses:
+//		'200':
+//			description: Array of trending statuses.
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/adminTrend"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) TrendsStatusesGETHandler(c *gin.Context) {
+	m.trendsGET(c, gtsmodel.TrendTypeStatus)
+}
+
+// TrendsLinksGETHandler swagger:operation GET /api/v1/admin/trends/links adminTrendsLinksGet
+//
+// View currently trending links, most trending first, for review.
+//
+// Trending links must be approved by an admin before they are shown to users.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: state
+//		type: string
+//		description: Only show trends in the given review state.
+//		enum:
+//		- pending
+//		- approved
+//		- rejected
+//		in: query
+//	-
+//		name: limit
+//		type: integer
+//		description: Number of trends to return.
+//		default: 20
+//		minimum: 1
+//		maximum: 100
+//		in: query
+//	-
+//		name: offset
+//		type: integer
+//		description: Skip the first n trends.
+//		default: 0
+//		minimum: 0
+//		in: query
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:read
+//
+//	responses:
+//		'200':
+//			description: Array of trending links.
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/adminTrend"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) TrendsLinksGETHandler(c *gin.Context) {
+	m.trendsGET(c, gtsmodel.TrendTypeLink)
+}
+
+func (m *Module) trendsGET(c *gin.Context, trendType gtsmodel.TrendType) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	state := gtsmodel.TrendState(c.Query(StateKey))
+	switch state {
+	case "", gtsmodel.TrendStatePending, gtsmodel.TrendStateApproved, gtsmodel.TrendStateRejected:
+		// No problem.
+	default:
+		err := fmt.Errorf("state %s not recognized; accepted values are pending, approved, rejected", state)
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	limit, errWithCode := apiutil.ParseLimit(c.Query(LimitKey), 20, 100, 1)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	offset, errWithCode := apiutil.ParseTrendsOffset(c.Query(OffsetKey), 0, 10000, 0)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	trends, errWithCode := m.processor.Admin().TrendsGet(c.Request.Context(), authed.Account, trendType, state, limit, offset)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	c.JSON(http.StatusOK, trends)
+}
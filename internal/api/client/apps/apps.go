@@ -21,11 +21,21 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
-// BasePath is the base path for this api module, excluding the api prefix
-const BasePath = "/v1/apps"
+const (
+	// BasePath is the base path for this api module, excluding the api prefix
+	BasePath = "/v1/apps"
+	// IDKey is the key to use for retrieving app ID from context.
+	IDKey = "id"
+	// AuthorizedPath is for listing applications authorized by the requesting user.
+	AuthorizedPath = BasePath + "/authorized"
+	// AuthorizedWithIDPath is for revoking the authorization of one application.
+	AuthorizedWithIDPath = AuthorizedPath + "/:" + IDKey
+)
 
 type Module struct {
 	processor *processing.Processor
@@ -39,4 +49,6 @@ func New(processor *processing.Processor) *Module {
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	attachHandler(http.MethodPost, BasePath, m.AppsPOSTHandler)
+	attachHandler(http.MethodGet, AuthorizedPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadAccounts), m.AppsAuthorizedGETHandler)
+	attachHandler(http.MethodDelete, AuthorizedWithIDPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteAccounts), m.AppAuthorizedDELETEHandler)
 }
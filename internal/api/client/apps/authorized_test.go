@@ -0,0 +1,121 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package apps_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/apps"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type AuthorizedTestSuite struct {
+	AppsStandardTestSuite
+}
+
+func (suite *AuthorizedTestSuite) newContext(method string, path string) (*httptest.ResponseRecorder, *gin.Context) {
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Request = httptest.NewRequest(method, fmt.Sprintf("http://localhost:8080/api%s", path), nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	return recorder, ctx
+}
+
+func (suite *AuthorizedTestSuite) TestAuthorizedAppsGet() {
+	recorder, ctx := suite.newContext(http.MethodGet, apps.AuthorizedPath)
+
+	suite.appsModule.AppsAuthorizedGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+
+	authorized := []*apimodel.AuthorizedApplication{}
+	suite.NoError(json.Unmarshal(b, &authorized))
+
+	// Only application_1 holds an access token for zork;
+	// the authorization code that hasn't been swapped for
+	// a token yet shouldn't show up as a separate entry.
+	if !suite.Len(authorized, 1) {
+		suite.FailNow("")
+	}
+	app := authorized[0]
+	suite.Equal(suite.testApplications["application_1"].ID, app.ID)
+	suite.Equal("really cool gts application", app.Name)
+	suite.Equal("https://reallycool.app", app.Website)
+	suite.Equal([]string{"read", "write", "follow", "push"}, app.Scopes)
+	suite.NotEmpty(app.CreatedAt)
+	suite.Nil(app.LastUsedAt)
+}
+
+func (suite *AuthorizedTestSuite) TestAuthorizedAppRevoke() {
+	appID := suite.testApplications["application_1"].ID
+
+	recorder, ctx := suite.newContext(http.MethodDelete, apps.AuthorizedPath+"/"+appID)
+	ctx.AddParam(apps.IDKey, appID)
+
+	suite.appsModule.AppAuthorizedDELETEHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	// All of zork's tokens for this app should be gone.
+	tokens := []*gtsmodel.Token{}
+	err := suite.db.GetWhere(context.Background(), []db.Where{
+		{Key: "user_id", Value: suite.testUsers["local_account_1"].ID},
+		{Key: "client_id", Value: suite.testApplications["application_1"].ClientID},
+	}, &tokens)
+	suite.NoError(err)
+	suite.Empty(tokens)
+
+	// The app's own client credentials token should be untouched.
+	err = suite.db.GetByID(context.Background(), suite.testTokens["local_account_1_client_application_token"].ID, &gtsmodel.Token{})
+	suite.NoError(err)
+}
+
+func (suite *AuthorizedTestSuite) TestAuthorizedAppRevokeNotAuthorized() {
+	// application_2 has no tokens for zork.
+	appID := suite.testApplications["application_2"].ID
+
+	recorder, ctx := suite.newContext(http.MethodDelete, apps.AuthorizedPath+"/"+appID)
+	ctx.AddParam(apps.IDKey, appID)
+
+	suite.appsModule.AppAuthorizedDELETEHandler(ctx)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+
+	// local_account_2's token for application_2 should be untouched.
+	err := suite.db.GetByID(context.Background(), suite.testTokens["local_account_2"].ID, &gtsmodel.Token{})
+	suite.NoError(err)
+}
+
+func TestAuthorizedTestSuite(t *testing.T) {
+	suite.Run(t, &AuthorizedTestSuite{})
+}
@@ -21,6 +21,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -49,7 +51,7 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, BasePath, m.FollowRequestGETHandler)
-	attachHandler(http.MethodPost, AuthorizePath, m.FollowRequestAuthorizePOSTHandler)
-	attachHandler(http.MethodPost, RejectPath, m.FollowRequestRejectPOSTHandler)
+	attachHandler(http.MethodGet, BasePath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadFollows), m.FollowRequestGETHandler)
+	attachHandler(http.MethodPost, AuthorizePath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteFollows), m.FollowRequestAuthorizePOSTHandler)
+	attachHandler(http.MethodPost, RejectPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteFollows), m.FollowRequestRejectPOSTHandler)
 }
@@ -160,7 +160,17 @@ func (suite *InstancePatchTestSuite) TestInstancePatch1() {
       "name": "admin"
     }
   },
-  "max_toot_chars": 5000
+  "max_toot_chars": 5000,
+  "rules": [
+    {
+      "id": "01GVTXNXMXKXBXBYKCMJAQJZJY",
+      "text": "Be gay"
+    },
+    {
+      "id": "01GVTXP8RE6YMA3DMTDC9TAZ8R",
+      "text": "Do crime"
+    }
+  ]
 }`, dst.String())
 }
 
@@ -264,7 +274,17 @@ func (suite *InstancePatchTestSuite) TestInstancePatch2() {
       "name": "admin"
     }
   },
-  "max_toot_chars": 5000
+  "max_toot_chars": 5000,
+  "rules": [
+    {
+      "id": "01GVTXNXMXKXBXBYKCMJAQJZJY",
+      "text": "Be gay"
+    },
+    {
+      "id": "01GVTXP8RE6YMA3DMTDC9TAZ8R",
+      "text": "Do crime"
+    }
+  ]
 }`, dst.String())
 }
 
@@ -368,7 +388,17 @@ func (suite *InstancePatchTestSuite) TestInstancePatch3() {
       "name": "admin"
     }
   },
-  "max_toot_chars": 5000
+  "max_toot_chars": 5000,
+  "rules": [
+    {
+      "id": "01GVTXNXMXKXBXBYKCMJAQJZJY",
+      "text": "Be gay"
+    },
+    {
+      "id": "01GVTXP8RE6YMA3DMTDC9TAZ8R",
+      "text": "Do crime"
+    }
+  ]
 }`, dst.String())
 }
 
@@ -523,7 +553,17 @@ func (suite *InstancePatchTestSuite) TestInstancePatch6() {
       "name": "admin"
     }
   },
-  "max_toot_chars": 5000
+  "max_toot_chars": 5000,
+  "rules": [
+    {
+      "id": "01GVTXNXMXKXBXBYKCMJAQJZJY",
+      "text": "Be gay"
+    },
+    {
+      "id": "01GVTXP8RE6YMA3DMTDC9TAZ8R",
+      "text": "Do crime"
+    }
+  ]
 }`, dst.String())
 }
 
@@ -651,7 +691,17 @@ func (suite *InstancePatchTestSuite) TestInstancePatch8() {
       "name": "admin"
     }
   },
-  "max_toot_chars": 5000
+  "max_toot_chars": 5000,
+  "rules": [
+    {
+      "id": "01GVTXNXMXKXBXBYKCMJAQJZJY",
+      "text": "Be gay"
+    },
+    {
+      "id": "01GVTXP8RE6YMA3DMTDC9TAZ8R",
+      "text": "Do crime"
+    }
+  ]
 }`, dst.String())
 
 	// extra bonus: check the v2 model thumbnail after the patch
@@ -790,7 +840,17 @@ func (suite *InstancePatchTestSuite) TestInstancePatch9() {
       "name": "admin"
     }
   },
-  "max_toot_chars": 5000
+  "max_toot_chars": 5000,
+  "rules": [
+    {
+      "id": "01GVTXNXMXKXBXBYKCMJAQJZJY",
+      "text": "Be gay"
+    },
+    {
+      "id": "01GVTXP8RE6YMA3DMTDC9TAZ8R",
+      "text": "Do crime"
+    }
+  ]
 }`, dst.String())
 }
 
@@ -0,0 +1,199 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package invites_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/invites"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+)
+
+type InviteTestSuite struct {
+	InvitesStandardTestSuite
+}
+
+func (suite *InviteTestSuite) createInvite(requestingUser string, body string) (*apimodel.Invite, int, string) {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, requestingUser, http.MethodPost, invites.BasePath, []byte(body))
+
+	suite.invitesModule.InviteCreatePOSTHandler(ctx)
+
+	b, err := io.ReadAll(recorder.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if recorder.Code != http.StatusOK {
+		return nil, recorder.Code, string(b)
+	}
+
+	invite := &apimodel.Invite{}
+	if err := json.Unmarshal(b, invite); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return invite, recorder.Code, string(b)
+}
+
+func (suite *InviteTestSuite) getInvites(requestingUser string) []*apimodel.Invite {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, requestingUser, http.MethodGet, invites.BasePath, nil)
+
+	suite.invitesModule.InvitesGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	apiInvites := []*apimodel.Invite{}
+	if err := json.NewDecoder(recorder.Body).Decode(&apiInvites); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return apiInvites
+}
+
+func (suite *InviteTestSuite) revokeInvite(requestingUser string, id string) int {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, requestingUser, http.MethodDelete, invites.BasePathWithID, nil)
+	ctx.AddParam(invites.IDKey, id)
+
+	suite.invitesModule.InviteDELETEHandler(ctx)
+
+	return recorder.Code
+}
+
+func (suite *InviteTestSuite) TestCreateInviteDefaults() {
+	invite, code, _ := suite.createInvite("admin_account", `{}`)
+	suite.Equal(http.StatusOK, code)
+	suite.NotEmpty(invite.ID)
+	suite.Len(invite.Code, 8)
+	suite.Equal("http://localhost:8080/invite/"+invite.Code, invite.URL)
+	suite.Equal(1, invite.MaxUses)
+	suite.Equal(0, invite.Uses)
+	suite.True(invite.Valid)
+	suite.NotNil(invite.ExpiresAt)
+}
+
+func (suite *InviteTestSuite) TestCreateInviteUnlimited() {
+	invite, code, _ := suite.createInvite("admin_account", `{"max_uses":0,"expires_in":0}`)
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(0, invite.MaxUses)
+	suite.Nil(invite.ExpiresAt)
+	suite.True(invite.Valid)
+}
+
+func (suite *InviteTestSuite) TestCreateInviteInvalid() {
+	_, code, b := suite.createInvite("admin_account", `{"max_uses":-1}`)
+	suite.Equal(http.StatusBadRequest, code)
+	suite.Equal(`{"error":"Bad Request: max_uses must not be negative"}`, b)
+
+	_, code, b = suite.createInvite("admin_account", `{"expires_in":-1}`)
+	suite.Equal(http.StatusBadRequest, code)
+	suite.Equal(`{"error":"Bad Request: expires_in must not be negative"}`, b)
+}
+
+func (suite *InviteTestSuite) TestCreateInviteNotAdmin() {
+	// Only admins by default.
+	_, code, b := suite.createInvite("local_account_1", `{}`)
+	suite.Equal(http.StatusForbidden, code)
+	suite.Equal(`{"error":"Forbidden: only admins can create invites on this instance"}`, b)
+
+	config.SetAccountsAllowUserInvites(true)
+	defer config.SetAccountsAllowUserInvites(false)
+
+	invite, code, _ := suite.createInvite("local_account_1", `{}`)
+	suite.Equal(http.StatusOK, code)
+	suite.NotEmpty(invite.Code)
+}
+
+func (suite *InviteTestSuite) TestCreateInviteNotAdminLimits() {
+	config.SetAccountsAllowUserInvites(true)
+	defer config.SetAccountsAllowUserInvites(false)
+
+	// Non-admins can't create unlimited invites.
+	_, code, b := suite.createInvite("local_account_1", `{"max_uses":0}`)
+	suite.Equal(http.StatusBadRequest, code)
+	suite.Equal(`{"error":"Bad Request: max_uses must be between 1 and 10"}`, b)
+
+	_, code, b = suite.createInvite("local_account_1", `{"max_uses":11}`)
+	suite.Equal(http.StatusBadRequest, code)
+	suite.Equal(`{"error":"Bad Request: max_uses must be between 1 and 10"}`, b)
+
+	// Or invites that never expire.
+	_, code, b = suite.createInvite("local_account_1", `{"expires_in":0}`)
+	suite.Equal(http.StatusBadRequest, code)
+	suite.Equal(`{"error":"Bad Request: expires_in must be between 1 and 2592000 seconds"}`, b)
+
+	_, code, b = suite.createInvite("local_account_1", `{"expires_in":2592001}`)
+	suite.Equal(http.StatusBadRequest, code)
+	suite.Equal(`{"error":"Bad Request: expires_in must be between 1 and 2592000 seconds"}`, b)
+
+	// Within the limits is fine.
+	invite, code, _ := suite.createInvite("local_account_1", `{"max_uses":10,"expires_in":2592000}`)
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(10, invite.MaxUses)
+	suite.NotNil(invite.ExpiresAt)
+}
+
+func (suite *InviteTestSuite) TestGetInvites() {
+	config.SetAccountsAllowUserInvites(true)
+	defer config.SetAccountsAllowUserInvites(false)
+
+	first, _, _ := suite.createInvite("local_account_1", `{}`)
+	second, _, _ := suite.createInvite("local_account_1", `{}`)
+	suite.createInvite("admin_account", `{}`)
+
+	// Only own invites, newest first.
+	apiInvites := suite.getInvites("local_account_1")
+	if suite.Len(apiInvites, 2) {
+		suite.Equal(second.ID, apiInvites[0].ID)
+		suite.Equal(first.ID, apiInvites[1].ID)
+	}
+
+	suite.Len(suite.getInvites("admin_account"), 1)
+}
+
+func (suite *InviteTestSuite) TestRevokeInvite() {
+	config.SetAccountsAllowUserInvites(true)
+	defer config.SetAccountsAllowUserInvites(false)
+
+	userInvite, _, _ := suite.createInvite("local_account_1", `{}`)
+	adminInvite, _, _ := suite.createInvite("admin_account", `{}`)
+
+	// Users can't revoke other people's invites.
+	suite.Equal(http.StatusNotFound, suite.revokeInvite("local_account_1", adminInvite.ID))
+
+	// But they can revoke their own.
+	suite.Equal(http.StatusOK, suite.revokeInvite("local_account_1", userInvite.ID))
+	suite.False(suite.getInvites("local_account_1")[0].Valid)
+
+	// Admins can revoke anyone's.
+	userInvite, _, _ = suite.createInvite("local_account_1", `{}`)
+	suite.Equal(http.StatusOK, suite.revokeInvite("admin_account", userInvite.ID))
+	suite.False(suite.getInvites("local_account_1")[0].Valid)
+
+	suite.Equal(http.StatusNotFound, suite.revokeInvite("admin_account", "01H7AK5EPKC7Q6CWZHAF2Q6SWQ"))
+}
+
+func TestInviteTestSuite(t *testing.T) {
+	suite.Run(t, &InviteTestSuite{})
+}
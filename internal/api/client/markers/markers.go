@@ -21,6 +21,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -40,6 +42,6 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, BasePath, m.MarkersGETHandler)
-	attachHandler(http.MethodPost, BasePath, m.MarkersPOSTHandler)
+	attachHandler(http.MethodGet, BasePath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadStatuses), m.MarkersGETHandler)
+	attachHandler(http.MethodPost, BasePath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteStatuses), m.MarkersPOSTHandler)
 }
@@ -22,6 +22,8 @@ import (
 
 	"github.com/gin-gonic/gin"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -42,7 +44,7 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodPost, BasePath, m.MediaCreatePOSTHandler)
-	attachHandler(http.MethodGet, AttachmentWithID, m.MediaGETHandler)
-	attachHandler(http.MethodPut, AttachmentWithID, m.MediaPUTHandler)
+	attachHandler(http.MethodPost, BasePath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteMedia), m.MediaCreatePOSTHandler)
+	attachHandler(http.MethodGet, AttachmentWithID, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteMedia), m.MediaGETHandler)
+	attachHandler(http.MethodPut, AttachmentWithID, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteMedia), m.MediaPUTHandler)
 }
@@ -48,7 +48,7 @@ import (
 //
 //	security:
 //	- OAuth2 Bearer:
-//		- read:media
+//		- write:media
 //
 //	responses:
 //		'200':
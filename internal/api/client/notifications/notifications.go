@@ -21,6 +21,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -31,15 +33,29 @@ const (
 	BasePath = "/v1/notifications"
 	// BasePathWithID is just the base path with the ID key in it.
 	// Use this anywhere you need to know the ID of the notification being queried.
-	BasePathWithID    = BasePath + "/:" + IDKey
-	BasePathWithClear = BasePath + "/clear"
+	BasePathWithID          = BasePath + "/:" + IDKey
+	BasePathWithDismiss     = BasePathWithID + "/dismiss"
+	BasePathWithClear       = BasePath + "/clear"
+	BasePathWithUnreadCount = BasePath + "/unread_count"
+	BasePathWithPolicy      = BasePath + "/policy"
+	BasePathWithRequests    = BasePath + "/requests"
+	// BasePathWithRequestID is the requests path with the ID key in it.
+	BasePathWithRequestID      = BasePathWithRequests + "/:" + IDKey
+	BasePathWithRequestAccept  = BasePathWithRequestID + "/accept"
+	BasePathWithRequestDismiss = BasePathWithRequestID + "/dismiss"
+	// BasePathV2 is the base path for serving grouped notifications, minus the 'api' prefix.
+	BasePathV2 = "/v2/notifications"
 
 	// ExcludeTypes is an array specifying notification types to exclude
 	ExcludeTypesKey = "exclude_types[]"
-	MaxIDKey        = "max_id"
-	LimitKey        = "limit"
-	SinceIDKey      = "since_id"
-	MinIDKey        = "min_id"
+	// TypesKey is an array specifying notification types to include
+	TypesKey = "types[]"
+	// AccountIDKey is for only returning notifications from one account
+	AccountIDKey = "account_id"
+	MaxIDKey     = "max_id"
+	LimitKey     = "limit"
+	SinceIDKey   = "since_id"
+	MinIDKey     = "min_id"
 )
 
 type Module struct {
@@ -53,7 +69,16 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, BasePath, m.NotificationsGETHandler)
-	attachHandler(http.MethodGet, BasePathWithID, m.NotificationGETHandler)
-	attachHandler(http.MethodPost, BasePathWithClear, m.NotificationsClearPOSTHandler)
+	attachHandler(http.MethodGet, BasePath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadNotifications), m.NotificationsGETHandler)
+	attachHandler(http.MethodGet, BasePathWithID, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadNotifications), m.NotificationGETHandler)
+	attachHandler(http.MethodGet, BasePathWithUnreadCount, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadNotifications), m.NotificationsUnreadCountGETHandler)
+	attachHandler(http.MethodPost, BasePathWithClear, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteNotifications), m.NotificationsClearPOSTHandler)
+	attachHandler(http.MethodPost, BasePathWithDismiss, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteNotifications), m.NotificationDismissPOSTHandler)
+	attachHandler(http.MethodGet, BasePathWithPolicy, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadNotifications), m.NotificationPolicyGETHandler)
+	attachHandler(http.MethodPatch, BasePathWithPolicy, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteNotifications), m.NotificationPolicyPATCHHandler)
+	attachHandler(http.MethodGet, BasePathWithRequests, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadNotifications), m.NotificationRequestsGETHandler)
+	attachHandler(http.MethodGet, BasePathWithRequestID, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadNotifications), m.NotificationRequestGETHandler)
+	attachHandler(http.MethodPost, BasePathWithRequestAccept, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteNotifications), m.NotificationRequestAcceptPOSTHandler)
+	attachHandler(http.MethodPost, BasePathWithRequestDismiss, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteNotifications), m.NotificationRequestDismissPOSTHandler)
+	attachHandler(http.MethodGet, BasePathV2, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadNotifications), m.NotificationsGroupedGETHandler)
 }
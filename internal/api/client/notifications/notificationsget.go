@@ -26,6 +26,7 @@ import (
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 )
 
 // NotificationsGETHandler swagger:operation GET /api/v1/notifications notifications
@@ -87,6 +88,22 @@ import (
 //			description: Array of types of notifications to exclude (follow, favourite, reblog, mention, poll, follow_request)
 //		in: query
 //		required: false
+//	-
+//		name: types
+//		type: array
+//		items:
+//			type: string
+//			description: Array of types of notifications to include. If not set, all types except excluded ones are returned.
+//		in: query
+//		required: false
+//	-
+//		name: account_id
+//		type: string
+//		description: >-
+//			Return only notifications received from the account with this ID.
+//			This includes notifications filtered by the notification policy.
+//		in: query
+//		required: false
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -141,11 +158,15 @@ func (m *Module) NotificationsGETHandler(c *gin.Context) {
 	resp, errWithCode := m.processor.Timeline().NotificationsGet(
 		c.Request.Context(),
 		authed,
-		c.Query(MaxIDKey),
-		c.Query(SinceIDKey),
-		c.Query(MinIDKey),
-		limit,
+		&paging.Pager{
+			SinceID: c.Query(SinceIDKey),
+			MinID:   c.Query(MinIDKey),
+			MaxID:   c.Query(MaxIDKey),
+			Limit:   limit,
+		},
+		c.QueryArray(TypesKey),
 		c.QueryArray(ExcludeTypesKey),
+		c.Query(AccountIDKey),
 	)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
@@ -0,0 +1,111 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
+)
+
+const (
+	// BasePath is the base path for serving the push API, minus the 'api' prefix
+	BasePath = "/v1/push"
+	// SubscriptionPath is for managing the web push subscription of the current token.
+	SubscriptionPath = BasePath + "/subscription"
+)
+
+type Module struct {
+	processor *processing.Processor
+}
+
+func New(processor *processing.Processor) *Module {
+	return &Module{
+		processor: processor,
+	}
+}
+
+func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	attachHandler(http.MethodGet, SubscriptionPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopePush), m.PushSubscriptionGETHandler)
+	attachHandler(http.MethodPost, SubscriptionPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopePush), m.PushSubscriptionPOSTHandler)
+	attachHandler(http.MethodPut, SubscriptionPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopePush), m.PushSubscriptionPUTHandler)
+	attachHandler(http.MethodDelete, SubscriptionPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopePush), m.PushSubscriptionDELETEHandler)
+}
+
+// isForm returns whether the request body is form data rather than JSON/XML.
+// Clients send nested push subscription fields in form data using bracket
+// notation, eg., 'subscription[keys][auth]', which gin can't bind to structs.
+func isForm(c *gin.Context) bool {
+	switch c.ContentType() {
+	case binding.MIMEPOSTForm, binding.MIMEMultipartPOSTForm:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseFormData parses the 'data' field of a push subscription create or
+// update request from bracket notation form fields, returning nil if unset.
+func parseFormData(c *gin.Context) (*apimodel.WebPushSubscriptionRequestData, error) {
+	var (
+		data   = &apimodel.WebPushSubscriptionRequestData{}
+		alerts = &apimodel.WebPushSubscriptionAlerts{}
+		set    bool
+		err    error
+	)
+
+	for key, alert := range map[string]*bool{
+		"follow":         &alerts.Follow,
+		"follow_request": &alerts.FollowRequest,
+		"favourite":      &alerts.Favourite,
+		"mention":        &alerts.Mention,
+		"reblog":         &alerts.Reblog,
+		"poll":           &alerts.Poll,
+		"status":         &alerts.Status,
+		"admin.report":   &alerts.AdminReport,
+	} {
+		value, ok := c.GetPostForm("data[alerts][" + key + "]")
+		if !ok {
+			continue
+		}
+
+		if *alert, err = strconv.ParseBool(value); err != nil {
+			return nil, err
+		}
+
+		data.Alerts = alerts
+		set = true
+	}
+
+	if policy, ok := c.GetPostForm("data[policy]"); ok {
+		data.Policy = &policy
+		set = true
+	}
+
+	if !set {
+		return nil, nil
+	}
+
+	return data, nil
+}
@@ -52,17 +52,13 @@ func (suite *ReportCreateTestSuite) createReport(expectedHTTPStatus int, expecte
 	// create the request
 	ctx.Request = httptest.NewRequest(http.MethodPost, config.GetProtocol()+"://"+config.GetHost()+"/api/"+reports.BasePath, nil)
 	ctx.Request.Header.Set("accept", "application/json")
-	ruleIDs := make([]string, 0, len(form.RuleIDs))
-	for _, r := range form.RuleIDs {
-		ruleIDs = append(ruleIDs, strconv.Itoa(r))
-	}
 	ctx.Request.Form = url.Values{
 		"account_id":   {form.AccountID},
 		"status_ids[]": form.StatusIDs,
 		"comment":      {form.Comment},
 		"forward":      {strconv.FormatBool(form.Forward)},
 		"category":     {form.Category},
-		"rule_ids[]":   ruleIDs,
+		"rule_ids[]":   form.RuleIDs,
 	}
 
 	// trigger the handler
@@ -195,6 +191,75 @@ func (suite *ReportCreateTestSuite) TestCreateReport7() {
 	suite.Nil(report)
 }
 
+func (suite *ReportCreateTestSuite) TestCreateReportRuleViolation() {
+	targetAccount := suite.testAccounts["remote_account_1"]
+	rule := suite.testRules["rule2"]
+
+	form := &apimodel.ReportCreateRequest{
+		AccountID: targetAccount.ID,
+		StatusIDs: []string{},
+		Comment:   "they're not doing enough crimes",
+		RuleIDs:   []string{rule.ID},
+	}
+
+	report, err := suite.createReport(http.StatusOK, "", form)
+	suite.NoError(err)
+	suite.NotEmpty(report)
+	suite.ReportOK(form, report)
+
+	// Category should default
+	// to violation for rules.
+	suite.Equal("violation", report.Category)
+	suite.Equal([]string{rule.ID}, report.RuleIDs)
+}
+
+func (suite *ReportCreateTestSuite) TestCreateReportDeletedRule() {
+	targetAccount := suite.testAccounts["remote_account_1"]
+	rule := suite.testRules["rule3"]
+
+	form := &apimodel.ReportCreateRequest{
+		AccountID: targetAccount.ID,
+		Category:  "violation",
+		RuleIDs:   []string{rule.ID},
+	}
+
+	report, err := suite.createReport(http.StatusBadRequest, `{"error":"Bad Request: rule with ID 01GVTXPF2XYK5S49D3QD2ZYGGA does not exist"}`, form)
+	suite.NoError(err)
+	suite.Nil(report)
+}
+
+func (suite *ReportCreateTestSuite) TestCreateReportSpam() {
+	targetAccount := suite.testAccounts["remote_account_1"]
+
+	form := &apimodel.ReportCreateRequest{
+		AccountID: targetAccount.ID,
+		StatusIDs: []string{},
+		Category:  "spam",
+		// Rules should be ignored
+		// for non-violation reports.
+		RuleIDs: []string{suite.testRules["rule1"].ID},
+	}
+
+	report, err := suite.createReport(http.StatusOK, "", form)
+	suite.NoError(err)
+	suite.NotEmpty(report)
+	suite.Equal("spam", report.Category)
+	suite.Empty(report.RuleIDs)
+}
+
+func (suite *ReportCreateTestSuite) TestCreateReportInvalidCategory() {
+	targetAccount := suite.testAccounts["remote_account_1"]
+
+	form := &apimodel.ReportCreateRequest{
+		AccountID: targetAccount.ID,
+		Category:  "vibes",
+	}
+
+	report, err := suite.createReport(http.StatusBadRequest, `{"error":"Bad Request: category vibes not recognized, valid options are 'spam', 'violation', 'legal', 'other'"}`, form)
+	suite.NoError(err)
+	suite.Nil(report)
+}
+
 func TestReportCreateTestSuite(t *testing.T) {
 	suite.Run(t, &ReportCreateTestSuite{})
 }
@@ -51,6 +51,7 @@ type ReportsStandardTestSuite struct {
 	testAccounts     map[string]*gtsmodel.Account
 	testStatuses     map[string]*gtsmodel.Status
 	testReports      map[string]*gtsmodel.Report
+	testRules        map[string]*gtsmodel.Rule
 
 	// module being tested
 	reportsModule *reports.Module
@@ -64,6 +65,7 @@ func (suite *ReportsStandardTestSuite) SetupSuite() {
 	suite.testAccounts = testrig.NewTestAccounts()
 	suite.testStatuses = testrig.NewTestStatuses()
 	suite.testReports = testrig.NewTestReports()
+	suite.testRules = testrig.NewTestRules()
 }
 
 func (suite *ReportsStandardTestSuite) SetupTest() {
@@ -1265,7 +1265,7 @@ func (suite *SearchGetTestSuite) TestSearchHashtagV1() {
 		queryType          *string = func() *string { i := "hashtags"; return &i }()
 		following          *bool   = nil
 		expectedHTTPStatus         = http.StatusOK
-		expectedBody               = `{"accounts":[],"statuses":[],"hashtags":[{"name":"welcome","url":"http://localhost:8080/tags/welcome","history":[]}]}`
+		expectedBody               = ""
 	)
 
 	searchResult, err := suite.getSearch(
@@ -1290,6 +1290,17 @@ func (suite *SearchGetTestSuite) TestSearchHashtagV1() {
 	suite.Len(searchResult.Accounts, 0)
 	suite.Len(searchResult.Statuses, 0)
 	suite.Len(searchResult.Hashtags, 1)
+
+	// Tag should be returned with a week of (empty) usage history.
+	hashtag := searchResult.Hashtags[0].(map[string]any)
+	suite.Equal("welcome", hashtag["name"])
+	suite.Equal("http://localhost:8080/tags/welcome", hashtag["url"])
+	history := hashtag["history"].([]any)
+	suite.Len(history, 7)
+	for _, day := range history {
+		suite.Equal("0", day.(map[string]any)["uses"])
+		suite.Equal("0", day.(map[string]any)["accounts"])
+	}
 }
 
 func (suite *SearchGetTestSuite) TestSearchHashtagV2() {
@@ -21,12 +21,16 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
 const (
 	// IDKey is for status UUIDs
 	IDKey = "id"
+	// EmojiKey is for the emoji of a status reaction
+	EmojiKey = "emoji"
 	// BasePath is the base path for serving the statuses API, minus the 'api' prefix
 	BasePath = "/v1/statuses"
 	// BasePathWithID is just the base path with the ID key in it.
@@ -64,6 +68,13 @@ const (
 
 	// ContextPath is used for fetching context of posts
 	ContextPath = BasePathWithID + "/context"
+
+	// TranslatePath is used for translating a status into another language
+	TranslatePath = BasePathWithID + "/translate"
+
+	// ReactionPath is for adding or removing an emoji reaction on a given status,
+	// using the Pleroma API, since Mastodon doesn't have emoji reactions.
+	ReactionPath = "/v1/pleroma/statuses/:" + IDKey + "/reactions/:" + EmojiKey
 )
 
 type Module struct {
@@ -78,26 +89,33 @@ func New(processor *processing.Processor) *Module {
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	// create / get / delete status
-	attachHandler(http.MethodPost, BasePath, m.StatusCreatePOSTHandler)
-	attachHandler(http.MethodGet, BasePathWithID, m.StatusGETHandler)
-	attachHandler(http.MethodDelete, BasePathWithID, m.StatusDELETEHandler)
+	attachHandler(http.MethodPost, BasePath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteStatuses), m.StatusCreatePOSTHandler)
+	attachHandler(http.MethodGet, BasePathWithID, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadStatuses), m.StatusGETHandler)
+	attachHandler(http.MethodDelete, BasePathWithID, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteStatuses), m.StatusDELETEHandler)
 
 	// fave stuff
-	attachHandler(http.MethodPost, FavouritePath, m.StatusFavePOSTHandler)
-	attachHandler(http.MethodPost, UnfavouritePath, m.StatusUnfavePOSTHandler)
-	attachHandler(http.MethodGet, FavouritedPath, m.StatusFavedByGETHandler)
+	attachHandler(http.MethodPost, FavouritePath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteFavourites), m.StatusFavePOSTHandler)
+	attachHandler(http.MethodPost, UnfavouritePath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteFavourites), m.StatusUnfavePOSTHandler)
+	attachHandler(http.MethodGet, FavouritedPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadAccounts), m.StatusFavedByGETHandler)
 
 	// pin stuff
-	attachHandler(http.MethodPost, PinPath, m.StatusPinPOSTHandler)
-	attachHandler(http.MethodPost, UnpinPath, m.StatusUnpinPOSTHandler)
+	attachHandler(http.MethodPost, PinPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteAccounts), m.StatusPinPOSTHandler)
+	attachHandler(http.MethodPost, UnpinPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteAccounts), m.StatusUnpinPOSTHandler)
 
 	// reblog stuff
-	attachHandler(http.MethodPost, ReblogPath, m.StatusBoostPOSTHandler)
-	attachHandler(http.MethodPost, UnreblogPath, m.StatusUnboostPOSTHandler)
-	attachHandler(http.MethodGet, RebloggedPath, m.StatusBoostedByGETHandler)
-	attachHandler(http.MethodPost, BookmarkPath, m.StatusBookmarkPOSTHandler)
-	attachHandler(http.MethodPost, UnbookmarkPath, m.StatusUnbookmarkPOSTHandler)
+	attachHandler(http.MethodPost, ReblogPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteStatuses), m.StatusBoostPOSTHandler)
+	attachHandler(http.MethodPost, UnreblogPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteStatuses), m.StatusUnboostPOSTHandler)
+	attachHandler(http.MethodGet, RebloggedPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadAccounts), m.StatusBoostedByGETHandler)
+	attachHandler(http.MethodPost, BookmarkPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteBookmarks), m.StatusBookmarkPOSTHandler)
+	attachHandler(http.MethodPost, UnbookmarkPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteBookmarks), m.StatusUnbookmarkPOSTHandler)
 
 	// context / status thread
-	attachHandler(http.MethodGet, ContextPath, m.StatusContextGETHandler)
+	attachHandler(http.MethodGet, ContextPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadStatuses), m.StatusContextGETHandler)
+
+	// emoji reactions
+	attachHandler(http.MethodPut, ReactionPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteFavourites), m.StatusReactionPUTHandler)
+	attachHandler(http.MethodDelete, ReactionPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteFavourites), m.StatusReactionDELETEHandler)
+
+	// translation
+	attachHandler(http.MethodPost, TranslatePath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadStatuses), m.StatusTranslatePOSTHandler)
 }
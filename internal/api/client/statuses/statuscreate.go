@@ -27,6 +27,7 @@ import (
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/validate"
 )
@@ -167,5 +168,15 @@ func validateCreateStatus(form *apimodel.AdvancedStatusCreateForm) error {
 		}
 	}
 
+	for _, policy := range []string{
+		form.ReplyPolicy,
+		form.BoostPolicy,
+		form.LikePolicy,
+	} {
+		if err := validate.InteractionPolicy(gtsmodel.InteractionPolicy(policy)); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
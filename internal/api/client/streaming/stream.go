@@ -99,7 +99,7 @@ import (
 //
 //	security:
 //	- OAuth2 Bearer:
-//		- read:streaming
+//		- read:statuses
 //
 //	responses:
 //		'101':
@@ -22,6 +22,8 @@ import (
 
 	"github.com/gin-gonic/gin"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -44,8 +46,8 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, HomeTimeline, m.HomeTimelineGETHandler)
-	attachHandler(http.MethodGet, PublicTimeline, m.PublicTimelineGETHandler)
-	attachHandler(http.MethodGet, ListTimeline, m.ListTimelineGETHandler)
-	attachHandler(http.MethodGet, TagTimeline, m.TagTimelineGETHandler)
+	attachHandler(http.MethodGet, HomeTimeline, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadStatuses), m.HomeTimelineGETHandler)
+	attachHandler(http.MethodGet, PublicTimeline, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadStatuses), m.PublicTimelineGETHandler)
+	attachHandler(http.MethodGet, ListTimeline, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadLists), m.ListTimelineGETHandler)
+	attachHandler(http.MethodGet, TagTimeline, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadStatuses), m.TagTimelineGETHandler)
 }
@@ -21,6 +21,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -29,6 +31,16 @@ const (
 	BasePath = "/v1/user"
 	// PasswordChangePath is the path for POSTing a password change request.
 	PasswordChangePath = BasePath + "/password_change"
+	// TwoFactorPath is the base path for managing two-factor authentication.
+	TwoFactorPath = BasePath + "/2fa"
+	// TwoFactorSetupPath is the path for POSTing a request for a new two-factor secret.
+	TwoFactorSetupPath = TwoFactorPath + "/setup"
+	// TwoFactorEnablePath is the path for POSTing a code to confirm and enable two-factor auth.
+	TwoFactorEnablePath = TwoFactorPath + "/enable"
+	// TwoFactorDisablePath is the path for POSTing a request to disable two-factor auth.
+	TwoFactorDisablePath = TwoFactorPath + "/disable"
+	// LanguagesPath is the path for getting and setting language preferences.
+	LanguagesPath = BasePath + "/languages"
 )
 
 type Module struct {
@@ -42,5 +54,10 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodPost, PasswordChangePath, m.PasswordChangePOSTHandler)
+	attachHandler(http.MethodPost, PasswordChangePath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteAccounts), m.PasswordChangePOSTHandler)
+	attachHandler(http.MethodPost, TwoFactorSetupPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteAccounts), m.TwoFactorSetupPOSTHandler)
+	attachHandler(http.MethodPost, TwoFactorEnablePath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteAccounts), m.TwoFactorEnablePOSTHandler)
+	attachHandler(http.MethodPost, TwoFactorDisablePath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteAccounts), m.TwoFactorDisablePOSTHandler)
+	attachHandler(http.MethodGet, LanguagesPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeReadAccounts), m.LanguagesGETHandler)
+	attachHandler(http.MethodPost, LanguagesPath, middleware.ScopeCheck(m.processor.InstanceGetV1, oauth.ScopeWriteAccounts), m.LanguagesPOSTHandler)
 }
@@ -129,6 +129,11 @@ type AccountCreateRequest struct {
 	// example: en
 	// Required: true
 	Locale string `form:"locale" json:"locale" xml:"locale" binding:"required"`
+	// Invite code to sign up with. A valid invite lets the account
+	// skip approval, and sign up even when registration is closed.
+	// swagger:parameters
+	// example: Xb7hQ2mK
+	InviteCode string `form:"invite_code" json:"invite_code" xml:"invite_code"`
 	// The IP of the sign up request, will not be parsed from the form.
 	// swagger:parameters
 	// swagger:ignore
@@ -0,0 +1,46 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// AccountWarning represents a moderation action taken
+// against an account, as seen by the account owner.
+//
+// swagger:model accountWarning
+type AccountWarning struct {
+	// The id of the account warning.
+	// example: 01H6EWBE8G5AKA1VQVY41VSAZN
+	ID string `json:"id"`
+	// Action taken against the account.
+	// 	none = No action was taken, this is just a warning
+	// 	sensitive = Media of the listed statuses has been marked as sensitive
+	// 	delete_statuses = The listed statuses have been deleted
+	// 	silence = The account has been silenced
+	// 	suspend = The account has been suspended
+	// example: delete_statuses
+	Action string `json:"action"`
+	// Message from the moderator to the account owner.
+	// example: Please don't post spam.
+	Text string `json:"text"`
+	// IDs of the statuses affected by this action, if any.
+	StatusIDs []string `json:"status_ids"`
+	// The account against which the action was taken.
+	TargetAccount *Account `json:"target_account"`
+	// The time the warning was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+}
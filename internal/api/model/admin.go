@@ -117,9 +117,9 @@ type AdminReport struct {
 	// Array of  statuses that were submitted along with this report.
 	// Will be empty if no status IDs were submitted with the report.
 	Statuses []*Status `json:"statuses"`
-	// Array of rule IDs that were submitted along with this report.
-	// NOT IMPLEMENTED, will always be empty array.
-	Rules []interface{} `json:"rule_ids"`
+	// Array of rules that were broken according to this report.
+	// Will be empty if no rule IDs were submitted with the report.
+	Rules []*InstanceRule `json:"rules"`
 	// If an action was taken, what comment was made by the admin on the taken action?
 	// Will be null if not set / no action yet taken.
 	// example: Account was suspended.
@@ -132,6 +132,14 @@ type AdminReport struct {
 type AdminReportResolveRequest struct {
 	// Comment to show to the creator of the report when an admin marks it as resolved.
 	ActionTakenComment *string `form:"action_taken_comment" json:"action_taken_comment" xml:"action_taken_comment"`
+	// Delete the statuses attached to the report.
+	DeleteStatuses bool `form:"delete_statuses" json:"delete_statuses" xml:"delete_statuses"`
+	// Mark media attached to the statuses of the report as sensitive.
+	MarkStatusesSensitive bool `form:"mark_statuses_sensitive" json:"mark_statuses_sensitive" xml:"mark_statuses_sensitive"`
+	// Action to take against the reported account: silence or suspend.
+	AccountAction string `form:"account_action" json:"account_action" xml:"account_action"`
+	// Send a warning to the reported account, even if no other action is taken.
+	SendWarning bool `form:"send_warning" json:"send_warning" xml:"send_warning"`
 }
 
 // AdminEmoji models the admin view of a custom emoji.
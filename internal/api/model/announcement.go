@@ -60,3 +60,53 @@ type Announcement struct {
 	// Reactions to this announcement.
 	Reactions []AnnouncementReaction `json:"reactions"`
 }
+
+// AnnouncementCreateRequest models a request to create an announcement.
+//
+// swagger:parameters announcementCreate
+type AnnouncementCreateRequest struct {
+	// Text of the announcement, markdown formatted.
+	// example: Server maintenance this weekend!
+	// in: formData
+	// required: true
+	Text string `form:"text" json:"text" xml:"text"`
+	// When the announcement should begin to be displayed (ISO 8601 Datetime).
+	// If not set, the announcement is displayed as soon as it's published.
+	// example: 2021-07-30T09:20:25+00:00
+	// in: formData
+	StartsAt string `form:"starts_at" json:"starts_at" xml:"starts_at"`
+	// When the announcement should stop being displayed (ISO 8601 Datetime).
+	// If not set, the announcement is displayed until it's deleted.
+	// example: 2021-07-30T09:20:25+00:00
+	// in: formData
+	EndsAt string `form:"ends_at" json:"ends_at" xml:"ends_at"`
+	// Treat starts_at and ends_at as whole days rather than exact times.
+	// in: formData
+	AllDay bool `form:"all_day" json:"all_day" xml:"all_day"`
+	// Publish the announcement straight away. Defaults to true.
+	// in: formData
+	Published *bool `form:"published" json:"published" xml:"published"`
+}
+
+// AnnouncementUpdateRequest models a request to update an announcement.
+// Only provided fields will be updated. Pass an empty string to
+// starts_at or ends_at to remove the start or end time.
+//
+// swagger:parameters announcementUpdate
+type AnnouncementUpdateRequest struct {
+	// Text of the announcement, markdown formatted.
+	// in: formData
+	Text *string `form:"text" json:"text" xml:"text"`
+	// When the announcement should begin to be displayed (ISO 8601 Datetime).
+	// in: formData
+	StartsAt *string `form:"starts_at" json:"starts_at" xml:"starts_at"`
+	// When the announcement should stop being displayed (ISO 8601 Datetime).
+	// in: formData
+	EndsAt *string `form:"ends_at" json:"ends_at" xml:"ends_at"`
+	// Treat starts_at and ends_at as whole days rather than exact times.
+	// in: formData
+	AllDay *bool `form:"all_day" json:"all_day" xml:"all_day"`
+	// Publish or unpublish the announcement.
+	// in: formData
+	Published *bool `form:"published" json:"published" xml:"published"`
+}
@@ -37,4 +37,8 @@ type AnnouncementReaction struct {
 	// Empty for unicode emojis.
 	// example: https://example.org/custom_emojis/statuc/blobcat_uwu.png
 	StaticURL string `json:"static_url,omitempty"`
+	// ID of the announcement this reaction belongs to.
+	// Only set when the reaction is sent over the streaming API.
+	// example: 01FC30T7X4TNCZK0TH90QYF3M4
+	AnnouncementID string `json:"announcement_id,omitempty"`
 }
@@ -79,3 +79,39 @@ type DomainBlockCreateRequest struct {
 	// public comment on the reason for the domain block
 	PublicComment string `form:"public_comment" json:"public_comment" xml:"public_comment"`
 }
+
+// EmailDomainBlock represents a block on sign-ups using email addresses at one domain.
+//
+// swagger:model emailDomainBlock
+type EmailDomainBlock struct {
+	// The ID of the email domain block.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	// readonly: true
+	ID string `json:"id"`
+	// The blocked email domain. Subdomains of this domain are blocked too.
+	// example: example.org
+	Domain string `json:"domain"`
+	// ID of the account that created this email domain block.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	CreatedBy string `json:"created_by,omitempty"`
+	// Time at which this block was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+}
+
+// EmailDomainBlockCreateRequest is the form submitted as a POST to /api/v1/admin/email_domain_blocks to create a new block.
+//
+// swagger:ignore
+type EmailDomainBlockCreateRequest struct {
+	// Email domain to block.
+	Domain string `form:"domain" json:"domain" xml:"domain"`
+}
+
+// AccountDomainBlockRequest is the form submitted as a POST or DELETE
+// to /api/v1/domain_blocks to block or unblock a domain for one account.
+//
+// swagger:ignore
+type AccountDomainBlockRequest struct {
+	// Domain to block or unblock.
+	Domain string `form:"domain" json:"domain" xml:"domain"`
+}
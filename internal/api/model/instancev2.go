@@ -63,8 +63,7 @@ type InstanceV2 struct {
 	//  Hints related to contacting a representative of the instance.
 	Contact InstanceV2Contact `json:"contact"`
 	// An itemized list of rules for this website.
-	// Currently not implemented (will always be empty array).
-	Rules []interface{} `json:"rules"`
+	Rules []InstanceRule `json:"rules"`
 }
 
 // Usage data for this instance.
@@ -134,7 +133,6 @@ type InstanceV2URLs struct {
 // swagger:model instanceV2ConfigurationTranslation
 type InstanceV2ConfigurationTranslation struct {
 	// Whether the Translations API is available on this instance.
-	// Not implemented so this value is always false.
 	Enabled bool `json:"enabled"`
 }
 
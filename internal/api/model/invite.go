@@ -0,0 +1,58 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package model
+
+// Invite represents an invite which can be used to sign up on this instance.
+//
+// swagger:model invite
+type Invite struct {
+	// The ID of the invite.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	// readonly: true
+	ID string `json:"id"`
+	// Code to give as invite_code when signing up.
+	// example: Xb7hQ2mK
+	Code string `json:"code"`
+	// Link to a page explaining how to sign up using this invite.
+	// example: https://example.org/invite/Xb7hQ2mK
+	URL string `json:"url"`
+	// Number of times the invite can be used. 0 means unlimited.
+	// example: 5
+	MaxUses int `json:"max_uses"`
+	// Number of times the invite has been used so far.
+	// example: 2
+	Uses int `json:"uses"`
+	// Whether the invite can still be used to sign up.
+	// example: true
+	Valid bool `json:"valid"`
+	// Time at which this invite was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Time at which this invite expires (ISO 8601 Datetime), if it expires.
+	// example: 2021-08-06T09:20:25+00:00
+	ExpiresAt *string `json:"expires_at"`
+}
+
+// InviteCreateRequest is the form submitted as a POST to /api/v1/invites to create a new invite.
+//
+// swagger:ignore
+type InviteCreateRequest struct {
+	// Number of times the invite can be used. 0 means unlimited. Defaults to 1.
+	MaxUses *int `form:"max_uses" json:"max_uses" xml:"max_uses"`
+	// Number of seconds after which the invite expires. 0 means never. Defaults to 1 week.
+	ExpiresIn *int `form:"expires_in" json:"expires_in" xml:"expires_in"`
+}
@@ -0,0 +1,57 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package model
+
+// IPBlock represents a block on sign-ups from, or all access from, a range of IP addresses.
+//
+// swagger:model ipBlock
+type IPBlock struct {
+	// The ID of the IP block.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	// readonly: true
+	ID string `json:"id"`
+	// The blocked IP address range, in CIDR notation.
+	// example: 192.0.2.0/24
+	IP string `json:"ip"`
+	// What the block prevents: `sign_up_block` prevents new sign-ups from the range,
+	// `no_access` denies all access to the instance from the range.
+	// example: sign_up_block
+	Severity string `json:"severity"`
+	// Private comment for this block, visible to our instance admins only.
+	// example: spam sign-ups
+	Comment string `json:"comment"`
+	// Time at which this block was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Time at which this block expires (ISO 8601 Datetime), if it expires.
+	// example: 2021-08-30T09:20:25+00:00
+	ExpiresAt *string `json:"expires_at"`
+}
+
+// IPBlockCreateRequest is the form submitted as a POST to /api/v1/admin/ip_blocks to create a new block.
+//
+// swagger:ignore
+type IPBlockCreateRequest struct {
+	// IP address or range to block, in CIDR notation.
+	IP string `form:"ip" json:"ip" xml:"ip"`
+	// Severity of the block: sign_up_block or no_access.
+	Severity string `form:"severity" json:"severity" xml:"severity"`
+	// Private comment on the block.
+	Comment string `form:"comment" json:"comment" xml:"comment"`
+	// Number of seconds after which the block expires. 0 or unset means never.
+	ExpiresIn int `form:"expires_in" json:"expires_in" xml:"expires_in"`
+}
@@ -31,8 +31,11 @@ type Notification struct {
 	// 	mention = Someone mentioned you in their status
 	// 	reblog = Someone boosted one of your statuses
 	// 	favourite = Someone favourited one of your statuses
+	// 	pleroma:emoji_reaction = Someone reacted to one of your statuses with an emoji
 	// 	poll = A poll you have voted in or created has ended
 	// 	status = Someone you enabled notifications for has posted a status
+	// 	moderation_warning = A moderator has taken action on your account or sent you a warning
+	// 	admin.report = A new report has been filed (only sent to moderators and admins)
 	Type string `json:"type"`
 	// The timestamp of the notification (ISO 8601 Datetime)
 	CreatedAt string `json:"created_at"`
@@ -43,6 +46,18 @@ type Notification struct {
 
 	// Status that was the object of the notification, e.g. in mentions, reblogs, favourites, or polls.
 	Status *Status `json:"status,omitempty"`
+	// Moderation warning that caused the notification.
+	ModerationWarning *AccountWarning `json:"moderation_warning,omitempty"`
+	// Report that was the object of the notification.
+	Report *Report `json:"report,omitempty"`
+	// Emoji of a pleroma:emoji_reaction notification.
+	// Either a unicode emoji, or a custom emoji's shortcode.
+	// example: blobcat_uwu
+	Emoji string `json:"emoji,omitempty"`
+	// Web link to the image of the custom emoji
+	// of a pleroma:emoji_reaction notification.
+	// example: https://example.org/custom_emojis/original/blobcat_uwu.png
+	EmojiURL string `json:"emoji_url,omitempty"`
 }
 
 /*
@@ -65,3 +80,59 @@ func (n *Notification) GetBoostOfID() string {
 func (n *Notification) GetBoostOfAccountID() string {
 	return ""
 }
+
+// NotificationsUnreadCount represents the number of
+// unread notifications for the requesting account.
+//
+// swagger:model notificationsUnreadCount
+type NotificationsUnreadCount struct {
+	// Number of notifications newer than the
+	// last read notification marker, capped
+	// to the requested limit.
+	Count int `json:"count"`
+}
+
+// GroupedNotificationsResults represents a page of notifications,
+// with notifications of some types grouped together, along with all
+// accounts and statuses referenced by the groups.
+//
+// swagger:model groupedNotificationsResults
+type GroupedNotificationsResults struct {
+	// Accounts referenced by notification groups.
+	Accounts []*Account `json:"accounts"`
+	// Statuses referenced by notification groups.
+	Statuses []*Status `json:"statuses"`
+	// The notification groups, ordered by most recent notification descending.
+	NotificationGroups []*NotificationGroup `json:"notification_groups"`
+}
+
+// NotificationGroup represents one or more notifications of the same type
+// about the same status, grouped together within one page of results.
+//
+// swagger:model notificationGroup
+type NotificationGroup struct {
+	// Key identifying this group. Grouped notifications share
+	// a key of the form `<type>-<status id>`, other notifications
+	// use `ungrouped-<notification id>`.
+	GroupKey string `json:"group_key"`
+	// Number of notifications in this group on this page.
+	NotificationsCount int `json:"notifications_count"`
+	// The type of event that resulted in the notifications in this group.
+	Type string `json:"type"`
+	// ID of the most recent notification in this group.
+	MostRecentNotificationID string `json:"most_recent_notification_id"`
+	// ID of the oldest notification in this group on this page.
+	PageMinID string `json:"page_min_id"`
+	// ID of the newest notification in this group on this page.
+	PageMaxID string `json:"page_max_id"`
+	// Timestamp of the most recent notification in this group (ISO 8601 Datetime).
+	LatestPageNotificationAt string `json:"latest_page_notification_at"`
+	// IDs of the accounts that performed the actions in this group, newest first.
+	SampleAccountIDs []string `json:"sample_account_ids"`
+	// ID of the status that was the object of the notifications, if any.
+	StatusID string `json:"status_id,omitempty"`
+	// Moderation warning that caused the notification.
+	ModerationWarning *AccountWarning `json:"moderation_warning,omitempty"`
+	// Report that was the object of the notification.
+	Report *Report `json:"report,omitempty"`
+}
@@ -0,0 +1,78 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// NotificationPolicy represents the requesting account's
+// preferences for filtering notifications from strangers.
+//
+// swagger:model notificationPolicy
+type NotificationPolicy struct {
+	// Filter notifications from accounts the requesting account doesn't follow.
+	FilterNotFollowing bool `json:"filter_not_following"`
+	// Filter notifications from accounts that don't follow the requesting account.
+	FilterNotFollowers bool `json:"filter_not_followers"`
+	// Filter notifications from accounts created in the past 30 days.
+	FilterNewAccounts bool `json:"filter_new_accounts"`
+	// Filter direct mentions from accounts the requesting account doesn't follow,
+	// unless they're in reply to a status of the requesting account.
+	FilterPrivateMentions bool `json:"filter_private_mentions"`
+	// Summary of pending notification requests.
+	Summary NotificationPolicySummary `json:"summary"`
+}
+
+// NotificationPolicySummary summarizes the
+// notifications currently kept aside by a policy.
+//
+// swagger:model notificationPolicySummary
+type NotificationPolicySummary struct {
+	// Number of pending notification requests.
+	PendingRequestsCount int `json:"pending_requests_count"`
+	// Number of notifications filtered into pending notification requests.
+	PendingNotificationsCount int `json:"pending_notifications_count"`
+}
+
+// NotificationPolicyUpdateRequest models a request to update the
+// notification policy of the requesting account. Unset fields are
+// left as they are.
+//
+// swagger:ignore
+type NotificationPolicyUpdateRequest struct {
+	FilterNotFollowing    *bool `form:"filter_not_following" json:"filter_not_following"`
+	FilterNotFollowers    *bool `form:"filter_not_followers" json:"filter_not_followers"`
+	FilterNewAccounts     *bool `form:"filter_new_accounts" json:"filter_new_accounts"`
+	FilterPrivateMentions *bool `form:"filter_private_mentions" json:"filter_private_mentions"`
+}
+
+// NotificationRequest represents the filtered notifications
+// sent to the requesting account by another account.
+//
+// swagger:model notificationRequest
+type NotificationRequest struct {
+	// The id of the notification request in the database.
+	ID string `json:"id"`
+	// When the first filtered notification from the account was received (ISO 8601 Datetime).
+	CreatedAt string `json:"created_at"`
+	// When the last filtered notification from the account was received (ISO 8601 Datetime).
+	UpdatedAt string `json:"updated_at"`
+	// The account that the filtered notifications originated from.
+	Account *Account `json:"account"`
+	// Number of notifications filtered into this request.
+	NotificationsCount int `json:"notifications_count"`
+	// Most recent status referenced by a filtered notification, if any.
+	LastStatus *Status `json:"last_status,omitempty"`
+}
@@ -36,4 +36,10 @@ type OAuthAuthorize struct {
 	// The authorization server must return the unmodified state value back to the application.
 	// See https://www.oauth.com/oauth2-servers/authorization/the-authorization-request/
 	State string `form:"state" json:"state"`
+	// PKCE code challenge derived from a code verifier generated by the application, as per RFC 7636.
+	// If set, the matching code_verifier must be provided when swapping the authorization code for a token.
+	// See https://datatracker.ietf.org/doc/html/rfc7636
+	CodeChallenge string `form:"code_challenge" json:"code_challenge"`
+	// Method used to derive the code challenge from the code verifier: S256 or plain. Defaults to plain.
+	CodeChallengeMethod string `form:"code_challenge_method" json:"code_challenge_method"`
 }
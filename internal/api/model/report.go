@@ -54,8 +54,8 @@ type Report struct {
 	StatusIDs []string `json:"status_ids"`
 	// Array of rule IDs that were submitted along with this report.
 	// Will be empty if no rule IDs were submitted.
-	// example: [1, 2]
-	RuleIDs []int `json:"rule_ids"`
+	// example: ["01GVTXNXMXKXBXBYKCMJAQJZJY","01GVTXP8RE6YMA3DMTDC9TAZ8R"]
+	RuleIDs []string `json:"rule_ids"`
 	// Account that was reported.
 	TargetAccount *Account `json:"target_account"`
 }
@@ -82,15 +82,16 @@ type ReportCreateRequest struct {
 	// default: false
 	// in: formData
 	Forward bool `form:"forward" json:"forward" xml:"forward"`
-	// Specify if the report is due to spam, violation of enumerated instance rules, or some other reason.
-	// Currently only 'other' is supported.
-	// example: other
+	// Specify if the report is due to spam, violation of enumerated instance rules, a legal issue, or some other reason.
+	// One of: spam, violation, legal, other.
+	// If not set, defaults to 'violation' when rule_ids are provided, or 'other' otherwise.
+	// example: violation
 	// default: other
 	// in: formData
 	Category string `form:"category" json:"category" xml:"category"`
 	// IDs of rules on this instance which have been broken according to the reporter.
-	// This is currently not supported, provided only for API compatibility.
-	// example: [1, 2, 3]
+	// Only used if category is 'violation'.
+	// example: ["01GVTXNXMXKXBXBYKCMJAQJZJY","01GVTXP8RE6YMA3DMTDC9TAZ8R"]
 	// in: formData
-	RuleIDs []int `form:"rule_ids[]" json:"rule_ids" xml:"rule_ids"`
+	RuleIDs []string `form:"rule_ids[]" json:"rule_ids" xml:"rule_ids"`
 }
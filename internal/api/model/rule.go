@@ -0,0 +1,52 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// InstanceRule represents one rule set by the admin(s) of this instance.
+//
+// swagger:model instanceRule
+type InstanceRule struct {
+	// The ID of the rule.
+	// example: 01GVTXNXMXKXBXBYKCMJAQJZJY
+	ID string `json:"id"`
+	// Text content of the rule.
+	// example: Be nice to each other.
+	Text string `json:"text"`
+}
+
+// InstanceRuleCreateRequest models a request to create an instance rule.
+//
+// swagger:parameters ruleCreate
+type InstanceRuleCreateRequest struct {
+	// Text content of the rule.
+	// example: Be nice to each other.
+	// in: formData
+	// required: true
+	Text string `form:"text" json:"text" xml:"text"`
+}
+
+// InstanceRuleUpdateRequest models a request to update an instance rule.
+//
+// swagger:parameters ruleUpdate
+type InstanceRuleUpdateRequest struct {
+	// Text content of the rule.
+	// example: Be nice to each other.
+	// in: formData
+	// required: true
+	Text string `form:"text" json:"text" xml:"text"`
+}
@@ -98,6 +98,31 @@ type Status struct {
 	// so the user may redraft from the source text without the client having to reverse-engineer
 	// the original text from the HTML content.
 	Text string `json:"text,omitempty"`
+	// This status is local-only: it will not be federated,
+	// and is only visible to accounts on this instance.
+	// example: false
+	LocalOnly bool `json:"local_only"`
+	// Who may interact with this status, and how.
+	InteractionPolicy StatusInteractionPolicy `json:"interaction_policy"`
+	// Emoji reactions to this status.
+	EmojiReactions []EmojiReaction `json:"emoji_reactions"`
+}
+
+// StatusInteractionPolicy models who may reply to, boost, or like a status.
+// Each value is one of 'anyone', 'followers', 'mentioned', 'nobody'. The
+// author of a status may always interact with it, regardless of policy.
+//
+// swagger:model statusInteractionPolicy
+type StatusInteractionPolicy struct {
+	// Who may reply to this status.
+	// example: anyone
+	CanReply string `json:"can_reply"`
+	// Who may boost this status.
+	// example: followers
+	CanReblog string `json:"can_reblog"`
+	// Who may like this status.
+	// example: mentioned
+	CanFavourite string `json:"can_favourite"`
 }
 
 /*
@@ -224,6 +249,15 @@ type AdvancedVisibilityFlagsForm struct {
 	Replyable *bool `form:"replyable" json:"replyable" xml:"replyable"`
 	// This status can be liked/faved.
 	Likeable *bool `form:"likeable" json:"likeable" xml:"likeable"`
+	// Who may reply to this status: one of 'anyone', 'followers', 'mentioned', 'nobody'.
+	// Followers also includes accounts mentioned in the status. Defaults to 'anyone'.
+	ReplyPolicy string `form:"reply_policy" json:"reply_policy" xml:"reply_policy"`
+	// Who may boost this status: one of 'anyone', 'followers', 'mentioned', 'nobody'.
+	// Followers also includes accounts mentioned in the status. Defaults to 'anyone'.
+	BoostPolicy string `form:"boost_policy" json:"boost_policy" xml:"boost_policy"`
+	// Who may like this status: one of 'anyone', 'followers', 'mentioned', 'nobody'.
+	// Followers also includes accounts mentioned in the status. Defaults to 'anyone'.
+	LikePolicy string `form:"like_policy" json:"like_policy" xml:"like_policy"`
 }
 
 // StatusContentType is the content type with which to parse the submitted status.
@@ -0,0 +1,40 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// Suggestion represents an account suggested to the requesting account as a follow.
+//
+// swagger:model suggestion
+type Suggestion struct {
+	// Reason this account is being suggested (deprecated, use sources instead).
+	// enum:
+	// - staff
+	// - past_interactions
+	// - global
+	// example: staff
+	Source string `json:"source"`
+	// Reasons this account is being suggested.
+	//
+	// - featured: pinned by an admin of this instance.
+	// - friends_of_friends: followed by accounts that the requesting account follows.
+	// - most_followed: one of the most followed accounts on this instance.
+	// example: ["featured"]
+	Sources []string `json:"sources"`
+	// The suggested account.
+	Account *Account `json:"account"`
+}
@@ -27,8 +27,22 @@ type Tag struct {
 	// Web link to the hashtag.
 	// example: https://example.org/tags/helloworld
 	URL string `json:"url"`
-	// History of this hashtag's usage.
-	// Currently just a stub, if provided will always be an empty array.
-	// example: []
-	History *[]any `json:"history,omitempty"`
+	// Usage history of this hashtag over the past week, most recent day first.
+	// Only provided where relevant, eg., in search results and trends.
+	History *[]TagHistory `json:"history,omitempty"`
+}
+
+// TagHistory represents one day of usage history of a hashtag or link.
+//
+// swagger:model tagHistory
+type TagHistory struct {
+	// UNIX timestamp of midnight (UTC) at the start of the day.
+	// example: 1691539200
+	Day string `json:"day"`
+	// Number of statuses using the hashtag or link on this day.
+	// example: 12
+	Uses string `json:"uses"`
+	// Number of distinct accounts using the hashtag or link on this day.
+	// example: 5
+	Accounts string `json:"accounts"`
 }
@@ -32,4 +32,11 @@ type Token struct {
 	// When the OAuth token was generated (UNIX timestamp seconds).
 	// example: 1627644520
 	CreatedAt int64 `json:"created_at"`
+	// Seconds until the access token expires.
+	// Only set if access tokens are configured to expire.
+	// example: 86400
+	ExpiresIn int64 `json:"expires_in,omitempty"`
+	// Refresh token which can be used to obtain a new access token.
+	// Only set if refresh tokens are enabled on this instance.
+	RefreshToken string `json:"refresh_token,omitempty"`
 }
@@ -0,0 +1,54 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// Translation represents the translation of a status into another language.
+//
+// swagger:model translation
+type Translation struct {
+	// Translated HTML content of the status.
+	Content string `json:"content"`
+	// Translated content warning of the status.
+	SpoilerText string `json:"spoiler_text"`
+	// Translated descriptions of the status' media attachments.
+	MediaAttachments []TranslationAttachment `json:"media_attachments"`
+	// Language of the original status, as given by the status or detected by the provider.
+	DetectedSourceLanguage string `json:"detected_source_language"`
+	// Name of the service that provided the translation.
+	Provider string `json:"provider"`
+}
+
+// TranslationAttachment represents the translated
+// description of one media attachment of a status.
+//
+// swagger:model translationAttachment
+type TranslationAttachment struct {
+	// ID of the media attachment.
+	ID string `json:"id"`
+	// Translated description of the media attachment.
+	Description string `json:"description"`
+}
+
+// StatusTranslateRequest models a request to translate a status.
+//
+// swagger:ignore
+type StatusTranslateRequest struct {
+	// BCP 47 language tag of the language to translate into.
+	// Defaults to the language of the requesting account.
+	Lang string `form:"lang" json:"lang"`
+}
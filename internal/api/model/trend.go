@@ -0,0 +1,62 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// TrendsLink represents a trending link: a preview card with usage history.
+//
+// swagger:model trendsLink
+type TrendsLink struct {
+	Card
+	// Usage history of this link over the past week, most recent day first.
+	History []TagHistory `json:"history"`
+}
+
+// AdminTrend represents a trending tag, status or link, as seen by an admin reviewing trends.
+//
+// swagger:model adminTrend
+type AdminTrend struct {
+	// The ID of the trend (not of the trending item).
+	// example: 01FBW9XGEP7G6K88VY4S9MPE1R
+	ID string `json:"id"`
+	// The type of the trending item.
+	// enum:
+	// - tag
+	// - status
+	// - link
+	// example: tag
+	Type string `json:"type"`
+	// Latest trend score of the item.
+	// example: 12.5
+	Score float64 `json:"score"`
+	// Review state of the trend. Only approved trends are shown to users.
+	// enum:
+	// - pending
+	// - approved
+	// - rejected
+	// example: pending
+	State string `json:"state"`
+	// Time when the trend was reviewed (ISO 8601 Datetime), if reviewed.
+	// example: 2021-07-30T09:20:25+00:00
+	ReviewedAt *string `json:"reviewed_at"`
+	// The trending tag, if type is tag.
+	Tag *Tag `json:"tag,omitempty"`
+	// The trending status, if type is status.
+	Status *Status `json:"status,omitempty"`
+	// The trending link, if type is link.
+	Link *TrendsLink `json:"link,omitempty"`
+}
@@ -34,3 +34,66 @@ type PasswordChangeRequest struct {
 	// required: true
 	NewPassword string `form:"new_password" json:"new_password" xml:"new_password" validation:"required"`
 }
+
+// TwoFactorSetup contains a newly-generated secret for
+// enrolling in two-factor authentication. The secret only
+// takes effect once a code generated from it has been confirmed.
+//
+// swagger:model twoFactorSetup
+type TwoFactorSetup struct {
+	// Base32-encoded TOTP secret, for manual entry into an authenticator app.
+	Secret string `json:"secret"`
+	// otpauth:// key URI containing the secret, suitable for rendering as a QR code.
+	URI string `json:"uri"`
+}
+
+// TwoFactorEnableRequest models two-factor authentication enable parameters.
+//
+// swagger:parameters userTwoFactorEnable
+type TwoFactorEnableRequest struct {
+	// Current code from the authenticator app,
+	// proving that the secret was entered correctly.
+	//
+	// in: formData
+	// required: true
+	Code string `form:"code" json:"code" xml:"code" validation:"required"`
+}
+
+// TwoFactorRecoveryCodes contains one-time recovery codes that can be
+// used to sign in instead of a TOTP code, if the authenticator is lost.
+// They are only shown once, so the user should store them somewhere safe.
+//
+// swagger:model twoFactorRecoveryCodes
+type TwoFactorRecoveryCodes struct {
+	// Unused recovery codes.
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// TwoFactorDisableRequest models two-factor authentication disable parameters.
+//
+// swagger:parameters userTwoFactorDisable
+type TwoFactorDisableRequest struct {
+	// User's current password.
+	//
+	// in: formData
+	// required: true
+	Password string `form:"password" json:"password" xml:"password" validation:"required"`
+}
+
+// UserLanguages contains the language preferences of a user.
+//
+// swagger:model userLanguages
+type UserLanguages struct {
+	// ISO 639-1 language codes of the languages that the user wants to see in
+	// public timelines. If empty, statuses in all languages are shown.
+	ChosenLanguages []string `json:"chosen_languages"`
+}
+
+// UserLanguagesUpdateRequest models user language preference update parameters.
+//
+// swagger:ignore
+type UserLanguagesUpdateRequest struct {
+	// ISO 639-1 language codes of the languages that the user wants to see
+	// in public timelines. Leave empty to show statuses in all languages.
+	ChosenLanguages []string `form:"chosen_languages[]" json:"chosen_languages" xml:"chosen_languages"`
+}
@@ -0,0 +1,132 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// WebPushSubscription represents a subscription to a Web Push server.
+//
+// swagger:model webPushSubscription
+type WebPushSubscription struct {
+	// The ID of the push subscription in the database.
+	// example: 01H7A8S2X8XGT8FTRA1X9FYRP6
+	ID string `json:"id"`
+	// Where push alerts will be sent to.
+	// example: https://updates.push.services.mozilla.com/wpush/v2/gAAAAA
+	Endpoint string `json:"endpoint"`
+	// Which alerts should be delivered to the endpoint.
+	Alerts WebPushSubscriptionAlerts `json:"alerts"`
+	// The streaming server's VAPID key.
+	// example: BCk-QqERU0q-CfYZjcuB6lnyyOYfJ2AifKqfeGIm7Z-HiTU5T9eTG5GxVA0_OH5mMlI4UkkDTpaZwozy0TzdZ2M=
+	ServerKey string `json:"server_key"`
+	// Which accounts push notifications are received from.
+	// example: all
+	Policy string `json:"policy"`
+}
+
+// WebPushSubscriptionAlerts represents the specific alerts that this push subscription will give.
+//
+// swagger:model webPushSubscriptionAlerts
+type WebPushSubscriptionAlerts struct {
+	// Receive a push notification when someone has followed you?
+	Follow bool `form:"follow" json:"follow"`
+	// Receive a push notification when someone has requested to follow you?
+	FollowRequest bool `form:"follow_request" json:"follow_request"`
+	// Receive a push notification when a status you created has been favourited by someone else?
+	Favourite bool `form:"favourite" json:"favourite"`
+	// Receive a push notification when someone else has mentioned you in a status?
+	Mention bool `form:"mention" json:"mention"`
+	// Receive a push notification when a status you created has been boosted by someone else?
+	Reblog bool `form:"reblog" json:"reblog"`
+	// Receive a push notification when a poll you voted in or created has ended?
+	Poll bool `form:"poll" json:"poll"`
+	// Receive a push notification when a subscribed account posts a status?
+	Status bool `form:"status" json:"status"`
+	// Receive a push notification when a new report has been filed?
+	AdminReport bool `form:"admin.report" json:"admin.report"`
+}
+
+// WebPushSubscriptionCreateRequest is the form submitted as a POST to
+// /api/v1/push/subscription to create a new push subscription.
+//
+// swagger:ignore
+type WebPushSubscriptionCreateRequest struct {
+	Subscription *WebPushSubscriptionRequestSubscription `form:"subscription" json:"subscription" xml:"subscription"`
+	Data         *WebPushSubscriptionRequestData         `form:"data" json:"data" xml:"data"`
+}
+
+// WebPushSubscriptionRequestSubscription models the
+// push service endpoint and user agent keys of a
+// push subscription create request.
+//
+// swagger:ignore
+type WebPushSubscriptionRequestSubscription struct {
+	// Where push alerts will be sent to.
+	Endpoint string `form:"endpoint" json:"endpoint" xml:"endpoint"`
+	// User agent public key and auth secret.
+	Keys WebPushSubscriptionRequestKeys `form:"keys" json:"keys" xml:"keys"`
+}
+
+// WebPushSubscriptionRequestKeys models the user agent
+// keys of a push subscription create request.
+//
+// swagger:ignore
+type WebPushSubscriptionRequestKeys struct {
+	// Base64 encoded P-256 ECDH public key of the user agent.
+	P256dh string `form:"p256dh" json:"p256dh" xml:"p256dh"`
+	// Base64 encoded authentication secret of the user agent.
+	Auth string `form:"auth" json:"auth" xml:"auth"`
+}
+
+// WebPushSubscriptionUpdateRequest is the form submitted as a PUT to
+// /api/v1/push/subscription to update the current push subscription.
+//
+// swagger:ignore
+type WebPushSubscriptionUpdateRequest struct {
+	Data *WebPushSubscriptionRequestData `form:"data" json:"data" xml:"data"`
+}
+
+// WebPushSubscriptionRequestData models the alerts
+// and policy of a push subscription create or update request.
+//
+// swagger:ignore
+type WebPushSubscriptionRequestData struct {
+	// Which alerts should be delivered to the endpoint.
+	Alerts *WebPushSubscriptionAlerts `form:"alerts" json:"alerts" xml:"alerts"`
+	// Which accounts to receive push notifications from: all, followed, follower, or none.
+	Policy *string `form:"policy" json:"policy" xml:"policy"`
+}
+
+// WebPushNotification is the decrypted payload of a push notification
+// delivered to a Web Push endpoint. It is never returned by the client API.
+//
+// swagger:ignore
+type WebPushNotification struct {
+	// Access token of the subscription, allows the client to fetch the notification.
+	AccessToken string `json:"access_token"`
+	// Preferred locale of the receiving user.
+	PreferredLocale string `json:"preferred_locale,omitempty"`
+	// ID of the notification.
+	NotificationID string `json:"notification_id"`
+	// Type of the notification.
+	NotificationType string `json:"notification_type"`
+	// Avatar URL of the account that caused the notification.
+	Icon string `json:"icon"`
+	// Title of the notification.
+	Title string `json:"title"`
+	// Body of the notification.
+	Body string `json:"body"`
+}
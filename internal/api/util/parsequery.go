@@ -51,6 +51,15 @@ const (
 	SearchResolveKey           = "resolve"
 	SearchTypeKey              = "type"
 
+	/* Trends keys */
+
+	TrendsOffsetKey = "offset"
+
+	/* Directory keys */
+
+	DirectoryOffsetKey = "offset"
+	DirectoryOrderKey  = "order"
+
 	/* Tag keys */
 
 	TagNameKey = "tag_name"
@@ -121,6 +130,28 @@ func ParseSearchResolve(value string, defaultValue bool) (bool, gtserror.WithCod
 	return parseBool(value, defaultValue, SearchResolveKey)
 }
 
+func ParseTrendsOffset(value string, defaultValue int, max, min int) (int, gtserror.WithCode) {
+	return parseInt(value, defaultValue, max, min, TrendsOffsetKey)
+}
+
+func ParseDirectoryOffset(value string, defaultValue int, max, min int) (int, gtserror.WithCode) {
+	return parseInt(value, defaultValue, max, min, DirectoryOffsetKey)
+}
+
+// ParseDirectoryOrder parses the profile directory order, which may
+// be either "active" (by most recent status) or "new" (by newest account).
+func ParseDirectoryOrder(value string, defaultValue string) (string, gtserror.WithCode) {
+	switch value {
+	case "":
+		return defaultValue, nil
+	case "active", "new":
+		return value, nil
+	default:
+		err := fmt.Errorf("%s must be either active or new", DirectoryOrderKey)
+		return "", parseError(DirectoryOrderKey, value, defaultValue, err)
+	}
+}
+
 func ParseDomainBlockExport(value string, defaultValue bool) (bool, gtserror.WithCode) {
 	return parseBool(value, defaultValue, DomainBlockExportKey)
 }
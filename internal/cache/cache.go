@@ -101,6 +101,19 @@ func (c *Caches) setuphooks() {
 
 		// Invalidate this account's block lists.
 		c.GTS.BlockIDs().Invalidate(account.ID)
+
+		// Invalidate this account's domain block lists.
+		c.GTS.AccountDomainBlockIDs().Invalidate(account.ID)
+	})
+
+	c.GTS.AccountDomainBlock().SetInvalidateCallback(func(block *gtsmodel.AccountDomainBlock) {
+		// Invalidate block origin account ID cached visibility;
+		// we don't know which accounts are on the blocked domain.
+		c.Visibility.Invalidate("ItemID", block.AccountID)
+		c.Visibility.Invalidate("RequesterID", block.AccountID)
+
+		// Invalidate source account's domain block lists.
+		c.GTS.AccountDomainBlockIDs().Invalidate(block.AccountID)
 	})
 
 	c.GTS.Block().SetInvalidateCallback(func(block *gtsmodel.Block) {
@@ -203,4 +216,9 @@ func (c *Caches) setuphooks() {
 		c.Visibility.Invalidate("ItemID", user.AccountID)
 		c.Visibility.Invalidate("RequesterID", user.AccountID)
 	})
+
+	c.GTS.WebPushSubscription().SetInvalidateCallback(func(sub *gtsmodel.WebPushSubscription) {
+		// Invalidate owning account's web push subscription lists.
+		c.GTS.WebPushSubscriptionIDs().Invalidate(sub.AccountID)
+	})
 }
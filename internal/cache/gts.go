@@ -20,39 +20,49 @@ package cache
 import (
 	"codeberg.org/gruf/go-cache/v3/result"
 	"codeberg.org/gruf/go-cache/v3/ttl"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/cache/domain"
+	"github.com/superseriousbusiness/gotosocial/internal/cache/ip"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
 type GTSCaches struct {
-	account          *result.Cache[*gtsmodel.Account]
-	accountNote      *result.Cache[*gtsmodel.AccountNote]
-	block            *result.Cache[*gtsmodel.Block]
-	blockIDs         *SliceCache[string]
-	domainBlock      *domain.BlockCache
-	emoji            *result.Cache[*gtsmodel.Emoji]
-	emojiCategory    *result.Cache[*gtsmodel.EmojiCategory]
-	follow           *result.Cache[*gtsmodel.Follow]
-	followIDs        *SliceCache[string]
-	followRequest    *result.Cache[*gtsmodel.FollowRequest]
-	followRequestIDs *SliceCache[string]
-	instance         *result.Cache[*gtsmodel.Instance]
-	list             *result.Cache[*gtsmodel.List]
-	listEntry        *result.Cache[*gtsmodel.ListEntry]
-	marker           *result.Cache[*gtsmodel.Marker]
-	media            *result.Cache[*gtsmodel.MediaAttachment]
-	mention          *result.Cache[*gtsmodel.Mention]
-	notification     *result.Cache[*gtsmodel.Notification]
-	report           *result.Cache[*gtsmodel.Report]
-	status           *result.Cache[*gtsmodel.Status]
-	statusFave       *result.Cache[*gtsmodel.StatusFave]
-	tag              *result.Cache[*gtsmodel.Tag]
-	tombstone        *result.Cache[*gtsmodel.Tombstone]
-	user             *result.Cache[*gtsmodel.User]
+	account                *result.Cache[*gtsmodel.Account]
+	accountNote            *result.Cache[*gtsmodel.AccountNote]
+	block                  *result.Cache[*gtsmodel.Block]
+	blockIDs               *SliceCache[string]
+	accountDomainBlock     *result.Cache[*gtsmodel.AccountDomainBlock]
+	accountDomainBlockIDs  *SliceCache[string]
+	card                   *result.Cache[*gtsmodel.Card]
+	domainBlock            *domain.BlockCache
+	emailDomainBlock       *domain.BlockCache
+	emoji                  *result.Cache[*gtsmodel.Emoji]
+	emojiCategory          *result.Cache[*gtsmodel.EmojiCategory]
+	follow                 *result.Cache[*gtsmodel.Follow]
+	followIDs              *SliceCache[string]
+	followRequest          *result.Cache[*gtsmodel.FollowRequest]
+	followRequestIDs       *SliceCache[string]
+	instance               *result.Cache[*gtsmodel.Instance]
+	ipBlock                *ip.BlockCache
+	list                   *result.Cache[*gtsmodel.List]
+	listEntry              *result.Cache[*gtsmodel.ListEntry]
+	marker                 *result.Cache[*gtsmodel.Marker]
+	media                  *result.Cache[*gtsmodel.MediaAttachment]
+	mention                *result.Cache[*gtsmodel.Mention]
+	notification           *result.Cache[*gtsmodel.Notification]
+	report                 *result.Cache[*gtsmodel.Report]
+	status                 *result.Cache[*gtsmodel.Status]
+	statusFave             *result.Cache[*gtsmodel.StatusFave]
+	tag                    *result.Cache[*gtsmodel.Tag]
+	tombstone              *result.Cache[*gtsmodel.Tombstone]
+	user                   *result.Cache[*gtsmodel.User]
+	webPushSubscription    *result.Cache[*gtsmodel.WebPushSubscription]
+	webPushSubscriptionIDs *SliceCache[string]
 
 	// TODO: move out of GTS caches since unrelated to DB.
-	webfinger *ttl.Cache[string, string]
+	translation *ttl.Cache[string, *apimodel.Translation]
+	webfinger   *ttl.Cache[string, string]
 }
 
 // Init will initialize all the gtsmodel caches in this collection.
@@ -62,7 +72,11 @@ func (c *GTSCaches) Init() {
 	c.initAccountNote()
 	c.initBlock()
 	c.initBlockIDs()
+	c.initAccountDomainBlock()
+	c.initAccountDomainBlockIDs()
+	c.initCard()
 	c.initDomainBlock()
+	c.initEmailDomainBlock()
 	c.initEmoji()
 	c.initEmojiCategory()
 	c.initFollow()
@@ -70,6 +84,7 @@ func (c *GTSCaches) Init() {
 	c.initFollowRequest()
 	c.initFollowRequestIDs()
 	c.initInstance()
+	c.initIPBlock()
 	c.initList()
 	c.initListEntry()
 	c.initMarker()
@@ -82,6 +97,9 @@ func (c *GTSCaches) Init() {
 	c.initTag()
 	c.initTombstone()
 	c.initUser()
+	c.initWebPushSubscription()
+	c.initWebPushSubscriptionIDs()
+	c.initTranslation()
 	c.initWebfinger()
 }
 
@@ -96,6 +114,14 @@ func (c *GTSCaches) Start() {
 		}
 		return true
 	})
+	tryStart(c.accountDomainBlock, config.GetCacheGTSAccountDomainBlockSweepFreq())
+	tryUntil("starting account domain block IDs cache", 5, func() bool {
+		if sweep := config.GetCacheGTSAccountDomainBlockIDsSweepFreq(); sweep > 0 {
+			return c.accountDomainBlockIDs.Start(sweep)
+		}
+		return true
+	})
+	tryStart(c.card, config.GetCacheGTSCardSweepFreq())
 	tryStart(c.emoji, config.GetCacheGTSEmojiSweepFreq())
 	tryStart(c.emojiCategory, config.GetCacheGTSEmojiCategorySweepFreq())
 	tryStart(c.follow, config.GetCacheGTSFollowSweepFreq())
@@ -125,6 +151,19 @@ func (c *GTSCaches) Start() {
 	tryStart(c.tag, config.GetCacheGTSTagSweepFreq())
 	tryStart(c.tombstone, config.GetCacheGTSTombstoneSweepFreq())
 	tryStart(c.user, config.GetCacheGTSUserSweepFreq())
+	tryStart(c.webPushSubscription, config.GetCacheGTSWebPushSubscriptionSweepFreq())
+	tryUntil("starting web push subscription IDs cache", 5, func() bool {
+		if sweep := config.GetCacheGTSWebPushSubscriptionIDsSweepFreq(); sweep > 0 {
+			return c.webPushSubscriptionIDs.Start(sweep)
+		}
+		return true
+	})
+	tryUntil("starting translation cache", 5, func() bool {
+		if sweep := config.GetCacheGTSTranslationSweepFreq(); sweep > 0 {
+			return c.translation.Start(sweep)
+		}
+		return true
+	})
 	tryUntil("starting *gtsmodel.Webfinger cache", 5, func() bool {
 		if sweep := config.GetCacheGTSWebfingerSweepFreq(); sweep > 0 {
 			return c.webfinger.Start(sweep)
@@ -144,6 +183,14 @@ func (c *GTSCaches) Stop() {
 		}
 		return true
 	})
+	tryStop(c.accountDomainBlock, config.GetCacheGTSAccountDomainBlockSweepFreq())
+	tryUntil("stopping account domain block IDs cache", 5, func() bool {
+		if config.GetCacheGTSAccountDomainBlockIDsSweepFreq() > 0 {
+			return c.accountDomainBlockIDs.Stop()
+		}
+		return true
+	})
+	tryStop(c.card, config.GetCacheGTSCardSweepFreq())
 	tryStop(c.emoji, config.GetCacheGTSEmojiSweepFreq())
 	tryStop(c.emojiCategory, config.GetCacheGTSEmojiCategorySweepFreq())
 	tryStop(c.follow, config.GetCacheGTSFollowSweepFreq())
@@ -173,6 +220,19 @@ func (c *GTSCaches) Stop() {
 	tryStop(c.tag, config.GetCacheGTSTagSweepFreq())
 	tryStop(c.tombstone, config.GetCacheGTSTombstoneSweepFreq())
 	tryStop(c.user, config.GetCacheGTSUserSweepFreq())
+	tryStop(c.webPushSubscription, config.GetCacheGTSWebPushSubscriptionSweepFreq())
+	tryUntil("stopping web push subscription IDs cache", 5, func() bool {
+		if config.GetCacheGTSWebPushSubscriptionIDsSweepFreq() > 0 {
+			return c.webPushSubscriptionIDs.Stop()
+		}
+		return true
+	})
+	tryUntil("stopping translation cache", 5, func() bool {
+		if config.GetCacheGTSTranslationSweepFreq() > 0 {
+			return c.translation.Stop()
+		}
+		return true
+	})
 	tryUntil("stopping *gtsmodel.Webfinger cache", 5, func() bool {
 		if config.GetCacheGTSWebfingerSweepFreq() > 0 {
 			return c.webfinger.Stop()
@@ -201,11 +261,31 @@ func (c *GTSCaches) BlockIDs() *SliceCache[string] {
 	return c.blockIDs
 }
 
+// AccountDomainBlock provides access to the gtsmodel AccountDomainBlock database cache.
+func (c *GTSCaches) AccountDomainBlock() *result.Cache[*gtsmodel.AccountDomainBlock] {
+	return c.accountDomainBlock
+}
+
+// AccountDomainBlockIDs provides access to the account domain block IDs database cache.
+func (c *GTSCaches) AccountDomainBlockIDs() *SliceCache[string] {
+	return c.accountDomainBlockIDs
+}
+
+// Card provides access to the gtsmodel Card database cache.
+func (c *GTSCaches) Card() *result.Cache[*gtsmodel.Card] {
+	return c.card
+}
+
 // DomainBlock provides access to the domain block database cache.
 func (c *GTSCaches) DomainBlock() *domain.BlockCache {
 	return c.domainBlock
 }
 
+// EmailDomainBlock provides access to the email domain block database cache.
+func (c *GTSCaches) EmailDomainBlock() *domain.BlockCache {
+	return c.emailDomainBlock
+}
+
 // Emoji provides access to the gtsmodel Emoji database cache.
 func (c *GTSCaches) Emoji() *result.Cache[*gtsmodel.Emoji] {
 	return c.emoji
@@ -249,6 +329,11 @@ func (c *GTSCaches) Instance() *result.Cache[*gtsmodel.Instance] {
 	return c.instance
 }
 
+// IPBlock provides access to the IP block database cache.
+func (c *GTSCaches) IPBlock() *ip.BlockCache {
+	return c.ipBlock
+}
+
 // List provides access to the gtsmodel List database cache.
 func (c *GTSCaches) List() *result.Cache[*gtsmodel.List] {
 	return c.list
@@ -309,6 +394,22 @@ func (c *GTSCaches) User() *result.Cache[*gtsmodel.User] {
 	return c.user
 }
 
+// WebPushSubscription provides access to the gtsmodel WebPushSubscription database cache.
+func (c *GTSCaches) WebPushSubscription() *result.Cache[*gtsmodel.WebPushSubscription] {
+	return c.webPushSubscription
+}
+
+// WebPushSubscriptionIDs provides access to the web push subscription IDs database cache.
+func (c *GTSCaches) WebPushSubscriptionIDs() *SliceCache[string] {
+	return c.webPushSubscriptionIDs
+}
+
+// Translation provides access to the status translation cache,
+// keyed by status ID and target language.
+func (c *GTSCaches) Translation() *ttl.Cache[string, *apimodel.Translation] {
+	return c.translation
+}
+
 // Webfinger provides access to the webfinger URL cache.
 func (c *GTSCaches) Webfinger() *ttl.Cache[string, string] {
 	return c.webfinger
@@ -371,10 +472,50 @@ func (c *GTSCaches) initBlockIDs() {
 	)}
 }
 
+func (c *GTSCaches) initAccountDomainBlock() {
+	c.accountDomainBlock = result.New([]result.Lookup{
+		{Name: "ID"},
+		{Name: "AccountID.Domain"},
+		{Name: "AccountID", Multi: true},
+	}, func(b1 *gtsmodel.AccountDomainBlock) *gtsmodel.AccountDomainBlock {
+		b2 := new(gtsmodel.AccountDomainBlock)
+		*b2 = *b1
+		return b2
+	}, config.GetCacheGTSAccountDomainBlockMaxSize())
+	c.accountDomainBlock.SetTTL(config.GetCacheGTSAccountDomainBlockTTL(), true)
+	c.accountDomainBlock.IgnoreErrors(ignoreErrors)
+}
+
+func (c *GTSCaches) initAccountDomainBlockIDs() {
+	c.accountDomainBlockIDs = &SliceCache[string]{Cache: ttl.New[string, []string](
+		0,
+		config.GetCacheGTSAccountDomainBlockIDsMaxSize(),
+		config.GetCacheGTSAccountDomainBlockIDsTTL(),
+	)}
+}
+
+func (c *GTSCaches) initCard() {
+	c.card = result.New([]result.Lookup{
+		{Name: "ID"},
+		{Name: "URL"},
+		{Name: "ImageID"},
+	}, func(c1 *gtsmodel.Card) *gtsmodel.Card {
+		c2 := new(gtsmodel.Card)
+		*c2 = *c1
+		return c2
+	}, config.GetCacheGTSCardMaxSize())
+	c.card.SetTTL(config.GetCacheGTSCardTTL(), true)
+	c.card.IgnoreErrors(ignoreErrors)
+}
+
 func (c *GTSCaches) initDomainBlock() {
 	c.domainBlock = new(domain.BlockCache)
 }
 
+func (c *GTSCaches) initEmailDomainBlock() {
+	c.emailDomainBlock = new(domain.BlockCache)
+}
+
 func (c *GTSCaches) initEmoji() {
 	c.emoji = result.New([]result.Lookup{
 		{Name: "ID"},
@@ -463,6 +604,10 @@ func (c *GTSCaches) initInstance() {
 	c.emojiCategory.IgnoreErrors(ignoreErrors)
 }
 
+func (c *GTSCaches) initIPBlock() {
+	c.ipBlock = new(ip.BlockCache)
+}
+
 func (c *GTSCaches) initList() {
 	c.list = result.New([]result.Lookup{
 		{Name: "ID"},
@@ -609,6 +754,7 @@ func (c *GTSCaches) initUser() {
 		{Name: "AccountID"},
 		{Name: "Email"},
 		{Name: "ConfirmationToken"},
+		{Name: "ResetPasswordToken"},
 		{Name: "ExternalID"},
 	}, func(u1 *gtsmodel.User) *gtsmodel.User {
 		u2 := new(gtsmodel.User)
@@ -619,6 +765,36 @@ func (c *GTSCaches) initUser() {
 	c.user.IgnoreErrors(ignoreErrors)
 }
 
+func (c *GTSCaches) initWebPushSubscription() {
+	c.webPushSubscription = result.New([]result.Lookup{
+		{Name: "ID"},
+		{Name: "TokenID"},
+		{Name: "AccountID", Multi: true},
+	}, func(s1 *gtsmodel.WebPushSubscription) *gtsmodel.WebPushSubscription {
+		s2 := new(gtsmodel.WebPushSubscription)
+		*s2 = *s1
+		return s2
+	}, config.GetCacheGTSWebPushSubscriptionMaxSize())
+	c.webPushSubscription.SetTTL(config.GetCacheGTSWebPushSubscriptionTTL(), true)
+	c.webPushSubscription.IgnoreErrors(ignoreErrors)
+}
+
+func (c *GTSCaches) initWebPushSubscriptionIDs() {
+	c.webPushSubscriptionIDs = &SliceCache[string]{Cache: ttl.New[string, []string](
+		0,
+		config.GetCacheGTSWebPushSubscriptionIDsMaxSize(),
+		config.GetCacheGTSWebPushSubscriptionIDsTTL(),
+	)}
+}
+
+func (c *GTSCaches) initTranslation() {
+	c.translation = ttl.New[string, *apimodel.Translation](
+		0,
+		config.GetCacheGTSTranslationMaxSize(),
+		config.GetCacheGTSTranslationTTL(),
+	)
+}
+
 func (c *GTSCaches) initWebfinger() {
 	c.webfinger = ttl.New[string, string](
 		0,
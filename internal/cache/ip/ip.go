@@ -0,0 +1,101 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package ip
+
+import (
+	"fmt"
+	"net/netip"
+	"sync/atomic"
+	"time"
+)
+
+// Block is one blocked range of IP addresses, as stored in BlockCache.
+type Block struct {
+	// Prefix is the blocked range.
+	Prefix netip.Prefix
+
+	// Severity of the block, opaque to the cache.
+	Severity string
+
+	// ExpiresAt is the time after which
+	// the block no longer applies; zero
+	// means the block never expires.
+	ExpiresAt time.Time
+}
+
+// BlockCache provides a means of caching IP blocks in memory to reduce load
+// on an underlying storage mechanism, e.g. a database.
+//
+// As with domain.BlockCache, the in-memory block list is hydrated by a passed
+// loader function during calls to .Match() if it's not currently loaded. The
+// .Clear() function can be used to invalidate the cache, e.g. when an IP block
+// is added / deleted from the database.
+type BlockCache struct {
+	// atomically updated ptr value to
+	// the current list of blocked ranges.
+	blocks atomic.Pointer[[]Block]
+}
+
+// Match returns all unexpired blocks with a range containing the given address.
+// If the cache is not currently loaded, then the provided load function is used
+// to hydrate it.
+func (b *BlockCache) Match(addr netip.Addr, load func() ([]Block, error)) ([]Block, error) {
+	blocks := b.blocks.Load()
+
+	if blocks == nil {
+		// Cache is not hydrated.
+		//
+		// Load blocks from callback.
+		loaded, err := load()
+		if err != nil {
+			return nil, fmt.Errorf("error reloading cache: %w", err)
+		}
+
+		// Store the new list ptr.
+		blocks = &loaded
+		b.blocks.Store(blocks)
+	}
+
+	// Strip any IPv6 zone, and unmap
+	// IPv4-mapped IPv6 addresses so that
+	// they match IPv4 blocks as expected.
+	addr = addr.WithZone("").Unmap()
+
+	var (
+		now     = time.Now()
+		matches []Block
+	)
+
+	for _, block := range *blocks {
+		if !block.ExpiresAt.IsZero() && !block.ExpiresAt.After(now) {
+			// Expired, ignore.
+			continue
+		}
+
+		if block.Prefix.Contains(addr) {
+			matches = append(matches, block)
+		}
+	}
+
+	return matches, nil
+}
+
+// Clear will drop the currently loaded block list,
+// triggering a reload on next call to .Match().
+func (b *BlockCache) Clear() {
+	b.blocks.Store(nil)
+}
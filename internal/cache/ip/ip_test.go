@@ -0,0 +1,85 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package ip_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/cache/ip"
+)
+
+func TestBlockCache(t *testing.T) {
+	c := new(ip.BlockCache)
+
+	blocks := []ip.Block{
+		{Prefix: netip.MustParsePrefix("192.0.2.0/24"), Severity: "no_access"},
+		{Prefix: netip.MustParsePrefix("198.51.100.7/32"), Severity: "sign_up_block"},
+		{Prefix: netip.MustParsePrefix("2001:db8::/32"), Severity: "sign_up_block"},
+		{Prefix: netip.MustParsePrefix("203.0.113.0/24"), Severity: "no_access", ExpiresAt: time.Now().Add(-time.Minute)},
+	}
+
+	loads := 0
+	loader := func() ([]ip.Block, error) {
+		loads++
+		return blocks, nil
+	}
+
+	for _, test := range []struct {
+		addr     string
+		severity string
+	}{
+		{"192.0.2.1", "no_access"},
+		{"192.0.2.255", "no_access"},
+		{"::ffff:192.0.2.10", "no_access"},
+		{"198.51.100.7", "sign_up_block"},
+		{"198.51.100.8", ""},
+		{"2001:db8:1234::1", "sign_up_block"},
+		{"2001:db9::1", ""},
+		{"203.0.113.1", ""}, // expired
+		{"127.0.0.1", ""},
+	} {
+		matches, err := c.Match(netip.MustParseAddr(test.addr), loader)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if test.severity == "" {
+			if len(matches) != 0 {
+				t.Errorf("address should not be blocked: %s", test.addr)
+			}
+			continue
+		}
+
+		if len(matches) != 1 || matches[0].Severity != test.severity {
+			t.Errorf("address %s should be blocked with severity %s, got %+v", test.addr, test.severity, matches)
+		}
+	}
+
+	if loads != 1 {
+		t.Errorf("expected 1 load, got %d", loads)
+	}
+
+	// Clearing the cache should trigger a reload.
+	c.Clear()
+	blocks = nil
+	matches, _ := c.Match(netip.MustParseAddr("192.0.2.1"), loader)
+	if len(matches) != 0 || loads != 2 {
+		t.Errorf("expected cache to be reloaded empty")
+	}
+}
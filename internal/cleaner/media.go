@@ -361,6 +361,15 @@ func (m *Media) pruneUnused(ctx context.Context, media *gtsmodel.MediaAttachment
 		}
 	}
 
+	// Check whether media is in use as a preview card thumbnail.
+	_, err = m.state.DB.GetCardByImageID(gtscontext.SetBarebones(ctx), media.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, gtserror.Newf("error checking card for media: %w", err)
+	} else if err == nil {
+		l.Debug("skipping as in use by preview card")
+		return false, nil
+	}
+
 	// Media totally unused, delete it.
 	l.Debug("deleting unused media")
 	return true, m.delete(ctx, media)
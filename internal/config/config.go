@@ -75,18 +75,20 @@ type Configuration struct {
 	WebTemplateBaseDir string `name:"web-template-base-dir" usage:"Basedir for html templating files for rendering pages and composing emails."`
 	WebAssetBaseDir    string `name:"web-asset-base-dir" usage:"Directory to serve static assets from, accessible at example.org/assets/"`
 
-	InstanceExposePeers            bool `name:"instance-expose-peers" usage:"Allow unauthenticated users to query /api/v1/instance/peers?filter=open"`
-	InstanceExposeSuspended        bool `name:"instance-expose-suspended" usage:"Expose suspended instances via web UI, and allow unauthenticated users to query /api/v1/instance/peers?filter=suspended"`
-	InstanceExposeSuspendedWeb     bool `name:"instance-expose-suspended-web" usage:"Expose list of suspended instances as webpage on /about/suspended"`
-	InstanceExposePublicTimeline   bool `name:"instance-expose-public-timeline" usage:"Allow unauthenticated users to query /api/v1/timelines/public"`
-	InstanceDeliverToSharedInboxes bool `name:"instance-deliver-to-shared-inboxes" usage:"Deliver federated messages to shared inboxes, if they're available."`
-	InstanceInjectMastodonVersion  bool `name:"instance-inject-mastodon-version" usage:"This injects a Mastodon compatible version in /api/v1/instance to help Mastodon clients that use that version for feature detection"`
+	InstanceExposePeers               bool `name:"instance-expose-peers" usage:"Allow unauthenticated users to query /api/v1/instance/peers?filter=open"`
+	InstanceExposeSuspended           bool `name:"instance-expose-suspended" usage:"Expose suspended instances via web UI, and allow unauthenticated users to query /api/v1/instance/peers?filter=suspended"`
+	InstanceExposeSuspendedWeb        bool `name:"instance-expose-suspended-web" usage:"Expose list of suspended instances as webpage on /about/suspended"`
+	InstanceExposePublicTimeline      bool `name:"instance-expose-public-timeline" usage:"Allow unauthenticated users to query /api/v1/timelines/public"`
+	InstanceDeliverToSharedInboxes    bool `name:"instance-deliver-to-shared-inboxes" usage:"Deliver federated messages to shared inboxes, if they're available."`
+	InstanceAutoCloseSuspendedReports bool `name:"instance-auto-close-suspended-reports" usage:"Automatically resolve incoming federated reports targeting accounts that are already suspended."`
+	InstanceInjectMastodonVersion     bool `name:"instance-inject-mastodon-version" usage:"This injects a Mastodon compatible version in /api/v1/instance to help Mastodon clients that use that version for feature detection"`
 
 	AccountsRegistrationOpen bool `name:"accounts-registration-open" usage:"Allow anyone to submit an account signup request. If false, server will be invite-only."`
 	AccountsApprovalRequired bool `name:"accounts-approval-required" usage:"Do account signups require approval by an admin or moderator before user can log in? If false, new registrations will be automatically approved."`
 	AccountsReasonRequired   bool `name:"accounts-reason-required" usage:"Do new account signups require a reason to be submitted on registration?"`
 	AccountsAllowCustomCSS   bool `name:"accounts-allow-custom-css" usage:"Allow accounts to enable custom CSS for their profile pages and statuses."`
 	AccountsCustomCSSLength  int  `name:"accounts-custom-css-length" usage:"Maximum permitted length (characters) of custom CSS for accounts."`
+	AccountsAllowUserInvites bool `name:"accounts-allow-user-invites" usage:"Allow all users, not just admins, to create invites which let new users sign up without approval, even if registration is closed."`
 
 	MediaImageMaxSize        bytesize.Size `name:"media-image-max-size" usage:"Max size of accepted images in bytes"`
 	MediaVideoMaxSize        bytesize.Size `name:"media-video-max-size" usage:"Max size of accepted videos in bytes"`
@@ -119,6 +121,10 @@ type Configuration struct {
 	TLSCertificateChain string `name:"tls-certificate-chain" usage:"Filesystem path to the certificate chain including any intermediate CAs and the TLS public key"`
 	TLSCertificateKey   string `name:"tls-certificate-key" usage:"Filesystem path to the TLS private key"`
 
+	OAuthAccessTokenExpiry  time.Duration `name:"oauth-access-token-expiry" usage:"Duration after which OAuth access tokens issued to users expire. 0 means tokens never expire, and must be revoked instead."`
+	OAuthRefreshTokens      bool          `name:"oauth-refresh-tokens" usage:"Issue refresh tokens alongside OAuth access tokens, which clients can use to get a new access token once the old one has expired."`
+	OAuthRefreshTokenExpiry time.Duration `name:"oauth-refresh-token-expiry" usage:"Duration after which OAuth refresh tokens expire. 0 means refresh tokens never expire."`
+
 	OIDCEnabled          bool     `name:"oidc-enabled" usage:"Enabled OIDC authorization for this instance. If set to true, then the other OIDC flags must also be set."`
 	OIDCIdpName          string   `name:"oidc-idp-name" usage:"Name of the OIDC identity provider. Will be shown to the user when logging in."`
 	OIDCSkipVerification bool     `name:"oidc-skip-verification" usage:"Skip verification of tokens returned by the OIDC provider. Should only be set to 'true' for testing purposes, never in a production environment!"`
@@ -145,6 +151,10 @@ type Configuration struct {
 	SyslogProtocol string `name:"syslog-protocol" usage:"Protocol to use when directing logs to syslog. Leave empty to connect to local syslog."`
 	SyslogAddress  string `name:"syslog-address" usage:"Address:port to send syslog logs to. Leave empty to connect to local syslog."`
 
+	TranslationBackend              string `name:"translation-backend" usage:"Backend to use for translating statuses: '' (translation disabled) or 'libretranslate'"`
+	TranslationLibreTranslateURL    string `name:"translation-libretranslate-url" usage:"Base URL of the LibreTranslate-compatible API to use for translating statuses. Eg., 'http://localhost:5000'"`
+	TranslationLibreTranslateAPIKey string `name:"translation-libretranslate-api-key" usage:"API key to pass to the LibreTranslate-compatible API, if it requires one."`
+
 	AdvancedCookiesSamesite      string        `name:"advanced-cookies-samesite" usage:"'strict' or 'lax', see https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Set-Cookie/SameSite"`
 	AdvancedRateLimitRequests    int           `name:"advanced-rate-limit-requests" usage:"Amount of HTTP requests to permit within a 5 minute window. 0 or less turns rate limiting off."`
 	AdvancedThrottlingMultiplier int           `name:"advanced-throttling-multiplier" usage:"Multiplier to use per cpu for http request throttling. 0 or less turns throttling off."`
@@ -158,11 +168,13 @@ type Configuration struct {
 	Cache CacheConfiguration `name:"cache"`
 
 	// TODO: move these elsewhere, these are more ephemeral vs long-running flags like above
-	AdminAccountUsername  string `name:"username" usage:"the username to create/delete/etc"`
-	AdminAccountEmail     string `name:"email" usage:"the email address of this account"`
-	AdminAccountPassword  string `name:"password" usage:"the password to set for this account"`
-	AdminTransPath        string `name:"path" usage:"the path of the file to import from/export to"`
-	AdminMediaPruneDryRun bool   `name:"dry-run" usage:"perform a dry run and only log number of items eligible for pruning"`
+	AdminAccountUsername  string        `name:"username" usage:"the username to create/delete/etc"`
+	AdminAccountEmail     string        `name:"email" usage:"the email address of this account"`
+	AdminAccountPassword  string        `name:"password" usage:"the password to set for this account"`
+	AdminTransPath        string        `name:"path" usage:"the path of the file to import from/export to"`
+	AdminMediaPruneDryRun bool          `name:"dry-run" usage:"perform a dry run and only log number of items eligible for pruning"`
+	AdminInviteMaxUses    int           `name:"max-uses" usage:"the number of times the invite can be used; 0 for unlimited"`
+	AdminInviteExpiresIn  time.Duration `name:"expires-in" usage:"how long until the invite expires, eg. '24h'; 0 for never"`
 
 	RequestIDHeader string `name:"request-id-header" usage:"Header to extract the Request ID from. Eg.,'X-Request-Id'."`
 }
@@ -186,6 +198,14 @@ type GTSCacheConfiguration struct {
 	AccountTTL       time.Duration `name:"account-ttl"`
 	AccountSweepFreq time.Duration `name:"account-sweep-freq"`
 
+	AccountDomainBlockMaxSize   int           `name:"account-domain-block-max-size"`
+	AccountDomainBlockTTL       time.Duration `name:"account-domain-block-ttl"`
+	AccountDomainBlockSweepFreq time.Duration `name:"account-domain-block-sweep-freq"`
+
+	AccountDomainBlockIDsMaxSize   int           `name:"account-domain-block-ids-max-size"`
+	AccountDomainBlockIDsTTL       time.Duration `name:"account-domain-block-ids-ttl"`
+	AccountDomainBlockIDsSweepFreq time.Duration `name:"account-domain-block-ids-sweep-freq"`
+
 	AccountNoteMaxSize   int           `name:"account-note-max-size"`
 	AccountNoteTTL       time.Duration `name:"account-note-ttl"`
 	AccountNoteSweepFreq time.Duration `name:"account-note-sweep-freq"`
@@ -198,6 +218,10 @@ type GTSCacheConfiguration struct {
 	BlockIDsTTL       time.Duration `name:"block-ids-ttl"`
 	BlockIDsSweepFreq time.Duration `name:"block-ids-sweep-freq"`
 
+	CardMaxSize   int           `name:"card-max-size"`
+	CardTTL       time.Duration `name:"card-ttl"`
+	CardSweepFreq time.Duration `name:"card-sweep-freq"`
+
 	DomainBlockMaxSize   int           `name:"domain-block-max-size"`
 	DomainBlockTTL       time.Duration `name:"domain-block-ttl"`
 	DomainBlockSweepFreq time.Duration `name:"domain-block-sweep-freq"`
@@ -274,10 +298,22 @@ type GTSCacheConfiguration struct {
 	TombstoneTTL       time.Duration `name:"tombstone-ttl"`
 	TombstoneSweepFreq time.Duration `name:"tombstone-sweep-freq"`
 
+	TranslationMaxSize   int           `name:"translation-max-size"`
+	TranslationTTL       time.Duration `name:"translation-ttl"`
+	TranslationSweepFreq time.Duration `name:"translation-sweep-freq"`
+
 	UserMaxSize   int           `name:"user-max-size"`
 	UserTTL       time.Duration `name:"user-ttl"`
 	UserSweepFreq time.Duration `name:"user-sweep-freq"`
 
+	WebPushSubscriptionMaxSize   int           `name:"web-push-subscription-max-size"`
+	WebPushSubscriptionTTL       time.Duration `name:"web-push-subscription-ttl"`
+	WebPushSubscriptionSweepFreq time.Duration `name:"web-push-subscription-sweep-freq"`
+
+	WebPushSubscriptionIDsMaxSize   int           `name:"web-push-subscription-ids-max-size"`
+	WebPushSubscriptionIDsTTL       time.Duration `name:"web-push-subscription-ids-ttl"`
+	WebPushSubscriptionIDsSweepFreq time.Duration `name:"web-push-subscription-ids-sweep-freq"`
+
 	WebfingerMaxSize   int           `name:"webfinger-max-size"`
 	WebfingerTTL       time.Duration `name:"webfinger-ttl"`
 	WebfingerSweepFreq time.Duration `name:"webfinger-sweep-freq"`
@@ -56,15 +56,17 @@ var Defaults = Configuration{
 	WebTemplateBaseDir: "./web/template/",
 	WebAssetBaseDir:    "./web/assets/",
 
-	InstanceExposePeers:            false,
-	InstanceExposeSuspended:        false,
-	InstanceExposeSuspendedWeb:     false,
-	InstanceDeliverToSharedInboxes: true,
+	InstanceExposePeers:               false,
+	InstanceExposeSuspended:           false,
+	InstanceExposeSuspendedWeb:        false,
+	InstanceDeliverToSharedInboxes:    true,
+	InstanceAutoCloseSuspendedReports: false,
 
 	AccountsRegistrationOpen: true,
 	AccountsApprovalRequired: true,
 	AccountsReasonRequired:   true,
 	AccountsAllowCustomCSS:   false,
+	AccountsAllowUserInvites: false,
 	AccountsCustomCSSLength:  10000,
 
 	MediaImageMaxSize:        10 * bytesize.MiB,
@@ -94,6 +96,10 @@ var Defaults = Configuration{
 	TLSCertificateChain: "",
 	TLSCertificateKey:   "",
 
+	OAuthAccessTokenExpiry:  0,
+	OAuthRefreshTokens:      false,
+	OAuthRefreshTokenExpiry: 0,
+
 	OIDCEnabled:          false,
 	OIDCIdpName:          "",
 	OIDCSkipVerification: false,
@@ -119,6 +125,10 @@ var Defaults = Configuration{
 	SyslogProtocol: "udp",
 	SyslogAddress:  "localhost:514",
 
+	TranslationBackend:              "",
+	TranslationLibreTranslateURL:    "",
+	TranslationLibreTranslateAPIKey: "",
+
 	AdvancedCookiesSamesite:      "lax",
 	AdvancedRateLimitRequests:    300, // 1 per second per 5 minutes
 	AdvancedThrottlingMultiplier: 8,   // 8 open requests per CPU
@@ -131,6 +141,14 @@ var Defaults = Configuration{
 			AccountTTL:       time.Minute * 30,
 			AccountSweepFreq: time.Minute,
 
+			AccountDomainBlockMaxSize:   1000,
+			AccountDomainBlockTTL:       time.Minute * 30,
+			AccountDomainBlockSweepFreq: time.Minute,
+
+			AccountDomainBlockIDsMaxSize:   500,
+			AccountDomainBlockIDsTTL:       time.Minute * 30,
+			AccountDomainBlockIDsSweepFreq: time.Minute,
+
 			AccountNoteMaxSize:   1000,
 			AccountNoteTTL:       time.Minute * 30,
 			AccountNoteSweepFreq: time.Minute,
@@ -143,6 +161,10 @@ var Defaults = Configuration{
 			BlockIDsTTL:       time.Minute * 30,
 			BlockIDsSweepFreq: time.Minute,
 
+			CardMaxSize:   1000,
+			CardTTL:       time.Minute * 30,
+			CardSweepFreq: time.Minute,
+
 			DomainBlockMaxSize:   2000,
 			DomainBlockTTL:       time.Hour * 24,
 			DomainBlockSweepFreq: time.Minute,
@@ -219,10 +241,22 @@ var Defaults = Configuration{
 			TombstoneTTL:       time.Minute * 30,
 			TombstoneSweepFreq: time.Minute,
 
+			TranslationMaxSize:   1000,
+			TranslationTTL:       time.Hour * 24,
+			TranslationSweepFreq: time.Minute * 15,
+
 			UserMaxSize:   500,
 			UserTTL:       time.Minute * 30,
 			UserSweepFreq: time.Minute,
 
+			WebPushSubscriptionMaxSize:   500,
+			WebPushSubscriptionTTL:       time.Minute * 30,
+			WebPushSubscriptionSweepFreq: time.Minute,
+
+			WebPushSubscriptionIDsMaxSize:   500,
+			WebPushSubscriptionIDsTTL:       time.Minute * 30,
+			WebPushSubscriptionIDsSweepFreq: time.Minute,
+
 			WebfingerMaxSize:   250,
 			WebfingerTTL:       time.Hour * 24,
 			WebfingerSweepFreq: time.Minute * 15,
@@ -240,6 +274,8 @@ var Defaults = Configuration{
 	},
 
 	AdminMediaPruneDryRun: true,
+	AdminInviteMaxUses:    1,
+	AdminInviteExpiresIn:  7 * 24 * time.Hour,
 
 	RequestIDHeader: "X-Request-Id",
 
@@ -18,6 +18,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 )
 
@@ -85,12 +87,14 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().Bool(InstanceExposeSuspendedFlag(), cfg.InstanceExposeSuspended, fieldtag("InstanceExposeSuspended", "usage"))
 		cmd.Flags().Bool(InstanceExposeSuspendedWebFlag(), cfg.InstanceExposeSuspendedWeb, fieldtag("InstanceExposeSuspendedWeb", "usage"))
 		cmd.Flags().Bool(InstanceDeliverToSharedInboxesFlag(), cfg.InstanceDeliverToSharedInboxes, fieldtag("InstanceDeliverToSharedInboxes", "usage"))
+		cmd.Flags().Bool(InstanceAutoCloseSuspendedReportsFlag(), cfg.InstanceAutoCloseSuspendedReports, fieldtag("InstanceAutoCloseSuspendedReports", "usage"))
 
 		// Accounts
 		cmd.Flags().Bool(AccountsRegistrationOpenFlag(), cfg.AccountsRegistrationOpen, fieldtag("AccountsRegistrationOpen", "usage"))
 		cmd.Flags().Bool(AccountsApprovalRequiredFlag(), cfg.AccountsApprovalRequired, fieldtag("AccountsApprovalRequired", "usage"))
 		cmd.Flags().Bool(AccountsReasonRequiredFlag(), cfg.AccountsReasonRequired, fieldtag("AccountsReasonRequired", "usage"))
 		cmd.Flags().Bool(AccountsAllowCustomCSSFlag(), cfg.AccountsAllowCustomCSS, fieldtag("AccountsAllowCustomCSS", "usage"))
+		cmd.Flags().Bool(AccountsAllowUserInvitesFlag(), cfg.AccountsAllowUserInvites, fieldtag("AccountsAllowUserInvites", "usage"))
 
 		// Media
 		cmd.Flags().Uint64(MediaImageMaxSizeFlag(), uint64(cfg.MediaImageMaxSize), fieldtag("MediaImageMaxSize", "usage"))
@@ -122,6 +126,11 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().String(TLSCertificateChainFlag(), cfg.TLSCertificateChain, fieldtag("TLSCertificateChain", "usage"))
 		cmd.Flags().String(TLSCertificateKeyFlag(), cfg.TLSCertificateKey, fieldtag("TLSCertificateKey", "usage"))
 
+		// OAuth
+		cmd.Flags().Duration(OAuthAccessTokenExpiryFlag(), cfg.OAuthAccessTokenExpiry, fieldtag("OAuthAccessTokenExpiry", "usage"))
+		cmd.Flags().Bool(OAuthRefreshTokensFlag(), cfg.OAuthRefreshTokens, fieldtag("OAuthRefreshTokens", "usage"))
+		cmd.Flags().Duration(OAuthRefreshTokenExpiryFlag(), cfg.OAuthRefreshTokenExpiry, fieldtag("OAuthRefreshTokenExpiry", "usage"))
+
 		// OIDC
 		cmd.Flags().Bool(OIDCEnabledFlag(), cfg.OIDCEnabled, fieldtag("OIDCEnabled", "usage"))
 		cmd.Flags().String(OIDCIdpNameFlag(), cfg.OIDCIdpName, fieldtag("OIDCIdpName", "usage"))
@@ -144,6 +153,11 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().String(SyslogProtocolFlag(), cfg.SyslogProtocol, fieldtag("SyslogProtocol", "usage"))
 		cmd.Flags().String(SyslogAddressFlag(), cfg.SyslogAddress, fieldtag("SyslogAddress", "usage"))
 
+		// Translation
+		cmd.Flags().String(TranslationBackendFlag(), cfg.TranslationBackend, fieldtag("TranslationBackend", "usage"))
+		cmd.Flags().String(TranslationLibreTranslateURLFlag(), cfg.TranslationLibreTranslateURL, fieldtag("TranslationLibreTranslateURL", "usage"))
+		cmd.Flags().String(TranslationLibreTranslateAPIKeyFlag(), cfg.TranslationLibreTranslateAPIKey, fieldtag("TranslationLibreTranslateAPIKey", "usage"))
+
 		// Advanced flags
 		cmd.Flags().String(AdvancedCookiesSamesiteFlag(), cfg.AdvancedCookiesSamesite, fieldtag("AdvancedCookiesSamesite", "usage"))
 		cmd.Flags().Int(AdvancedRateLimitRequestsFlag(), cfg.AdvancedRateLimitRequests, fieldtag("AdvancedRateLimitRequests", "usage"))
@@ -189,6 +203,12 @@ func AddAdminAccountCreate(cmd *cobra.Command) {
 	}
 }
 
+// AddAdminInviteCreate attaches flags pertaining to admin invite creation.
+func AddAdminInviteCreate(cmd *cobra.Command) {
+	cmd.Flags().Int(AdminInviteMaxUsesFlag(), 1, fieldtag("AdminInviteMaxUses", "usage"))
+	cmd.Flags().Duration(AdminInviteExpiresInFlag(), 7*24*time.Hour, fieldtag("AdminInviteExpiresIn", "usage"))
+}
+
 // AddAdminTrans attaches flags pertaining to import/export commands.
 func AddAdminTrans(cmd *cobra.Command) {
 	name := AdminTransPathFlag()
@@ -849,6 +849,33 @@ func GetInstanceDeliverToSharedInboxes() bool { return global.GetInstanceDeliver
 // SetInstanceDeliverToSharedInboxes safely sets the value for global configuration 'InstanceDeliverToSharedInboxes' field
 func SetInstanceDeliverToSharedInboxes(v bool) { global.SetInstanceDeliverToSharedInboxes(v) }
 
+// GetInstanceAutoCloseSuspendedReports safely fetches the Configuration value for state's 'InstanceAutoCloseSuspendedReports' field
+func (st *ConfigState) GetInstanceAutoCloseSuspendedReports() (v bool) {
+	st.mutex.RLock()
+	v = st.config.InstanceAutoCloseSuspendedReports
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceAutoCloseSuspendedReports safely sets the Configuration value for state's 'InstanceAutoCloseSuspendedReports' field
+func (st *ConfigState) SetInstanceAutoCloseSuspendedReports(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceAutoCloseSuspendedReports = v
+	st.reloadToViper()
+}
+
+// InstanceAutoCloseSuspendedReportsFlag returns the flag name for the 'InstanceAutoCloseSuspendedReports' field
+func InstanceAutoCloseSuspendedReportsFlag() string { return "instance-auto-close-suspended-reports" }
+
+// GetInstanceAutoCloseSuspendedReports safely fetches the value for global configuration 'InstanceAutoCloseSuspendedReports' field
+func GetInstanceAutoCloseSuspendedReports() bool {
+	return global.GetInstanceAutoCloseSuspendedReports()
+}
+
+// SetInstanceAutoCloseSuspendedReports safely sets the value for global configuration 'InstanceAutoCloseSuspendedReports' field
+func SetInstanceAutoCloseSuspendedReports(v bool) { global.SetInstanceAutoCloseSuspendedReports(v) }
+
 // GetInstanceInjectMastodonVersion safely fetches the Configuration value for state's 'InstanceInjectMastodonVersion' field
 func (st *ConfigState) GetInstanceInjectMastodonVersion() (v bool) {
 	st.mutex.RLock()
@@ -999,6 +1026,31 @@ func GetAccountsCustomCSSLength() int { return global.GetAccountsCustomCSSLength
 // SetAccountsCustomCSSLength safely sets the value for global configuration 'AccountsCustomCSSLength' field
 func SetAccountsCustomCSSLength(v int) { global.SetAccountsCustomCSSLength(v) }
 
+// GetAccountsAllowUserInvites safely fetches the Configuration value for state's 'AccountsAllowUserInvites' field
+func (st *ConfigState) GetAccountsAllowUserInvites() (v bool) {
+	st.mutex.RLock()
+	v = st.config.AccountsAllowUserInvites
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsAllowUserInvites safely sets the Configuration value for state's 'AccountsAllowUserInvites' field
+func (st *ConfigState) SetAccountsAllowUserInvites(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsAllowUserInvites = v
+	st.reloadToViper()
+}
+
+// AccountsAllowUserInvitesFlag returns the flag name for the 'AccountsAllowUserInvites' field
+func AccountsAllowUserInvitesFlag() string { return "accounts-allow-user-invites" }
+
+// GetAccountsAllowUserInvites safely fetches the value for global configuration 'AccountsAllowUserInvites' field
+func GetAccountsAllowUserInvites() bool { return global.GetAccountsAllowUserInvites() }
+
+// SetAccountsAllowUserInvites safely sets the value for global configuration 'AccountsAllowUserInvites' field
+func SetAccountsAllowUserInvites(v bool) { global.SetAccountsAllowUserInvites(v) }
+
 // GetMediaImageMaxSize safely fetches the Configuration value for state's 'MediaImageMaxSize' field
 func (st *ConfigState) GetMediaImageMaxSize() (v bytesize.Size) {
 	st.mutex.RLock()
@@ -1649,6 +1701,81 @@ func GetTLSCertificateKey() string { return global.GetTLSCertificateKey() }
 // SetTLSCertificateKey safely sets the value for global configuration 'TLSCertificateKey' field
 func SetTLSCertificateKey(v string) { global.SetTLSCertificateKey(v) }
 
+// GetOAuthAccessTokenExpiry safely fetches the Configuration value for state's 'OAuthAccessTokenExpiry' field
+func (st *ConfigState) GetOAuthAccessTokenExpiry() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.OAuthAccessTokenExpiry
+	st.mutex.RUnlock()
+	return
+}
+
+// SetOAuthAccessTokenExpiry safely sets the Configuration value for state's 'OAuthAccessTokenExpiry' field
+func (st *ConfigState) SetOAuthAccessTokenExpiry(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.OAuthAccessTokenExpiry = v
+	st.reloadToViper()
+}
+
+// OAuthAccessTokenExpiryFlag returns the flag name for the 'OAuthAccessTokenExpiry' field
+func OAuthAccessTokenExpiryFlag() string { return "oauth-access-token-expiry" }
+
+// GetOAuthAccessTokenExpiry safely fetches the value for global configuration 'OAuthAccessTokenExpiry' field
+func GetOAuthAccessTokenExpiry() time.Duration { return global.GetOAuthAccessTokenExpiry() }
+
+// SetOAuthAccessTokenExpiry safely sets the value for global configuration 'OAuthAccessTokenExpiry' field
+func SetOAuthAccessTokenExpiry(v time.Duration) { global.SetOAuthAccessTokenExpiry(v) }
+
+// GetOAuthRefreshTokens safely fetches the Configuration value for state's 'OAuthRefreshTokens' field
+func (st *ConfigState) GetOAuthRefreshTokens() (v bool) {
+	st.mutex.RLock()
+	v = st.config.OAuthRefreshTokens
+	st.mutex.RUnlock()
+	return
+}
+
+// SetOAuthRefreshTokens safely sets the Configuration value for state's 'OAuthRefreshTokens' field
+func (st *ConfigState) SetOAuthRefreshTokens(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.OAuthRefreshTokens = v
+	st.reloadToViper()
+}
+
+// OAuthRefreshTokensFlag returns the flag name for the 'OAuthRefreshTokens' field
+func OAuthRefreshTokensFlag() string { return "oauth-refresh-tokens" }
+
+// GetOAuthRefreshTokens safely fetches the value for global configuration 'OAuthRefreshTokens' field
+func GetOAuthRefreshTokens() bool { return global.GetOAuthRefreshTokens() }
+
+// SetOAuthRefreshTokens safely sets the value for global configuration 'OAuthRefreshTokens' field
+func SetOAuthRefreshTokens(v bool) { global.SetOAuthRefreshTokens(v) }
+
+// GetOAuthRefreshTokenExpiry safely fetches the Configuration value for state's 'OAuthRefreshTokenExpiry' field
+func (st *ConfigState) GetOAuthRefreshTokenExpiry() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.OAuthRefreshTokenExpiry
+	st.mutex.RUnlock()
+	return
+}
+
+// SetOAuthRefreshTokenExpiry safely sets the Configuration value for state's 'OAuthRefreshTokenExpiry' field
+func (st *ConfigState) SetOAuthRefreshTokenExpiry(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.OAuthRefreshTokenExpiry = v
+	st.reloadToViper()
+}
+
+// OAuthRefreshTokenExpiryFlag returns the flag name for the 'OAuthRefreshTokenExpiry' field
+func OAuthRefreshTokenExpiryFlag() string { return "oauth-refresh-token-expiry" }
+
+// GetOAuthRefreshTokenExpiry safely fetches the value for global configuration 'OAuthRefreshTokenExpiry' field
+func GetOAuthRefreshTokenExpiry() time.Duration { return global.GetOAuthRefreshTokenExpiry() }
+
+// SetOAuthRefreshTokenExpiry safely sets the value for global configuration 'OAuthRefreshTokenExpiry' field
+func SetOAuthRefreshTokenExpiry(v time.Duration) { global.SetOAuthRefreshTokenExpiry(v) }
+
 // GetOIDCEnabled safely fetches the Configuration value for state's 'OIDCEnabled' field
 func (st *ConfigState) GetOIDCEnabled() (v bool) {
 	st.mutex.RLock()
@@ -2199,6 +2326,81 @@ func GetSyslogAddress() string { return global.GetSyslogAddress() }
 // SetSyslogAddress safely sets the value for global configuration 'SyslogAddress' field
 func SetSyslogAddress(v string) { global.SetSyslogAddress(v) }
 
+// GetTranslationBackend safely fetches the Configuration value for state's 'TranslationBackend' field
+func (st *ConfigState) GetTranslationBackend() (v string) {
+	st.mutex.RLock()
+	v = st.config.TranslationBackend
+	st.mutex.RUnlock()
+	return
+}
+
+// SetTranslationBackend safely sets the Configuration value for state's 'TranslationBackend' field
+func (st *ConfigState) SetTranslationBackend(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.TranslationBackend = v
+	st.reloadToViper()
+}
+
+// TranslationBackendFlag returns the flag name for the 'TranslationBackend' field
+func TranslationBackendFlag() string { return "translation-backend" }
+
+// GetTranslationBackend safely fetches the value for global configuration 'TranslationBackend' field
+func GetTranslationBackend() string { return global.GetTranslationBackend() }
+
+// SetTranslationBackend safely sets the value for global configuration 'TranslationBackend' field
+func SetTranslationBackend(v string) { global.SetTranslationBackend(v) }
+
+// GetTranslationLibreTranslateURL safely fetches the Configuration value for state's 'TranslationLibreTranslateURL' field
+func (st *ConfigState) GetTranslationLibreTranslateURL() (v string) {
+	st.mutex.RLock()
+	v = st.config.TranslationLibreTranslateURL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetTranslationLibreTranslateURL safely sets the Configuration value for state's 'TranslationLibreTranslateURL' field
+func (st *ConfigState) SetTranslationLibreTranslateURL(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.TranslationLibreTranslateURL = v
+	st.reloadToViper()
+}
+
+// TranslationLibreTranslateURLFlag returns the flag name for the 'TranslationLibreTranslateURL' field
+func TranslationLibreTranslateURLFlag() string { return "translation-libretranslate-url" }
+
+// GetTranslationLibreTranslateURL safely fetches the value for global configuration 'TranslationLibreTranslateURL' field
+func GetTranslationLibreTranslateURL() string { return global.GetTranslationLibreTranslateURL() }
+
+// SetTranslationLibreTranslateURL safely sets the value for global configuration 'TranslationLibreTranslateURL' field
+func SetTranslationLibreTranslateURL(v string) { global.SetTranslationLibreTranslateURL(v) }
+
+// GetTranslationLibreTranslateAPIKey safely fetches the Configuration value for state's 'TranslationLibreTranslateAPIKey' field
+func (st *ConfigState) GetTranslationLibreTranslateAPIKey() (v string) {
+	st.mutex.RLock()
+	v = st.config.TranslationLibreTranslateAPIKey
+	st.mutex.RUnlock()
+	return
+}
+
+// SetTranslationLibreTranslateAPIKey safely sets the Configuration value for state's 'TranslationLibreTranslateAPIKey' field
+func (st *ConfigState) SetTranslationLibreTranslateAPIKey(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.TranslationLibreTranslateAPIKey = v
+	st.reloadToViper()
+}
+
+// TranslationLibreTranslateAPIKeyFlag returns the flag name for the 'TranslationLibreTranslateAPIKey' field
+func TranslationLibreTranslateAPIKeyFlag() string { return "translation-libretranslate-api-key" }
+
+// GetTranslationLibreTranslateAPIKey safely fetches the value for global configuration 'TranslationLibreTranslateAPIKey' field
+func GetTranslationLibreTranslateAPIKey() string { return global.GetTranslationLibreTranslateAPIKey() }
+
+// SetTranslationLibreTranslateAPIKey safely sets the value for global configuration 'TranslationLibreTranslateAPIKey' field
+func SetTranslationLibreTranslateAPIKey(v string) { global.SetTranslationLibreTranslateAPIKey(v) }
+
 // GetAdvancedCookiesSamesite safely fetches the Configuration value for state's 'AdvancedCookiesSamesite' field
 func (st *ConfigState) GetAdvancedCookiesSamesite() (v string) {
 	st.mutex.RLock()
@@ -2474,6 +2676,180 @@ func GetCacheGTSAccountSweepFreq() time.Duration { return global.GetCacheGTSAcco
 // SetCacheGTSAccountSweepFreq safely sets the value for global configuration 'Cache.GTS.AccountSweepFreq' field
 func SetCacheGTSAccountSweepFreq(v time.Duration) { global.SetCacheGTSAccountSweepFreq(v) }
 
+// GetCacheGTSAccountDomainBlockMaxSize safely fetches the Configuration value for state's 'Cache.GTS.AccountDomainBlockMaxSize' field
+func (st *ConfigState) GetCacheGTSAccountDomainBlockMaxSize() (v int) {
+	st.mutex.RLock()
+	v = st.config.Cache.GTS.AccountDomainBlockMaxSize
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheGTSAccountDomainBlockMaxSize safely sets the Configuration value for state's 'Cache.GTS.AccountDomainBlockMaxSize' field
+func (st *ConfigState) SetCacheGTSAccountDomainBlockMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.AccountDomainBlockMaxSize = v
+	st.reloadToViper()
+}
+
+// CacheGTSAccountDomainBlockMaxSizeFlag returns the flag name for the 'Cache.GTS.AccountDomainBlockMaxSize' field
+func CacheGTSAccountDomainBlockMaxSizeFlag() string { return "cache-gts-account-domain-block-max-size" }
+
+// GetCacheGTSAccountDomainBlockMaxSize safely fetches the value for global configuration 'Cache.GTS.AccountDomainBlockMaxSize' field
+func GetCacheGTSAccountDomainBlockMaxSize() int { return global.GetCacheGTSAccountDomainBlockMaxSize() }
+
+// SetCacheGTSAccountDomainBlockMaxSize safely sets the value for global configuration 'Cache.GTS.AccountDomainBlockMaxSize' field
+func SetCacheGTSAccountDomainBlockMaxSize(v int) { global.SetCacheGTSAccountDomainBlockMaxSize(v) }
+
+// GetCacheGTSAccountDomainBlockTTL safely fetches the Configuration value for state's 'Cache.GTS.AccountDomainBlockTTL' field
+func (st *ConfigState) GetCacheGTSAccountDomainBlockTTL() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.Cache.GTS.AccountDomainBlockTTL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheGTSAccountDomainBlockTTL safely sets the Configuration value for state's 'Cache.GTS.AccountDomainBlockTTL' field
+func (st *ConfigState) SetCacheGTSAccountDomainBlockTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.AccountDomainBlockTTL = v
+	st.reloadToViper()
+}
+
+// CacheGTSAccountDomainBlockTTLFlag returns the flag name for the 'Cache.GTS.AccountDomainBlockTTL' field
+func CacheGTSAccountDomainBlockTTLFlag() string { return "cache-gts-account-domain-block-ttl" }
+
+// GetCacheGTSAccountDomainBlockTTL safely fetches the value for global configuration 'Cache.GTS.AccountDomainBlockTTL' field
+func GetCacheGTSAccountDomainBlockTTL() time.Duration {
+	return global.GetCacheGTSAccountDomainBlockTTL()
+}
+
+// SetCacheGTSAccountDomainBlockTTL safely sets the value for global configuration 'Cache.GTS.AccountDomainBlockTTL' field
+func SetCacheGTSAccountDomainBlockTTL(v time.Duration) { global.SetCacheGTSAccountDomainBlockTTL(v) }
+
+// GetCacheGTSAccountDomainBlockSweepFreq safely fetches the Configuration value for state's 'Cache.GTS.AccountDomainBlockSweepFreq' field
+func (st *ConfigState) GetCacheGTSAccountDomainBlockSweepFreq() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.Cache.GTS.AccountDomainBlockSweepFreq
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheGTSAccountDomainBlockSweepFreq safely sets the Configuration value for state's 'Cache.GTS.AccountDomainBlockSweepFreq' field
+func (st *ConfigState) SetCacheGTSAccountDomainBlockSweepFreq(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.AccountDomainBlockSweepFreq = v
+	st.reloadToViper()
+}
+
+// CacheGTSAccountDomainBlockSweepFreqFlag returns the flag name for the 'Cache.GTS.AccountDomainBlockSweepFreq' field
+func CacheGTSAccountDomainBlockSweepFreqFlag() string {
+	return "cache-gts-account-domain-block-sweep-freq"
+}
+
+// GetCacheGTSAccountDomainBlockSweepFreq safely fetches the value for global configuration 'Cache.GTS.AccountDomainBlockSweepFreq' field
+func GetCacheGTSAccountDomainBlockSweepFreq() time.Duration {
+	return global.GetCacheGTSAccountDomainBlockSweepFreq()
+}
+
+// SetCacheGTSAccountDomainBlockSweepFreq safely sets the value for global configuration 'Cache.GTS.AccountDomainBlockSweepFreq' field
+func SetCacheGTSAccountDomainBlockSweepFreq(v time.Duration) {
+	global.SetCacheGTSAccountDomainBlockSweepFreq(v)
+}
+
+// GetCacheGTSAccountDomainBlockIDsMaxSize safely fetches the Configuration value for state's 'Cache.GTS.AccountDomainBlockIDsMaxSize' field
+func (st *ConfigState) GetCacheGTSAccountDomainBlockIDsMaxSize() (v int) {
+	st.mutex.RLock()
+	v = st.config.Cache.GTS.AccountDomainBlockIDsMaxSize
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheGTSAccountDomainBlockIDsMaxSize safely sets the Configuration value for state's 'Cache.GTS.AccountDomainBlockIDsMaxSize' field
+func (st *ConfigState) SetCacheGTSAccountDomainBlockIDsMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.AccountDomainBlockIDsMaxSize = v
+	st.reloadToViper()
+}
+
+// CacheGTSAccountDomainBlockIDsMaxSizeFlag returns the flag name for the 'Cache.GTS.AccountDomainBlockIDsMaxSize' field
+func CacheGTSAccountDomainBlockIDsMaxSizeFlag() string {
+	return "cache-gts-account-domain-block-ids-max-size"
+}
+
+// GetCacheGTSAccountDomainBlockIDsMaxSize safely fetches the value for global configuration 'Cache.GTS.AccountDomainBlockIDsMaxSize' field
+func GetCacheGTSAccountDomainBlockIDsMaxSize() int {
+	return global.GetCacheGTSAccountDomainBlockIDsMaxSize()
+}
+
+// SetCacheGTSAccountDomainBlockIDsMaxSize safely sets the value for global configuration 'Cache.GTS.AccountDomainBlockIDsMaxSize' field
+func SetCacheGTSAccountDomainBlockIDsMaxSize(v int) {
+	global.SetCacheGTSAccountDomainBlockIDsMaxSize(v)
+}
+
+// GetCacheGTSAccountDomainBlockIDsTTL safely fetches the Configuration value for state's 'Cache.GTS.AccountDomainBlockIDsTTL' field
+func (st *ConfigState) GetCacheGTSAccountDomainBlockIDsTTL() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.Cache.GTS.AccountDomainBlockIDsTTL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheGTSAccountDomainBlockIDsTTL safely sets the Configuration value for state's 'Cache.GTS.AccountDomainBlockIDsTTL' field
+func (st *ConfigState) SetCacheGTSAccountDomainBlockIDsTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.AccountDomainBlockIDsTTL = v
+	st.reloadToViper()
+}
+
+// CacheGTSAccountDomainBlockIDsTTLFlag returns the flag name for the 'Cache.GTS.AccountDomainBlockIDsTTL' field
+func CacheGTSAccountDomainBlockIDsTTLFlag() string { return "cache-gts-account-domain-block-ids-ttl" }
+
+// GetCacheGTSAccountDomainBlockIDsTTL safely fetches the value for global configuration 'Cache.GTS.AccountDomainBlockIDsTTL' field
+func GetCacheGTSAccountDomainBlockIDsTTL() time.Duration {
+	return global.GetCacheGTSAccountDomainBlockIDsTTL()
+}
+
+// SetCacheGTSAccountDomainBlockIDsTTL safely sets the value for global configuration 'Cache.GTS.AccountDomainBlockIDsTTL' field
+func SetCacheGTSAccountDomainBlockIDsTTL(v time.Duration) {
+	global.SetCacheGTSAccountDomainBlockIDsTTL(v)
+}
+
+// GetCacheGTSAccountDomainBlockIDsSweepFreq safely fetches the Configuration value for state's 'Cache.GTS.AccountDomainBlockIDsSweepFreq' field
+func (st *ConfigState) GetCacheGTSAccountDomainBlockIDsSweepFreq() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.Cache.GTS.AccountDomainBlockIDsSweepFreq
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheGTSAccountDomainBlockIDsSweepFreq safely sets the Configuration value for state's 'Cache.GTS.AccountDomainBlockIDsSweepFreq' field
+func (st *ConfigState) SetCacheGTSAccountDomainBlockIDsSweepFreq(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.AccountDomainBlockIDsSweepFreq = v
+	st.reloadToViper()
+}
+
+// CacheGTSAccountDomainBlockIDsSweepFreqFlag returns the flag name for the 'Cache.GTS.AccountDomainBlockIDsSweepFreq' field
+func CacheGTSAccountDomainBlockIDsSweepFreqFlag() string {
+	return "cache-gts-account-domain-block-ids-sweep-freq"
+}
+
+// GetCacheGTSAccountDomainBlockIDsSweepFreq safely fetches the value for global configuration 'Cache.GTS.AccountDomainBlockIDsSweepFreq' field
+func GetCacheGTSAccountDomainBlockIDsSweepFreq() time.Duration {
+	return global.GetCacheGTSAccountDomainBlockIDsSweepFreq()
+}
+
+// SetCacheGTSAccountDomainBlockIDsSweepFreq safely sets the value for global configuration 'Cache.GTS.AccountDomainBlockIDsSweepFreq' field
+func SetCacheGTSAccountDomainBlockIDsSweepFreq(v time.Duration) {
+	global.SetCacheGTSAccountDomainBlockIDsSweepFreq(v)
+}
+
 // GetCacheGTSAccountNoteMaxSize safely fetches the Configuration value for state's 'Cache.GTS.AccountNoteMaxSize' field
 func (st *ConfigState) GetCacheGTSAccountNoteMaxSize() (v int) {
 	st.mutex.RLock()
@@ -2699,6 +3075,81 @@ func GetCacheGTSBlockIDsSweepFreq() time.Duration { return global.GetCacheGTSBlo
 // SetCacheGTSBlockIDsSweepFreq safely sets the value for global configuration 'Cache.GTS.BlockIDsSweepFreq' field
 func SetCacheGTSBlockIDsSweepFreq(v time.Duration) { global.SetCacheGTSBlockIDsSweepFreq(v) }
 
+// GetCacheGTSCardMaxSize safely fetches the Configuration value for state's 'Cache.GTS.CardMaxSize' field
+func (st *ConfigState) GetCacheGTSCardMaxSize() (v int) {
+	st.mutex.RLock()
+	v = st.config.Cache.GTS.CardMaxSize
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheGTSCardMaxSize safely sets the Configuration value for state's 'Cache.GTS.CardMaxSize' field
+func (st *ConfigState) SetCacheGTSCardMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.CardMaxSize = v
+	st.reloadToViper()
+}
+
+// CacheGTSCardMaxSizeFlag returns the flag name for the 'Cache.GTS.CardMaxSize' field
+func CacheGTSCardMaxSizeFlag() string { return "cache-gts-card-max-size" }
+
+// GetCacheGTSCardMaxSize safely fetches the value for global configuration 'Cache.GTS.CardMaxSize' field
+func GetCacheGTSCardMaxSize() int { return global.GetCacheGTSCardMaxSize() }
+
+// SetCacheGTSCardMaxSize safely sets the value for global configuration 'Cache.GTS.CardMaxSize' field
+func SetCacheGTSCardMaxSize(v int) { global.SetCacheGTSCardMaxSize(v) }
+
+// GetCacheGTSCardTTL safely fetches the Configuration value for state's 'Cache.GTS.CardTTL' field
+func (st *ConfigState) GetCacheGTSCardTTL() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.Cache.GTS.CardTTL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheGTSCardTTL safely sets the Configuration value for state's 'Cache.GTS.CardTTL' field
+func (st *ConfigState) SetCacheGTSCardTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.CardTTL = v
+	st.reloadToViper()
+}
+
+// CacheGTSCardTTLFlag returns the flag name for the 'Cache.GTS.CardTTL' field
+func CacheGTSCardTTLFlag() string { return "cache-gts-card-ttl" }
+
+// GetCacheGTSCardTTL safely fetches the value for global configuration 'Cache.GTS.CardTTL' field
+func GetCacheGTSCardTTL() time.Duration { return global.GetCacheGTSCardTTL() }
+
+// SetCacheGTSCardTTL safely sets the value for global configuration 'Cache.GTS.CardTTL' field
+func SetCacheGTSCardTTL(v time.Duration) { global.SetCacheGTSCardTTL(v) }
+
+// GetCacheGTSCardSweepFreq safely fetches the Configuration value for state's 'Cache.GTS.CardSweepFreq' field
+func (st *ConfigState) GetCacheGTSCardSweepFreq() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.Cache.GTS.CardSweepFreq
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheGTSCardSweepFreq safely sets the Configuration value for state's 'Cache.GTS.CardSweepFreq' field
+func (st *ConfigState) SetCacheGTSCardSweepFreq(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.CardSweepFreq = v
+	st.reloadToViper()
+}
+
+// CacheGTSCardSweepFreqFlag returns the flag name for the 'Cache.GTS.CardSweepFreq' field
+func CacheGTSCardSweepFreqFlag() string { return "cache-gts-card-sweep-freq" }
+
+// GetCacheGTSCardSweepFreq safely fetches the value for global configuration 'Cache.GTS.CardSweepFreq' field
+func GetCacheGTSCardSweepFreq() time.Duration { return global.GetCacheGTSCardSweepFreq() }
+
+// SetCacheGTSCardSweepFreq safely sets the value for global configuration 'Cache.GTS.CardSweepFreq' field
+func SetCacheGTSCardSweepFreq(v time.Duration) { global.SetCacheGTSCardSweepFreq(v) }
+
 // GetCacheGTSDomainBlockMaxSize safely fetches the Configuration value for state's 'Cache.GTS.DomainBlockMaxSize' field
 func (st *ConfigState) GetCacheGTSDomainBlockMaxSize() (v int) {
 	st.mutex.RLock()
@@ -4134,6 +4585,81 @@ func GetCacheGTSTombstoneSweepFreq() time.Duration { return global.GetCacheGTSTo
 // SetCacheGTSTombstoneSweepFreq safely sets the value for global configuration 'Cache.GTS.TombstoneSweepFreq' field
 func SetCacheGTSTombstoneSweepFreq(v time.Duration) { global.SetCacheGTSTombstoneSweepFreq(v) }
 
+// GetCacheGTSTranslationMaxSize safely fetches the Configuration value for state's 'Cache.GTS.TranslationMaxSize' field
+func (st *ConfigState) GetCacheGTSTranslationMaxSize() (v int) {
+	st.mutex.RLock()
+	v = st.config.Cache.GTS.TranslationMaxSize
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheGTSTranslationMaxSize safely sets the Configuration value for state's 'Cache.GTS.TranslationMaxSize' field
+func (st *ConfigState) SetCacheGTSTranslationMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.TranslationMaxSize = v
+	st.reloadToViper()
+}
+
+// CacheGTSTranslationMaxSizeFlag returns the flag name for the 'Cache.GTS.TranslationMaxSize' field
+func CacheGTSTranslationMaxSizeFlag() string { return "cache-gts-translation-max-size" }
+
+// GetCacheGTSTranslationMaxSize safely fetches the value for global configuration 'Cache.GTS.TranslationMaxSize' field
+func GetCacheGTSTranslationMaxSize() int { return global.GetCacheGTSTranslationMaxSize() }
+
+// SetCacheGTSTranslationMaxSize safely sets the value for global configuration 'Cache.GTS.TranslationMaxSize' field
+func SetCacheGTSTranslationMaxSize(v int) { global.SetCacheGTSTranslationMaxSize(v) }
+
+// GetCacheGTSTranslationTTL safely fetches the Configuration value for state's 'Cache.GTS.TranslationTTL' field
+func (st *ConfigState) GetCacheGTSTranslationTTL() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.Cache.GTS.TranslationTTL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheGTSTranslationTTL safely sets the Configuration value for state's 'Cache.GTS.TranslationTTL' field
+func (st *ConfigState) SetCacheGTSTranslationTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.TranslationTTL = v
+	st.reloadToViper()
+}
+
+// CacheGTSTranslationTTLFlag returns the flag name for the 'Cache.GTS.TranslationTTL' field
+func CacheGTSTranslationTTLFlag() string { return "cache-gts-translation-ttl" }
+
+// GetCacheGTSTranslationTTL safely fetches the value for global configuration 'Cache.GTS.TranslationTTL' field
+func GetCacheGTSTranslationTTL() time.Duration { return global.GetCacheGTSTranslationTTL() }
+
+// SetCacheGTSTranslationTTL safely sets the value for global configuration 'Cache.GTS.TranslationTTL' field
+func SetCacheGTSTranslationTTL(v time.Duration) { global.SetCacheGTSTranslationTTL(v) }
+
+// GetCacheGTSTranslationSweepFreq safely fetches the Configuration value for state's 'Cache.GTS.TranslationSweepFreq' field
+func (st *ConfigState) GetCacheGTSTranslationSweepFreq() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.Cache.GTS.TranslationSweepFreq
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheGTSTranslationSweepFreq safely sets the Configuration value for state's 'Cache.GTS.TranslationSweepFreq' field
+func (st *ConfigState) SetCacheGTSTranslationSweepFreq(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.TranslationSweepFreq = v
+	st.reloadToViper()
+}
+
+// CacheGTSTranslationSweepFreqFlag returns the flag name for the 'Cache.GTS.TranslationSweepFreq' field
+func CacheGTSTranslationSweepFreqFlag() string { return "cache-gts-translation-sweep-freq" }
+
+// GetCacheGTSTranslationSweepFreq safely fetches the value for global configuration 'Cache.GTS.TranslationSweepFreq' field
+func GetCacheGTSTranslationSweepFreq() time.Duration { return global.GetCacheGTSTranslationSweepFreq() }
+
+// SetCacheGTSTranslationSweepFreq safely sets the value for global configuration 'Cache.GTS.TranslationSweepFreq' field
+func SetCacheGTSTranslationSweepFreq(v time.Duration) { global.SetCacheGTSTranslationSweepFreq(v) }
+
 // GetCacheGTSUserMaxSize safely fetches the Configuration value for state's 'Cache.GTS.UserMaxSize' field
 func (st *ConfigState) GetCacheGTSUserMaxSize() (v int) {
 	st.mutex.RLock()
@@ -4209,6 +4735,184 @@ func GetCacheGTSUserSweepFreq() time.Duration { return global.GetCacheGTSUserSwe
 // SetCacheGTSUserSweepFreq safely sets the value for global configuration 'Cache.GTS.UserSweepFreq' field
 func SetCacheGTSUserSweepFreq(v time.Duration) { global.SetCacheGTSUserSweepFreq(v) }
 
+// GetCacheGTSWebPushSubscriptionMaxSize safely fetches the Configuration value for state's 'Cache.GTS.WebPushSubscriptionMaxSize' field
+func (st *ConfigState) GetCacheGTSWebPushSubscriptionMaxSize() (v int) {
+	st.mutex.RLock()
+	v = st.config.Cache.GTS.WebPushSubscriptionMaxSize
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheGTSWebPushSubscriptionMaxSize safely sets the Configuration value for state's 'Cache.GTS.WebPushSubscriptionMaxSize' field
+func (st *ConfigState) SetCacheGTSWebPushSubscriptionMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.WebPushSubscriptionMaxSize = v
+	st.reloadToViper()
+}
+
+// CacheGTSWebPushSubscriptionMaxSizeFlag returns the flag name for the 'Cache.GTS.WebPushSubscriptionMaxSize' field
+func CacheGTSWebPushSubscriptionMaxSizeFlag() string {
+	return "cache-gts-web-push-subscription-max-size"
+}
+
+// GetCacheGTSWebPushSubscriptionMaxSize safely fetches the value for global configuration 'Cache.GTS.WebPushSubscriptionMaxSize' field
+func GetCacheGTSWebPushSubscriptionMaxSize() int {
+	return global.GetCacheGTSWebPushSubscriptionMaxSize()
+}
+
+// SetCacheGTSWebPushSubscriptionMaxSize safely sets the value for global configuration 'Cache.GTS.WebPushSubscriptionMaxSize' field
+func SetCacheGTSWebPushSubscriptionMaxSize(v int) { global.SetCacheGTSWebPushSubscriptionMaxSize(v) }
+
+// GetCacheGTSWebPushSubscriptionTTL safely fetches the Configuration value for state's 'Cache.GTS.WebPushSubscriptionTTL' field
+func (st *ConfigState) GetCacheGTSWebPushSubscriptionTTL() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.Cache.GTS.WebPushSubscriptionTTL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheGTSWebPushSubscriptionTTL safely sets the Configuration value for state's 'Cache.GTS.WebPushSubscriptionTTL' field
+func (st *ConfigState) SetCacheGTSWebPushSubscriptionTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.WebPushSubscriptionTTL = v
+	st.reloadToViper()
+}
+
+// CacheGTSWebPushSubscriptionTTLFlag returns the flag name for the 'Cache.GTS.WebPushSubscriptionTTL' field
+func CacheGTSWebPushSubscriptionTTLFlag() string { return "cache-gts-web-push-subscription-ttl" }
+
+// GetCacheGTSWebPushSubscriptionTTL safely fetches the value for global configuration 'Cache.GTS.WebPushSubscriptionTTL' field
+func GetCacheGTSWebPushSubscriptionTTL() time.Duration {
+	return global.GetCacheGTSWebPushSubscriptionTTL()
+}
+
+// SetCacheGTSWebPushSubscriptionTTL safely sets the value for global configuration 'Cache.GTS.WebPushSubscriptionTTL' field
+func SetCacheGTSWebPushSubscriptionTTL(v time.Duration) { global.SetCacheGTSWebPushSubscriptionTTL(v) }
+
+// GetCacheGTSWebPushSubscriptionSweepFreq safely fetches the Configuration value for state's 'Cache.GTS.WebPushSubscriptionSweepFreq' field
+func (st *ConfigState) GetCacheGTSWebPushSubscriptionSweepFreq() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.Cache.GTS.WebPushSubscriptionSweepFreq
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheGTSWebPushSubscriptionSweepFreq safely sets the Configuration value for state's 'Cache.GTS.WebPushSubscriptionSweepFreq' field
+func (st *ConfigState) SetCacheGTSWebPushSubscriptionSweepFreq(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.WebPushSubscriptionSweepFreq = v
+	st.reloadToViper()
+}
+
+// CacheGTSWebPushSubscriptionSweepFreqFlag returns the flag name for the 'Cache.GTS.WebPushSubscriptionSweepFreq' field
+func CacheGTSWebPushSubscriptionSweepFreqFlag() string {
+	return "cache-gts-web-push-subscription-sweep-freq"
+}
+
+// GetCacheGTSWebPushSubscriptionSweepFreq safely fetches the value for global configuration 'Cache.GTS.WebPushSubscriptionSweepFreq' field
+func GetCacheGTSWebPushSubscriptionSweepFreq() time.Duration {
+	return global.GetCacheGTSWebPushSubscriptionSweepFreq()
+}
+
+// SetCacheGTSWebPushSubscriptionSweepFreq safely sets the value for global configuration 'Cache.GTS.WebPushSubscriptionSweepFreq' field
+func SetCacheGTSWebPushSubscriptionSweepFreq(v time.Duration) {
+	global.SetCacheGTSWebPushSubscriptionSweepFreq(v)
+}
+
+// GetCacheGTSWebPushSubscriptionIDsMaxSize safely fetches the Configuration value for state's 'Cache.GTS.WebPushSubscriptionIDsMaxSize' field
+func (st *ConfigState) GetCacheGTSWebPushSubscriptionIDsMaxSize() (v int) {
+	st.mutex.RLock()
+	v = st.config.Cache.GTS.WebPushSubscriptionIDsMaxSize
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheGTSWebPushSubscriptionIDsMaxSize safely sets the Configuration value for state's 'Cache.GTS.WebPushSubscriptionIDsMaxSize' field
+func (st *ConfigState) SetCacheGTSWebPushSubscriptionIDsMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.WebPushSubscriptionIDsMaxSize = v
+	st.reloadToViper()
+}
+
+// CacheGTSWebPushSubscriptionIDsMaxSizeFlag returns the flag name for the 'Cache.GTS.WebPushSubscriptionIDsMaxSize' field
+func CacheGTSWebPushSubscriptionIDsMaxSizeFlag() string {
+	return "cache-gts-web-push-subscription-ids-max-size"
+}
+
+// GetCacheGTSWebPushSubscriptionIDsMaxSize safely fetches the value for global configuration 'Cache.GTS.WebPushSubscriptionIDsMaxSize' field
+func GetCacheGTSWebPushSubscriptionIDsMaxSize() int {
+	return global.GetCacheGTSWebPushSubscriptionIDsMaxSize()
+}
+
+// SetCacheGTSWebPushSubscriptionIDsMaxSize safely sets the value for global configuration 'Cache.GTS.WebPushSubscriptionIDsMaxSize' field
+func SetCacheGTSWebPushSubscriptionIDsMaxSize(v int) {
+	global.SetCacheGTSWebPushSubscriptionIDsMaxSize(v)
+}
+
+// GetCacheGTSWebPushSubscriptionIDsTTL safely fetches the Configuration value for state's 'Cache.GTS.WebPushSubscriptionIDsTTL' field
+func (st *ConfigState) GetCacheGTSWebPushSubscriptionIDsTTL() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.Cache.GTS.WebPushSubscriptionIDsTTL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheGTSWebPushSubscriptionIDsTTL safely sets the Configuration value for state's 'Cache.GTS.WebPushSubscriptionIDsTTL' field
+func (st *ConfigState) SetCacheGTSWebPushSubscriptionIDsTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.WebPushSubscriptionIDsTTL = v
+	st.reloadToViper()
+}
+
+// CacheGTSWebPushSubscriptionIDsTTLFlag returns the flag name for the 'Cache.GTS.WebPushSubscriptionIDsTTL' field
+func CacheGTSWebPushSubscriptionIDsTTLFlag() string { return "cache-gts-web-push-subscription-ids-ttl" }
+
+// GetCacheGTSWebPushSubscriptionIDsTTL safely fetches the value for global configuration 'Cache.GTS.WebPushSubscriptionIDsTTL' field
+func GetCacheGTSWebPushSubscriptionIDsTTL() time.Duration {
+	return global.GetCacheGTSWebPushSubscriptionIDsTTL()
+}
+
+// SetCacheGTSWebPushSubscriptionIDsTTL safely sets the value for global configuration 'Cache.GTS.WebPushSubscriptionIDsTTL' field
+func SetCacheGTSWebPushSubscriptionIDsTTL(v time.Duration) {
+	global.SetCacheGTSWebPushSubscriptionIDsTTL(v)
+}
+
+// GetCacheGTSWebPushSubscriptionIDsSweepFreq safely fetches the Configuration value for state's 'Cache.GTS.WebPushSubscriptionIDsSweepFreq' field
+func (st *ConfigState) GetCacheGTSWebPushSubscriptionIDsSweepFreq() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.Cache.GTS.WebPushSubscriptionIDsSweepFreq
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheGTSWebPushSubscriptionIDsSweepFreq safely sets the Configuration value for state's 'Cache.GTS.WebPushSubscriptionIDsSweepFreq' field
+func (st *ConfigState) SetCacheGTSWebPushSubscriptionIDsSweepFreq(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.WebPushSubscriptionIDsSweepFreq = v
+	st.reloadToViper()
+}
+
+// CacheGTSWebPushSubscriptionIDsSweepFreqFlag returns the flag name for the 'Cache.GTS.WebPushSubscriptionIDsSweepFreq' field
+func CacheGTSWebPushSubscriptionIDsSweepFreqFlag() string {
+	return "cache-gts-web-push-subscription-ids-sweep-freq"
+}
+
+// GetCacheGTSWebPushSubscriptionIDsSweepFreq safely fetches the value for global configuration 'Cache.GTS.WebPushSubscriptionIDsSweepFreq' field
+func GetCacheGTSWebPushSubscriptionIDsSweepFreq() time.Duration {
+	return global.GetCacheGTSWebPushSubscriptionIDsSweepFreq()
+}
+
+// SetCacheGTSWebPushSubscriptionIDsSweepFreq safely sets the value for global configuration 'Cache.GTS.WebPushSubscriptionIDsSweepFreq' field
+func SetCacheGTSWebPushSubscriptionIDsSweepFreq(v time.Duration) {
+	global.SetCacheGTSWebPushSubscriptionIDsSweepFreq(v)
+}
+
 // GetCacheGTSWebfingerMaxSize safely fetches the Configuration value for state's 'Cache.GTS.WebfingerMaxSize' field
 func (st *ConfigState) GetCacheGTSWebfingerMaxSize() (v int) {
 	st.mutex.RLock()
@@ -4484,6 +5188,56 @@ func GetAdminMediaPruneDryRun() bool { return global.GetAdminMediaPruneDryRun()
 // SetAdminMediaPruneDryRun safely sets the value for global configuration 'AdminMediaPruneDryRun' field
 func SetAdminMediaPruneDryRun(v bool) { global.SetAdminMediaPruneDryRun(v) }
 
+// GetAdminInviteMaxUses safely fetches the Configuration value for state's 'AdminInviteMaxUses' field
+func (st *ConfigState) GetAdminInviteMaxUses() (v int) {
+	st.mutex.RLock()
+	v = st.config.AdminInviteMaxUses
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminInviteMaxUses safely sets the Configuration value for state's 'AdminInviteMaxUses' field
+func (st *ConfigState) SetAdminInviteMaxUses(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminInviteMaxUses = v
+	st.reloadToViper()
+}
+
+// AdminInviteMaxUsesFlag returns the flag name for the 'AdminInviteMaxUses' field
+func AdminInviteMaxUsesFlag() string { return "max-uses" }
+
+// GetAdminInviteMaxUses safely fetches the value for global configuration 'AdminInviteMaxUses' field
+func GetAdminInviteMaxUses() int { return global.GetAdminInviteMaxUses() }
+
+// SetAdminInviteMaxUses safely sets the value for global configuration 'AdminInviteMaxUses' field
+func SetAdminInviteMaxUses(v int) { global.SetAdminInviteMaxUses(v) }
+
+// GetAdminInviteExpiresIn safely fetches the Configuration value for state's 'AdminInviteExpiresIn' field
+func (st *ConfigState) GetAdminInviteExpiresIn() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.AdminInviteExpiresIn
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminInviteExpiresIn safely sets the Configuration value for state's 'AdminInviteExpiresIn' field
+func (st *ConfigState) SetAdminInviteExpiresIn(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminInviteExpiresIn = v
+	st.reloadToViper()
+}
+
+// AdminInviteExpiresInFlag returns the flag name for the 'AdminInviteExpiresIn' field
+func AdminInviteExpiresInFlag() string { return "expires-in" }
+
+// GetAdminInviteExpiresIn safely fetches the value for global configuration 'AdminInviteExpiresIn' field
+func GetAdminInviteExpiresIn() time.Duration { return global.GetAdminInviteExpiresIn() }
+
+// SetAdminInviteExpiresIn safely sets the value for global configuration 'AdminInviteExpiresIn' field
+func SetAdminInviteExpiresIn(v time.Duration) { global.SetAdminInviteExpiresIn(v) }
+
 // GetRequestIDHeader safely fetches the Configuration value for state's 'RequestIDHeader' field
 func (st *ConfigState) GetRequestIDHeader() (v string) {
 	st.mutex.RLock()
@@ -79,6 +79,19 @@ func Validate() error {
 		errs = append(errs, fmt.Errorf("%s and %s need to both be set or unset", tlsChainFlag, tlsKeyFlag))
 	}
 
+	// translation
+	switch backend := GetTranslationBackend(); backend {
+	case "":
+		// translation disabled
+		break
+	case "libretranslate":
+		if GetTranslationLibreTranslateURL() == "" {
+			errs = append(errs, fmt.Errorf("%s must be set for translation backend %s", TranslationLibreTranslateURLFlag(), backend))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("%s must be either empty or libretranslate, provided value was %s", TranslationBackendFlag(), backend))
+	}
+
 	if len(errs) > 0 {
 		errStrings := []string{}
 		for _, err := range errs {
@@ -140,6 +140,25 @@ func (suite *ConfigValidateTestSuite) TestValidateConfigBadProtocolNoHost() {
 	suite.EqualError(err, "host must be set; protocol must be set to either http or https, provided value was foo")
 }
 
+func (suite *ConfigValidateTestSuite) TestValidateConfigTranslationNoURL() {
+	testrig.InitTestConfig()
+
+	config.SetTranslationBackend("libretranslate")
+	config.SetTranslationLibreTranslateURL("")
+
+	err := config.Validate()
+	suite.EqualError(err, "translation-libretranslate-url must be set for translation backend libretranslate")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadTranslationBackend() {
+	testrig.InitTestConfig()
+
+	config.SetTranslationBackend("foo")
+
+	err := config.Validate()
+	suite.EqualError(err, "translation-backend must be either empty or libretranslate, provided value was foo")
+}
+
 func TestConfigValidateTestSuite(t *testing.T) {
 	suite.Run(t, &ConfigValidateTestSuite{})
 }
@@ -70,6 +70,18 @@ type Account interface {
 	// GetAccountCustomCSSByUsername returns the custom css of an account on this instance with the given username.
 	GetAccountCustomCSSByUsername(ctx context.Context, username string) (string, error)
 
+	// GetDirectoryAccounts gets accounts for the profile directory, ie., discoverable accounts which are not suspended,
+	// silenced or instance accounts. Accounts are ordered by most recent status, or by newest account if byNewest is set.
+	// If localOnly is set, only accounts from this instance are returned. A limit of 0 means no limit.
+	GetDirectoryAccounts(ctx context.Context, byNewest bool, localOnly bool, limit int, offset int) ([]*gtsmodel.Account, error)
+
+	// GetPopularLocalAccounts gets discoverable accounts from this instance, ordered by number of followers.
+	GetPopularLocalAccounts(ctx context.Context, limit int) ([]*gtsmodel.Account, error)
+
+	// GetAccountsFollowedByFollows gets discoverable accounts which are followed by accounts that the given account
+	// follows, but not (yet) by the given account itself, ordered by number of such follows.
+	GetAccountsFollowedByFollows(ctx context.Context, accountID string, limit int) ([]*gtsmodel.Account, error)
+
 	// GetAccountFaves fetches faves/likes created by the target accountID.
 	GetAccountFaves(ctx context.Context, accountID string) ([]*gtsmodel.StatusFave, error)
 
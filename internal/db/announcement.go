@@ -0,0 +1,76 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Announcement contains functions for getting / creating / updating
+// server-wide announcements, and reads and reactions of announcements.
+type Announcement interface {
+	// GetAnnouncementByID gets one announcement by its db id.
+	GetAnnouncementByID(ctx context.Context, id string) (*gtsmodel.Announcement, error)
+
+	// GetAnnouncements gets all announcements, including unpublished
+	// and expired ones, with the most recently created first.
+	GetAnnouncements(ctx context.Context) ([]*gtsmodel.Announcement, error)
+
+	// GetActiveAnnouncements gets all published announcements whose
+	// display window includes the given time, most recently published first.
+	GetActiveAnnouncements(ctx context.Context, now time.Time) ([]*gtsmodel.Announcement, error)
+
+	// PutAnnouncement puts the given announcement in the database.
+	PutAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement) error
+
+	// UpdateAnnouncement updates one announcement by its db id.
+	// The given columns will be updated; if no columns are
+	// provided, then all columns will be updated.
+	// updated_at will also be updated, no need to pass this
+	// as a specific column.
+	UpdateAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement, columns ...string) error
+
+	// DeleteAnnouncementByID deletes one announcement by its
+	// db id, along with all reads and reactions of it.
+	DeleteAnnouncementByID(ctx context.Context, id string) error
+
+	// GetReadAnnouncementIDs gets the IDs of all announcements read by the given account.
+	GetReadAnnouncementIDs(ctx context.Context, accountID string) ([]string, error)
+
+	// PutAnnouncementRead puts the given announcement read in the database.
+	PutAnnouncementRead(ctx context.Context, read *gtsmodel.AnnouncementRead) error
+
+	// GetAnnouncementReactions gets all reactions to the given announcement, oldest first.
+	GetAnnouncementReactions(ctx context.Context, announcementID string) ([]*gtsmodel.AnnouncementReaction, error)
+
+	// GetAnnouncementReaction gets the reaction with the given name by the given account to the given announcement.
+	GetAnnouncementReaction(ctx context.Context, announcementID string, accountID string, name string) (*gtsmodel.AnnouncementReaction, error)
+
+	// PutAnnouncementReaction puts the given announcement reaction in the database.
+	PutAnnouncementReaction(ctx context.Context, reaction *gtsmodel.AnnouncementReaction) error
+
+	// DeleteAnnouncementReactionByID deletes one announcement reaction by its db id.
+	DeleteAnnouncementReactionByID(ctx context.Context, id string) error
+
+	// DeleteAccountAnnouncementData deletes all announcement
+	// reads and reactions made by the given account.
+	DeleteAccountAnnouncementData(ctx context.Context, accountID string) error
+}
@@ -493,6 +493,118 @@ func (a *accountDB) GetAccountsUsingEmoji(ctx context.Context, emojiID string) (
 	return a.GetAccountsByIDs(ctx, accountIDs)
 }
 
+func (a *accountDB) GetDirectoryAccounts(ctx context.Context, byNewest bool, localOnly bool, limit int, offset int) ([]*gtsmodel.Account, error) {
+	var accountIDs []string
+
+	q := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		Column("account.id").
+		Apply(discoverableAccounts)
+
+	if localOnly {
+		q = q.Where("? IS NULL", bun.Ident("account.domain"))
+	}
+
+	if byNewest {
+		q = q.OrderExpr("? DESC", bun.Ident("account.created_at"))
+	} else {
+		// Order by time of most recent status, falling
+		// back to account creation for accounts which
+		// have never posted anything.
+		q = q.OrderExpr("COALESCE((?), ?) DESC",
+			a.db.
+				NewSelect().
+				TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+				ColumnExpr("MAX(?)", bun.Ident("status.created_at")).
+				Where("? = ?", bun.Ident("status.account_id"), bun.Ident("account.id")),
+			bun.Ident("account.created_at"),
+		)
+	}
+
+	q = q.OrderExpr("? DESC", bun.Ident("account.id"))
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if offset > 0 {
+		q = q.Offset(offset)
+	}
+
+	if err := q.Scan(ctx, &accountIDs); err != nil {
+		return nil, a.db.ProcessError(err)
+	}
+
+	return a.GetAccountsByIDs(ctx, accountIDs)
+}
+
+func (a *accountDB) GetPopularLocalAccounts(ctx context.Context, limit int) ([]*gtsmodel.Account, error) {
+	var accountIDs []string
+
+	if err := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		Column("account.id").
+		Apply(discoverableAccounts).
+		Where("? IS NULL", bun.Ident("account.domain")).
+		OrderExpr("(?) DESC",
+			a.db.
+				NewSelect().
+				TableExpr("? AS ?", bun.Ident("follows"), bun.Ident("follow")).
+				ColumnExpr("COUNT(*)").
+				Where("? = ?", bun.Ident("follow.target_account_id"), bun.Ident("account.id")),
+		).
+		OrderExpr("? DESC", bun.Ident("account.id")).
+		Limit(limit).
+		Scan(ctx, &accountIDs); err != nil {
+		return nil, a.db.ProcessError(err)
+	}
+
+	return a.GetAccountsByIDs(ctx, accountIDs)
+}
+
+func (a *accountDB) GetAccountsFollowedByFollows(ctx context.Context, accountID string, limit int) ([]*gtsmodel.Account, error) {
+	var accountIDs []string
+
+	// Accounts followed by the given account.
+	followed := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("follows"), bun.Ident("followed")).
+		Column("followed.target_account_id").
+		Where("? = ?", bun.Ident("followed.account_id"), accountID)
+
+	if err := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("follows"), bun.Ident("follow")).
+		ColumnExpr("? AS ?", bun.Ident("follow.target_account_id"), bun.Ident("id")).
+		Join("JOIN ? AS ? ON ? = ?", bun.Ident("accounts"), bun.Ident("account"), bun.Ident("account.id"), bun.Ident("follow.target_account_id")).
+		Apply(discoverableAccounts).
+		Where("? IN (?)", bun.Ident("follow.account_id"), followed).
+		Where("? NOT IN (?)", bun.Ident("follow.target_account_id"), followed).
+		Where("? != ?", bun.Ident("follow.target_account_id"), accountID).
+		Group("follow.target_account_id").
+		OrderExpr("COUNT(*) DESC").
+		OrderExpr("? DESC", bun.Ident("follow.target_account_id")).
+		Limit(limit).
+		Scan(ctx, &accountIDs); err != nil {
+		return nil, a.db.ProcessError(err)
+	}
+
+	return a.GetAccountsByIDs(ctx, accountIDs)
+}
+
+// discoverableAccounts limits the given query (aliasing the accounts table as
+// "account") to accounts that have opted in to discovery, and that aren't
+// suspended, silenced, or instance accounts.
+func discoverableAccounts(q *bun.SelectQuery) *bun.SelectQuery {
+	return q.
+		Where("? = ?", bun.Ident("account.discoverable"), true).
+		Where("? IS NULL", bun.Ident("account.suspended_at")).
+		Where("? IS NULL", bun.Ident("account.silenced_at")).
+		Where("? != COALESCE(?, ?)", bun.Ident("account.username"), bun.Ident("account.domain"), config.GetHost())
+}
+
 func (a *accountDB) GetAccountFaves(ctx context.Context, accountID string) ([]*gtsmodel.StatusFave, error) {
 	faves := new([]*gtsmodel.StatusFave)
 
@@ -375,6 +375,59 @@ func (suite *AccountTestSuite) TestCountAccountPinnedNothingPinned() {
 	suite.Equal(pinned, 0) // This account has nothing pinned.
 }
 
+func (suite *AccountTestSuite) TestGetDirectoryAccounts() {
+	accountIDs := func(accounts []*gtsmodel.Account) []string {
+		ids := make([]string, 0, len(accounts))
+		for _, account := range accounts {
+			ids = append(ids, account.ID)
+		}
+		return ids
+	}
+
+	// Only discoverable local accounts, without the instance account.
+	accounts, err := suite.db.GetDirectoryAccounts(context.Background(), false, true, 0, 0)
+	suite.NoError(err)
+	suite.ElementsMatch([]string{
+		suite.testAccounts["admin_account"].ID,
+		suite.testAccounts["local_account_1"].ID,
+	}, accountIDs(accounts))
+
+	// Plus discoverable remote accounts.
+	accounts, err = suite.db.GetDirectoryAccounts(context.Background(), true, false, 0, 0)
+	suite.NoError(err)
+	suite.ElementsMatch([]string{
+		suite.testAccounts["admin_account"].ID,
+		suite.testAccounts["local_account_1"].ID,
+		suite.testAccounts["remote_account_1"].ID,
+		suite.testAccounts["remote_account_2"].ID,
+		suite.testAccounts["remote_account_3"].ID,
+	}, accountIDs(accounts))
+
+	// Paged.
+	accounts, err = suite.db.GetDirectoryAccounts(context.Background(), true, false, 2, 4)
+	suite.NoError(err)
+	suite.Len(accounts, 1)
+}
+
+func (suite *AccountTestSuite) TestGetPopularLocalAccounts() {
+	accounts, err := suite.db.GetPopularLocalAccounts(context.Background(), 10)
+	suite.NoError(err)
+	suite.Len(accounts, 2)
+
+	// local_account_1 has the most followers.
+	suite.Equal(suite.testAccounts["local_account_1"].ID, accounts[0].ID)
+	suite.Equal(suite.testAccounts["admin_account"].ID, accounts[1].ID)
+}
+
+func (suite *AccountTestSuite) TestGetAccountsFollowedByFollows() {
+	// local_account_2 follows local_account_1, who follows
+	// admin_account and local_account_2 (ie., itself).
+	accounts, err := suite.db.GetAccountsFollowedByFollows(context.Background(), suite.testAccounts["local_account_2"].ID, 10)
+	suite.NoError(err)
+	suite.Len(accounts, 1)
+	suite.Equal(suite.testAccounts["admin_account"].ID, accounts[0].ID)
+}
+
 func TestAccountTestSuite(t *testing.T) {
 	suite.Run(t, new(AccountTestSuite))
 }
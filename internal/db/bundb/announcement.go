@@ -0,0 +1,289 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type announcementDB struct {
+	db    *WrappedDB
+	state *state.State
+}
+
+func (a *announcementDB) GetAnnouncementByID(ctx context.Context, id string) (*gtsmodel.Announcement, error) {
+	var announcement gtsmodel.Announcement
+
+	if err := a.db.
+		NewSelect().
+		Model(&announcement).
+		Where("? = ?", bun.Ident("announcement.id"), id).
+		Scan(ctx); err != nil {
+		return nil, a.db.ProcessError(err)
+	}
+
+	if err := a.populateAnnouncement(ctx, &announcement); err != nil {
+		return nil, err
+	}
+
+	return &announcement, nil
+}
+
+func (a *announcementDB) GetAnnouncements(ctx context.Context) ([]*gtsmodel.Announcement, error) {
+	announcements := []*gtsmodel.Announcement{}
+
+	if err := a.db.
+		NewSelect().
+		Model(&announcements).
+		OrderExpr("? DESC", bun.Ident("announcement.id")).
+		Scan(ctx); err != nil {
+		return nil, a.db.ProcessError(err)
+	}
+
+	for _, announcement := range announcements {
+		if err := a.populateAnnouncement(ctx, announcement); err != nil {
+			return nil, err
+		}
+	}
+
+	return announcements, nil
+}
+
+func (a *announcementDB) GetActiveAnnouncements(ctx context.Context, now time.Time) ([]*gtsmodel.Announcement, error) {
+	announcements := []*gtsmodel.Announcement{}
+
+	if err := a.db.
+		NewSelect().
+		Model(&announcements).
+		Where("? = ?", bun.Ident("announcement.published"), true).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? IS NULL", bun.Ident("announcement.starts_at")).
+				WhereOr("? <= ?", bun.Ident("announcement.starts_at"), now)
+		}).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? IS NULL", bun.Ident("announcement.ends_at")).
+				WhereOr("? > ?", bun.Ident("announcement.ends_at"), now)
+		}).
+		OrderExpr("? DESC", bun.Ident("announcement.published_at")).
+		Scan(ctx); err != nil {
+		return nil, a.db.ProcessError(err)
+	}
+
+	for _, announcement := range announcements {
+		if err := a.populateAnnouncement(ctx, announcement); err != nil {
+			return nil, err
+		}
+	}
+
+	return announcements, nil
+}
+
+func (a *announcementDB) populateAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement) error {
+	var err error
+
+	if announcement.Emojis == nil && len(announcement.EmojiIDs) != 0 {
+		announcement.Emojis, err = a.state.DB.GetEmojisByIDs(ctx, announcement.EmojiIDs)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return err
+		}
+	}
+
+	if announcement.Tags == nil && len(announcement.TagIDs) != 0 {
+		announcement.Tags, err = a.state.DB.GetTags(ctx, announcement.TagIDs)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *announcementDB) PutAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement) error {
+	_, err := a.db.
+		NewInsert().
+		Model(announcement).
+		Exec(ctx)
+	return a.db.ProcessError(err)
+}
+
+func (a *announcementDB) UpdateAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement, columns ...string) error {
+	// Update the announcement's last-updated
+	announcement.UpdatedAt = time.Now()
+	if len(columns) != 0 {
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := a.db.
+		NewUpdate().
+		Model(announcement).
+		Where("? = ?", bun.Ident("announcement.id"), announcement.ID).
+		Column(columns...).
+		Exec(ctx)
+	return a.db.ProcessError(err)
+}
+
+func (a *announcementDB) DeleteAnnouncementByID(ctx context.Context, id string) error {
+	return a.db.RunInTx(ctx, func(tx bun.Tx) error {
+		// Delete all reads of this announcement.
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("announcement_reads"), bun.Ident("announcement_read")).
+			Where("? = ?", bun.Ident("announcement_read.announcement_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Delete all reactions to this announcement.
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("announcement_reactions"), bun.Ident("announcement_reaction")).
+			Where("? = ?", bun.Ident("announcement_reaction.announcement_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Delete the announcement itself.
+		_, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("announcements"), bun.Ident("announcement")).
+			Where("? = ?", bun.Ident("announcement.id"), id).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (a *announcementDB) GetReadAnnouncementIDs(ctx context.Context, accountID string) ([]string, error) {
+	var announcementIDs []string
+
+	if err := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("announcement_reads"), bun.Ident("announcement_read")).
+		Column("announcement_read.announcement_id").
+		Where("? = ?", bun.Ident("announcement_read.account_id"), accountID).
+		Scan(ctx, &announcementIDs); err != nil {
+		return nil, a.db.ProcessError(err)
+	}
+
+	return announcementIDs, nil
+}
+
+func (a *announcementDB) PutAnnouncementRead(ctx context.Context, read *gtsmodel.AnnouncementRead) error {
+	_, err := a.db.
+		NewInsert().
+		Model(read).
+		Exec(ctx)
+	return a.db.ProcessError(err)
+}
+
+func (a *announcementDB) GetAnnouncementReactions(ctx context.Context, announcementID string) ([]*gtsmodel.AnnouncementReaction, error) {
+	reactions := []*gtsmodel.AnnouncementReaction{}
+
+	if err := a.db.
+		NewSelect().
+		Model(&reactions).
+		Where("? = ?", bun.Ident("announcement_reaction.announcement_id"), announcementID).
+		OrderExpr("? ASC", bun.Ident("announcement_reaction.id")).
+		Scan(ctx); err != nil {
+		return nil, a.db.ProcessError(err)
+	}
+
+	for _, reaction := range reactions {
+		if err := a.populateAnnouncementReaction(ctx, reaction); err != nil {
+			return nil, err
+		}
+	}
+
+	return reactions, nil
+}
+
+func (a *announcementDB) GetAnnouncementReaction(ctx context.Context, announcementID string, accountID string, name string) (*gtsmodel.AnnouncementReaction, error) {
+	var reaction gtsmodel.AnnouncementReaction
+
+	if err := a.db.
+		NewSelect().
+		Model(&reaction).
+		Where("? = ?", bun.Ident("announcement_reaction.announcement_id"), announcementID).
+		Where("? = ?", bun.Ident("announcement_reaction.account_id"), accountID).
+		Where("? = ?", bun.Ident("announcement_reaction.name"), name).
+		Scan(ctx); err != nil {
+		return nil, a.db.ProcessError(err)
+	}
+
+	if err := a.populateAnnouncementReaction(ctx, &reaction); err != nil {
+		return nil, err
+	}
+
+	return &reaction, nil
+}
+
+func (a *announcementDB) populateAnnouncementReaction(ctx context.Context, reaction *gtsmodel.AnnouncementReaction) error {
+	if reaction.Emoji != nil || reaction.EmojiID == "" {
+		return nil
+	}
+
+	emoji, err := a.state.DB.GetEmojiByID(ctx, reaction.EmojiID)
+	if err != nil {
+		return err
+	}
+
+	reaction.Emoji = emoji
+	return nil
+}
+
+func (a *announcementDB) PutAnnouncementReaction(ctx context.Context, reaction *gtsmodel.AnnouncementReaction) error {
+	_, err := a.db.
+		NewInsert().
+		Model(reaction).
+		Exec(ctx)
+	return a.db.ProcessError(err)
+}
+
+func (a *announcementDB) DeleteAnnouncementReactionByID(ctx context.Context, id string) error {
+	_, err := a.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("announcement_reactions"), bun.Ident("announcement_reaction")).
+		Where("? = ?", bun.Ident("announcement_reaction.id"), id).
+		Exec(ctx)
+	return a.db.ProcessError(err)
+}
+
+func (a *announcementDB) DeleteAccountAnnouncementData(ctx context.Context, accountID string) error {
+	if _, err := a.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("announcement_reads"), bun.Ident("announcement_read")).
+		Where("? = ?", bun.Ident("announcement_read.account_id"), accountID).
+		Exec(ctx); err != nil {
+		return a.db.ProcessError(err)
+	}
+
+	_, err := a.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("announcement_reactions"), bun.Ident("announcement_reaction")).
+		Where("? = ?", bun.Ident("announcement_reaction.account_id"), accountID).
+		Exec(ctx)
+	return a.db.ProcessError(err)
+}
@@ -0,0 +1,128 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type AnnouncementTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *AnnouncementTestSuite) putAnnouncement(published bool, startsAt time.Time, endsAt time.Time) *gtsmodel.Announcement {
+	announcement := &gtsmodel.Announcement{
+		ID:          id.NewULID(),
+		Text:        "hello world",
+		Content:     "<p>hello world</p>",
+		StartsAt:    startsAt,
+		EndsAt:      endsAt,
+		AllDay:      testrig.FalseBool(),
+		Published:   &published,
+		PublishedAt: time.Now(),
+		EmojiIDs:    []string{suite.testEmojis["rainbow"].ID},
+	}
+
+	suite.NoError(suite.db.PutAnnouncement(context.Background(), announcement))
+	return announcement
+}
+
+func (suite *AnnouncementTestSuite) TestGetActiveAnnouncements() {
+	ctx := context.Background()
+	now := time.Now()
+
+	active := suite.putAnnouncement(true, time.Time{}, time.Time{})
+	suite.putAnnouncement(false, time.Time{}, time.Time{})        // unpublished
+	suite.putAnnouncement(true, now.Add(time.Hour), time.Time{})  // not started
+	suite.putAnnouncement(true, time.Time{}, now.Add(-time.Hour)) // ended
+
+	announcements, err := suite.db.GetActiveAnnouncements(ctx, now)
+	suite.NoError(err)
+	suite.Len(announcements, 1)
+	suite.Equal(active.ID, announcements[0].ID)
+	suite.Len(announcements[0].Emojis, 1)
+
+	// All announcements should be
+	// returned for admin purposes.
+	announcements, err = suite.db.GetAnnouncements(ctx)
+	suite.NoError(err)
+	suite.Len(announcements, 4)
+}
+
+func (suite *AnnouncementTestSuite) TestAnnouncementReadsAndReactions() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+	announcement := suite.putAnnouncement(true, time.Time{}, time.Time{})
+
+	suite.NoError(suite.db.PutAnnouncementRead(ctx, &gtsmodel.AnnouncementRead{
+		ID:             id.NewULID(),
+		AccountID:      account.ID,
+		AnnouncementID: announcement.ID,
+	}))
+
+	readIDs, err := suite.db.GetReadAnnouncementIDs(ctx, account.ID)
+	suite.NoError(err)
+	suite.Equal([]string{announcement.ID}, readIDs)
+
+	emoji := suite.testEmojis["rainbow"]
+	suite.NoError(suite.db.PutAnnouncementReaction(ctx, &gtsmodel.AnnouncementReaction{
+		ID:             id.NewULID(),
+		AccountID:      account.ID,
+		AnnouncementID: announcement.ID,
+		Name:           emoji.Shortcode,
+		EmojiID:        emoji.ID,
+	}))
+
+	// An account can only react once with the same name.
+	err = suite.db.PutAnnouncementReaction(ctx, &gtsmodel.AnnouncementReaction{
+		ID:             id.NewULID(),
+		AccountID:      account.ID,
+		AnnouncementID: announcement.ID,
+		Name:           emoji.Shortcode,
+		EmojiID:        emoji.ID,
+	})
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+
+	reaction, err := suite.db.GetAnnouncementReaction(ctx, announcement.ID, account.ID, emoji.Shortcode)
+	suite.NoError(err)
+	suite.Equal(emoji.ID, reaction.Emoji.ID)
+
+	// Deleting the announcement should
+	// delete its reads and reactions too.
+	suite.NoError(suite.db.DeleteAnnouncementByID(ctx, announcement.ID))
+
+	readIDs, err = suite.db.GetReadAnnouncementIDs(ctx, account.ID)
+	suite.NoError(err)
+	suite.Empty(readIDs)
+
+	reactions, err := suite.db.GetAnnouncementReactions(ctx, announcement.ID)
+	suite.NoError(err)
+	suite.Empty(reactions)
+}
+
+func TestAnnouncementTestSuite(t *testing.T) {
+	suite.Run(t, new(AnnouncementTestSuite))
+}
@@ -60,10 +60,14 @@ var registerTables = []interface{}{
 type DBService struct {
 	db.Account
 	db.Admin
+	db.Announcement
 	db.Basic
+	db.Card
 	db.Domain
 	db.Emoji
 	db.Instance
+	db.Invite
+	db.IPBlock
 	db.List
 	db.Marker
 	db.Media
@@ -71,15 +75,20 @@ type DBService struct {
 	db.Notification
 	db.Relationship
 	db.Report
+	db.Rule
 	db.Search
 	db.Session
 	db.Status
 	db.StatusBookmark
 	db.StatusFave
+	db.StatusReaction
+	db.Suggestion
 	db.Tag
 	db.Timeline
+	db.Trend
 	db.User
 	db.Tombstone
+	db.WebPush
 	db *WrappedDB
 }
 
@@ -168,9 +177,17 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		Announcement: &announcementDB{
+			db:    db,
+			state: state,
+		},
 		Basic: &basicDB{
 			db: db,
 		},
+		Card: &cardDB{
+			db:    db,
+			state: state,
+		},
 		Domain: &domainDB{
 			db:    db,
 			state: state,
@@ -183,6 +200,14 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		Invite: &inviteDB{
+			db:    db,
+			state: state,
+		},
+		IPBlock: &ipBlockDB{
+			db:    db,
+			state: state,
+		},
 		List: &listDB{
 			db:    db,
 			state: state,
@@ -211,6 +236,10 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		Rule: &ruleDB{
+			db:    db,
+			state: state,
+		},
 		Search: &searchDB{
 			db:    db,
 			state: state,
@@ -230,6 +259,14 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		StatusReaction: &statusReactionDB{
+			db:    db,
+			state: state,
+		},
+		Suggestion: &suggestionDB{
+			db:    db,
+			state: state,
+		},
 		Tag: &tagDB{
 			conn:  db,
 			state: state,
@@ -238,6 +275,10 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		Trend: &trendDB{
+			db:    db,
+			state: state,
+		},
 		User: &userDB{
 			db:    db,
 			state: state,
@@ -246,6 +287,10 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		WebPush: &webPushDB{
+			db:    db,
+			state: state,
+		},
 		db: db,
 	}
 
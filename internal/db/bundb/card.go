@@ -0,0 +1,147 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type cardDB struct {
+	db    *WrappedDB
+	state *state.State
+}
+
+func (c *cardDB) GetCardByID(ctx context.Context, id string) (*gtsmodel.Card, error) {
+	return c.getCard(
+		ctx,
+		"ID",
+		func(card *gtsmodel.Card) error {
+			return c.db.NewSelect().
+				Model(card).
+				Where("? = ?", bun.Ident("card.id"), id).
+				Scan(ctx)
+		},
+		id,
+	)
+}
+
+func (c *cardDB) GetCardByURL(ctx context.Context, url string) (*gtsmodel.Card, error) {
+	return c.getCard(
+		ctx,
+		"URL",
+		func(card *gtsmodel.Card) error {
+			return c.db.NewSelect().
+				Model(card).
+				Where("? = ?", bun.Ident("card.url"), url).
+				Scan(ctx)
+		},
+		url,
+	)
+}
+
+func (c *cardDB) GetCardByImageID(ctx context.Context, imageID string) (*gtsmodel.Card, error) {
+	return c.getCard(
+		ctx,
+		"ImageID",
+		func(card *gtsmodel.Card) error {
+			return c.db.NewSelect().
+				Model(card).
+				Where("? = ?", bun.Ident("card.image_id"), imageID).
+				Scan(ctx)
+		},
+		imageID,
+	)
+}
+
+func (c *cardDB) getCard(ctx context.Context, lookup string, dbQuery func(*gtsmodel.Card) error, keyParts ...any) (*gtsmodel.Card, error) {
+	// Fetch card from database cache with loader callback
+	card, err := c.state.Caches.GTS.Card().Load(lookup, func() (*gtsmodel.Card, error) {
+		var card gtsmodel.Card
+
+		// Not cached! Perform database query.
+		if err := dbQuery(&card); err != nil {
+			return nil, c.db.ProcessError(err)
+		}
+
+		return &card, nil
+	}, keyParts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// Only a barebones model was requested.
+		return card, nil
+	}
+
+	if err := c.PopulateCard(ctx, card); err != nil {
+		return nil, err
+	}
+
+	return card, nil
+}
+
+func (c *cardDB) PopulateCard(ctx context.Context, card *gtsmodel.Card) error {
+	var err error
+
+	if card.ImageID != "" && card.Image == nil {
+		// Card thumbnail is not set, fetch from database.
+		card.Image, err = c.state.DB.GetAttachmentByID(
+			ctx, // these are already barebones
+			card.ImageID,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("error populating card image: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (c *cardDB) PutCard(ctx context.Context, card *gtsmodel.Card) error {
+	return c.state.Caches.GTS.Card().Store(card, func() error {
+		_, err := c.db.NewInsert().Model(card).Exec(ctx)
+		return c.db.ProcessError(err)
+	})
+}
+
+func (c *cardDB) UpdateCard(ctx context.Context, card *gtsmodel.Card, columns ...string) error {
+	card.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	return c.state.Caches.GTS.Card().Store(card, func() error {
+		_, err := c.db.NewUpdate().
+			Model(card).
+			Where("? = ?", bun.Ident("card.id"), card.ID).
+			Column(columns...).
+			Exec(ctx)
+		return c.db.ProcessError(err)
+	})
+}
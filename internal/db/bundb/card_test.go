@@ -0,0 +1,117 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type CardTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *CardTestSuite) TestPutGetCard() {
+	ctx := context.Background()
+	image := suite.testAttachments["local_account_1_unattached_1"]
+
+	card := &gtsmodel.Card{
+		ID:      id.NewULID(),
+		URL:     "https://example.org/articles/water",
+		Title:   "Is Water Wet?",
+		Type:    gtsmodel.CardTypeLink,
+		ImageID: image.ID,
+	}
+	suite.NoError(suite.db.PutCard(ctx, card))
+
+	// Card should be gettable by URL, with thumbnail populated.
+	dbCard, err := suite.db.GetCardByURL(ctx, card.URL)
+	suite.NoError(err)
+	suite.Equal(card.ID, dbCard.ID)
+	suite.NotNil(dbCard.Image)
+	suite.Equal(image.ID, dbCard.Image.ID)
+
+	// And by thumbnail ID.
+	dbCard, err = suite.db.GetCardByImageID(ctx, image.ID)
+	suite.NoError(err)
+	suite.Equal(card.ID, dbCard.ID)
+
+	// Only one card per URL.
+	err = suite.db.PutCard(ctx, &gtsmodel.Card{
+		ID:    id.NewULID(),
+		URL:   card.URL,
+		Title: "Duplicate",
+		Type:  gtsmodel.CardTypeLink,
+	})
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+}
+
+func (suite *CardTestSuite) TestStatusCard() {
+	ctx := context.Background()
+
+	card := &gtsmodel.Card{
+		ID:    id.NewULID(),
+		URL:   "https://example.org/articles/water",
+		Title: "Is Water Wet?",
+		Type:  gtsmodel.CardTypeLink,
+	}
+	suite.NoError(suite.db.PutCard(ctx, card))
+
+	// Attach card to a status.
+	status := &gtsmodel.Status{}
+	*status = *suite.testStatuses["local_account_1_status_1"]
+	status.CardID = card.ID
+	suite.NoError(suite.db.UpdateStatus(ctx, status, "card_id"))
+
+	// Card should be populated on fetched status.
+	dbStatus, err := suite.db.GetStatusByID(ctx, status.ID)
+	suite.NoError(err)
+	suite.NotNil(dbStatus.Card)
+	suite.Equal(card.URL, dbStatus.Card.URL)
+}
+
+func (suite *CardTestSuite) TestDeleteCardImage() {
+	ctx := context.Background()
+	image := suite.testAttachments["local_account_1_unattached_1"]
+
+	card := &gtsmodel.Card{
+		ID:      id.NewULID(),
+		URL:     "https://example.org/articles/water",
+		Title:   "Is Water Wet?",
+		Type:    gtsmodel.CardTypeLink,
+		ImageID: image.ID,
+	}
+	suite.NoError(suite.db.PutCard(ctx, card))
+
+	// Deleting the thumbnail should unset it on the card.
+	suite.NoError(suite.db.DeleteAttachment(ctx, image.ID))
+
+	dbCard, err := suite.db.GetCardByID(ctx, card.ID)
+	suite.NoError(err)
+	suite.Empty(dbCard.ImageID)
+	suite.Nil(dbCard.Image)
+}
+
+func TestCardTestSuite(t *testing.T) {
+	suite.Run(t, new(CardTestSuite))
+}
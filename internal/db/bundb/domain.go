@@ -184,3 +184,121 @@ func (d *domainDB) AreURIsBlocked(ctx context.Context, uris []*url.URL) (bool, e
 	}
 	return false, nil
 }
+
+func (d *domainDB) CreateEmailDomainBlock(ctx context.Context, block *gtsmodel.EmailDomainBlock) error {
+	// Normalize the domain as punycode
+	var err error
+	block.Domain, err = util.Punify(block.Domain)
+	if err != nil {
+		return err
+	}
+
+	// Attempt to store email domain block in DB
+	if _, err := d.db.NewInsert().
+		Model(block).
+		Exec(ctx); err != nil {
+		return d.db.ProcessError(err)
+	}
+
+	// Clear the email domain block cache (for later reload)
+	d.state.Caches.GTS.EmailDomainBlock().Clear()
+
+	return nil
+}
+
+func (d *domainDB) GetEmailDomainBlock(ctx context.Context, domain string) (*gtsmodel.EmailDomainBlock, error) {
+	// Normalize the domain as punycode
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	var block gtsmodel.EmailDomainBlock
+
+	// Look for block matching domain in DB
+	q := d.db.
+		NewSelect().
+		Model(&block).
+		Where("? = ?", bun.Ident("email_domain_block.domain"), domain)
+	if err := q.Scan(ctx); err != nil {
+		return nil, d.db.ProcessError(err)
+	}
+
+	return &block, nil
+}
+
+func (d *domainDB) GetEmailDomainBlockByID(ctx context.Context, id string) (*gtsmodel.EmailDomainBlock, error) {
+	var block gtsmodel.EmailDomainBlock
+
+	q := d.db.
+		NewSelect().
+		Model(&block).
+		Where("? = ?", bun.Ident("email_domain_block.id"), id)
+	if err := q.Scan(ctx); err != nil {
+		return nil, d.db.ProcessError(err)
+	}
+
+	return &block, nil
+}
+
+func (d *domainDB) GetEmailDomainBlocks(ctx context.Context) ([]*gtsmodel.EmailDomainBlock, error) {
+	blocks := []*gtsmodel.EmailDomainBlock{}
+
+	if err := d.db.
+		NewSelect().
+		Model(&blocks).
+		OrderExpr("? ASC", bun.Ident("email_domain_block.domain")).
+		Scan(ctx); err != nil {
+		return nil, d.db.ProcessError(err)
+	}
+
+	return blocks, nil
+}
+
+func (d *domainDB) DeleteEmailDomainBlock(ctx context.Context, domain string) error {
+	// Normalize the domain as punycode
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return err
+	}
+
+	// Attempt to delete email domain block
+	if _, err := d.db.NewDelete().
+		Model((*gtsmodel.EmailDomainBlock)(nil)).
+		Where("? = ?", bun.Ident("email_domain_block.domain"), domain).
+		Exec(ctx); err != nil {
+		return d.db.ProcessError(err)
+	}
+
+	// Clear the email domain block cache (for later reload)
+	d.state.Caches.GTS.EmailDomainBlock().Clear()
+
+	return nil
+}
+
+func (d *domainDB) IsEmailDomainBlocked(ctx context.Context, domain string) (bool, error) {
+	// Normalize the domain as punycode
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return false, err
+	}
+
+	if domain == "" {
+		return false, nil
+	}
+
+	// Check the cache for an email domain block (hydrating the cache with callback if necessary)
+	return d.state.Caches.GTS.EmailDomainBlock().IsBlocked(domain, func() ([]string, error) {
+		var domains []string
+
+		// Scan list of all blocked email domains from DB
+		q := d.db.NewSelect().
+			Table("email_domain_blocks").
+			Column("domain")
+		if err := q.Scan(ctx, &domains); err != nil {
+			return nil, d.db.ProcessError(err)
+		}
+
+		return domains, nil
+	})
+}
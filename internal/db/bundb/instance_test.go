@@ -128,6 +128,13 @@ func (suite *InstanceTestSuite) TestGetInstanceModeratorAddressesNoAdmin() {
 	suite.Empty(addresses)
 }
 
+func (suite *InstanceTestSuite) TestGetInstanceModeratorAccountIDsOK() {
+	// We have one admin user by default.
+	accountIDs, err := suite.db.GetInstanceModeratorAccountIDs(context.Background())
+	suite.NoError(err)
+	suite.EqualValues([]string{suite.testAccounts["admin_account"].ID}, accountIDs)
+}
+
 func TestInstanceTestSuite(t *testing.T) {
 	suite.Run(t, new(InstanceTestSuite))
 }
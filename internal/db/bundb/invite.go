@@ -0,0 +1,153 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type inviteDB struct {
+	db    *WrappedDB
+	state *state.State
+}
+
+func (i *inviteDB) GetInviteByID(ctx context.Context, id string) (*gtsmodel.Invite, error) {
+	return i.getInvite(ctx, "id", id)
+}
+
+func (i *inviteDB) GetInviteByCode(ctx context.Context, code string) (*gtsmodel.Invite, error) {
+	return i.getInvite(ctx, "code", code)
+}
+
+func (i *inviteDB) getInvite(ctx context.Context, column string, value any) (*gtsmodel.Invite, error) {
+	var invite gtsmodel.Invite
+
+	if err := i.db.
+		NewSelect().
+		Model(&invite).
+		Where("? = ?", bun.Ident("invite."+column), value).
+		Scan(ctx); err != nil {
+		return nil, i.db.ProcessError(err)
+	}
+
+	return &invite, nil
+}
+
+func (i *inviteDB) GetAccountInvites(ctx context.Context, accountID string) ([]*gtsmodel.Invite, error) {
+	invites := []*gtsmodel.Invite{}
+
+	if err := i.db.
+		NewSelect().
+		Model(&invites).
+		Where("? = ?", bun.Ident("invite.account_id"), accountID).
+		OrderExpr("? DESC", bun.Ident("invite.id")).
+		Scan(ctx); err != nil {
+		return nil, i.db.ProcessError(err)
+	}
+
+	return invites, nil
+}
+
+func (i *inviteDB) PutInvite(ctx context.Context, invite *gtsmodel.Invite) error {
+	if _, err := i.db.
+		NewInsert().
+		Model(invite).
+		Exec(ctx); err != nil {
+		return i.db.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (i *inviteDB) UpdateInvite(ctx context.Context, invite *gtsmodel.Invite, columns ...string) error {
+	invite.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	if _, err := i.db.
+		NewUpdate().
+		Model(invite).
+		Where("? = ?", bun.Ident("invite.id"), invite.ID).
+		Column(columns...).
+		Exec(ctx); err != nil {
+		return i.db.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (i *inviteDB) UseInvite(ctx context.Context, id string) error {
+	// Increment in the database rather than in
+	// Go, so concurrent sign ups can't both take
+	// the last use of a limited-use invite.
+	res, err := i.db.
+		NewUpdate().
+		Model((*gtsmodel.Invite)(nil)).
+		Set("? = ? + 1", bun.Ident("uses"), bun.Ident("uses")).
+		Set("? = ?", bun.Ident("updated_at"), time.Now()).
+		Where("? = ?", bun.Ident("invite.id"), id).
+		WhereGroup(" AND ", func(q *bun.UpdateQuery) *bun.UpdateQuery {
+			return q.
+				Where("? = 0", bun.Ident("invite.max_uses")).
+				WhereOr("? < ?", bun.Ident("invite.uses"), bun.Ident("invite.max_uses"))
+		}).
+		// Revoking an invite expires it, so
+		// this catches revoked invites too.
+		WhereGroup(" AND ", func(q *bun.UpdateQuery) *bun.UpdateQuery {
+			return q.
+				Where("? IS NULL", bun.Ident("invite.expires_at")).
+				WhereOr("? > ?", bun.Ident("invite.expires_at"), time.Now())
+		}).
+		Exec(ctx)
+	if err != nil {
+		return i.db.ProcessError(err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return i.db.ProcessError(err)
+	}
+
+	if rows == 0 {
+		return db.ErrNoEntries
+	}
+
+	return nil
+}
+
+func (i *inviteDB) UnuseInvite(ctx context.Context, id string) error {
+	if _, err := i.db.
+		NewUpdate().
+		Model((*gtsmodel.Invite)(nil)).
+		Set("? = ? - 1", bun.Ident("uses"), bun.Ident("uses")).
+		Set("? = ?", bun.Ident("updated_at"), time.Now()).
+		Where("? = ?", bun.Ident("invite.id"), id).
+		Where("? > 0", bun.Ident("invite.uses")).
+		Exec(ctx); err != nil {
+		return i.db.ProcessError(err)
+	}
+
+	return nil
+}
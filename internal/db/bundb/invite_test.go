@@ -0,0 +1,106 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type InviteTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *InviteTestSuite) putInvite(maxUses int, expiresAt time.Time) *gtsmodel.Invite {
+	invite := &gtsmodel.Invite{
+		ID:        "01H6ZQ0ZB0YJ6V7A4K0J7YQ1QX",
+		Code:      "ABCD2345",
+		AccountID: suite.testAccounts["admin_account"].ID,
+		MaxUses:   maxUses,
+		ExpiresAt: expiresAt,
+	}
+
+	if err := suite.db.PutInvite(context.Background(), invite); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return invite
+}
+
+func (suite *InviteTestSuite) uses(id string) int {
+	invite, err := suite.db.GetInviteByID(context.Background(), id)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	return invite.Uses
+}
+
+func (suite *InviteTestSuite) TestUseInvite() {
+	ctx := context.Background()
+	invite := suite.putInvite(2, time.Now().Add(time.Hour))
+
+	suite.NoError(suite.db.UseInvite(ctx, invite.ID))
+	suite.NoError(suite.db.UseInvite(ctx, invite.ID))
+	suite.Equal(2, suite.uses(invite.ID))
+
+	// Used up now.
+	suite.ErrorIs(suite.db.UseInvite(ctx, invite.ID), db.ErrNoEntries)
+	suite.Equal(2, suite.uses(invite.ID))
+
+	// Give one back, and it can be used again.
+	suite.NoError(suite.db.UnuseInvite(ctx, invite.ID))
+	suite.Equal(1, suite.uses(invite.ID))
+	suite.NoError(suite.db.UseInvite(ctx, invite.ID))
+	suite.Equal(2, suite.uses(invite.ID))
+}
+
+func (suite *InviteTestSuite) TestUseInviteExpired() {
+	ctx := context.Background()
+
+	// Unlimited uses, but it's
+	// expired (or was revoked).
+	invite := suite.putInvite(0, time.Now().Add(-time.Minute))
+
+	suite.ErrorIs(suite.db.UseInvite(ctx, invite.ID), db.ErrNoEntries)
+	suite.Equal(0, suite.uses(invite.ID))
+}
+
+func (suite *InviteTestSuite) TestUseInviteNoExpiry() {
+	ctx := context.Background()
+	invite := suite.putInvite(0, time.Time{})
+
+	suite.NoError(suite.db.UseInvite(ctx, invite.ID))
+	suite.Equal(1, suite.uses(invite.ID))
+}
+
+func (suite *InviteTestSuite) TestUnuseInviteNoUses() {
+	ctx := context.Background()
+	invite := suite.putInvite(1, time.Time{})
+
+	// Uses shouldn't go negative.
+	suite.NoError(suite.db.UnuseInvite(ctx, invite.ID))
+	suite.Equal(0, suite.uses(invite.ID))
+}
+
+func TestInviteTestSuite(t *testing.T) {
+	suite.Run(t, new(InviteTestSuite))
+}
@@ -0,0 +1,149 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package bundb
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/superseriousbusiness/gotosocial/internal/cache/ip"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type ipBlockDB struct {
+	db    *WrappedDB
+	state *state.State
+}
+
+func (i *ipBlockDB) GetIPBlockByID(ctx context.Context, id string) (*gtsmodel.IPBlock, error) {
+	var block gtsmodel.IPBlock
+
+	if err := i.db.
+		NewSelect().
+		Model(&block).
+		Where("? = ?", bun.Ident("ip_block.id"), id).
+		Scan(ctx); err != nil {
+		return nil, i.db.ProcessError(err)
+	}
+
+	return &block, nil
+}
+
+func (i *ipBlockDB) GetIPBlock(ctx context.Context, ip string) (*gtsmodel.IPBlock, error) {
+	var block gtsmodel.IPBlock
+
+	if err := i.db.
+		NewSelect().
+		Model(&block).
+		Where("? = ?", bun.Ident("ip_block.ip"), ip).
+		Scan(ctx); err != nil {
+		return nil, i.db.ProcessError(err)
+	}
+
+	return &block, nil
+}
+
+func (i *ipBlockDB) GetIPBlocks(ctx context.Context) ([]*gtsmodel.IPBlock, error) {
+	blocks := []*gtsmodel.IPBlock{}
+
+	if err := i.db.
+		NewSelect().
+		Model(&blocks).
+		OrderExpr("? DESC", bun.Ident("ip_block.id")).
+		Scan(ctx); err != nil {
+		return nil, i.db.ProcessError(err)
+	}
+
+	return blocks, nil
+}
+
+func (i *ipBlockDB) PutIPBlock(ctx context.Context, block *gtsmodel.IPBlock) error {
+	if _, err := i.db.
+		NewInsert().
+		Model(block).
+		Exec(ctx); err != nil {
+		return i.db.ProcessError(err)
+	}
+
+	// Clear the IP block cache (for later reload)
+	i.state.Caches.GTS.IPBlock().Clear()
+
+	return nil
+}
+
+func (i *ipBlockDB) DeleteIPBlockByID(ctx context.Context, id string) error {
+	if _, err := i.db.
+		NewDelete().
+		Model((*gtsmodel.IPBlock)(nil)).
+		Where("? = ?", bun.Ident("ip_block.id"), id).
+		Exec(ctx); err != nil {
+		return i.db.ProcessError(err)
+	}
+
+	// Clear the IP block cache (for later reload)
+	i.state.Caches.GTS.IPBlock().Clear()
+
+	return nil
+}
+
+func (i *ipBlockDB) IsIPBlocked(ctx context.Context, addr netip.Addr, severity gtsmodel.IPBlockSeverity) (bool, error) {
+	// Check the cache for matching IP blocks (hydrating the cache with callback if necessary)
+	matches, err := i.state.Caches.GTS.IPBlock().Match(addr, func() ([]ip.Block, error) {
+		var blocks []*gtsmodel.IPBlock
+
+		// Scan list of all IP blocks from DB
+		if err := i.db.
+			NewSelect().
+			Model(&blocks).
+			Column("ip", "severity", "expires_at").
+			Scan(ctx); err != nil {
+			return nil, i.db.ProcessError(err)
+		}
+
+		cached := make([]ip.Block, 0, len(blocks))
+		for _, block := range blocks {
+			prefix, err := netip.ParsePrefix(block.IP)
+			if err != nil {
+				log.Errorf(ctx, "skipping invalid ip block %s: %v", block.IP, err)
+				continue
+			}
+
+			cached = append(cached, ip.Block{
+				Prefix:    prefix.Masked(),
+				Severity:  string(block.Severity),
+				ExpiresAt: block.ExpiresAt,
+			})
+		}
+
+		return cached, nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, match := range matches {
+		switch gtsmodel.IPBlockSeverity(match.Severity) {
+		case severity, gtsmodel.IPBlockSeverityNoAccess:
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -121,6 +121,10 @@ func (m *mediaDB) DeleteAttachment(ctx context.Context, id string) error {
 	// On return, ensure that media with ID is invalidated.
 	defer m.state.Caches.GTS.Media().Invalidate("ID", id)
 
+	// Media may be in use as the thumbnail of a preview
+	// card, ensure any such card is invalidated on return.
+	defer m.state.Caches.GTS.Card().Invalidate("ImageID", id)
+
 	// Delete media attachment in new transaction.
 	err = m.db.RunInTx(ctx, func(tx bun.Tx) error {
 		if media.AccountID != "" {
@@ -186,6 +190,17 @@ func (m *mediaDB) DeleteAttachment(ctx context.Context, id string) error {
 			}
 		}
 
+		// Note: this handles not found.
+		//
+		// Unset this media as any preview card thumbnail.
+		if _, err := tx.NewUpdate().
+			Table("cards").
+			Where("? = ?", bun.Ident("image_id"), id).
+			Set("? = NULL", bun.Ident("image_id")).
+			Exec(ctx); err != nil {
+			return gtserror.Newf("error updating card: %w", err)
+		}
+
 		// Finally delete this media.
 		if _, err := tx.NewDelete().
 			Table("media_attachments").
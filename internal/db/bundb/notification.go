@@ -26,6 +26,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/uptrace/bun"
 )
@@ -78,13 +79,17 @@ func (n *notificationDB) GetNotification(
 func (n *notificationDB) GetAccountNotifications(
 	ctx context.Context,
 	accountID string,
-	maxID string,
-	sinceID string,
-	minID string,
-	limit int,
+	page *paging.Pager,
+	types []string,
 	excludeTypes []string,
+	originAccountID string,
 ) ([]*gtsmodel.Notification, error) {
+	if page == nil {
+		page = new(paging.Pager)
+	}
+
 	// Ensure reasonable
+	limit := page.Limit
 	if limit < 0 {
 		limit = 0
 	}
@@ -100,6 +105,7 @@ func (n *notificationDB) GetAccountNotifications(
 		TableExpr("? AS ?", bun.Ident("notifications"), bun.Ident("notification")).
 		Column("notification.id")
 
+	maxID := page.MaxID
 	if maxID == "" {
 		maxID = id.Highest
 	}
@@ -107,26 +113,31 @@ func (n *notificationDB) GetAccountNotifications(
 	// Return only notifs LOWER (ie., older) than maxID.
 	q = q.Where("? < ?", bun.Ident("notification.id"), maxID)
 
-	if sinceID != "" {
+	if page.SinceID != "" {
 		// Return only notifs HIGHER (ie., newer) than sinceID.
-		q = q.Where("? > ?", bun.Ident("notification.id"), sinceID)
-	}
-
-	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("notification.id"), page.SinceID)
+	} else if page.MinID != "" {
 		// Return only notifs HIGHER (ie., newer) than minID.
-		q = q.Where("? > ?", bun.Ident("notification.id"), minID)
+		q = q.Where("? > ?", bun.Ident("notification.id"), page.MinID)
 
 		frontToBack = false // page up
 	}
 
-	for _, excludeType := range excludeTypes {
-		// Filter out unwanted notif types.
-		q = q.Where("? != ?", bun.Ident("notification.notification_type"), excludeType)
-	}
-
 	// Return only notifs for this account.
 	q = q.Where("? = ?", bun.Ident("notification.target_account_id"), accountID)
 
+	// Apply type / origin filters.
+	q = whereNotificationFilters(q, types, excludeTypes)
+
+	if originAccountID != "" {
+		// Return only notifs from given origin account,
+		// including any filtered by the notif policy.
+		q = q.Where("? = ?", bun.Ident("notification.origin_account_id"), originAccountID)
+	} else {
+		// Leave out notifs filtered by the notif policy.
+		q = q.Where("? = ?", bun.Ident("notification.filtered"), false)
+	}
+
 	if limit > 0 {
 		q = q.Limit(limit)
 	}
@@ -172,6 +183,50 @@ func (n *notificationDB) GetAccountNotifications(
 	return notifs, nil
 }
 
+func (n *notificationDB) CountAccountNotifications(
+	ctx context.Context,
+	accountID string,
+	sinceID string,
+	types []string,
+	excludeTypes []string,
+) (int, error) {
+	q := n.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("notifications"), bun.Ident("notification")).
+		Where("? = ?", bun.Ident("notification.target_account_id"), accountID).
+		Where("? = ?", bun.Ident("notification.filtered"), false)
+
+	if sinceID != "" {
+		// Count only notifs HIGHER (ie., newer) than sinceID.
+		q = q.Where("? > ?", bun.Ident("notification.id"), sinceID)
+	}
+
+	q = whereNotificationFilters(q, types, excludeTypes)
+
+	count, err := q.Count(ctx)
+	if err != nil {
+		return 0, n.db.ProcessError(err)
+	}
+
+	return count, nil
+}
+
+// whereNotificationFilters adds the given include
+// and exclude notification type filters to query.
+func whereNotificationFilters(q *bun.SelectQuery, types []string, excludeTypes []string) *bun.SelectQuery {
+	if len(types) > 0 {
+		// Return only wanted notif types.
+		q = q.Where("? IN (?)", bun.Ident("notification.notification_type"), bun.In(types))
+	}
+
+	for _, excludeType := range excludeTypes {
+		// Filter out unwanted notif types.
+		q = q.Where("? != ?", bun.Ident("notification.notification_type"), excludeType)
+	}
+
+	return q
+}
+
 func (n *notificationDB) PutNotification(ctx context.Context, notif *gtsmodel.Notification) error {
 	return n.state.Caches.GTS.Notification().Store(notif, func() error {
 		_, err := n.db.NewInsert().Model(notif).Exec(ctx)
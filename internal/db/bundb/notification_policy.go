@@ -0,0 +1,318 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/uptrace/bun"
+)
+
+func (n *notificationDB) getFilteredNotificationIDs(ctx context.Context, targetAccountID string, originAccountID string) ([]string, error) {
+	var notifIDs []string
+
+	if _, err := n.db.
+		NewSelect().
+		Column("id").
+		Table("notifications").
+		Where("? = ?", bun.Ident("target_account_id"), targetAccountID).
+		Where("? = ?", bun.Ident("origin_account_id"), originAccountID).
+		Where("? = ?", bun.Ident("filtered"), true).
+		Exec(ctx, &notifIDs); err != nil {
+		return nil, n.db.ProcessError(err)
+	}
+
+	return notifIDs, nil
+}
+
+func (n *notificationDB) UnfilterNotifications(ctx context.Context, targetAccountID string, originAccountID string) error {
+	notifIDs, err := n.getFilteredNotificationIDs(ctx, targetAccountID, originAccountID)
+	if err != nil {
+		return err
+	}
+
+	if len(notifIDs) == 0 {
+		// Nothing to do.
+		return nil
+	}
+
+	defer func() {
+		// Invalidate all IDs on return.
+		for _, id := range notifIDs {
+			n.state.Caches.GTS.Notification().Invalidate("ID", id)
+		}
+	}()
+
+	_, err = n.db.NewUpdate().
+		Table("notifications").
+		Set("? = ?", bun.Ident("filtered"), false).
+		Where("? IN (?)", bun.Ident("id"), bun.In(notifIDs)).
+		Exec(ctx)
+	return n.db.ProcessError(err)
+}
+
+func (n *notificationDB) DeleteFilteredNotifications(ctx context.Context, targetAccountID string, originAccountID string) error {
+	notifIDs, err := n.getFilteredNotificationIDs(ctx, targetAccountID, originAccountID)
+	if err != nil {
+		return err
+	}
+
+	if len(notifIDs) == 0 {
+		// Nothing to do.
+		return nil
+	}
+
+	defer func() {
+		// Invalidate all IDs on return.
+		for _, id := range notifIDs {
+			n.state.Caches.GTS.Notification().Invalidate("ID", id)
+		}
+	}()
+
+	// Load all notif into cache, this *really* isn't great
+	// but it is the only way we can ensure we invalidate all
+	// related caches correctly (e.g. visibility).
+	for _, id := range notifIDs {
+		_, err := n.GetNotificationByID(ctx, id)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return err
+		}
+	}
+
+	// Finally delete all from DB.
+	_, err = n.db.NewDelete().
+		Table("notifications").
+		Where("? IN (?)", bun.Ident("id"), bun.In(notifIDs)).
+		Exec(ctx)
+	return n.db.ProcessError(err)
+}
+
+func (n *notificationDB) GetNotificationPolicy(ctx context.Context, accountID string) (*gtsmodel.NotificationPolicy, error) {
+	var policy gtsmodel.NotificationPolicy
+
+	if err := n.db.
+		NewSelect().
+		Model(&policy).
+		Where("? = ?", bun.Ident("notification_policy.account_id"), accountID).
+		Scan(ctx); err != nil {
+		return nil, n.db.ProcessError(err)
+	}
+
+	return &policy, nil
+}
+
+func (n *notificationDB) PutNotificationPolicy(ctx context.Context, policy *gtsmodel.NotificationPolicy) error {
+	_, err := n.db.
+		NewInsert().
+		Model(policy).
+		Exec(ctx)
+	return n.db.ProcessError(err)
+}
+
+func (n *notificationDB) UpdateNotificationPolicy(ctx context.Context, policy *gtsmodel.NotificationPolicy, columns ...string) error {
+	// Update the policy's last-updated
+	policy.UpdatedAt = time.Now()
+	if len(columns) != 0 {
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := n.db.
+		NewUpdate().
+		Model(policy).
+		Column(columns...).
+		Where("? = ?", bun.Ident("notification_policy.id"), policy.ID).
+		Exec(ctx)
+	return n.db.ProcessError(err)
+}
+
+func (n *notificationDB) GetNotificationRequestByID(ctx context.Context, id string) (*gtsmodel.NotificationRequest, error) {
+	return n.getNotificationRequest(ctx, func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.Where("? = ?", bun.Ident("notification_request.id"), id)
+	})
+}
+
+func (n *notificationDB) GetNotificationRequest(ctx context.Context, accountID string, fromAccountID string) (*gtsmodel.NotificationRequest, error) {
+	return n.getNotificationRequest(ctx, func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.
+			Where("? = ?", bun.Ident("notification_request.account_id"), accountID).
+			Where("? = ?", bun.Ident("notification_request.from_account_id"), fromAccountID)
+	})
+}
+
+func (n *notificationDB) getNotificationRequest(ctx context.Context, where func(*bun.SelectQuery) *bun.SelectQuery) (*gtsmodel.NotificationRequest, error) {
+	var request gtsmodel.NotificationRequest
+
+	q := n.db.
+		NewSelect().
+		Model(&request)
+
+	if err := where(q).Scan(ctx); err != nil {
+		return nil, n.db.ProcessError(err)
+	}
+
+	if err := n.populateNotificationRequest(ctx, &request); err != nil {
+		return nil, err
+	}
+
+	return &request, nil
+}
+
+func (n *notificationDB) GetAccountNotificationRequests(ctx context.Context, accountID string, page *paging.Pager) ([]*gtsmodel.NotificationRequest, error) {
+	if page == nil {
+		page = new(paging.Pager)
+	}
+
+	requests := []*gtsmodel.NotificationRequest{}
+
+	q := n.db.
+		NewSelect().
+		Model(&requests).
+		Where("? = ?", bun.Ident("notification_request.account_id"), accountID).
+		Where("? = ?", bun.Ident("notification_request.accepted"), false)
+
+	if page.MaxID != "" {
+		q = q.Where("? < ?", bun.Ident("notification_request.id"), page.MaxID)
+	}
+
+	// As with paging.Pager{}.PageDesc, results
+	// are descending unless only min ID is set.
+	asc := false
+	if page.SinceID != "" {
+		q = q.Where("? > ?", bun.Ident("notification_request.id"), page.SinceID)
+	} else if page.MinID != "" {
+		q = q.Where("? > ?", bun.Ident("notification_request.id"), page.MinID)
+		asc = true
+	}
+
+	if asc {
+		q = q.OrderExpr("? ASC", bun.Ident("notification_request.id"))
+	} else {
+		q = q.OrderExpr("? DESC", bun.Ident("notification_request.id"))
+	}
+
+	if page.Limit > 0 {
+		q = q.Limit(page.Limit)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, n.db.ProcessError(err)
+	}
+
+	if asc {
+		// Always return requests ID descending.
+		for l, r := 0, len(requests)-1; l < r; l, r = l+1, r-1 {
+			requests[l], requests[r] = requests[r], requests[l]
+		}
+	}
+
+	for _, request := range requests {
+		if err := n.populateNotificationRequest(ctx, request); err != nil {
+			return nil, err
+		}
+	}
+
+	return requests, nil
+}
+
+func (n *notificationDB) populateNotificationRequest(ctx context.Context, request *gtsmodel.NotificationRequest) error {
+	var err error
+
+	if request.Account == nil {
+		request.Account, err = n.state.DB.GetAccountByID(ctx, request.AccountID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if request.FromAccount == nil {
+		request.FromAccount, err = n.state.DB.GetAccountByID(ctx, request.FromAccountID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if request.LastStatus == nil && request.LastStatusID != "" {
+		request.LastStatus, err = n.state.DB.GetStatusByID(ctx, request.LastStatusID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (n *notificationDB) PutNotificationRequest(ctx context.Context, request *gtsmodel.NotificationRequest) error {
+	_, err := n.db.
+		NewInsert().
+		Model(request).
+		Exec(ctx)
+	return n.db.ProcessError(err)
+}
+
+func (n *notificationDB) UpdateNotificationRequest(ctx context.Context, request *gtsmodel.NotificationRequest, columns ...string) error {
+	// Update the request's last-updated
+	request.UpdatedAt = time.Now()
+	if len(columns) != 0 {
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := n.db.
+		NewUpdate().
+		Model(request).
+		Column(columns...).
+		Where("? = ?", bun.Ident("notification_request.id"), request.ID).
+		Exec(ctx)
+	return n.db.ProcessError(err)
+}
+
+func (n *notificationDB) DeleteNotificationRequestByID(ctx context.Context, id string) error {
+	_, err := n.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("notification_requests"), bun.Ident("notification_request")).
+		Where("? = ?", bun.Ident("notification_request.id"), id).
+		Exec(ctx)
+	return n.db.ProcessError(err)
+}
+
+func (n *notificationDB) DeleteAccountNotificationPolicyData(ctx context.Context, accountID string) error {
+	return n.db.RunInTx(ctx, func(tx bun.Tx) error {
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("notification_policies"), bun.Ident("notification_policy")).
+			Where("? = ?", bun.Ident("notification_policy.account_id"), accountID).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("notification_requests"), bun.Ident("notification_request")).
+			WhereGroup(" AND ", func(q *bun.DeleteQuery) *bun.DeleteQuery {
+				return q.
+					Where("? = ?", bun.Ident("notification_request.account_id"), accountID).
+					WhereOr("? = ?", bun.Ident("notification_request.from_account_id"), accountID)
+			}).
+			Exec(ctx)
+		return err
+	})
+}
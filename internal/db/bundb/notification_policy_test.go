@@ -0,0 +1,178 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type NotificationPolicyTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *NotificationPolicyTestSuite) TestPutGetUpdateNotificationPolicy() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_1"]
+
+	_, err := suite.db.GetNotificationPolicy(ctx, testAccount.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	policy := &gtsmodel.NotificationPolicy{
+		ID:                    id.NewULID(),
+		AccountID:             testAccount.ID,
+		FilterNotFollowing:    testrig.TrueBool(),
+		FilterNotFollowers:    testrig.FalseBool(),
+		FilterNewAccounts:     testrig.FalseBool(),
+		FilterPrivateMentions: testrig.FalseBool(),
+	}
+	if err := suite.db.PutNotificationPolicy(ctx, policy); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	policy.FilterNewAccounts = testrig.TrueBool()
+	if err := suite.db.UpdateNotificationPolicy(ctx, policy, "filter_new_accounts"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dbPolicy, err := suite.db.GetNotificationPolicy(ctx, testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(*dbPolicy.FilterNotFollowing)
+	suite.False(*dbPolicy.FilterNotFollowers)
+	suite.True(*dbPolicy.FilterNewAccounts)
+	suite.False(*dbPolicy.FilterPrivateMentions)
+}
+
+func (suite *NotificationPolicyTestSuite) TestFilteredNotifications() {
+	ctx := context.Background()
+	targetAccount := suite.testAccounts["local_account_1"]
+	originAccount := suite.testAccounts["remote_account_1"]
+	testStatus := suite.testStatuses["local_account_1_status_1"]
+
+	notif := &gtsmodel.Notification{
+		ID:               id.NewULID(),
+		NotificationType: gtsmodel.NotificationFave,
+		TargetAccountID:  targetAccount.ID,
+		OriginAccountID:  originAccount.ID,
+		StatusID:         testStatus.ID,
+		Read:             testrig.FalseBool(),
+		Filtered:         testrig.TrueBool(),
+	}
+	if err := suite.db.PutNotification(ctx, notif); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	request := &gtsmodel.NotificationRequest{
+		ID:                 id.NewULID(),
+		AccountID:          targetAccount.ID,
+		FromAccountID:      originAccount.ID,
+		LastStatusID:       testStatus.ID,
+		NotificationsCount: 1,
+		Accepted:           testrig.FalseBool(),
+	}
+	if err := suite.db.PutNotificationRequest(ctx, request); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Filtered notification should only be
+	// returned when filtering by origin account.
+	notifs, err := suite.db.GetAccountNotifications(ctx, targetAccount.ID, &paging.Pager{Limit: 20}, nil, nil, "")
+	suite.NoError(err)
+	for _, n := range notifs {
+		suite.NotEqual(notif.ID, n.ID)
+	}
+
+	notifs, err = suite.db.GetAccountNotifications(ctx, targetAccount.ID, &paging.Pager{Limit: 20}, nil, nil, originAccount.ID)
+	suite.NoError(err)
+	suite.Len(notifs, 1)
+
+	requests, err := suite.db.GetAccountNotificationRequests(ctx, targetAccount.ID, &paging.Pager{Limit: 20})
+	suite.NoError(err)
+	if suite.Len(requests, 1) {
+		suite.Equal(request.ID, requests[0].ID)
+		suite.Equal(originAccount.ID, requests[0].FromAccount.ID)
+		suite.Equal(testStatus.ID, requests[0].LastStatus.ID)
+	}
+
+	// Accept the request.
+	if err := suite.db.UnfilterNotifications(ctx, targetAccount.ID, originAccount.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+	request.Accepted = testrig.TrueBool()
+	if err := suite.db.UpdateNotificationRequest(ctx, request, "accepted"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dbNotif, err := suite.db.GetNotificationByID(ctx, notif.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(*dbNotif.Filtered)
+
+	// Accepted requests are no longer pending.
+	requests, err = suite.db.GetAccountNotificationRequests(ctx, targetAccount.ID, nil)
+	suite.NoError(err)
+	suite.Empty(requests)
+
+	// Account deletion clears the request.
+	if err := suite.db.DeleteAccountNotificationPolicyData(ctx, originAccount.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = suite.db.GetNotificationRequestByID(ctx, request.ID)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+}
+
+func (suite *NotificationPolicyTestSuite) TestDeleteFilteredNotifications() {
+	ctx := context.Background()
+	targetAccount := suite.testAccounts["local_account_1"]
+	originAccount := suite.testAccounts["remote_account_1"]
+
+	notif := &gtsmodel.Notification{
+		ID:               id.NewULID(),
+		NotificationType: gtsmodel.NotificationFollow,
+		TargetAccountID:  targetAccount.ID,
+		OriginAccountID:  originAccount.ID,
+		Read:             testrig.FalseBool(),
+		Filtered:         testrig.TrueBool(),
+	}
+	if err := suite.db.PutNotification(ctx, notif); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.db.DeleteFilteredNotifications(ctx, targetAccount.ID, originAccount.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err := suite.db.GetNotificationByID(ctx, notif.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func TestNotificationPolicyTestSuite(t *testing.T) {
+	suite.Run(t, new(NotificationPolicyTestSuite))
+}
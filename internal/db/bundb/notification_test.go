@@ -28,6 +28,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -89,7 +90,7 @@ func (suite *NotificationTestSuite) TestGetAccountNotificationsWithSpam() {
 	suite.spamNotifs()
 	testAccount := suite.testAccounts["local_account_1"]
 	before := time.Now()
-	notifications, err := suite.db.GetAccountNotifications(context.Background(), testAccount.ID, id.Highest, id.Lowest, "", 20, nil)
+	notifications, err := suite.db.GetAccountNotifications(context.Background(), testAccount.ID, &paging.Pager{MaxID: id.Highest, SinceID: id.Lowest, Limit: 20}, nil, nil, "")
 	suite.NoError(err)
 	timeTaken := time.Since(before)
 	fmt.Printf("\n\n\n withSpam: got %d notifications in %s\n\n\n", len(notifications), timeTaken)
@@ -103,7 +104,7 @@ func (suite *NotificationTestSuite) TestGetAccountNotificationsWithSpam() {
 func (suite *NotificationTestSuite) TestGetAccountNotificationsWithoutSpam() {
 	testAccount := suite.testAccounts["local_account_1"]
 	before := time.Now()
-	notifications, err := suite.db.GetAccountNotifications(context.Background(), testAccount.ID, id.Highest, id.Lowest, "", 20, nil)
+	notifications, err := suite.db.GetAccountNotifications(context.Background(), testAccount.ID, &paging.Pager{MaxID: id.Highest, SinceID: id.Lowest, Limit: 20}, nil, nil, "")
 	suite.NoError(err)
 	timeTaken := time.Since(before)
 	fmt.Printf("\n\n\n withoutSpam: got %d notifications in %s\n\n\n", len(notifications), timeTaken)
@@ -114,13 +115,57 @@ func (suite *NotificationTestSuite) TestGetAccountNotificationsWithoutSpam() {
 	}
 }
 
+func (suite *NotificationTestSuite) TestGetAccountNotificationsTypes() {
+	suite.spamNotifs()
+	testAccount := suite.testAccounts["local_account_1"]
+
+	notifications, err := suite.db.GetAccountNotifications(context.Background(), testAccount.ID, &paging.Pager{Limit: 20}, []string{string(gtsmodel.NotificationMention)}, nil, "")
+	suite.NoError(err)
+	suite.Empty(notifications)
+
+	notifications, err = suite.db.GetAccountNotifications(context.Background(), testAccount.ID, &paging.Pager{Limit: 20}, []string{string(gtsmodel.NotificationFave)}, nil, "")
+	suite.NoError(err)
+	suite.Len(notifications, 20)
+	for _, n := range notifications {
+		suite.Equal(gtsmodel.NotificationFave, n.NotificationType)
+	}
+}
+
+func (suite *NotificationTestSuite) TestGetAccountNotificationsFromAccount() {
+	suite.spamNotifs()
+	testAccount := suite.testAccounts["local_account_1"]
+	originAccount := suite.testAccounts["admin_account"]
+
+	notifications, err := suite.db.GetAccountNotifications(context.Background(), testAccount.ID, &paging.Pager{Limit: 20}, nil, nil, originAccount.ID)
+	suite.NoError(err)
+	suite.Len(notifications, 1)
+	suite.Equal(originAccount.ID, notifications[0].OriginAccountID)
+}
+
+func (suite *NotificationTestSuite) TestCountAccountNotifications() {
+	testAccount := suite.testAccounts["local_account_1"]
+	testNotif := testrig.NewTestNotifications()["local_account_1_like"]
+
+	count, err := suite.db.CountAccountNotifications(context.Background(), testAccount.ID, "", nil, nil)
+	suite.NoError(err)
+	suite.Equal(1, count)
+
+	count, err = suite.db.CountAccountNotifications(context.Background(), testAccount.ID, "", nil, []string{string(gtsmodel.NotificationFave)})
+	suite.NoError(err)
+	suite.Zero(count)
+
+	count, err = suite.db.CountAccountNotifications(context.Background(), testAccount.ID, testNotif.ID, nil, nil)
+	suite.NoError(err)
+	suite.Zero(count)
+}
+
 func (suite *NotificationTestSuite) TestDeleteNotificationsWithSpam() {
 	suite.spamNotifs()
 	testAccount := suite.testAccounts["local_account_1"]
 	err := suite.db.DeleteNotifications(context.Background(), nil, testAccount.ID, "")
 	suite.NoError(err)
 
-	notifications, err := suite.db.GetAccountNotifications(context.Background(), testAccount.ID, id.Highest, id.Lowest, "", 20, nil)
+	notifications, err := suite.db.GetAccountNotifications(context.Background(), testAccount.ID, &paging.Pager{MaxID: id.Highest, SinceID: id.Lowest, Limit: 20}, nil, nil, "")
 	suite.NoError(err)
 	suite.Nil(notifications)
 	suite.Empty(notifications)
@@ -132,7 +177,7 @@ func (suite *NotificationTestSuite) TestDeleteNotificationsWithTwoAccounts() {
 	err := suite.db.DeleteNotifications(context.Background(), nil, testAccount.ID, "")
 	suite.NoError(err)
 
-	notifications, err := suite.db.GetAccountNotifications(context.Background(), testAccount.ID, id.Highest, id.Lowest, "", 20, nil)
+	notifications, err := suite.db.GetAccountNotifications(context.Background(), testAccount.ID, &paging.Pager{MaxID: id.Highest, SinceID: id.Lowest, Limit: 20}, nil, nil, "")
 	suite.NoError(err)
 	suite.Nil(notifications)
 	suite.Empty(notifications)
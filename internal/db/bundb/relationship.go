@@ -102,8 +102,8 @@ func (r *relationshipDB) GetRelationship(ctx context.Context, requestingAccount
 	return &rel, nil
 }
 
-func (r *relationshipDB) GetAccountFollows(ctx context.Context, accountID string) ([]*gtsmodel.Follow, error) {
-	followIDs, err := r.getAccountFollowIDs(ctx, accountID)
+func (r *relationshipDB) GetAccountFollows(ctx context.Context, accountID string, page *paging.Pager) ([]*gtsmodel.Follow, error) {
+	followIDs, err := r.getAccountFollowIDs(ctx, accountID, page)
 	if err != nil {
 		return nil, err
 	}
@@ -118,8 +118,8 @@ func (r *relationshipDB) GetAccountLocalFollows(ctx context.Context, accountID s
 	return r.GetFollowsByIDs(ctx, followIDs)
 }
 
-func (r *relationshipDB) GetAccountFollowers(ctx context.Context, accountID string) ([]*gtsmodel.Follow, error) {
-	followerIDs, err := r.getAccountFollowerIDs(ctx, accountID)
+func (r *relationshipDB) GetAccountFollowers(ctx context.Context, accountID string, page *paging.Pager) ([]*gtsmodel.Follow, error) {
+	followerIDs, err := r.getAccountFollowerIDs(ctx, accountID, page)
 	if err != nil {
 		return nil, err
 	}
@@ -172,7 +172,7 @@ func (r *relationshipDB) GetAccountBlocks(ctx context.Context, accountID string,
 }
 
 func (r *relationshipDB) CountAccountFollows(ctx context.Context, accountID string) (int, error) {
-	followIDs, err := r.getAccountFollowIDs(ctx, accountID)
+	followIDs, err := r.getAccountFollowIDs(ctx, accountID, nil)
 	return len(followIDs), err
 }
 
@@ -182,7 +182,7 @@ func (r *relationshipDB) CountAccountLocalFollows(ctx context.Context, accountID
 }
 
 func (r *relationshipDB) CountAccountFollowers(ctx context.Context, accountID string) (int, error) {
-	followerIDs, err := r.getAccountFollowerIDs(ctx, accountID)
+	followerIDs, err := r.getAccountFollowerIDs(ctx, accountID, nil)
 	return len(followerIDs), err
 }
 
@@ -201,7 +201,16 @@ func (r *relationshipDB) CountAccountFollowRequesting(ctx context.Context, accou
 	return len(followReqIDs), err
 }
 
-func (r *relationshipDB) getAccountFollowIDs(ctx context.Context, accountID string) ([]string, error) {
+func (r *relationshipDB) getAccountFollowIDs(ctx context.Context, accountID string, page *paging.Pager) ([]string, error) {
+	if page != nil {
+		// Paged queries go straight to the db.
+		q := r.db.NewSelect().
+			Table("follows").
+			Column("id").
+			Where("? = ?", bun.Ident("account_id"), accountID)
+		return r.getPagedFollowIDs(ctx, q, page)
+	}
+
 	return r.state.Caches.GTS.FollowIDs().Load(">"+accountID, func() ([]string, error) {
 		var followIDs []string
 
@@ -229,7 +238,16 @@ func (r *relationshipDB) getAccountLocalFollowIDs(ctx context.Context, accountID
 	})
 }
 
-func (r *relationshipDB) getAccountFollowerIDs(ctx context.Context, accountID string) ([]string, error) {
+func (r *relationshipDB) getAccountFollowerIDs(ctx context.Context, accountID string, page *paging.Pager) ([]string, error) {
+	if page != nil {
+		// Paged queries go straight to the db.
+		q := r.db.NewSelect().
+			Table("follows").
+			Column("id").
+			Where("? = ?", bun.Ident("target_account_id"), accountID)
+		return r.getPagedFollowIDs(ctx, q, page)
+	}
+
 	return r.state.Caches.GTS.FollowIDs().Load("<"+accountID, func() ([]string, error) {
 		var followIDs []string
 
@@ -243,6 +261,44 @@ func (r *relationshipDB) getAccountFollowerIDs(ctx context.Context, accountID st
 	})
 }
 
+// getPagedFollowIDs selects the follow IDs from the given query that
+// fall within the given page. Unlike the cached (unpaged) follow IDs,
+// these are ordered by ID, and the page's IDs are compared against
+// rather than looked for, so that paging is stable even if the follow
+// at the edge of the previous page has since been removed.
+func (r *relationshipDB) getPagedFollowIDs(ctx context.Context, q *bun.SelectQuery, page *paging.Pager) ([]string, error) {
+	if page.MaxID != "" {
+		q = q.Where("? < ?", bun.Ident("id"), page.MaxID)
+	}
+
+	// As with paging.Pager{}.PageDesc, results
+	// are descending unless only min ID is set.
+	asc := false
+	if page.SinceID != "" {
+		q = q.Where("? > ?", bun.Ident("id"), page.SinceID)
+	} else if page.MinID != "" {
+		q = q.Where("? > ?", bun.Ident("id"), page.MinID)
+		asc = true
+	}
+
+	if asc {
+		q = q.OrderExpr("? ASC", bun.Ident("id"))
+	} else {
+		q = q.OrderExpr("? DESC", bun.Ident("id"))
+	}
+
+	if page.Limit > 0 {
+		q = q.Limit(page.Limit)
+	}
+
+	var followIDs []string
+	if _, err := q.Exec(ctx, &followIDs); err != nil {
+		return nil, r.db.ProcessError(err)
+	}
+
+	return followIDs, nil
+}
+
 func (r *relationshipDB) getAccountLocalFollowerIDs(ctx context.Context, accountID string) ([]string, error) {
 	return r.state.Caches.GTS.FollowIDs().Load("l<"+accountID, func() ([]string, error) {
 		var followIDs []string
@@ -0,0 +1,245 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/uptrace/bun"
+)
+
+func (r *relationshipDB) IsAccountDomainBlocked(ctx context.Context, accountID string, domain string) (bool, error) {
+	if domain == "" {
+		// Local accounts
+		// are never blocked.
+		return false, nil
+	}
+
+	// Normalize the domain as punycode
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return false, err
+	}
+
+	blocks, err := r.GetAccountDomainBlocks(
+		gtscontext.SetBarebones(ctx),
+		accountID,
+		nil,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, err
+	}
+
+	for _, block := range blocks {
+		// Blocks cover the domain itself and all its subdomains.
+		if domain == block.Domain || strings.HasSuffix(domain, "."+block.Domain) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (r *relationshipDB) GetAccountDomainBlockByID(ctx context.Context, id string) (*gtsmodel.AccountDomainBlock, error) {
+	return r.getAccountDomainBlock(
+		ctx,
+		"ID",
+		func(block *gtsmodel.AccountDomainBlock) error {
+			return r.db.NewSelect().Model(block).
+				Where("? = ?", bun.Ident("account_domain_block.id"), id).
+				Scan(ctx)
+		},
+		id,
+	)
+}
+
+func (r *relationshipDB) GetAccountDomainBlock(ctx context.Context, accountID string, domain string) (*gtsmodel.AccountDomainBlock, error) {
+	// Normalize the domain as punycode
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.getAccountDomainBlock(
+		ctx,
+		"AccountID.Domain",
+		func(block *gtsmodel.AccountDomainBlock) error {
+			return r.db.NewSelect().Model(block).
+				Where("? = ?", bun.Ident("account_domain_block.account_id"), accountID).
+				Where("? = ?", bun.Ident("account_domain_block.domain"), domain).
+				Scan(ctx)
+		},
+		accountID,
+		domain,
+	)
+}
+
+func (r *relationshipDB) GetAccountDomainBlocks(ctx context.Context, accountID string, page *paging.Pager) ([]*gtsmodel.AccountDomainBlock, error) {
+	// Load domain block IDs from cache with database loader callback.
+	blockIDs, err := r.state.Caches.GTS.AccountDomainBlockIDs().LoadRange(accountID, func() ([]string, error) {
+		var blockIDs []string
+
+		// Domain block IDs not in cache, perform DB query!
+		if _, err := r.db.NewSelect().
+			Table("account_domain_blocks").
+			Column("id").
+			Where("? = ?", bun.Ident("account_id"), accountID).
+			OrderExpr("? DESC", bun.Ident("id")).
+			Exec(ctx, &blockIDs); err != nil {
+			return nil, r.db.ProcessError(err)
+		}
+
+		return blockIDs, nil
+	}, page.PageDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Preallocate slice of expected length.
+	blocks := make([]*gtsmodel.AccountDomainBlock, 0, len(blockIDs))
+
+	for _, id := range blockIDs {
+		// Fetch domain block model for this ID.
+		block, err := r.GetAccountDomainBlockByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error getting account domain block %q: %v", id, err)
+			continue
+		}
+
+		// Append to return slice.
+		blocks = append(blocks, block)
+	}
+
+	return blocks, nil
+}
+
+func (r *relationshipDB) getAccountDomainBlock(ctx context.Context, lookup string, dbQuery func(*gtsmodel.AccountDomainBlock) error, keyParts ...any) (*gtsmodel.AccountDomainBlock, error) {
+	// Fetch domain block from cache with loader callback
+	block, err := r.state.Caches.GTS.AccountDomainBlock().Load(lookup, func() (*gtsmodel.AccountDomainBlock, error) {
+		var block gtsmodel.AccountDomainBlock
+
+		// Not cached! Perform database query
+		if err := dbQuery(&block); err != nil {
+			return nil, r.db.ProcessError(err)
+		}
+
+		return &block, nil
+	}, keyParts...)
+	if err != nil {
+		// already processed
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// Only a barebones model was requested.
+		return block, nil
+	}
+
+	// Set the domain block source account
+	block.Account, err = r.state.DB.GetAccountByID(
+		gtscontext.SetBarebones(ctx),
+		block.AccountID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error getting account domain block source account: %w", err)
+	}
+
+	return block, nil
+}
+
+func (r *relationshipDB) PutAccountDomainBlock(ctx context.Context, block *gtsmodel.AccountDomainBlock) error {
+	// Normalize the domain as punycode
+	var err error
+	block.Domain, err = util.Punify(block.Domain)
+	if err != nil {
+		return err
+	}
+
+	return r.state.Caches.GTS.AccountDomainBlock().Store(block, func() error {
+		_, err := r.db.NewInsert().Model(block).Exec(ctx)
+		return r.db.ProcessError(err)
+	})
+}
+
+func (r *relationshipDB) DeleteAccountDomainBlockByID(ctx context.Context, id string) error {
+	// Load domain block into cache before attempting a delete,
+	// as we need it cached in order to trigger the invalidate
+	// callback. This in turn invalidates others.
+	_, err := r.GetAccountDomainBlockByID(gtscontext.SetBarebones(ctx), id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// not an issue.
+			err = nil
+		}
+		return err
+	}
+
+	// Drop this now-cached domain block on return after delete.
+	defer r.state.Caches.GTS.AccountDomainBlock().Invalidate("ID", id)
+
+	// Finally delete domain block from DB.
+	_, err = r.db.NewDelete().
+		Table("account_domain_blocks").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	return r.db.ProcessError(err)
+}
+
+func (r *relationshipDB) DeleteAccountDomainBlocks(ctx context.Context, accountID string) error {
+	var blockIDs []string
+
+	// Get full list of IDs.
+	if err := r.db.NewSelect().
+		Column("id").
+		Table("account_domain_blocks").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Scan(ctx, &blockIDs); err != nil {
+		return r.db.ProcessError(err)
+	}
+
+	defer func() {
+		// Invalidate all account's outgoing domain blocks on return.
+		r.state.Caches.GTS.AccountDomainBlock().Invalidate("AccountID", accountID)
+	}()
+
+	// Load all domain blocks into cache, this *really* isn't
+	// great but it is the only way we can ensure we invalidate
+	// all related caches correctly (e.g. visibility).
+	for _, id := range blockIDs {
+		_, err := r.GetAccountDomainBlockByID(ctx, id)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return err
+		}
+	}
+
+	// Finally delete all from DB.
+	_, err := r.db.NewDelete().
+		Table("account_domain_blocks").
+		Where("? IN (?)", bun.Ident("id"), bun.In(blockIDs)).
+		Exec(ctx)
+	return r.db.ProcessError(err)
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -510,6 +511,100 @@ func (suite *RelationshipTestSuite) TestDeleteAccountBlocks() {
 	suite.Nil(block)
 }
 
+func (suite *RelationshipTestSuite) TestIsAccountDomainBlocked() {
+	ctx := context.Background()
+
+	account := suite.testAccounts["local_account_1"].ID
+
+	// no domain blocks exist yet
+	blocked, err := suite.db.IsAccountDomainBlocked(ctx, account, "example.org")
+	suite.NoError(err)
+	suite.False(blocked)
+
+	// have account block example.org
+	block := &gtsmodel.AccountDomainBlock{
+		ID:        "01H7A4X5GC2BSB0S3QVXFZ5EGP",
+		AccountID: account,
+		Domain:    "example.org",
+	}
+	if err := suite.db.PutAccountDomainBlock(ctx, block); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// the domain and its subdomains are now blocked
+	blocked, err = suite.db.IsAccountDomainBlocked(ctx, account, "example.org")
+	suite.NoError(err)
+	suite.True(blocked)
+
+	blocked, err = suite.db.IsAccountDomainBlocked(ctx, account, "sub.example.org")
+	suite.NoError(err)
+	suite.True(blocked)
+
+	// other domains and local accounts are not blocked
+	blocked, err = suite.db.IsAccountDomainBlocked(ctx, account, "notexample.org")
+	suite.NoError(err)
+	suite.False(blocked)
+
+	blocked, err = suite.db.IsAccountDomainBlocked(ctx, account, "")
+	suite.NoError(err)
+	suite.False(blocked)
+
+	// other accounts are unaffected
+	blocked, err = suite.db.IsAccountDomainBlocked(ctx, suite.testAccounts["local_account_2"].ID, "example.org")
+	suite.NoError(err)
+	suite.False(blocked)
+
+	// remove the domain block
+	if err := suite.db.DeleteAccountDomainBlockByID(ctx, block.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	blocked, err = suite.db.IsAccountDomainBlocked(ctx, account, "example.org")
+	suite.NoError(err)
+	suite.False(blocked)
+}
+
+func (suite *RelationshipTestSuite) TestGetAccountDomainBlocks() {
+	ctx := context.Background()
+
+	account := suite.testAccounts["local_account_1"].ID
+
+	for blockID, domain := range map[string]string{
+		"01H7A4X5GC2BSB0S3QVXFZ5EG0": "example.org",
+		"01H7A4X5GC2BSB0S3QVXFZ5EG1": "fossbros-anonymous.io",
+	} {
+		if err := suite.db.PutAccountDomainBlock(ctx, &gtsmodel.AccountDomainBlock{
+			ID:        blockID,
+			AccountID: account,
+			Domain:    domain,
+		}); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	blocks, err := suite.db.GetAccountDomainBlocks(ctx, account, nil)
+	suite.NoError(err)
+	suite.Len(blocks, 2)
+
+	// newest first
+	suite.Equal("fossbros-anonymous.io", blocks[0].Domain)
+	suite.Equal("example.org", blocks[1].Domain)
+
+	// fetch by account + domain
+	block, err := suite.db.GetAccountDomainBlock(ctx, account, "example.org")
+	suite.NoError(err)
+	suite.Equal(blocks[1].ID, block.ID)
+
+	// delete all of account's domain blocks
+	if err := suite.db.DeleteAccountDomainBlocks(ctx, account); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	blocks, err = suite.db.GetAccountDomainBlocks(ctx, account, nil)
+	suite.NoError(err)
+	suite.Empty(blocks)
+}
+
 func (suite *RelationshipTestSuite) TestGetRelationship() {
 	requestingAccount := suite.testAccounts["local_account_1"]
 	targetAccount := suite.testAccounts["admin_account"]
@@ -760,7 +855,7 @@ func (suite *RelationshipTestSuite) TestGetAccountFollowRequests() {
 
 func (suite *RelationshipTestSuite) TestGetAccountFollows() {
 	account := suite.testAccounts["local_account_1"]
-	follows, err := suite.db.GetAccountFollows(context.Background(), account.ID)
+	follows, err := suite.db.GetAccountFollows(context.Background(), account.ID, nil)
 	suite.NoError(err)
 	suite.Len(follows, 2)
 }
@@ -781,11 +876,45 @@ func (suite *RelationshipTestSuite) TestCountAccountFollows() {
 
 func (suite *RelationshipTestSuite) TestGetAccountFollowers() {
 	account := suite.testAccounts["local_account_1"]
-	follows, err := suite.db.GetAccountFollowers(context.Background(), account.ID)
+	follows, err := suite.db.GetAccountFollowers(context.Background(), account.ID, nil)
 	suite.NoError(err)
 	suite.Len(follows, 2)
 }
 
+func (suite *RelationshipTestSuite) TestGetAccountFollowersPaged() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+
+	// First page should have the newest follow.
+	follows, err := suite.db.GetAccountFollowers(ctx, account.ID, &paging.Pager{Limit: 1})
+	suite.NoError(err)
+	suite.Len(follows, 1)
+	first := follows[0]
+
+	// Next page should have the other one.
+	follows, err = suite.db.GetAccountFollowers(ctx, account.ID, &paging.Pager{MaxID: first.ID, Limit: 1})
+	suite.NoError(err)
+	suite.Len(follows, 1)
+	second := follows[0]
+	suite.Less(second.ID, first.ID)
+
+	// Remove the follow at the edge of the first page.
+	err = suite.db.DeleteFollowByID(ctx, first.ID)
+	suite.NoError(err)
+
+	// Paging on from it should still give the second
+	// page, not loop back around to the first one.
+	follows, err = suite.db.GetAccountFollowers(ctx, account.ID, &paging.Pager{MaxID: first.ID, Limit: 1})
+	suite.NoError(err)
+	suite.Len(follows, 1)
+	suite.Equal(second.ID, follows[0].ID)
+
+	// And there's nothing after that.
+	follows, err = suite.db.GetAccountFollowers(ctx, account.ID, &paging.Pager{MaxID: second.ID, Limit: 1})
+	suite.NoError(err)
+	suite.Empty(follows)
+}
+
 func (suite *RelationshipTestSuite) TestCountAccountFollowers() {
 	account := suite.testAccounts["local_account_1"]
 	followsCount, err := suite.db.CountAccountFollowers(context.Background(), account.ID)
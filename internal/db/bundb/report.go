@@ -155,6 +155,14 @@ func (r *reportDB) getReport(ctx context.Context, lookup string, dbQuery func(*g
 		}
 	}
 
+	if len(report.RuleIDs) > 0 {
+		// Fetch reported rules
+		report.Rules, err = r.state.DB.GetRulesByIDs(ctx, report.RuleIDs)
+		if err != nil {
+			return nil, fmt.Errorf("error getting report rules: %w", err)
+		}
+	}
+
 	if report.ActionTakenByAccountID != "" {
 		// Set the report action taken by account
 		report.ActionTakenByAccount, err = r.state.DB.GetAccountByID(ctx, report.ActionTakenByAccountID)
@@ -193,6 +201,52 @@ func (r *reportDB) UpdateReport(ctx context.Context, report *gtsmodel.Report, co
 	return report, nil
 }
 
+func (r *reportDB) ResolveReport(ctx context.Context, report *gtsmodel.Report) error {
+	report.UpdatedAt = time.Now()
+
+	// Only update if still unresolved, so concurrent
+	// resolves can't both go on to take actions.
+	res, err := r.db.
+		NewUpdate().
+		Model(report).
+		Column("action_taken_at", "action_taken_by_account_id", "updated_at").
+		Where("? = ?", bun.Ident("report.id"), report.ID).
+		Where("? IS NULL", bun.Ident("report.action_taken_at")).
+		Exec(ctx)
+	if err != nil {
+		return r.db.ProcessError(err)
+	}
+
+	r.state.Caches.GTS.Report().Invalidate("ID", report.ID)
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return r.db.ProcessError(err)
+	}
+
+	if rows == 0 {
+		return db.ErrNoEntries
+	}
+
+	return nil
+}
+
+func (r *reportDB) UnresolveReport(ctx context.Context, id string) error {
+	if _, err := r.db.
+		NewUpdate().
+		Model((*gtsmodel.Report)(nil)).
+		Set("? = NULL", bun.Ident("action_taken_at")).
+		Set("? = NULL", bun.Ident("action_taken_by_account_id")).
+		Set("? = ?", bun.Ident("updated_at"), time.Now()).
+		Where("? = ?", bun.Ident("report.id"), id).
+		Exec(ctx); err != nil {
+		return r.db.ProcessError(err)
+	}
+
+	r.state.Caches.GTS.Report().Invalidate("ID", id)
+	return nil
+}
+
 func (r *reportDB) DeleteReportByID(ctx context.Context, id string) error {
 	defer r.state.Caches.GTS.Report().Invalidate("ID", id)
 
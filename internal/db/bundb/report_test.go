@@ -147,6 +147,40 @@ func (suite *ReportTestSuite) TestUpdateReportAllColumns() {
 	suite.NotEmpty(dbReport.URI)
 }
 
+func (suite *ReportTestSuite) TestResolveReport() {
+	ctx := context.Background()
+
+	report := &gtsmodel.Report{}
+	*report = *suite.testReports["local_account_2_report_remote_account_1"]
+	report.ActionTakenByAccountID = suite.testAccounts["admin_account"].ID
+	report.ActionTakenAt = testrig.TimeMustParse("2022-05-14T12:20:03+02:00")
+
+	// First resolve claims the report.
+	suite.NoError(suite.db.ResolveReport(ctx, report))
+
+	dbReport, err := suite.db.GetReportByID(ctx, report.ID)
+	suite.NoError(err)
+	suite.NotZero(dbReport.ActionTakenAt)
+	suite.Equal(report.ActionTakenByAccountID, dbReport.ActionTakenByAccountID)
+
+	// Second resolve finds it already claimed.
+	other := &gtsmodel.Report{}
+	*other = *suite.testReports["local_account_2_report_remote_account_1"]
+	other.ActionTakenByAccountID = suite.testAccounts["local_account_1"].ID
+	other.ActionTakenAt = testrig.TimeMustParse("2022-05-14T12:20:04+02:00")
+	suite.ErrorIs(suite.db.ResolveReport(ctx, other), db.ErrNoEntries)
+
+	// Unresolving gives the claim back.
+	suite.NoError(suite.db.UnresolveReport(ctx, report.ID))
+
+	dbReport, err = suite.db.GetReportByID(ctx, report.ID)
+	suite.NoError(err)
+	suite.Zero(dbReport.ActionTakenAt)
+	suite.Empty(dbReport.ActionTakenByAccountID)
+
+	suite.NoError(suite.db.ResolveReport(ctx, other))
+}
+
 func (suite *ReportTestSuite) TestDeleteReport() {
 	if err := suite.db.DeleteReportByID(context.Background(), suite.testReports["remote_account_1_report_local_account_2"].ID); err != nil {
 		suite.FailNow(err.Error())
@@ -0,0 +1,103 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type ruleDB struct {
+	db    *WrappedDB
+	state *state.State
+}
+
+func (r *ruleDB) GetRuleByID(ctx context.Context, id string) (*gtsmodel.Rule, error) {
+	var rule gtsmodel.Rule
+
+	if err := r.db.
+		NewSelect().
+		Model(&rule).
+		Where("? = ?", bun.Ident("rule.id"), id).
+		Scan(ctx); err != nil {
+		return nil, r.db.ProcessError(err)
+	}
+
+	return &rule, nil
+}
+
+func (r *ruleDB) GetRulesByIDs(ctx context.Context, ids []string) ([]*gtsmodel.Rule, error) {
+	rules := make([]*gtsmodel.Rule, 0, len(ids))
+	if len(ids) == 0 {
+		return rules, nil
+	}
+
+	if err := r.db.
+		NewSelect().
+		Model(&rules).
+		Where("? IN (?)", bun.Ident("rule.id"), bun.In(ids)).
+		OrderExpr("? ASC", bun.Ident("rule.order")).
+		Scan(ctx); err != nil {
+		return nil, r.db.ProcessError(err)
+	}
+
+	return rules, nil
+}
+
+func (r *ruleDB) GetActiveRules(ctx context.Context) ([]*gtsmodel.Rule, error) {
+	rules := []*gtsmodel.Rule{}
+
+	if err := r.db.
+		NewSelect().
+		Model(&rules).
+		Where("? = ?", bun.Ident("rule.deleted"), false).
+		OrderExpr("? ASC", bun.Ident("rule.order")).
+		Scan(ctx); err != nil {
+		return nil, r.db.ProcessError(err)
+	}
+
+	return rules, nil
+}
+
+func (r *ruleDB) PutRule(ctx context.Context, rule *gtsmodel.Rule) error {
+	_, err := r.db.
+		NewInsert().
+		Model(rule).
+		Exec(ctx)
+	return r.db.ProcessError(err)
+}
+
+func (r *ruleDB) UpdateRule(ctx context.Context, rule *gtsmodel.Rule, columns ...string) error {
+	// Update the rule's last-updated
+	rule.UpdatedAt = time.Now()
+	if len(columns) != 0 {
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := r.db.
+		NewUpdate().
+		Model(rule).
+		Where("? = ?", bun.Ident("rule.id"), rule.ID).
+		Column(columns...).
+		Exec(ctx)
+	return r.db.ProcessError(err)
+}
@@ -0,0 +1,103 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type RuleTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *RuleTestSuite) TestGetRuleByID() {
+	rule, err := suite.db.GetRuleByID(context.Background(), suite.testRules["rule1"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal("Be gay", rule.Text)
+	suite.EqualValues(0, *rule.Order)
+	suite.False(*rule.Deleted)
+}
+
+func (suite *RuleTestSuite) TestGetRulesByIDs() {
+	rules, err := suite.db.GetRulesByIDs(context.Background(), []string{
+		suite.testRules["rule3"].ID,
+		suite.testRules["rule1"].ID,
+	})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Deleted rules should be included,
+	// and rules should be in order.
+	if suite.Len(rules, 2) {
+		suite.Equal(suite.testRules["rule1"].ID, rules[0].ID)
+		suite.Equal(suite.testRules["rule3"].ID, rules[1].ID)
+	}
+}
+
+func (suite *RuleTestSuite) TestGetActiveRules() {
+	rules, err := suite.db.GetActiveRules(context.Background())
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Deleted rule3 should not be included.
+	if suite.Len(rules, 2) {
+		suite.Equal(suite.testRules["rule1"].ID, rules[0].ID)
+		suite.Equal(suite.testRules["rule2"].ID, rules[1].ID)
+	}
+}
+
+func (suite *RuleTestSuite) TestPutUpdateRule() {
+	ctx := context.Background()
+
+	rule := &gtsmodel.Rule{
+		ID:      "01H6JCSBQ3XQJ2PXSCSRWK3MFS",
+		Text:    "No hate speech",
+		Order:   testrig.UintPtr(2),
+		Deleted: testrig.FalseBool(),
+	}
+
+	if err := suite.db.PutRule(ctx, rule); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	rule.Text = "No hate speech, ever"
+	if err := suite.db.UpdateRule(ctx, rule, "text"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dbRule, err := suite.db.GetRuleByID(ctx, rule.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("No hate speech, ever", dbRule.Text)
+	suite.EqualValues(2, *dbRule.Order)
+}
+
+func TestRuleTestSuite(t *testing.T) {
+	suite.Run(t, new(RuleTestSuite))
+}
@@ -130,7 +130,7 @@ func (s *statusDB) getStatus(ctx context.Context, lookup string, dbQuery func(*g
 func (s *statusDB) PopulateStatus(ctx context.Context, status *gtsmodel.Status) error {
 	var (
 		err  error
-		errs = make(gtserror.MultiError, 0, 9)
+		errs = make(gtserror.MultiError, 0, 10)
 	)
 
 	if status.Account == nil {
@@ -247,6 +247,17 @@ func (s *statusDB) PopulateStatus(ctx context.Context, status *gtsmodel.Status)
 		}
 	}
 
+	if status.CardID != "" && status.Card == nil {
+		// Status preview card is not set, fetch from database.
+		status.Card, err = s.state.DB.GetCardByID(
+			ctx, // populates card thumbnail
+			status.CardID,
+		)
+		if err != nil {
+			errs.Append(fmt.Errorf("error populating status card: %w", err))
+		}
+	}
+
 	return errs.Combine()
 }
 
@@ -212,6 +212,30 @@ func (suite *StatusTestSuite) TestUpdateStatus() {
 	suite.True(updated.PinnedAt.IsZero())
 }
 
+func (suite *StatusTestSuite) TestUpdateStatusInteractionPolicies() {
+	// Take a copy of the status.
+	targetStatus := &gtsmodel.Status{}
+	*targetStatus = *suite.testStatuses["admin_account_status_1"]
+
+	// Policies should be empty (ie., anyone) by default.
+	suite.Empty(targetStatus.ReplyPolicy)
+	suite.Empty(targetStatus.BoostPolicy)
+	suite.Empty(targetStatus.LikePolicy)
+
+	targetStatus.ReplyPolicy = gtsmodel.InteractionPolicyMentioned
+	targetStatus.BoostPolicy = gtsmodel.InteractionPolicyFollowers
+	targetStatus.LikePolicy = gtsmodel.InteractionPolicyNobody
+
+	err := suite.db.UpdateStatus(context.Background(), targetStatus, "reply_policy", "boost_policy", "like_policy")
+	suite.NoError(err)
+
+	updated, err := suite.db.GetStatusByID(context.Background(), targetStatus.ID)
+	suite.NoError(err)
+	suite.Equal(gtsmodel.InteractionPolicyMentioned, updated.ReplyPolicy)
+	suite.Equal(gtsmodel.InteractionPolicyFollowers, updated.BoostPolicy)
+	suite.Equal(gtsmodel.InteractionPolicyNobody, updated.LikePolicy)
+}
+
 func TestStatusTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusTestSuite))
 }
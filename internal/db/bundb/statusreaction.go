@@ -0,0 +1,145 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type statusReactionDB struct {
+	db    *WrappedDB
+	state *state.State
+}
+
+func (s *statusReactionDB) GetStatusReactionByURI(ctx context.Context, uri string) (*gtsmodel.StatusReaction, error) {
+	return s.getStatusReaction(ctx, func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.Where("? = ?", bun.Ident("status_reaction.uri"), uri)
+	})
+}
+
+func (s *statusReactionDB) GetStatusReaction(ctx context.Context, statusID string, accountID string, name string) (*gtsmodel.StatusReaction, error) {
+	return s.getStatusReaction(ctx, func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.
+			Where("? = ?", bun.Ident("status_reaction.status_id"), statusID).
+			Where("? = ?", bun.Ident("status_reaction.account_id"), accountID).
+			Where("? = ?", bun.Ident("status_reaction.name"), name)
+	})
+}
+
+func (s *statusReactionDB) GetLatestStatusReaction(ctx context.Context, statusID string, accountID string) (*gtsmodel.StatusReaction, error) {
+	return s.getStatusReaction(ctx, func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.
+			Where("? = ?", bun.Ident("status_reaction.status_id"), statusID).
+			Where("? = ?", bun.Ident("status_reaction.account_id"), accountID).
+			OrderExpr("? DESC", bun.Ident("status_reaction.id")).
+			Limit(1)
+	})
+}
+
+func (s *statusReactionDB) getStatusReaction(ctx context.Context, where func(*bun.SelectQuery) *bun.SelectQuery) (*gtsmodel.StatusReaction, error) {
+	var reaction gtsmodel.StatusReaction
+
+	q := s.db.
+		NewSelect().
+		Model(&reaction)
+
+	if err := where(q).Scan(ctx); err != nil {
+		return nil, s.db.ProcessError(err)
+	}
+
+	if err := s.populateStatusReaction(ctx, &reaction); err != nil {
+		return nil, err
+	}
+
+	return &reaction, nil
+}
+
+func (s *statusReactionDB) GetStatusReactions(ctx context.Context, statusID string) ([]*gtsmodel.StatusReaction, error) {
+	reactions := []*gtsmodel.StatusReaction{}
+
+	if err := s.db.
+		NewSelect().
+		Model(&reactions).
+		Where("? = ?", bun.Ident("status_reaction.status_id"), statusID).
+		OrderExpr("? ASC", bun.Ident("status_reaction.id")).
+		Scan(ctx); err != nil {
+		return nil, s.db.ProcessError(err)
+	}
+
+	for _, reaction := range reactions {
+		if err := s.populateStatusReaction(ctx, reaction); err != nil {
+			return nil, err
+		}
+	}
+
+	return reactions, nil
+}
+
+func (s *statusReactionDB) populateStatusReaction(ctx context.Context, reaction *gtsmodel.StatusReaction) error {
+	if reaction.Emoji != nil || reaction.EmojiID == "" {
+		return nil
+	}
+
+	emoji, err := s.state.DB.GetEmojiByID(ctx, reaction.EmojiID)
+	if err != nil {
+		return err
+	}
+
+	reaction.Emoji = emoji
+	return nil
+}
+
+func (s *statusReactionDB) PutStatusReaction(ctx context.Context, reaction *gtsmodel.StatusReaction) error {
+	_, err := s.db.
+		NewInsert().
+		Model(reaction).
+		Exec(ctx)
+	return s.db.ProcessError(err)
+}
+
+func (s *statusReactionDB) DeleteStatusReactionByID(ctx context.Context, id string) error {
+	_, err := s.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("status_reactions"), bun.Ident("status_reaction")).
+		Where("? = ?", bun.Ident("status_reaction.id"), id).
+		Exec(ctx)
+	return s.db.ProcessError(err)
+}
+
+func (s *statusReactionDB) DeleteStatusReactionsForStatus(ctx context.Context, statusID string) error {
+	_, err := s.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("status_reactions"), bun.Ident("status_reaction")).
+		Where("? = ?", bun.Ident("status_reaction.status_id"), statusID).
+		Exec(ctx)
+	return s.db.ProcessError(err)
+}
+
+func (s *statusReactionDB) DeleteStatusReactionsForAccount(ctx context.Context, accountID string) error {
+	_, err := s.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("status_reactions"), bun.Ident("status_reaction")).
+		WhereOr("? = ?", bun.Ident("status_reaction.account_id"), accountID).
+		WhereOr("? = ?", bun.Ident("status_reaction.target_account_id"), accountID).
+		Exec(ctx)
+	return s.db.ProcessError(err)
+}
@@ -0,0 +1,125 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type StatusReactionTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *StatusReactionTestSuite) putReaction(account *gtsmodel.Account, status *gtsmodel.Status, name string, emojiID string) *gtsmodel.StatusReaction {
+	reactionID := id.NewULID()
+	reaction := &gtsmodel.StatusReaction{
+		ID:              reactionID,
+		AccountID:       account.ID,
+		TargetAccountID: status.AccountID,
+		StatusID:        status.ID,
+		Name:            name,
+		EmojiID:         emojiID,
+		URI:             account.URI + "/reactions/" + reactionID,
+	}
+
+	if err := suite.db.PutStatusReaction(context.Background(), reaction); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return reaction
+}
+
+func (suite *StatusReactionTestSuite) TestPutGetStatusReactions() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+	status := suite.testStatuses["admin_account_status_1"]
+	emoji := suite.testEmojis["rainbow"]
+
+	unicode := suite.putReaction(account, status, "🐕", "")
+	custom := suite.putReaction(account, status, emoji.Shortcode, emoji.ID)
+
+	// An account can only react once with the same name.
+	err := suite.db.PutStatusReaction(ctx, &gtsmodel.StatusReaction{
+		ID:              id.NewULID(),
+		AccountID:       account.ID,
+		TargetAccountID: status.AccountID,
+		StatusID:        status.ID,
+		Name:            "🐕",
+		URI:             "http://localhost:8080/some/other/uri",
+	})
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+
+	reactions, err := suite.db.GetStatusReactions(ctx, status.ID)
+	suite.NoError(err)
+	if suite.Len(reactions, 2) {
+		suite.Equal(unicode.ID, reactions[0].ID)
+		suite.Nil(reactions[0].Emoji)
+		suite.Equal(custom.ID, reactions[1].ID)
+		suite.Equal(emoji.ID, reactions[1].Emoji.ID)
+	}
+
+	reaction, err := suite.db.GetStatusReaction(ctx, status.ID, account.ID, emoji.Shortcode)
+	suite.NoError(err)
+	suite.Equal(custom.ID, reaction.ID)
+
+	reaction, err = suite.db.GetStatusReactionByURI(ctx, unicode.URI)
+	suite.NoError(err)
+	suite.Equal(unicode.ID, reaction.ID)
+
+	reaction, err = suite.db.GetLatestStatusReaction(ctx, status.ID, account.ID)
+	suite.NoError(err)
+	suite.Equal(custom.ID, reaction.ID)
+}
+
+func (suite *StatusReactionTestSuite) TestDeleteStatusReactions() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+	status := suite.testStatuses["admin_account_status_1"]
+
+	reaction := suite.putReaction(account, status, "🐕", "")
+	suite.putReaction(account, status, "🐈", "")
+	suite.putReaction(suite.testAccounts["local_account_2"], status, "🐕", "")
+
+	suite.NoError(suite.db.DeleteStatusReactionByID(ctx, reaction.ID))
+
+	_, err := suite.db.GetStatusReactionByURI(ctx, reaction.URI)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Deleting by account should leave other accounts' reactions alone.
+	suite.NoError(suite.db.DeleteStatusReactionsForAccount(ctx, account.ID))
+
+	reactions, err := suite.db.GetStatusReactions(ctx, status.ID)
+	suite.NoError(err)
+	suite.Len(reactions, 1)
+
+	suite.NoError(suite.db.DeleteStatusReactionsForStatus(ctx, status.ID))
+
+	reactions, err = suite.db.GetStatusReactions(ctx, status.ID)
+	suite.NoError(err)
+	suite.Empty(reactions)
+}
+
+func TestStatusReactionTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusReactionTestSuite))
+}
@@ -0,0 +1,137 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type suggestionDB struct {
+	db    *WrappedDB
+	state *state.State
+}
+
+func (s *suggestionDB) GetPinnedSuggestions(ctx context.Context) ([]*gtsmodel.PinnedSuggestion, error) {
+	suggestions := []*gtsmodel.PinnedSuggestion{}
+
+	if err := s.db.
+		NewSelect().
+		Model(&suggestions).
+		OrderExpr("? DESC", bun.Ident("pinned_suggestion.id")).
+		Scan(ctx); err != nil {
+		return nil, s.db.ProcessError(err)
+	}
+
+	for _, suggestion := range suggestions {
+		if err := s.populatePinnedSuggestion(ctx, suggestion); err != nil {
+			return nil, err
+		}
+	}
+
+	return suggestions, nil
+}
+
+func (s *suggestionDB) GetPinnedSuggestionByAccountID(ctx context.Context, accountID string) (*gtsmodel.PinnedSuggestion, error) {
+	var suggestion gtsmodel.PinnedSuggestion
+
+	if err := s.db.
+		NewSelect().
+		Model(&suggestion).
+		Where("? = ?", bun.Ident("pinned_suggestion.account_id"), accountID).
+		Scan(ctx); err != nil {
+		return nil, s.db.ProcessError(err)
+	}
+
+	if err := s.populatePinnedSuggestion(ctx, &suggestion); err != nil {
+		return nil, err
+	}
+
+	return &suggestion, nil
+}
+
+func (s *suggestionDB) populatePinnedSuggestion(ctx context.Context, suggestion *gtsmodel.PinnedSuggestion) error {
+	if suggestion.Account != nil {
+		return nil
+	}
+
+	account, err := s.state.DB.GetAccountByID(ctx, suggestion.AccountID)
+	if err != nil {
+		return err
+	}
+
+	suggestion.Account = account
+	return nil
+}
+
+func (s *suggestionDB) PutPinnedSuggestion(ctx context.Context, suggestion *gtsmodel.PinnedSuggestion) error {
+	_, err := s.db.
+		NewInsert().
+		Model(suggestion).
+		Exec(ctx)
+	return s.db.ProcessError(err)
+}
+
+func (s *suggestionDB) DeletePinnedSuggestionByAccountID(ctx context.Context, accountID string) error {
+	_, err := s.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("pinned_suggestions"), bun.Ident("pinned_suggestion")).
+		Where("? = ?", bun.Ident("pinned_suggestion.account_id"), accountID).
+		Exec(ctx)
+	return s.db.ProcessError(err)
+}
+
+func (s *suggestionDB) GetDismissedSuggestionIDs(ctx context.Context, accountID string) ([]string, error) {
+	var targetAccountIDs []string
+
+	if err := s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("suggestion_dismissals"), bun.Ident("suggestion_dismissal")).
+		Column("suggestion_dismissal.target_account_id").
+		Where("? = ?", bun.Ident("suggestion_dismissal.account_id"), accountID).
+		Scan(ctx, &targetAccountIDs); err != nil {
+		return nil, s.db.ProcessError(err)
+	}
+
+	return targetAccountIDs, nil
+}
+
+func (s *suggestionDB) PutSuggestionDismissal(ctx context.Context, dismissal *gtsmodel.SuggestionDismissal) error {
+	_, err := s.db.
+		NewInsert().
+		Model(dismissal).
+		Exec(ctx)
+	return s.db.ProcessError(err)
+}
+
+func (s *suggestionDB) DeleteAccountSuggestions(ctx context.Context, accountID string) error {
+	if err := s.DeletePinnedSuggestionByAccountID(ctx, accountID); err != nil {
+		return err
+	}
+
+	_, err := s.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("suggestion_dismissals"), bun.Ident("suggestion_dismissal")).
+		WhereOr("? = ?", bun.Ident("suggestion_dismissal.account_id"), accountID).
+		WhereOr("? = ?", bun.Ident("suggestion_dismissal.target_account_id"), accountID).
+		Exec(ctx)
+	return s.db.ProcessError(err)
+}
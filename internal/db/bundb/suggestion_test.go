@@ -0,0 +1,88 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type SuggestionTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *SuggestionTestSuite) TestPinnedSuggestions() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_2"]
+
+	suite.NoError(suite.db.PutPinnedSuggestion(ctx, &gtsmodel.PinnedSuggestion{
+		ID:        id.NewULID(),
+		AccountID: account.ID,
+	}))
+
+	// Pinned suggestion should be returned with account populated.
+	pinned, err := suite.db.GetPinnedSuggestions(ctx)
+	suite.NoError(err)
+	suite.Len(pinned, 1)
+	suite.Equal(account.ID, pinned[0].Account.ID)
+
+	// An account can only be pinned once.
+	err = suite.db.PutPinnedSuggestion(ctx, &gtsmodel.PinnedSuggestion{
+		ID:        id.NewULID(),
+		AccountID: account.ID,
+	})
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+
+	suite.NoError(suite.db.DeletePinnedSuggestionByAccountID(ctx, account.ID))
+
+	_, err = suite.db.GetPinnedSuggestionByAccountID(ctx, account.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *SuggestionTestSuite) TestSuggestionDismissals() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+	target := suite.testAccounts["remote_account_1"]
+
+	suite.NoError(suite.db.PutSuggestionDismissal(ctx, &gtsmodel.SuggestionDismissal{
+		ID:              id.NewULID(),
+		AccountID:       account.ID,
+		TargetAccountID: target.ID,
+	}))
+
+	dismissedIDs, err := suite.db.GetDismissedSuggestionIDs(ctx, account.ID)
+	suite.NoError(err)
+	suite.Equal([]string{target.ID}, dismissedIDs)
+
+	// Deleting the target's suggestions
+	// should also delete dismissals of it.
+	suite.NoError(suite.db.DeleteAccountSuggestions(ctx, target.ID))
+
+	dismissedIDs, err = suite.db.GetDismissedSuggestionIDs(ctx, account.ID)
+	suite.NoError(err)
+	suite.Empty(dismissedIDs)
+}
+
+func TestSuggestionTestSuite(t *testing.T) {
+	suite.Run(t, new(SuggestionTestSuite))
+}
@@ -0,0 +1,287 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type trendDB struct {
+	db    *WrappedDB
+	state *state.State
+}
+
+// trendCount is a scan target
+// for per-item activity counts.
+type trendCount struct {
+	ID    string `bun:"id"`
+	Count int    `bun:"count"`
+}
+
+func (t *trendDB) GetTrendByID(ctx context.Context, id string) (*gtsmodel.Trend, error) {
+	var trend gtsmodel.Trend
+
+	if err := t.db.
+		NewSelect().
+		Model(&trend).
+		Where("? = ?", bun.Ident("trend.id"), id).
+		Scan(ctx); err != nil {
+		return nil, t.db.ProcessError(err)
+	}
+
+	return &trend, nil
+}
+
+func (t *trendDB) GetTrendByTarget(ctx context.Context, trendType gtsmodel.TrendType, targetID string) (*gtsmodel.Trend, error) {
+	var trend gtsmodel.Trend
+
+	if err := t.db.
+		NewSelect().
+		Model(&trend).
+		Where("? = ?", bun.Ident("trend.type"), trendType).
+		Where("? = ?", bun.Ident("trend.target_id"), targetID).
+		Scan(ctx); err != nil {
+		return nil, t.db.ProcessError(err)
+	}
+
+	return &trend, nil
+}
+
+func (t *trendDB) GetTrends(ctx context.Context, trendType gtsmodel.TrendType, state gtsmodel.TrendState, limit int, offset int) ([]*gtsmodel.Trend, error) {
+	trends := []*gtsmodel.Trend{}
+
+	q := t.db.
+		NewSelect().
+		Model(&trends).
+		Where("? = ?", bun.Ident("trend.type"), trendType).
+		Where("? > ?", bun.Ident("trend.score"), 0).
+		OrderExpr("? DESC", bun.Ident("trend.score")).
+		OrderExpr("? DESC", bun.Ident("trend.id"))
+
+	if state != "" {
+		q = q.Where("? = ?", bun.Ident("trend.state"), state)
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if offset > 0 {
+		q = q.Offset(offset)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, t.db.ProcessError(err)
+	}
+
+	return trends, nil
+}
+
+func (t *trendDB) PutTrend(ctx context.Context, trend *gtsmodel.Trend) error {
+	_, err := t.db.
+		NewInsert().
+		Model(trend).
+		Exec(ctx)
+	return t.db.ProcessError(err)
+}
+
+func (t *trendDB) UpdateTrend(ctx context.Context, trend *gtsmodel.Trend, columns ...string) error {
+	trend.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := t.db.
+		NewUpdate().
+		Model(trend).
+		Where("? = ?", bun.Ident("trend.id"), trend.ID).
+		Column(columns...).
+		Exec(ctx)
+	return t.db.ProcessError(err)
+}
+
+func (t *trendDB) ZeroTrendScores(ctx context.Context, trendType gtsmodel.TrendType, exceptTargetIDs []string) error {
+	q := t.db.
+		NewUpdate().
+		Model((*gtsmodel.Trend)(nil)).
+		Set("? = ?", bun.Ident("score"), 0).
+		Set("? = ?", bun.Ident("updated_at"), time.Now()).
+		Where("? = ?", bun.Ident("trend.type"), trendType).
+		Where("? > ?", bun.Ident("trend.score"), 0)
+
+	if len(exceptTargetIDs) > 0 {
+		q = q.Where("? NOT IN (?)", bun.Ident("trend.target_id"), bun.In(exceptTargetIDs))
+	}
+
+	_, err := q.Exec(ctx)
+	return t.db.ProcessError(err)
+}
+
+func (t *trendDB) DeleteStaleTrends(ctx context.Context, before time.Time) error {
+	_, err := t.db.
+		NewDelete().
+		Model((*gtsmodel.Trend)(nil)).
+		Where("? = ?", bun.Ident("trend.score"), 0).
+		Where("? = ?", bun.Ident("trend.state"), gtsmodel.TrendStatePending).
+		Where("? < ?", bun.Ident("trend.updated_at"), before).
+		Exec(ctx)
+	return t.db.ProcessError(err)
+}
+
+func (t *trendDB) CountRecentTagAccounts(ctx context.Context, since time.Time) (map[string]int, error) {
+	var counts []trendCount
+
+	if err := t.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
+		ColumnExpr("? AS ?", bun.Ident("status_to_tag.tag_id"), bun.Ident("id")).
+		ColumnExpr("COUNT(DISTINCT ?) AS ?", bun.Ident("status.account_id"), bun.Ident("count")).
+		Join("JOIN ? AS ? ON ? = ?", bun.Ident("statuses"), bun.Ident("status"), bun.Ident("status.id"), bun.Ident("status_to_tag.status_id")).
+		Join("JOIN ? AS ? ON ? = ?", bun.Ident("tags"), bun.Ident("tag"), bun.Ident("tag.id"), bun.Ident("status_to_tag.tag_id")).
+		Apply(trendableStatuses(since)).
+		Where("? = ?", bun.Ident("tag.listable"), true).
+		Group("status_to_tag.tag_id").
+		Scan(ctx, &counts); err != nil {
+		return nil, t.db.ProcessError(err)
+	}
+
+	return countsToMap(counts), nil
+}
+
+func (t *trendDB) CountRecentStatusInteractions(ctx context.Context, since time.Time) (map[string]int, error) {
+	var faves, boosts []trendCount
+
+	// Count faves created since the given time.
+	if err := t.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_faves"), bun.Ident("status_fave")).
+		ColumnExpr("? AS ?", bun.Ident("status_fave.status_id"), bun.Ident("id")).
+		ColumnExpr("COUNT(*) AS ?", bun.Ident("count")).
+		Join("JOIN ? AS ? ON ? = ?", bun.Ident("statuses"), bun.Ident("status"), bun.Ident("status.id"), bun.Ident("status_fave.status_id")).
+		Apply(trendableStatuses(time.Time{})).
+		Where("? > ?", bun.Ident("status_fave.created_at"), since).
+		Group("status_fave.status_id").
+		Scan(ctx, &faves); err != nil {
+		return nil, t.db.ProcessError(err)
+	}
+
+	// Count boosts created since the given time.
+	if err := t.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("boost")).
+		ColumnExpr("? AS ?", bun.Ident("boost.boost_of_id"), bun.Ident("id")).
+		ColumnExpr("COUNT(*) AS ?", bun.Ident("count")).
+		Join("JOIN ? AS ? ON ? = ?", bun.Ident("statuses"), bun.Ident("status"), bun.Ident("status.id"), bun.Ident("boost.boost_of_id")).
+		Apply(trendableStatuses(time.Time{})).
+		Where("? > ?", bun.Ident("boost.created_at"), since).
+		Group("boost.boost_of_id").
+		Scan(ctx, &boosts); err != nil {
+		return nil, t.db.ProcessError(err)
+	}
+
+	interactions := countsToMap(faves)
+	for _, boost := range boosts {
+		interactions[boost.ID] += boost.Count
+	}
+
+	return interactions, nil
+}
+
+func (t *trendDB) CountRecentCardAccounts(ctx context.Context, since time.Time) (map[string]int, error) {
+	var counts []trendCount
+
+	if err := t.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		ColumnExpr("? AS ?", bun.Ident("status.card_id"), bun.Ident("id")).
+		ColumnExpr("COUNT(DISTINCT ?) AS ?", bun.Ident("status.account_id"), bun.Ident("count")).
+		Apply(trendableStatuses(since)).
+		Where("? IS NOT NULL", bun.Ident("status.card_id")).
+		Group("status.card_id").
+		Scan(ctx, &counts); err != nil {
+		return nil, t.db.ProcessError(err)
+	}
+
+	return countsToMap(counts), nil
+}
+
+func (t *trendDB) GetTagUses(ctx context.Context, tagID string, since time.Time) ([]gtsmodel.TrendUse, error) {
+	var uses []gtsmodel.TrendUse
+
+	if err := t.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
+		Column("status.account_id", "status.created_at").
+		Join("JOIN ? AS ? ON ? = ?", bun.Ident("statuses"), bun.Ident("status"), bun.Ident("status.id"), bun.Ident("status_to_tag.status_id")).
+		Apply(trendableStatuses(since)).
+		Where("? = ?", bun.Ident("status_to_tag.tag_id"), tagID).
+		Scan(ctx, &uses); err != nil {
+		return nil, t.db.ProcessError(err)
+	}
+
+	return uses, nil
+}
+
+func (t *trendDB) GetCardUses(ctx context.Context, cardID string, since time.Time) ([]gtsmodel.TrendUse, error) {
+	var uses []gtsmodel.TrendUse
+
+	if err := t.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Column("status.account_id", "status.created_at").
+		Apply(trendableStatuses(since)).
+		Where("? = ?", bun.Ident("status.card_id"), cardID).
+		Scan(ctx, &uses); err != nil {
+		return nil, t.db.ProcessError(err)
+	}
+
+	return uses, nil
+}
+
+// trendableStatuses limits the given query (aliasing the statuses
+// table as "status") to original (non-boost) public, federated statuses,
+// created after the given time if set; only these may contribute to trends.
+func trendableStatuses(since time.Time) func(*bun.SelectQuery) *bun.SelectQuery {
+	return func(q *bun.SelectQuery) *bun.SelectQuery {
+		q = q.
+			Where("? = ?", bun.Ident("status.visibility"), gtsmodel.VisibilityPublic).
+			Where("? = ?", bun.Ident("status.federated"), true).
+			Where("? IS NULL", bun.Ident("status.boost_of_id"))
+
+		if !since.IsZero() {
+			q = q.Where("? > ?", bun.Ident("status.created_at"), since)
+		}
+
+		return q
+	}
+}
+
+// countsToMap converts the given counts to a map of counts keyed by ID.
+func countsToMap(counts []trendCount) map[string]int {
+	m := make(map[string]int, len(counts))
+	for _, c := range counts {
+		m[c.ID] = c.Count
+	}
+	return m
+}
@@ -0,0 +1,98 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type TrendTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *TrendTestSuite) TestPutGetTrends() {
+	ctx := context.Background()
+
+	for _, trend := range []*gtsmodel.Trend{
+		{ID: id.NewULID(), Type: gtsmodel.TrendTypeTag, TargetID: suite.testTags["welcome"].ID, Score: 2, State: gtsmodel.TrendStateApproved},
+		{ID: id.NewULID(), Type: gtsmodel.TrendTypeTag, TargetID: suite.testTags["Hashtag"].ID, Score: 5, State: gtsmodel.TrendStatePending},
+		{ID: id.NewULID(), Type: gtsmodel.TrendTypeStatus, TargetID: suite.testStatuses["admin_account_status_1"].ID, Score: 1, State: gtsmodel.TrendStateApproved},
+	} {
+		suite.NoError(suite.db.PutTrend(ctx, trend))
+	}
+
+	// All tag trends, most trending first.
+	trends, err := suite.db.GetTrends(ctx, gtsmodel.TrendTypeTag, "", 0, 0)
+	suite.NoError(err)
+	suite.Len(trends, 2)
+	suite.Equal(suite.testTags["Hashtag"].ID, trends[0].TargetID)
+	suite.Equal(suite.testTags["welcome"].ID, trends[1].TargetID)
+
+	// Only approved tag trends.
+	trends, err = suite.db.GetTrends(ctx, gtsmodel.TrendTypeTag, gtsmodel.TrendStateApproved, 0, 0)
+	suite.NoError(err)
+	suite.Len(trends, 1)
+	suite.Equal(suite.testTags["welcome"].ID, trends[0].TargetID)
+
+	// Trends with zero score are no longer trending.
+	trend, err := suite.db.GetTrendByTarget(ctx, gtsmodel.TrendTypeTag, suite.testTags["welcome"].ID)
+	suite.NoError(err)
+	trend.Score = 0
+	suite.NoError(suite.db.UpdateTrend(ctx, trend, "score"))
+
+	trends, err = suite.db.GetTrends(ctx, gtsmodel.TrendTypeTag, gtsmodel.TrendStateApproved, 0, 0)
+	suite.NoError(err)
+	suite.Empty(trends)
+
+	// Review state is kept.
+	trend, err = suite.db.GetTrendByID(ctx, trend.ID)
+	suite.NoError(err)
+	suite.Equal(gtsmodel.TrendStateApproved, trend.State)
+}
+
+func (suite *TrendTestSuite) TestTagUses() {
+	ctx := context.Background()
+	tag := suite.testTags["welcome"]
+	status := suite.testStatuses["admin_account_status_1"]
+	since := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	counts, err := suite.db.CountRecentTagAccounts(ctx, since)
+	suite.NoError(err)
+	suite.Equal(1, counts[tag.ID])
+
+	uses, err := suite.db.GetTagUses(ctx, tag.ID, since)
+	suite.NoError(err)
+	suite.Len(uses, 1)
+	suite.Equal(status.AccountID, uses[0].AccountID)
+	suite.True(status.CreatedAt.Equal(uses[0].CreatedAt))
+
+	// Nothing recent.
+	counts, err = suite.db.CountRecentTagAccounts(ctx, time.Now().Add(-24*time.Hour))
+	suite.NoError(err)
+	suite.Empty(counts)
+}
+
+func TestTrendTestSuite(t *testing.T) {
+	suite.Run(t, new(TrendTestSuite))
+}
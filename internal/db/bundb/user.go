@@ -124,6 +124,24 @@ func (u *userDB) GetUserByConfirmationToken(ctx context.Context, confirmationTok
 	}, confirmationToken)
 }
 
+func (u *userDB) GetUserByResetPasswordToken(ctx context.Context, resetPasswordToken string) (*gtsmodel.User, error) {
+	return u.state.Caches.GTS.User().Load("ResetPasswordToken", func() (*gtsmodel.User, error) {
+		var user gtsmodel.User
+
+		q := u.db.
+			NewSelect().
+			Model(&user).
+			Relation("Account").
+			Where("? = ?", bun.Ident("user.reset_password_token"), resetPasswordToken)
+
+		if err := q.Scan(ctx); err != nil {
+			return nil, u.db.ProcessError(err)
+		}
+
+		return &user, nil
+	}, resetPasswordToken)
+}
+
 func (u *userDB) GetAllUsers(ctx context.Context) ([]*gtsmodel.User, error) {
 	var users []*gtsmodel.User
 	q := u.db.
@@ -164,7 +182,15 @@ func (u *userDB) UpdateUser(ctx context.Context, user *gtsmodel.User, columns ..
 			Where("? = ?", bun.Ident("user.id"), user.ID).
 			Column(columns...).
 			Exec(ctx)
-		return u.db.ProcessError(err)
+		if err != nil {
+			return u.db.ProcessError(err)
+		}
+
+		// Drop any previously cached copy of this user, so that
+		// lookups by keys which have since changed or been cleared
+		// (eg., a used reset password token) don't return it.
+		u.state.Caches.GTS.User().Invalidate("ID", user.ID)
+		return nil
 	})
 }
 
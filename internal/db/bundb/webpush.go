@@ -0,0 +1,258 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type webPushDB struct {
+	db    *WrappedDB
+	state *state.State
+}
+
+func (w *webPushDB) GetWebPushSubscriptionByID(ctx context.Context, id string) (*gtsmodel.WebPushSubscription, error) {
+	return w.getWebPushSubscription(
+		ctx,
+		"ID",
+		func(sub *gtsmodel.WebPushSubscription) error {
+			return w.db.NewSelect().
+				Model(sub).
+				Where("? = ?", bun.Ident("web_push_subscription.id"), id).
+				Scan(ctx)
+		},
+		id,
+	)
+}
+
+func (w *webPushDB) GetWebPushSubscriptionByTokenID(ctx context.Context, tokenID string) (*gtsmodel.WebPushSubscription, error) {
+	return w.getWebPushSubscription(
+		ctx,
+		"TokenID",
+		func(sub *gtsmodel.WebPushSubscription) error {
+			return w.db.NewSelect().
+				Model(sub).
+				Where("? = ?", bun.Ident("web_push_subscription.token_id"), tokenID).
+				Scan(ctx)
+		},
+		tokenID,
+	)
+}
+
+func (w *webPushDB) getWebPushSubscription(ctx context.Context, lookup string, dbQuery func(*gtsmodel.WebPushSubscription) error, keyParts ...any) (*gtsmodel.WebPushSubscription, error) {
+	return w.state.Caches.GTS.WebPushSubscription().Load(lookup, func() (*gtsmodel.WebPushSubscription, error) {
+		var sub gtsmodel.WebPushSubscription
+
+		// Not cached! Perform database query.
+		if err := dbQuery(&sub); err != nil {
+			return nil, w.db.ProcessError(err)
+		}
+
+		return &sub, nil
+	}, keyParts...)
+}
+
+func (w *webPushDB) GetWebPushSubscriptionsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.WebPushSubscription, error) {
+	// Load subscription IDs from cache with database loader callback.
+	subIDs, err := w.state.Caches.GTS.WebPushSubscriptionIDs().Load(accountID, func() ([]string, error) {
+		var subIDs []string
+
+		// Subscription IDs not in cache, perform DB query!
+		if _, err := w.db.NewSelect().
+			Table("web_push_subscriptions").
+			Column("id").
+			Where("? = ?", bun.Ident("account_id"), accountID).
+			Exec(ctx, &subIDs); err != nil {
+			return nil, w.db.ProcessError(err)
+		}
+
+		return subIDs, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Preallocate slice of expected length.
+	subs := make([]*gtsmodel.WebPushSubscription, 0, len(subIDs))
+
+	for _, id := range subIDs {
+		// Fetch subscription model for this ID.
+		sub, err := w.GetWebPushSubscriptionByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error getting web push subscription %q: %v", id, err)
+			continue
+		}
+
+		// Append to return slice.
+		subs = append(subs, sub)
+	}
+
+	return subs, nil
+}
+
+func (w *webPushDB) PutWebPushSubscription(ctx context.Context, sub *gtsmodel.WebPushSubscription) error {
+	return w.state.Caches.GTS.WebPushSubscription().Store(sub, func() error {
+		_, err := w.db.NewInsert().Model(sub).Exec(ctx)
+		return w.db.ProcessError(err)
+	})
+}
+
+func (w *webPushDB) UpdateWebPushSubscription(ctx context.Context, sub *gtsmodel.WebPushSubscription, columns ...string) error {
+	sub.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	return w.state.Caches.GTS.WebPushSubscription().Store(sub, func() error {
+		_, err := w.db.NewUpdate().
+			Model(sub).
+			Where("? = ?", bun.Ident("web_push_subscription.id"), sub.ID).
+			Column(columns...).
+			Exec(ctx)
+		return w.db.ProcessError(err)
+	})
+}
+
+func (w *webPushDB) DeleteWebPushSubscriptionByID(ctx context.Context, id string) error {
+	// Load subscription into cache before attempting a delete,
+	// as we need it cached in order to trigger the invalidate
+	// callback. This in turn invalidates others.
+	_, err := w.GetWebPushSubscriptionByID(gtscontext.SetBarebones(ctx), id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// not an issue.
+			err = nil
+		}
+		return err
+	}
+
+	// Drop this now-cached subscription on return after delete.
+	defer w.state.Caches.GTS.WebPushSubscription().Invalidate("ID", id)
+
+	// Finally delete subscription from DB.
+	_, err = w.db.NewDelete().
+		Table("web_push_subscriptions").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	return w.db.ProcessError(err)
+}
+
+func (w *webPushDB) DeleteWebPushSubscriptionByTokenID(ctx context.Context, tokenID string) error {
+	sub, err := w.GetWebPushSubscriptionByTokenID(gtscontext.SetBarebones(ctx), tokenID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// not an issue.
+			err = nil
+		}
+		return err
+	}
+
+	return w.DeleteWebPushSubscriptionByID(ctx, sub.ID)
+}
+
+func (w *webPushDB) DeleteWebPushSubscriptionsByAccountID(ctx context.Context, accountID string) error {
+	var subIDs []string
+
+	// Get full list of IDs.
+	if err := w.db.NewSelect().
+		Column("id").
+		Table("web_push_subscriptions").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Scan(ctx, &subIDs); err != nil {
+		return w.db.ProcessError(err)
+	}
+
+	defer func() {
+		// Invalidate all account's subscriptions on return.
+		w.state.Caches.GTS.WebPushSubscription().Invalidate("AccountID", accountID)
+		w.state.Caches.GTS.WebPushSubscriptionIDs().Invalidate(accountID)
+	}()
+
+	if len(subIDs) == 0 {
+		// Nothing to do.
+		return nil
+	}
+
+	// Finally delete all from DB.
+	_, err := w.db.NewDelete().
+		Table("web_push_subscriptions").
+		Where("? IN (?)", bun.Ident("id"), bun.In(subIDs)).
+		Exec(ctx)
+	return w.db.ProcessError(err)
+}
+
+func (w *webPushDB) GetVAPIDKeyPair(ctx context.Context) (*gtsmodel.VAPIDKeyPair, error) {
+	var keyPair gtsmodel.VAPIDKeyPair
+
+	if err := w.db.NewSelect().
+		Model(&keyPair).
+		Limit(1).
+		Scan(ctx); err != nil {
+		return nil, w.db.ProcessError(err)
+	}
+
+	return &keyPair, nil
+}
+
+func (w *webPushDB) CreateVAPIDKeyPair(ctx context.Context) error {
+	exists, err := w.db.Exists(ctx, w.db.
+		NewSelect().
+		Table("vapid_key_pairs").
+		Column("id"),
+	)
+	if err != nil {
+		return err
+	}
+	if exists {
+		log.Info(ctx, "VAPID key pair already exists")
+		return nil
+	}
+
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		log.Errorf(ctx, "error creating new VAPID key: %s", err)
+		return err
+	}
+
+	keyPair := &gtsmodel.VAPIDKeyPair{
+		ID:      id.NewULID(),
+		Public:  base64.RawURLEncoding.EncodeToString(key.PublicKey().Bytes()),
+		Private: base64.RawURLEncoding.EncodeToString(key.Bytes()),
+	}
+
+	if _, err := w.db.NewInsert().Model(keyPair).Exec(ctx); err != nil {
+		return w.db.ProcessError(err)
+	}
+
+	log.Info(ctx, "created VAPID key pair")
+	return nil
+}
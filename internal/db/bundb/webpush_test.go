@@ -0,0 +1,137 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type WebPushTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *WebPushTestSuite) putSubscription(ctx context.Context) *gtsmodel.WebPushSubscription {
+	sub := &gtsmodel.WebPushSubscription{
+		ID:                 "01H7A8S2X8XGT8FTRA1X9FYRP6",
+		AccountID:          suite.testAccounts["local_account_1"].ID,
+		TokenID:            suite.testTokens["local_account_1"].ID,
+		Endpoint:           "https://push.example.org/wpush/v2/some_subscription",
+		Auth:               "BTBZMqHH6r4Tts7J_aSIgg",
+		P256dh:             "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4",
+		AlertMention:       testrig.TrueBool(),
+		AlertFollow:        testrig.FalseBool(),
+		AlertFollowRequest: testrig.FalseBool(),
+		AlertFavourite:     testrig.FalseBool(),
+		AlertReblog:        testrig.FalseBool(),
+		AlertPoll:          testrig.FalseBool(),
+		AlertStatus:        testrig.FalseBool(),
+		AlertAdminReport:   testrig.FalseBool(),
+		Policy:             gtsmodel.WebPushNotificationPolicyAll,
+	}
+	if err := suite.db.PutWebPushSubscription(ctx, sub); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return sub
+}
+
+func (suite *WebPushTestSuite) TestPutGetWebPushSubscription() {
+	ctx := context.Background()
+	sub := suite.putSubscription(ctx)
+
+	byToken, err := suite.db.GetWebPushSubscriptionByTokenID(ctx, sub.TokenID)
+	suite.NoError(err)
+	suite.Equal(sub.ID, byToken.ID)
+	suite.True(byToken.AlertEnabled(gtsmodel.NotificationMention))
+	suite.False(byToken.AlertEnabled(gtsmodel.NotificationFollow))
+
+	subs, err := suite.db.GetWebPushSubscriptionsByAccountID(ctx, sub.AccountID)
+	suite.NoError(err)
+	suite.Len(subs, 1)
+	suite.Equal(sub.ID, subs[0].ID)
+}
+
+func (suite *WebPushTestSuite) TestUpdateWebPushSubscription() {
+	ctx := context.Background()
+	sub := suite.putSubscription(ctx)
+
+	sub.Policy = gtsmodel.WebPushNotificationPolicyFollowed
+	sub.AlertFollow = testrig.TrueBool()
+	if err := suite.db.UpdateWebPushSubscription(ctx, sub, "policy", "alert_follow"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Invalidate the cache to ensure we fetch from the db.
+	suite.state.Caches.GTS.WebPushSubscription().Invalidate("ID", sub.ID)
+
+	dbSub, err := suite.db.GetWebPushSubscriptionByID(ctx, sub.ID)
+	suite.NoError(err)
+	suite.Equal(gtsmodel.WebPushNotificationPolicyFollowed, dbSub.Policy)
+	suite.True(*dbSub.AlertFollow)
+}
+
+func (suite *WebPushTestSuite) TestDeleteWebPushSubscriptionByTokenID() {
+	ctx := context.Background()
+	sub := suite.putSubscription(ctx)
+
+	// Load the account's subscription IDs into the cache.
+	subs, err := suite.db.GetWebPushSubscriptionsByAccountID(ctx, sub.AccountID)
+	suite.NoError(err)
+	suite.Len(subs, 1)
+
+	if err := suite.db.DeleteWebPushSubscriptionByTokenID(ctx, sub.TokenID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = suite.db.GetWebPushSubscriptionByTokenID(ctx, sub.TokenID)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+
+	subs, err = suite.db.GetWebPushSubscriptionsByAccountID(ctx, sub.AccountID)
+	suite.NoError(err)
+	suite.Empty(subs)
+
+	// Deleting again is not an error.
+	suite.NoError(suite.db.DeleteWebPushSubscriptionByTokenID(ctx, sub.TokenID))
+}
+
+func (suite *WebPushTestSuite) TestGetVAPIDKeyPair() {
+	ctx := context.Background()
+
+	keyPair, err := suite.db.GetVAPIDKeyPair(ctx)
+	suite.NoError(err)
+	suite.NotEmpty(keyPair.Public)
+	suite.NotEmpty(keyPair.Private)
+
+	// Creating again should be a no-op.
+	suite.NoError(suite.db.CreateVAPIDKeyPair(ctx))
+
+	again, err := suite.db.GetVAPIDKeyPair(ctx)
+	suite.NoError(err)
+	suite.Equal(keyPair.Public, again.Public)
+}
+
+func TestWebPushTestSuite(t *testing.T) {
+	suite.Run(t, new(WebPushTestSuite))
+}
@@ -26,10 +26,14 @@ const (
 type DB interface {
 	Account
 	Admin
+	Announcement
 	Basic
+	Card
 	Domain
 	Emoji
 	Instance
+	Invite
+	IPBlock
 	List
 	Marker
 	Media
@@ -37,13 +41,18 @@ type DB interface {
 	Notification
 	Relationship
 	Report
+	Rule
 	Search
 	Session
 	Status
 	StatusBookmark
 	StatusFave
+	StatusReaction
+	Suggestion
 	Tag
 	Timeline
+	Trend
 	User
 	Tombstone
+	WebPush
 }
@@ -52,4 +52,23 @@ type Domain interface {
 
 	// AreURIsBlocked checks if an instance-level domain block exists for any `host` in the given URI slice, and returns true if even one is found.
 	AreURIsBlocked(ctx context.Context, uris []*url.URL) (bool, error)
+
+	// CreateEmailDomainBlock puts the given email domain block into the database.
+	CreateEmailDomainBlock(ctx context.Context, block *gtsmodel.EmailDomainBlock) error
+
+	// GetEmailDomainBlock returns one email domain block with the given domain, if it exists.
+	GetEmailDomainBlock(ctx context.Context, domain string) (*gtsmodel.EmailDomainBlock, error)
+
+	// GetEmailDomainBlockByID returns one email domain block with the given id, if it exists.
+	GetEmailDomainBlockByID(ctx context.Context, id string) (*gtsmodel.EmailDomainBlock, error)
+
+	// GetEmailDomainBlocks returns all email domain blocks currently enforced by this instance.
+	GetEmailDomainBlocks(ctx context.Context) ([]*gtsmodel.EmailDomainBlock, error)
+
+	// DeleteEmailDomainBlock deletes an email domain block with the given domain, if it exists.
+	DeleteEmailDomainBlock(ctx context.Context, domain string) error
+
+	// IsEmailDomainBlocked checks if an email domain block exists for the given domain string
+	// (eg., `example.org`), or for any of its parent domains (eg., `org` blocks `example.org`).
+	IsEmailDomainBlocked(ctx context.Context, domain string) (bool, error)
 }
@@ -55,4 +55,8 @@ type Instance interface {
 	// GetInstanceModeratorAddresses returns a slice of email addresses belonging to active
 	// (as in, not suspended) moderators + admins on this instance.
 	GetInstanceModeratorAddresses(ctx context.Context) ([]string, error)
+
+	// GetInstanceModeratorAccountIDs returns a slice of account IDs belonging to active
+	// (as in, not suspended) moderators + admins on this instance.
+	GetInstanceModeratorAccountIDs(ctx context.Context) ([]string, error)
 }
@@ -0,0 +1,51 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Invite handles getting/creation/updating of sign-up invites.
+type Invite interface {
+	// GetInviteByID gets one invite by its db id.
+	GetInviteByID(ctx context.Context, id string) (*gtsmodel.Invite, error)
+
+	// GetInviteByCode gets one invite by its code.
+	GetInviteByCode(ctx context.Context, code string) (*gtsmodel.Invite, error)
+
+	// GetAccountInvites gets all invites created by the given account,
+	// including expired and used up ones, newest first.
+	GetAccountInvites(ctx context.Context, accountID string) ([]*gtsmodel.Invite, error)
+
+	// PutInvite puts the given invite in the database.
+	PutInvite(ctx context.Context, invite *gtsmodel.Invite) error
+
+	// UpdateInvite updates the given invite in the database, only updating the given columns if provided.
+	UpdateInvite(ctx context.Context, invite *gtsmodel.Invite, columns ...string) error
+
+	// UseInvite increments the use count of the invite with the given id,
+	// provided it has uses left and has not expired (or been revoked). If
+	// it can't be used, ErrNoEntries will be returned.
+	UseInvite(ctx context.Context, id string) error
+
+	// UnuseInvite decrements the use count of the invite with the given id,
+	// giving back a use taken by UseInvite, eg., if a sign up failed.
+	UnuseInvite(ctx context.Context, id string) error
+}
@@ -0,0 +1,47 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package db
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// IPBlock handles getting/creation/deletion of IP blocks.
+type IPBlock interface {
+	// GetIPBlockByID gets one IP block by its db id.
+	GetIPBlockByID(ctx context.Context, id string) (*gtsmodel.IPBlock, error)
+
+	// GetIPBlock gets one IP block by its IP range, in CIDR notation.
+	GetIPBlock(ctx context.Context, ip string) (*gtsmodel.IPBlock, error)
+
+	// GetIPBlocks gets all IP blocks, including expired ones.
+	GetIPBlocks(ctx context.Context) ([]*gtsmodel.IPBlock, error)
+
+	// PutIPBlock puts the given IP block in the database.
+	PutIPBlock(ctx context.Context, block *gtsmodel.IPBlock) error
+
+	// DeleteIPBlockByID deletes one IP block by its db id.
+	DeleteIPBlockByID(ctx context.Context, id string) error
+
+	// IsIPBlocked checks if an unexpired IP block with the given severity exists for
+	// a range containing the given address. A block with severity no_access is taken
+	// to also block sign-ups, since it's the stricter of the two.
+	IsIPBlocked(ctx context.Context, addr netip.Addr, severity gtsmodel.IPBlockSeverity) (bool, error)
+}
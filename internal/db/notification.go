@@ -21,14 +21,26 @@ import (
 	"context"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 )
 
 // Notification contains functions for creating and getting notifications.
 type Notification interface {
-	// GetNotifications returns a slice of notifications that pertain to the given accountID.
+	// GetAccountNotifications returns a page of notifications that pertain to the given accountID.
+	//
+	// If types is set, only notifications of those types will be returned. Notifications of
+	// excludeTypes will never be returned. If originAccountID is set, only notifications
+	// originating from that account will be returned, including filtered notifications;
+	// otherwise, notifications filtered by the account's notification policy are left out.
 	//
 	// Returned notifications will be ordered ID descending (ie., highest/newest to lowest/oldest).
-	GetAccountNotifications(ctx context.Context, accountID string, maxID string, sinceID string, minID string, limit int, excludeTypes []string) ([]*gtsmodel.Notification, error)
+	GetAccountNotifications(ctx context.Context, accountID string, page *paging.Pager, types []string, excludeTypes []string, originAccountID string) ([]*gtsmodel.Notification, error)
+
+	// CountAccountNotifications returns the number of notifications targeting the given
+	// accountID that are newer than sinceID, filtered by types and excludeTypes as in
+	// GetAccountNotifications. If sinceID is empty, all notifications are counted.
+	// Notifications filtered by the account's notification policy are never counted.
+	CountAccountNotifications(ctx context.Context, accountID string, sinceID string, types []string, excludeTypes []string) (int, error)
 
 	// GetNotification returns one notification according to its id.
 	GetNotificationByID(ctx context.Context, id string) (*gtsmodel.Notification, error)
@@ -63,4 +75,48 @@ type Notification interface {
 	// the given statusID. This function is useful when a status has been deleted,
 	// and so notifications relating to that status must also be deleted.
 	DeleteNotificationsForStatus(ctx context.Context, statusID string) error
+
+	// UnfilterNotifications marks all filtered notifications targeting
+	// targetAccountID and originating from originAccountID as unfiltered.
+	UnfilterNotifications(ctx context.Context, targetAccountID string, originAccountID string) error
+
+	// DeleteFilteredNotifications deletes all filtered notifications targeting
+	// targetAccountID and originating from originAccountID.
+	DeleteFilteredNotifications(ctx context.Context, targetAccountID string, originAccountID string) error
+
+	// GetNotificationPolicy returns the notification policy of the given account.
+	GetNotificationPolicy(ctx context.Context, accountID string) (*gtsmodel.NotificationPolicy, error)
+
+	// PutNotificationPolicy stores the given notification policy.
+	PutNotificationPolicy(ctx context.Context, policy *gtsmodel.NotificationPolicy) error
+
+	// UpdateNotificationPolicy updates the given notification policy.
+	// If no columns are specified, every column is updated.
+	UpdateNotificationPolicy(ctx context.Context, policy *gtsmodel.NotificationPolicy, columns ...string) error
+
+	// GetNotificationRequestByID returns one notification request according to its id.
+	GetNotificationRequestByID(ctx context.Context, id string) (*gtsmodel.NotificationRequest, error)
+
+	// GetNotificationRequest returns the notification request of
+	// accountID for notifications originating from fromAccountID.
+	GetNotificationRequest(ctx context.Context, accountID string, fromAccountID string) (*gtsmodel.NotificationRequest, error)
+
+	// GetAccountNotificationRequests returns a page of the pending (ie., not
+	// accepted) notification requests of the given account, ordered by ID descending.
+	// If page is nil, all pending notification requests are returned.
+	GetAccountNotificationRequests(ctx context.Context, accountID string, page *paging.Pager) ([]*gtsmodel.NotificationRequest, error)
+
+	// PutNotificationRequest stores the given notification request.
+	PutNotificationRequest(ctx context.Context, request *gtsmodel.NotificationRequest) error
+
+	// UpdateNotificationRequest updates the given notification request.
+	// If no columns are specified, every column is updated.
+	UpdateNotificationRequest(ctx context.Context, request *gtsmodel.NotificationRequest, columns ...string) error
+
+	// DeleteNotificationRequestByID deletes one notification request according to its id.
+	DeleteNotificationRequestByID(ctx context.Context, id string) error
+
+	// DeleteAccountNotificationPolicyData deletes the notification policy of the given
+	// account, and all notification requests made to or originating from it.
+	DeleteAccountNotificationPolicyData(ctx context.Context, accountID string) error
 }
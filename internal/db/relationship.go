@@ -131,8 +131,8 @@ type Relationship interface {
 	// RejectFollowRequest fetches a follow request from the database, and then deletes it.
 	RejectFollowRequest(ctx context.Context, originAccountID string, targetAccountID string) error
 
-	// GetAccountFollows returns a slice of follows owned by the given accountID.
-	GetAccountFollows(ctx context.Context, accountID string) ([]*gtsmodel.Follow, error)
+	// GetAccountFollows returns a slice of follows owned by the given accountID, paged by the given pager (if not nil).
+	GetAccountFollows(ctx context.Context, accountID string, page *paging.Pager) ([]*gtsmodel.Follow, error)
 
 	// GetAccountLocalFollows returns a slice of follows owned by the given accountID, only including follows from this instance.
 	GetAccountLocalFollows(ctx context.Context, accountID string) ([]*gtsmodel.Follow, error)
@@ -143,8 +143,8 @@ type Relationship interface {
 	// CountAccountLocalFollows returns the amount of accounts that the given accountID is following, only including follows from this instance.
 	CountAccountLocalFollows(ctx context.Context, accountID string) (int, error)
 
-	// GetAccountFollowers fetches follows that target given accountID.
-	GetAccountFollowers(ctx context.Context, accountID string) ([]*gtsmodel.Follow, error)
+	// GetAccountFollowers fetches follows that target given accountID, paged by the given pager (if not nil).
+	GetAccountFollowers(ctx context.Context, accountID string, page *paging.Pager) ([]*gtsmodel.Follow, error)
 
 	// GetAccountLocalFollowers fetches follows that target given accountID, only including follows from this instance.
 	GetAccountLocalFollowers(ctx context.Context, accountID string) ([]*gtsmodel.Follow, error)
@@ -170,6 +170,27 @@ type Relationship interface {
 	// GetAccountBlocks returns all blocks originating from the given account, with given optional paging parameters.
 	GetAccountBlocks(ctx context.Context, accountID string, paging *paging.Pager) ([]*gtsmodel.Block, error)
 
+	// IsAccountDomainBlocked checks whether the given account has a domain block in place against the given domain, or any of its parent domains.
+	IsAccountDomainBlocked(ctx context.Context, accountID string, domain string) (bool, error)
+
+	// GetAccountDomainBlockByID fetches account domain block with given ID from the database.
+	GetAccountDomainBlockByID(ctx context.Context, id string) (*gtsmodel.AccountDomainBlock, error)
+
+	// GetAccountDomainBlock returns the block from given account targeting exactly the given domain, if it exists, or an error if it doesn't.
+	GetAccountDomainBlock(ctx context.Context, accountID string, domain string) (*gtsmodel.AccountDomainBlock, error)
+
+	// GetAccountDomainBlocks returns all domain blocks originating from the given account, with given optional paging parameters.
+	GetAccountDomainBlocks(ctx context.Context, accountID string, page *paging.Pager) ([]*gtsmodel.AccountDomainBlock, error)
+
+	// PutAccountDomainBlock attempts to place the given account domain block in the database.
+	PutAccountDomainBlock(ctx context.Context, block *gtsmodel.AccountDomainBlock) error
+
+	// DeleteAccountDomainBlockByID removes account domain block with given ID from the database.
+	DeleteAccountDomainBlockByID(ctx context.Context, id string) error
+
+	// DeleteAccountDomainBlocks will delete all database domain blocks originating from the given account ID.
+	DeleteAccountDomainBlocks(ctx context.Context, accountID string) error
+
 	// GetNote gets a private note from a source account on a target account, if it exists.
 	GetNote(ctx context.Context, sourceAccountID string, targetAccountID string) (*gtsmodel.AccountNote, error)
 
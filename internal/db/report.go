@@ -38,6 +38,13 @@ type Report interface {
 	// updated_at will also be updated, no need to pass this
 	// as a specific column.
 	UpdateReport(ctx context.Context, report *gtsmodel.Report, columns ...string) (*gtsmodel.Report, error)
+	// ResolveReport stores the action_taken_at and action_taken_by_account_id
+	// columns of the given report, provided it hasn't already been resolved. If
+	// it has, eg., by another moderator at the same time, ErrNoEntries is returned.
+	ResolveReport(ctx context.Context, report *gtsmodel.Report) error
+	// UnresolveReport clears the resolution of the report with the given id,
+	// giving back a resolution taken by ResolveReport, eg., if actions failed.
+	UnresolveReport(ctx context.Context, id string) error
 	// DeleteReportByID deletes report with the given id.
 	DeleteReportByID(ctx context.Context, id string) error
 }
@@ -0,0 +1,55 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type StatusReaction interface {
+	// GetStatusReactionByURI returns one status reaction with the given ActivityPub URI.
+	GetStatusReactionByURI(ctx context.Context, uri string) (*gtsmodel.StatusReaction, error)
+
+	// GetStatusReaction returns the reaction with the given emoji name
+	// created by the given accountID, targeting the given statusID.
+	GetStatusReaction(ctx context.Context, statusID string, accountID string, name string) (*gtsmodel.StatusReaction, error)
+
+	// GetStatusReactions returns all reactions to the given status, oldest first.
+	// This slice will be unfiltered, not taking account of blocks and whatnot, so filter it before serving it back to a user.
+	GetStatusReactions(ctx context.Context, statusID string) ([]*gtsmodel.StatusReaction, error)
+
+	// GetLatestStatusReaction returns the most recent reaction
+	// created by the given accountID, targeting the given statusID.
+	GetLatestStatusReaction(ctx context.Context, statusID string, accountID string) (*gtsmodel.StatusReaction, error)
+
+	// PutStatusReaction inserts the given reaction into the database.
+	PutStatusReaction(ctx context.Context, reaction *gtsmodel.StatusReaction) error
+
+	// DeleteStatusReactionByID deletes one status reaction with the given id.
+	DeleteStatusReactionByID(ctx context.Context, id string) error
+
+	// DeleteStatusReactionsForStatus deletes all reactions that target the
+	// given status ID. This is useful when a status has been deleted.
+	DeleteStatusReactionsForStatus(ctx context.Context, statusID string) error
+
+	// DeleteStatusReactionsForAccount deletes all reactions
+	// created by, or targeting, the given account ID.
+	DeleteStatusReactionsForAccount(ctx context.Context, accountID string) error
+}
@@ -0,0 +1,50 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Suggestion contains functions for getting / creating
+// admin-pinned follow suggestions, and dismissals of suggestions.
+type Suggestion interface {
+	// GetPinnedSuggestions gets all admin-pinned follow suggestions, newest first.
+	GetPinnedSuggestions(ctx context.Context) ([]*gtsmodel.PinnedSuggestion, error)
+
+	// GetPinnedSuggestionByAccountID gets the pinned follow suggestion of the given account.
+	GetPinnedSuggestionByAccountID(ctx context.Context, accountID string) (*gtsmodel.PinnedSuggestion, error)
+
+	// PutPinnedSuggestion puts the given pinned follow suggestion in the database.
+	PutPinnedSuggestion(ctx context.Context, suggestion *gtsmodel.PinnedSuggestion) error
+
+	// DeletePinnedSuggestionByAccountID deletes the pinned follow suggestion of the given account, if any.
+	DeletePinnedSuggestionByAccountID(ctx context.Context, accountID string) error
+
+	// GetDismissedSuggestionIDs gets the IDs of all accounts whose suggestion has been dismissed by the given account.
+	GetDismissedSuggestionIDs(ctx context.Context, accountID string) ([]string, error)
+
+	// PutSuggestionDismissal puts the given suggestion dismissal in the database.
+	PutSuggestionDismissal(ctx context.Context, dismissal *gtsmodel.SuggestionDismissal) error
+
+	// DeleteAccountSuggestions deletes the pinned suggestion of the given
+	// account, and all suggestion dismissals by or targeting the account.
+	DeleteAccountSuggestions(ctx context.Context, accountID string) error
+}
@@ -0,0 +1,73 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Trend contains functions for getting / creating trends, and
+// for gathering the recent activity from which trends are scored.
+type Trend interface {
+	// GetTrendByID gets one trend with the given ID.
+	GetTrendByID(ctx context.Context, id string) (*gtsmodel.Trend, error)
+
+	// GetTrendByTarget gets the trend of the given type for the given tag, status or card ID.
+	GetTrendByTarget(ctx context.Context, trendType gtsmodel.TrendType, targetID string) (*gtsmodel.Trend, error)
+
+	// GetTrends gets currently trending items (ie., with a score above zero) of the given type,
+	// ordered by score descending. If state is set, only trends in the given review state are
+	// returned. A limit of 0 means no limit.
+	GetTrends(ctx context.Context, trendType gtsmodel.TrendType, state gtsmodel.TrendState, limit int, offset int) ([]*gtsmodel.Trend, error)
+
+	// PutTrend puts the given trend in the database.
+	PutTrend(ctx context.Context, trend *gtsmodel.Trend) error
+
+	// UpdateTrend updates the given trend, only updating given columns (or all if none given).
+	UpdateTrend(ctx context.Context, trend *gtsmodel.Trend, columns ...string) error
+
+	// ZeroTrendScores sets the score of all currently trending items (ie., with a score
+	// above zero) of the given type to zero, except for those with the given target IDs.
+	ZeroTrendScores(ctx context.Context, trendType gtsmodel.TrendType, exceptTargetIDs []string) error
+
+	// DeleteStaleTrends deletes pending trends which are no longer trending,
+	// and which were last updated before the given time. Reviewed trends are
+	// kept, so that items don't need reviewing again if they trend again later.
+	DeleteStaleTrends(ctx context.Context, before time.Time) error
+
+	// CountRecentTagAccounts returns, keyed by tag ID, the number of distinct accounts that
+	// have used each listable tag in public, federated statuses created since the given time.
+	CountRecentTagAccounts(ctx context.Context, since time.Time) (map[string]int, error)
+
+	// CountRecentStatusInteractions returns, keyed by status ID, the number of faves and boosts
+	// created since the given time on each public, federated status.
+	CountRecentStatusInteractions(ctx context.Context, since time.Time) (map[string]int, error)
+
+	// CountRecentCardAccounts returns, keyed by card ID, the number of distinct accounts that
+	// have linked to each preview card in public, federated statuses created since the given time.
+	CountRecentCardAccounts(ctx context.Context, since time.Time) (map[string]int, error)
+
+	// GetTagUses returns the uses of the given tag in public, federated statuses created since the given time.
+	GetTagUses(ctx context.Context, tagID string, since time.Time) ([]gtsmodel.TrendUse, error)
+
+	// GetCardUses returns the uses of the given preview card in public, federated statuses created since the given time.
+	GetCardUses(ctx context.Context, cardID string, since time.Time) ([]gtsmodel.TrendUse, error)
+}
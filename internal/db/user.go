@@ -37,6 +37,8 @@ type User interface {
 	GetUserByExternalID(ctx context.Context, id string) (*gtsmodel.User, error)
 	// GetUserByConfirmationToken returns one user by its confirmation token, or an error if something goes wrong.
 	GetUserByConfirmationToken(ctx context.Context, confirmationToken string) (*gtsmodel.User, error)
+	// GetUserByResetPasswordToken returns one user by its reset password token, or an error if something goes wrong.
+	GetUserByResetPasswordToken(ctx context.Context, resetPasswordToken string) (*gtsmodel.User, error)
 	// PutUser will attempt to place user in the database
 	PutUser(ctx context.Context, user *gtsmodel.User) error
 	// UpdateUser updates one user by its primary key, updating either only the specified columns, or all of them.
@@ -0,0 +1,57 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type WebPush interface {
+	// GetWebPushSubscriptionByID gets one web push subscription with the given ID.
+	GetWebPushSubscriptionByID(ctx context.Context, id string) (*gtsmodel.WebPushSubscription, error)
+
+	// GetWebPushSubscriptionByTokenID gets the web push subscription created with the given OAuth token.
+	GetWebPushSubscriptionByTokenID(ctx context.Context, tokenID string) (*gtsmodel.WebPushSubscription, error)
+
+	// GetWebPushSubscriptionsByAccountID gets all web push subscriptions owned by the given account.
+	GetWebPushSubscriptionsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.WebPushSubscription, error)
+
+	// PutWebPushSubscription stores the given web push subscription.
+	PutWebPushSubscription(ctx context.Context, subscription *gtsmodel.WebPushSubscription) error
+
+	// UpdateWebPushSubscription updates the given web push subscription. If columns
+	// is empty, all columns are updated; updated_at is always updated.
+	UpdateWebPushSubscription(ctx context.Context, subscription *gtsmodel.WebPushSubscription, columns ...string) error
+
+	// DeleteWebPushSubscriptionByID deletes one web push subscription with the given ID.
+	DeleteWebPushSubscriptionByID(ctx context.Context, id string) error
+
+	// DeleteWebPushSubscriptionByTokenID deletes the web push subscription created with the given OAuth token.
+	DeleteWebPushSubscriptionByTokenID(ctx context.Context, tokenID string) error
+
+	// DeleteWebPushSubscriptionsByAccountID deletes all web push subscriptions owned by the given account.
+	DeleteWebPushSubscriptionsByAccountID(ctx context.Context, accountID string) error
+
+	// GetVAPIDKeyPair gets the instance's VAPID key pair.
+	GetVAPIDKeyPair(ctx context.Context) (*gtsmodel.VAPIDKeyPair, error)
+
+	// CreateVAPIDKeyPair generates and stores the instance's VAPID key pair, if it doesn't exist yet.
+	CreateVAPIDKeyPair(ctx context.Context) error
+}
@@ -435,6 +435,11 @@ func (d *deref) enrichAccount(ctx context.Context, requestUser string, uri *url.
 		log.Errorf(ctx, "error fetching remote emojis for account %s: %v", uri, err)
 	}
 
+	// Keep any followers / following counts we already knew
+	// about; the latest counts are fetched async further down.
+	latestAcc.FollowersCount = account.FollowersCount
+	latestAcc.FollowingCount = account.FollowingCount
+
 	if account.CreatedAt.IsZero() {
 		// CreatedAt will be zero if no local copy was
 		// found in one of the GetAccountBy___() functions.
@@ -469,6 +474,10 @@ func (d *deref) enrichAccount(ctx context.Context, requestUser string, uri *url.
 		}
 	}
 
+	// Fetching the collection counts takes a few more remote
+	// calls, which we don't want to hold up the caller with.
+	d.fetchRemoteAccountCollectionCountsAsync(ctx, requestUser, latestAcc)
+
 	return latestAcc, apubAcc, nil
 }
 
@@ -764,6 +773,174 @@ func (d *deref) fetchRemoteAccountEmojis(ctx context.Context, targetAccount *gts
 	return changed, nil
 }
 
+// fetchRemoteAccountCollectionCountsAsync enqueues a worker function to update the
+// followers and following counts of the given remote account in the database, from
+// the totalItems of its remote collections. Since the account is only enriched when
+// it's stale, the counts are only refetched along with the rest of the account.
+func (d *deref) fetchRemoteAccountCollectionCountsAsync(ctx context.Context, requestUser string, account *gtsmodel.Account) {
+	if account.FollowersURI == "" && account.FollowingURI == "" {
+		// Nothing to fetch.
+		return
+	}
+
+	accountID := account.ID
+
+	d.state.Workers.Federator.MustEnqueueCtx(ctx, func(ctx context.Context) {
+		// Fetch latest barebones account model, as the given
+		// account may still be in use by the calling goroutine.
+		account, err := d.state.DB.GetAccountByID(gtscontext.SetBarebones(ctx), accountID)
+		if err != nil {
+			log.Errorf(ctx, "error getting account %s: %v", accountID, err)
+			return
+		}
+
+		tsport, err := d.transportController.NewTransportForUsername(ctx, requestUser)
+		if err != nil {
+			log.Errorf(ctx, "couldn't create transport: %v", err)
+			return
+		}
+
+		followersCount := account.FollowersCount
+		followingCount := account.FollowingCount
+		d.fetchRemoteAccountCollectionCounts(ctx, tsport, requestUser, account)
+
+		if account.FollowersCount == followersCount &&
+			account.FollowingCount == followingCount {
+			// Neither count could be fetched.
+			return
+		}
+
+		if err := d.state.DB.UpdateAccount(ctx, account, "followers_count", "following_count"); err != nil {
+			log.Errorf(ctx, "error updating counts for account %s: %v", account.URI, err)
+		}
+	})
+}
+
+// fetchRemoteAccountCollectionCounts sets the followers and following counts of
+// the given remote account from the totalItems of its remote collections. Errors
+// are only logged, since the counts are informational and may well be hidden.
+func (d *deref) fetchRemoteAccountCollectionCounts(ctx context.Context, tsport transport.Transport, requestUser string, account *gtsmodel.Account) {
+	if account.FollowersURI != "" {
+		count, err := d.dereferenceCollectionTotalItems(ctx, tsport, requestUser, account.FollowersURI)
+		if err != nil {
+			log.Debugf(ctx, "couldn't get followers count for account %s: %v", account.URI, err)
+		} else {
+			account.FollowersCount = &count
+		}
+	}
+
+	if account.FollowingURI != "" {
+		count, err := d.dereferenceCollectionTotalItems(ctx, tsport, requestUser, account.FollowingURI)
+		if err != nil {
+			log.Debugf(ctx, "couldn't get following count for account %s: %v", account.URI, err)
+		} else {
+			account.FollowingCount = &count
+		}
+	}
+}
+
+// withTotalItems is implemented by both
+// (Ordered)Collections and (Ordered)CollectionPages.
+type withTotalItems interface {
+	GetActivityStreamsTotalItems() vocab.ActivityStreamsTotalItemsProperty
+}
+
+// dereferenceCollectionTotalItems dereferences the (Ordered)Collection at the given
+// uri and returns its totalItems. If the collection itself doesn't give totalItems,
+// then the collection's first page is checked instead, since some implementations
+// only give the count on the page.
+func (d *deref) dereferenceCollectionTotalItems(ctx context.Context, tsport transport.Transport, requestUser string, uri string) (int, error) {
+	collectionIRI, err := url.Parse(uri)
+	if err != nil {
+		return 0, gtserror.Newf("invalid collection uri %s: %w", uri, err)
+	}
+
+	if blocked, err := d.state.DB.IsDomainBlocked(ctx, collectionIRI.Host); err != nil {
+		return 0, gtserror.Newf("error checking blocked domain: %w", err)
+	} else if blocked {
+		return 0, gtserror.Newf("domain %s is blocked", collectionIRI.Host)
+	}
+
+	b, err := tsport.Dereference(ctx, collectionIRI)
+	if err != nil {
+		return 0, err
+	}
+
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(b, &m); err != nil {
+		return 0, gtserror.Newf("error unmarshalling bytes into json: %w", err)
+	}
+
+	t, err := streams.ToType(ctx, m)
+	if err != nil {
+		return 0, gtserror.Newf("error resolving json into ap vocab type: %w", err)
+	}
+
+	var first vocab.ActivityStreamsFirstProperty
+
+	switch t.GetTypeName() {
+	case ap.ObjectCollection:
+		collection, ok := t.(vocab.ActivityStreamsCollection)
+		if !ok {
+			return 0, gtserror.New("couldn't coerce Collection")
+		}
+		if count, ok := totalItems(collection); ok {
+			return count, nil
+		}
+		first = collection.GetActivityStreamsFirst()
+
+	case ap.ObjectOrderedCollection:
+		collection, ok := t.(vocab.ActivityStreamsOrderedCollection)
+		if !ok {
+			return 0, gtserror.New("couldn't coerce OrderedCollection")
+		}
+		if count, ok := totalItems(collection); ok {
+			return count, nil
+		}
+		first = collection.GetActivityStreamsFirst()
+
+	default:
+		return 0, gtserror.Newf("%s was not a Collection or OrderedCollection", uri)
+	}
+
+	if first == nil {
+		return 0, gtserror.Newf("%s had no totalItems and no first page", uri)
+	}
+
+	if page, ok := first.GetType().(withTotalItems); ok {
+		// First page was embedded.
+		if count, ok := totalItems(page); ok {
+			return count, nil
+		}
+	}
+
+	if first.IsIRI() {
+		// First page needs dereferencing.
+		page, err := d.dereferenceCollectionPage(ctx, requestUser, first.GetIRI())
+		if err != nil {
+			return 0, err
+		}
+
+		if page, ok := page.(withTotalItems); ok {
+			if count, ok := totalItems(page); ok {
+				return count, nil
+			}
+		}
+	}
+
+	return 0, gtserror.Newf("no totalItems found for %s", uri)
+}
+
+// totalItems returns the value of the
+// given collection's totalItems, if set.
+func totalItems(collection withTotalItems) (int, bool) {
+	prop := collection.GetActivityStreamsTotalItems()
+	if prop == nil || !prop.IsXMLSchemaNonNegativeInteger() {
+		return 0, false
+	}
+	return prop.Get(), true
+}
+
 // dereferenceAccountFeatured dereferences an account's featuredCollectionURI (if not empty). For each discovered status, this status will
 // be dereferenced (if necessary) and marked as pinned (if necessary). Then, old pins will be removed if they're not included in new pins.
 func (d *deref) dereferenceAccountFeatured(ctx context.Context, requestUser string, account *gtsmodel.Account) error {
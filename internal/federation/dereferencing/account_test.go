@@ -19,6 +19,9 @@ package dereferencing_test
 
 import (
 	"context"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -26,7 +29,9 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/federation/dereferencing"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -207,6 +212,81 @@ func (suite *AccountTestSuite) TestDereferenceLocalAccountWithUnknownUserURI() {
 	suite.Nil(fetchedAccount)
 }
 
+func (suite *AccountTestSuite) TestDereferenceAccountCollectionCounts() {
+	fetchingAccount := suite.testAccounts["local_account_1"]
+
+	// Serve followers with totalItems on the collection,
+	// and following with totalItems only on the first page.
+	collections := map[string]string{
+		"https://unknown-instance.com/users/brand_new_person/followers": `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://unknown-instance.com/users/brand_new_person/followers",
+  "type": "OrderedCollection",
+  "totalItems": 420,
+  "first": "https://unknown-instance.com/users/brand_new_person/followers?page=1"
+}`,
+		"https://unknown-instance.com/users/brand_new_person/following": `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://unknown-instance.com/users/brand_new_person/following",
+  "type": "OrderedCollection",
+  "first": "https://unknown-instance.com/users/brand_new_person/following?page=1"
+}`,
+		"https://unknown-instance.com/users/brand_new_person/following?page=1": `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://unknown-instance.com/users/brand_new_person/following?page=1",
+  "type": "OrderedCollectionPage",
+  "partOf": "https://unknown-instance.com/users/brand_new_person/following",
+  "totalItems": 69,
+  "orderedItems": []
+}`,
+	}
+
+	baseClient := testrig.NewMockHTTPClient(nil, "../../../testrig/media")
+	httpClient := testrig.NewMockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		body, ok := collections[req.URL.String()]
+		if !ok {
+			return baseClient.Do(req)
+		}
+
+		return &http.Response{
+			Request:       req,
+			StatusCode:    http.StatusOK,
+			Body:          io.NopCloser(strings.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Header: http.Header{
+				"content-type": {"application/activity+json"},
+			},
+		}, nil
+	}, "../../../testrig/media")
+
+	dereferencer := dereferencing.NewDereferencer(
+		&suite.state,
+		testrig.NewTestTypeConverter(suite.db),
+		testrig.NewTestTransportController(&suite.state, httpClient),
+		testrig.NewTestMediaManager(&suite.state),
+	)
+
+	account, _, err := dereferencer.GetAccountByURI(
+		context.Background(),
+		fetchingAccount.Username,
+		testrig.URLMustParse("https://unknown-instance.com/users/brand_new_person"),
+	)
+	suite.NoError(err)
+
+	// Counts are fetched async, and
+	// should end up in the database.
+	var dbAccount *gtsmodel.Account
+	if !testrig.WaitFor(func() bool {
+		dbAccount, err = suite.db.GetAccountByID(context.Background(), account.ID)
+		return err == nil && dbAccount.FollowersCount != nil && dbAccount.FollowingCount != nil
+	}) {
+		suite.FailNow("timed out waiting for account counts")
+	}
+
+	suite.Equal(420, *dbAccount.FollowersCount)
+	suite.Equal(69, *dbAccount.FollowingCount)
+}
+
 func TestAccountTestSuite(t *testing.T) {
 	suite.Run(t, new(AccountTestSuite))
 }
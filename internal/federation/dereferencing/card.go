@@ -0,0 +1,376 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dereferencing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"mime"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+const (
+	// cardFetchLimit is the maximum number of preview
+	// card fetches permitted to a single link domain
+	// within each cardFetchWindow; beyond this, links
+	// to the domain are left without a preview card.
+	cardFetchLimit  = 5
+	cardFetchWindow = time.Minute
+)
+
+// cardLimiter limits preview card fetches per link domain.
+type cardLimiter struct {
+	windows map[string]*cardWindow
+	mu      sync.Mutex
+}
+
+// cardWindow counts the fetches to a
+// link domain within the current window.
+type cardWindow struct {
+	start time.Time
+	count int
+}
+
+// allow returns whether a preview card fetch to
+// domain is permitted at given time, counting it if so.
+func (l *cardLimiter) allow(domain string, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.windows == nil {
+		l.windows = make(map[string]*cardWindow)
+	}
+
+	w, ok := l.windows[domain]
+	if ok && now.Sub(w.start) < cardFetchWindow {
+		if w.count >= cardFetchLimit {
+			return false
+		}
+		w.count++
+		return true
+	}
+
+	// Start a new window for this domain,
+	// first dropping any expired windows.
+	for d, w := range l.windows {
+		if now.Sub(w.start) >= cardFetchWindow {
+			delete(l.windows, d)
+		}
+	}
+
+	l.windows[domain] = &cardWindow{start: now, count: 1}
+	return true
+}
+
+func (d *deref) FetchStatusCardAsync(ctx context.Context, status *gtsmodel.Status) {
+	if status.CardID != "" || status.BoostOfID != "" {
+		// Already has a card, or is a boost
+		// (whose original status has the card).
+		return
+	}
+
+	link := firstCardLink(status.Content)
+	if link == nil {
+		// Nothing to
+		// preview.
+		return
+	}
+
+	statusID := status.ID
+
+	// Enqueue a worker function to fetch this status' card async.
+	d.state.Workers.Federator.MustEnqueueCtx(ctx, func(ctx context.Context) {
+		// Fetch latest barebones status model, as the given
+		// status may still be in use by the calling goroutine.
+		status, err := d.state.DB.GetStatusByID(gtscontext.SetBarebones(ctx), statusID)
+		if err != nil {
+			log.Errorf(ctx, "error getting status %s: %v", statusID, err)
+			return
+		}
+
+		if err := d.fetchStatusCard(ctx, status, link); err != nil {
+			log.Errorf(ctx, "error fetching card for status %s: %v", statusID, err)
+		}
+	})
+}
+
+func (d *deref) FetchStatusCard(ctx context.Context, status *gtsmodel.Status) error {
+	if status.CardID != "" || status.BoostOfID != "" {
+		return nil
+	}
+
+	link := firstCardLink(status.Content)
+	if link == nil {
+		return nil
+	}
+
+	return d.fetchStatusCard(ctx, status, link)
+}
+
+// fetchStatusCard fetches (or reuses an existing) preview card for link,
+// and attaches it to the given status, updating the status in the database.
+func (d *deref) fetchStatusCard(ctx context.Context, status *gtsmodel.Status, link *url.URL) error {
+	card, err := d.getCard(ctx, link)
+	if err != nil {
+		return err
+	}
+
+	if card == nil {
+		// No card could
+		// be generated.
+		return nil
+	}
+
+	// Attach card to the status.
+	status.CardID = card.ID
+	status.Card = card
+
+	if err := d.state.DB.UpdateStatus(ctx, status, "card_id"); err != nil {
+		return gtserror.Newf("error updating status: %w", err)
+	}
+
+	// Uncache the prepared version of this status
+	// from all timelines, so that it gets the card.
+	if err := d.state.Timelines.Home.UnprepareItemFromAllTimelines(ctx, status.ID); err != nil {
+		log.Errorf(ctx, "error unpreparing status from home timelines: %v", err)
+	}
+
+	if err := d.state.Timelines.List.UnprepareItemFromAllTimelines(ctx, status.ID); err != nil {
+		log.Errorf(ctx, "error unpreparing status from list timelines: %v", err)
+	}
+
+	return nil
+}
+
+// getCard returns the preview card for link, either from the database or by
+// dereferencing it. A nil card is returned if link's domain is blocked, fetches
+// to it are currently rate-limited, or no card could be generated from it.
+func (d *deref) getCard(ctx context.Context, link *url.URL) (*gtsmodel.Card, error) {
+	// Check for an existing card for this link.
+	card, err := d.state.DB.GetCardByURL(ctx, link.String())
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("error getting card from db: %w", err)
+	}
+
+	if card != nil {
+		// Already got it.
+		return card, nil
+	}
+
+	if blocked, err := d.state.DB.IsURIBlocked(ctx, link); err != nil {
+		return nil, gtserror.Newf("error checking domain block: %w", err)
+	} else if blocked {
+		log.Debugf(ctx, "not fetching card for link to blocked domain %s", link.Host)
+		return nil, nil
+	}
+
+	if !d.cardLimiter.allow(strings.ToLower(link.Hostname()), time.Now()) {
+		log.Debugf(ctx, "not fetching card for link to rate-limited domain %s", link.Host)
+		return nil, nil
+	}
+
+	b, contentType, err := d.transportController.DereferenceLink(ctx, link, "text/html,application/xhtml+xml")
+	if err != nil {
+		return nil, gtserror.Newf("error dereferencing %s: %w", link, err)
+	}
+
+	if mt, _, _ := mime.ParseMediaType(contentType); // nocollapse
+	mt != "text/html" && mt != "application/xhtml+xml" {
+		log.Debugf(ctx, "not generating card for link %s of content-type %s", link, contentType)
+		return nil, nil
+	}
+
+	meta := parseLinkMeta(bytes.NewReader(b), link)
+	card, thumbnail := meta.toCard(link)
+
+	if meta.oEmbedURL != "" {
+		// Enrich card with oEmbed data, if possible.
+		card, thumbnail = d.applyOEmbed(ctx, meta.oEmbedURL, link, card, thumbnail)
+	}
+
+	if card == nil {
+		// No card could
+		// be generated.
+		return nil, nil
+	}
+
+	card.ID = id.NewULID()
+
+	if thumbnail != "" {
+		// Cache card thumbnail; failure to do so isn't fatal.
+		card.ImageID, err = d.getCardImage(ctx, thumbnail, card.Title)
+		if err != nil {
+			log.Errorf(ctx, "error fetching card thumbnail %s: %v", thumbnail, err)
+		}
+	}
+
+	if err := d.state.DB.PutCard(ctx, card); err != nil {
+		if !errors.Is(err, db.ErrAlreadyExists) {
+			return nil, gtserror.Newf("error putting card in db: %w", err)
+		}
+
+		// Card for this link was created in the meantime, use that.
+		return d.state.DB.GetCardByURL(ctx, link.String())
+	}
+
+	return card, nil
+}
+
+// applyOEmbed fetches the oEmbed representation of link at oEmbedURL, and applies it
+// to card (which may be nil), returning the card and thumbnail. On failure, the given
+// card and thumbnail are returned unchanged.
+func (d *deref) applyOEmbed(ctx context.Context, oEmbedURL string, link *url.URL, card *gtsmodel.Card, thumbnail string) (*gtsmodel.Card, string) {
+	uri, err := url.Parse(oEmbedURL)
+	if err != nil {
+		return card, thumbnail
+	}
+
+	if blocked, err := d.state.DB.IsURIBlocked(ctx, uri); err != nil || blocked {
+		return card, thumbnail
+	}
+
+	b, _, err := d.transportController.DereferenceLink(ctx, uri, "application/json")
+	if err != nil {
+		log.Debugf(ctx, "error dereferencing oembed %s: %v", oEmbedURL, err)
+		return card, thumbnail
+	}
+
+	o, err := parseOEmbed(b)
+	if err != nil {
+		log.Debugf(ctx, "error parsing oembed %s: %v", oEmbedURL, err)
+		return card, thumbnail
+	}
+
+	card, oThumbnail := o.applyTo(card, link)
+	if thumbnail == "" {
+		thumbnail = oThumbnail
+	}
+
+	return card, thumbnail
+}
+
+// getCardImage caches the card thumbnail at given
+// URL as a media attachment owned by the instance
+// account, returning the new attachment ID.
+func (d *deref) getCardImage(ctx context.Context, thumbnail string, description string) (string, error) {
+	uri, err := url.Parse(thumbnail)
+	if err != nil {
+		return "", gtserror.Newf("error parsing url: %w", err)
+	}
+
+	if blocked, err := d.state.DB.IsURIBlocked(ctx, uri); err != nil {
+		return "", gtserror.Newf("error checking domain block: %w", err)
+	} else if blocked {
+		return "", nil
+	}
+
+	instanceAcc, err := d.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		return "", gtserror.Newf("error getting instance account: %w", err)
+	}
+
+	processing, err := d.GetRemoteMedia(ctx, "", instanceAcc.ID, thumbnail, &media.AdditionalMediaInfo{
+		RemoteURL:   &thumbnail,
+		Description: &description,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	attachment, err := processing.LoadAttachment(ctx)
+	if err != nil {
+		return "", gtserror.Newf("error loading attachment: %w", err)
+	}
+
+	return attachment.ID, nil
+}
+
+// firstCardLink returns the first http(s) link in the given
+// status HTML content which is not a mention or a hashtag.
+func firstCardLink(content string) *url.URL {
+	tokenizer := html.NewTokenizer(strings.NewReader(content))
+
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return nil
+
+		case html.StartTagToken:
+			name, hasAttr := tokenizer.TagName()
+			if atom.Lookup(name) != atom.A || !hasAttr {
+				continue
+			}
+
+			attrs := attrs(tokenizer)
+
+			// Skip mentions and hashtags, as marked
+			// up by us and most other AP software.
+			if hasToken(attrs["class"], "mention") ||
+				hasToken(attrs["class"], "hashtag") ||
+				hasToken(attrs["rel"], "tag") {
+				continue
+			}
+
+			// Also skip links whose text looks like a mention
+			// or hashtag, for software without the above markup.
+			if tokenizer.Next() == html.TextToken {
+				text := strings.TrimSpace(string(tokenizer.Text()))
+				if strings.HasPrefix(text, "@") || strings.HasPrefix(text, "#") {
+					continue
+				}
+			}
+
+			link, err := url.Parse(attrs["href"])
+			if err != nil ||
+				(link.Scheme != "http" && link.Scheme != "https") ||
+				link.Host == "" {
+				continue
+			}
+
+			// Drop any fragment, it's
+			// irrelevant to the card.
+			link.Fragment = ""
+
+			return link
+		}
+	}
+}
+
+// hasToken returns whether space-separated list contains token.
+func hasToken(list string, token string) bool {
+	for _, t := range strings.Fields(list) {
+		if strings.EqualFold(t, token) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,313 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dereferencing
+
+import (
+	"encoding/json"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// linkMeta contains preview card metadata
+// parsed from the head of a linked HTML document.
+type linkMeta struct {
+	// OpenGraph / Twitter card / plain HTML
+	// values, keyed by property / name.
+	props map[string]string
+
+	// Text of the document <title>.
+	title string
+
+	// Location of the oEmbed JSON
+	// representation of the document.
+	oEmbedURL string
+}
+
+// parseLinkMeta parses OpenGraph, Twitter card and plain HTML metadata from
+// the head of the HTML document in r, resolving any URLs relative to base.
+func parseLinkMeta(r io.Reader, base *url.URL) *linkMeta {
+	meta := &linkMeta{props: make(map[string]string)}
+	tokenizer := html.NewTokenizer(r)
+
+	for inTitle := false; ; {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			// Either EOF or malformed
+			// document, we're done.
+			return meta
+
+		case html.TextToken:
+			if inTitle && meta.title == "" {
+				meta.title = strings.TrimSpace(string(tokenizer.Text()))
+			}
+
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+			switch atom.Lookup(name) {
+			case atom.Title:
+				inTitle = false
+			case atom.Head:
+				// Metadata is only in the head.
+				return meta
+			}
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := tokenizer.TagName()
+			switch atom.Lookup(name) {
+			case atom.Body:
+				// Metadata is only in the head.
+				return meta
+			case atom.Title:
+				inTitle = true
+			case atom.Meta:
+				if hasAttr {
+					meta.parseMeta(attrs(tokenizer))
+				}
+			case atom.Link:
+				if hasAttr {
+					meta.parseLink(attrs(tokenizer), base)
+				}
+			}
+		}
+	}
+}
+
+// attrs returns the attributes of the
+// tokenizer's current tag, with lowercase keys.
+func attrs(tokenizer *html.Tokenizer) map[string]string {
+	attrs := make(map[string]string)
+	for {
+		key, val, more := tokenizer.TagAttr()
+		attrs[strings.ToLower(string(key))] = string(val)
+		if !more {
+			return attrs
+		}
+	}
+}
+
+// parseMeta stores the content of a <meta> tag with the given attributes.
+func (m *linkMeta) parseMeta(attrs map[string]string) {
+	// OpenGraph uses "property", Twitter
+	// cards and plain HTML use "name".
+	key := attrs["property"]
+	if key == "" {
+		key = attrs["name"]
+	}
+
+	key = strings.ToLower(strings.TrimSpace(key))
+	content := strings.TrimSpace(attrs["content"])
+	if key == "" || content == "" {
+		return
+	}
+
+	if _, ok := m.props[key]; !ok {
+		// Only take the first value of
+		// each, e.g. of multiple og:image.
+		m.props[key] = content
+	}
+}
+
+// parseLink stores the oEmbed discovery URL from a <link> tag with the given attributes.
+func (m *linkMeta) parseLink(attrs map[string]string, base *url.URL) {
+	if m.oEmbedURL != "" ||
+		!strings.EqualFold(attrs["rel"], "alternate") ||
+		!strings.EqualFold(attrs["type"], "application/json+oembed") {
+		return
+	}
+
+	m.oEmbedURL = resolveURL(base, attrs["href"])
+}
+
+// first returns the first non-empty property value for the given keys.
+func (m *linkMeta) first(keys ...string) string {
+	for _, key := range keys {
+		if v := m.props[key]; v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// toCard converts the parsed metadata to a preview card for link, with
+// OpenGraph values taking precedence over Twitter card values, which in
+// turn take precedence over plain HTML values. The returned card is not
+// yet given an ID. The thumbnail URL is returned, if any, and a nil card
+// if no title could be found for it.
+func (m *linkMeta) toCard(link *url.URL) (*gtsmodel.Card, string) {
+	title := m.first("og:title", "twitter:title")
+	if title == "" {
+		title = m.title
+	}
+
+	if title == "" {
+		// Not much of
+		// a preview...
+		return nil, ""
+	}
+
+	card := &gtsmodel.Card{
+		URL:          link.String(),
+		Title:        text.SanitizePlaintext(title),
+		Description:  text.SanitizePlaintext(m.first("og:description", "twitter:description", "description")),
+		Type:         gtsmodel.CardTypeLink,
+		AuthorName:   text.SanitizePlaintext(m.first("author", "article:author")),
+		ProviderName: text.SanitizePlaintext(m.first("og:site_name", "application-name")),
+	}
+
+	thumbnail := resolveURL(link, m.first(
+		"og:image:secure_url",
+		"og:image:url",
+		"og:image",
+		"twitter:image",
+		"twitter:image:src",
+	))
+
+	if thumbnail != "" {
+		card.Width = atoi(m.props["og:image:width"])
+		card.Height = atoi(m.props["og:image:height"])
+	}
+
+	return card, thumbnail
+}
+
+// oEmbed models the fields of an oEmbed (https://oembed.com/)
+// response that we're interested in for preview cards.
+type oEmbed struct {
+	Type         string    `json:"type"`
+	Title        string    `json:"title"`
+	AuthorName   string    `json:"author_name"`
+	AuthorURL    string    `json:"author_url"`
+	ProviderName string    `json:"provider_name"`
+	ProviderURL  string    `json:"provider_url"`
+	HTML         string    `json:"html"`
+	URL          string    `json:"url"`
+	Width        oEmbedInt `json:"width"`
+	Height       oEmbedInt `json:"height"`
+	ThumbnailURL string    `json:"thumbnail_url"`
+}
+
+// oEmbedInt is an int that unmarshals from both
+// JSON numbers and strings, as providers vary.
+type oEmbedInt int
+
+func (i *oEmbedInt) UnmarshalJSON(b []byte) error {
+	*i = oEmbedInt(atoi(strings.Trim(string(b), `"`)))
+	return nil
+}
+
+// parseOEmbed parses an oEmbed JSON response from the given bytes.
+func parseOEmbed(b []byte) (*oEmbed, error) {
+	var o oEmbed
+	if err := json.Unmarshal(b, &o); err != nil {
+		return nil, err
+	}
+	return &o, nil
+}
+
+// applyTo applies the oEmbed values to the given card, which may be nil in the
+// case that no card could be generated from the linked document alone. The
+// thumbnail URL is returned, if any, and a nil card if none could be generated.
+func (o *oEmbed) applyTo(card *gtsmodel.Card, link *url.URL) (*gtsmodel.Card, string) {
+	if card == nil {
+		if o.Title == "" {
+			return nil, ""
+		}
+		card = &gtsmodel.Card{
+			URL:  link.String(),
+			Type: gtsmodel.CardTypeLink,
+		}
+	}
+
+	if o.Title != "" {
+		card.Title = text.SanitizePlaintext(o.Title)
+	}
+
+	if o.AuthorName != "" {
+		card.AuthorName = text.SanitizePlaintext(o.AuthorName)
+		card.AuthorURL = resolveURL(link, o.AuthorURL)
+	}
+
+	if o.ProviderName != "" {
+		card.ProviderName = text.SanitizePlaintext(o.ProviderName)
+		card.ProviderURL = resolveURL(link, o.ProviderURL)
+	}
+
+	thumbnail := resolveURL(link, o.ThumbnailURL)
+
+	switch gtsmodel.CardType(o.Type) {
+	case gtsmodel.CardTypePhoto:
+		embedURL := resolveURL(link, o.URL)
+		if embedURL == "" {
+			break
+		}
+		card.Type = gtsmodel.CardTypePhoto
+		card.EmbedURL = embedURL
+		card.Width = int(o.Width)
+		card.Height = int(o.Height)
+		if thumbnail == "" {
+			thumbnail = embedURL
+		}
+
+	case gtsmodel.CardTypeVideo, gtsmodel.CardTypeRich:
+		html := text.SanitizeEmbedHTML(o.HTML)
+		if html == "" {
+			break
+		}
+		card.Type = gtsmodel.CardType(o.Type)
+		card.HTML = html
+		card.Width = int(o.Width)
+		card.Height = int(o.Height)
+	}
+
+	return card, thumbnail
+}
+
+// resolveURL resolves the given reference against base,
+// returning it only if it's a valid absolute http(s) URL.
+func resolveURL(base *url.URL, ref string) string {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return ""
+	}
+
+	u, err := base.Parse(ref)
+	if err != nil ||
+		(u.Scheme != "http" && u.Scheme != "https") ||
+		u.Host == "" {
+		return ""
+	}
+
+	return u.String()
+}
+
+// atoi parses a non-negative int
+// from s, returning 0 on failure.
+func atoi(s string) int {
+	i, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil || i < 0 {
+		return 0
+	}
+	return i
+}
@@ -0,0 +1,186 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dereferencing
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type CardMetaTestSuite struct {
+	suite.Suite
+}
+
+func (suite *CardMetaTestSuite) TestParseLinkMetaOpenGraph() {
+	const doc = `<!DOCTYPE html>
+<html>
+<head>
+	<title>Fallback title</title>
+	<meta name="description" content="Fallback description">
+	<meta name="twitter:title" content="Twitter title">
+	<meta property="og:title" content="Is Water Wet?">
+	<meta property="og:description" content="We&#39;re not sure. We asked an expert.">
+	<meta property="og:site_name" content="Example News">
+	<meta property="og:image" content="/images/water.jpg">
+	<meta property="og:image" content="/images/second.jpg">
+	<meta property="og:image:width" content="1200">
+	<meta property="og:image:height" content="630">
+	<link rel="alternate" type="application/json+oembed" href="/oembed?url=https%3A%2F%2Fexample.org%2Fwater">
+</head>
+<body>
+	<meta property="og:title" content="Not in the head!">
+</body>
+</html>`
+
+	link, _ := url.Parse("https://example.org/articles/water")
+	meta := parseLinkMeta(strings.NewReader(doc), link)
+	suite.Equal("https://example.org/oembed?url=https%3A%2F%2Fexample.org%2Fwater", meta.oEmbedURL)
+
+	card, thumbnail := meta.toCard(link)
+	suite.NotNil(card)
+	suite.Equal("https://example.org/articles/water", card.URL)
+	suite.Equal("Is Water Wet?", card.Title)
+	suite.Equal("We're not sure. We asked an expert.", card.Description)
+	suite.Equal("Example News", card.ProviderName)
+	suite.Equal(gtsmodel.CardTypeLink, card.Type)
+	suite.Equal(1200, card.Width)
+	suite.Equal(630, card.Height)
+	suite.Equal("https://example.org/images/water.jpg", thumbnail)
+}
+
+func (suite *CardMetaTestSuite) TestParseLinkMetaFallbacks() {
+	const doc = `<html><head>
+	<title> Just a title </title>
+	<meta name="twitter:description" content="Twitter description">
+	<meta name="twitter:image" content="javascript:alert(1)">
+</head></html>`
+
+	link, _ := url.Parse("https://example.org/page")
+	card, thumbnail := parseLinkMeta(strings.NewReader(doc), link).toCard(link)
+	suite.NotNil(card)
+	suite.Equal("Just a title", card.Title)
+	suite.Equal("Twitter description", card.Description)
+	suite.Empty(thumbnail)
+	suite.Zero(card.Width)
+}
+
+func (suite *CardMetaTestSuite) TestParseLinkMetaNoTitle() {
+	link, _ := url.Parse("https://example.org/page")
+	card, _ := parseLinkMeta(strings.NewReader(`<html><head></head><body><title>nope</title></body></html>`), link).toCard(link)
+	suite.Nil(card)
+}
+
+func (suite *CardMetaTestSuite) TestOEmbedVideo() {
+	o, err := parseOEmbed([]byte(`{
+		"type": "video",
+		"version": "1.0",
+		"title": "A video",
+		"author_name": "Some Author",
+		"author_url": "https://video.example.org/@author",
+		"provider_name": "Example Video",
+		"provider_url": "https://video.example.org/",
+		"html": "<iframe src=\"https://video.example.org/embed/1\" width=\"560\" height=\"315\" onload=\"alert(1)\"></iframe><script>alert(1)</script>",
+		"width": "560",
+		"height": 315,
+		"thumbnail_url": "https://video.example.org/thumb/1.jpg"
+	}`))
+	suite.NoError(err)
+
+	link, _ := url.Parse("https://video.example.org/watch/1")
+	card, thumbnail := o.applyTo(nil, link)
+	suite.NotNil(card)
+	suite.Equal(gtsmodel.CardTypeVideo, card.Type)
+	suite.Equal("A video", card.Title)
+	suite.Equal("Some Author", card.AuthorName)
+	suite.Equal("https://video.example.org/@author", card.AuthorURL)
+	suite.Equal("Example Video", card.ProviderName)
+	suite.Equal(560, card.Width)
+	suite.Equal(315, card.Height)
+	suite.Equal(`<iframe src="https://video.example.org/embed/1" width="560" height="315"></iframe>`, card.HTML)
+	suite.Equal("https://video.example.org/thumb/1.jpg", thumbnail)
+}
+
+func (suite *CardMetaTestSuite) TestOEmbedPhoto() {
+	o, err := parseOEmbed([]byte(`{"type":"photo","url":"https://photos.example.org/1.png","width":640,"height":480}`))
+	suite.NoError(err)
+
+	link, _ := url.Parse("https://photos.example.org/view/1")
+	card := &gtsmodel.Card{URL: link.String(), Title: "A photo", Type: gtsmodel.CardTypeLink}
+	card, thumbnail := o.applyTo(card, link)
+	suite.Equal(gtsmodel.CardTypePhoto, card.Type)
+	suite.Equal("A photo", card.Title)
+	suite.Equal("https://photos.example.org/1.png", card.EmbedURL)
+	suite.Equal("https://photos.example.org/1.png", thumbnail)
+	suite.Equal(640, card.Width)
+}
+
+func (suite *CardMetaTestSuite) TestFirstCardLink() {
+	for _, test := range []struct {
+		content string
+		expect  string
+	}{
+		{
+			content: `<p>hi <span class="h-card"><a href="http://localhost:8080/@the_mighty_zork" class="u-url mention">@<span>the_mighty_zork</span></a></span> look at <a href="http://localhost:8080/tags/water" class="mention hashtag" rel="tag">#<span>water</span></a>: <a href="https://example.org/articles/water#section" rel="nofollow noreferrer noopener" target="_blank">https://example.org/articles/water</a> and <a href="https://example.org/second">second</a></p>`,
+			expect:  "https://example.org/articles/water",
+		},
+		{
+			content: `<p><a href="https://example.org/@someone">@someone</a> <a href="https://example.org/tags/thing">#thing</a> <a href="mailto:someone@example.org">mail</a></p>`,
+			expect:  "",
+		},
+		{
+			content: `just some text, no links`,
+			expect:  "",
+		},
+	} {
+		link := firstCardLink(test.content)
+		if test.expect == "" {
+			suite.Nil(link)
+			continue
+		}
+		if suite.NotNil(link) {
+			suite.Equal(test.expect, link.String())
+		}
+	}
+}
+
+func (suite *CardMetaTestSuite) TestCardLimiter() {
+	var (
+		limiter cardLimiter
+		now     = time.Now()
+	)
+
+	for i := 0; i < cardFetchLimit; i++ {
+		suite.True(limiter.allow("example.org", now))
+	}
+
+	// Limit reached for this domain, but not others.
+	suite.False(limiter.allow("example.org", now))
+	suite.True(limiter.allow("example.com", now))
+
+	// Allowed again once the window has passed.
+	suite.True(limiter.allow("example.org", now.Add(cardFetchWindow)))
+}
+
+func TestCardMetaTestSuite(t *testing.T) {
+	suite.Run(t, &CardMetaTestSuite{})
+}
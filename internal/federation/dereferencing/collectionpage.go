@@ -55,14 +55,55 @@ func (d *deref) dereferenceCollectionPage(ctx context.Context, username string,
 		return nil, fmt.Errorf("DereferenceCollectionPage: error resolving json into ap vocab type: %s", err)
 	}
 
-	if t.GetTypeName() != ap.ObjectCollectionPage {
+	switch t.GetTypeName() {
+	case ap.ObjectCollectionPage:
+		p, ok := t.(vocab.ActivityStreamsCollectionPage)
+		if !ok {
+			return nil, errors.New("DereferenceCollectionPage: error resolving type as activitystreams collection page")
+		}
+		return p, nil
+
+	case ap.ObjectOrderedCollectionPage:
+		p, ok := t.(vocab.ActivityStreamsOrderedCollectionPage)
+		if !ok {
+			return nil, errors.New("DereferenceCollectionPage: error resolving type as activitystreams ordered collection page")
+		}
+		return orderedToCollectionPage(p)
+
+	default:
 		return nil, fmt.Errorf("DereferenceCollectionPage: type name %s not supported", t.GetTypeName())
 	}
+}
+
+// orderedToCollectionPage converts the given OrderedCollectionPage into a
+// regular CollectionPage, moving orderedItems across to items, so that callers
+// can treat both kinds of page (eg., Mastodon replies vs followers) the same way.
+func orderedToCollectionPage(ordered vocab.ActivityStreamsOrderedCollectionPage) (vocab.ActivityStreamsCollectionPage, error) {
+	page := streams.NewActivityStreamsCollectionPage()
+	page.SetJSONLDId(ordered.GetJSONLDId())
+	page.SetActivityStreamsNext(ordered.GetActivityStreamsNext())
+	page.SetActivityStreamsPartOf(ordered.GetActivityStreamsPartOf())
+	page.SetActivityStreamsTotalItems(ordered.GetActivityStreamsTotalItems())
+
+	orderedItems := ordered.GetActivityStreamsOrderedItems()
+	if orderedItems == nil {
+		return page, nil
+	}
+
+	items := streams.NewActivityStreamsItemsProperty()
+	for iter := orderedItems.Begin(); iter != orderedItems.End(); iter = iter.Next() {
+		if iter.IsIRI() {
+			items.AppendIRI(iter.GetIRI())
+			continue
+		}
 
-	p, ok := t.(vocab.ActivityStreamsCollectionPage)
-	if !ok {
-		return nil, errors.New("DereferenceCollectionPage: error resolving type as activitystreams collection page")
+		if t := iter.GetType(); t != nil {
+			if err := items.AppendType(t); err != nil {
+				return nil, fmt.Errorf("DereferenceCollectionPage: error appending item: %w", err)
+			}
+		}
 	}
+	page.SetActivityStreamsItems(items)
 
-	return p, nil
+	return page, nil
 }
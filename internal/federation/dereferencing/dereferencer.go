@@ -72,6 +72,14 @@ type Dereferencer interface {
 	// DereferenceStatusDescendents iterates downwards from the given status, using its replies, to ensure that as many children statuses as possible are dereferenced.
 	DereferenceStatusDescendants(ctx context.Context, requestUser string, statusIRI *url.URL, parent ap.Statusable) error
 
+	// FetchStatusCard fetches a preview card for the first link in the given status (excluding mentions and hashtags), reusing
+	// any existing card for the link, and attaches it to the status. Links to domains blocked by the instance are not fetched,
+	// and fetches are rate-limited per link domain.
+	FetchStatusCard(ctx context.Context, status *gtsmodel.Status) error
+
+	// FetchStatusCardAsync enqueues the given status for asynchronous fetching of its preview card, if it contains a link.
+	FetchStatusCardAsync(ctx context.Context, status *gtsmodel.Status)
+
 	GetRemoteInstance(ctx context.Context, username string, remoteInstanceURI *url.URL) (*gtsmodel.Instance, error)
 
 	DereferenceAnnounce(ctx context.Context, announce *gtsmodel.Status, requestingUsername string) error
@@ -95,7 +103,8 @@ type deref struct {
 	derefEmojis         map[string]*media.ProcessingEmoji
 	derefEmojisMu       mutexes.Mutex
 	handshakes          map[string][]*url.URL
-	handshakesMu        sync.Mutex // mutex to lock/unlock when checking or updating the handshakes map
+	handshakesMu        sync.Mutex  // mutex to lock/unlock when checking or updating the handshakes map
+	cardLimiter         cardLimiter // per-domain rate limiting of preview card fetches
 }
 
 // NewDereferencer returns a Dereferencer initialized with the given parameters.
@@ -33,6 +33,7 @@ import (
 	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 )
@@ -145,11 +146,15 @@ func (f *federatingActor) PostInboxScheme(ctx context.Context, w http.ResponseWr
 	*/
 
 	// Obtain the activity; reject unknown activities.
-	activity, errWithCode := resolveActivity(ctx, r)
+	activity, body, errWithCode := resolveActivity(ctx, r)
 	if errWithCode != nil {
 		return false, errWithCode
 	}
 
+	// Keep the raw body around, in case
+	// we need to forward it on unmodified.
+	ctx = gtscontext.SetActivityBody(ctx, body)
+
 	// Set additional context data. Primarily this means
 	// looking at the Activity and seeing which IRIs are
 	// involved in it tangentially.
@@ -244,45 +249,50 @@ func (f *federatingActor) PostInboxScheme(ctx context.Context, w http.ResponseWr
 
 // resolveActivity is a util function for pulling a
 // pub.Activity type out of an incoming POST request.
-func resolveActivity(ctx context.Context, r *http.Request) (pub.Activity, gtserror.WithCode) {
+// The raw request body is returned alongside it.
+func resolveActivity(ctx context.Context, r *http.Request) (pub.Activity, []byte, gtserror.WithCode) {
 	// Tidy up when done.
 	defer r.Body.Close()
 
 	b, err := io.ReadAll(r.Body)
 	if err != nil {
 		err = fmt.Errorf("error reading request body: %w", err)
-		return nil, gtserror.NewErrorInternalError(err)
+		return nil, nil, gtserror.NewErrorInternalError(err)
 	}
 
 	var rawActivity map[string]interface{}
 	if err := json.Unmarshal(b, &rawActivity); err != nil {
 		err = fmt.Errorf("error unmarshalling request body: %w", err)
-		return nil, gtserror.NewErrorInternalError(err)
+		return nil, nil, gtserror.NewErrorInternalError(err)
 	}
 
+	// Go-fed doesn't know about EmojiReact, so
+	// rewrite any reactions to Likes before parsing.
+	ap.NormalizeIncomingEmojiReact(rawActivity)
+
 	t, err := streams.ToType(ctx, rawActivity)
 	if err != nil {
 		if !streams.IsUnmatchedErr(err) {
 			// Real error.
 			err = fmt.Errorf("error matching json to type: %w", err)
-			return nil, gtserror.NewErrorInternalError(err)
+			return nil, nil, gtserror.NewErrorInternalError(err)
 		}
 
 		// Respond with bad request; we just couldn't
 		// match the type to one that we know about.
 		err = errors.New("body json could not be resolved to ActivityStreams value")
-		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		return nil, nil, gtserror.NewErrorBadRequest(err, err.Error())
 	}
 
 	activity, ok := t.(pub.Activity)
 	if !ok {
 		err = fmt.Errorf("ActivityStreams value with type %T is not a pub.Activity", t)
-		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		return nil, nil, gtserror.NewErrorBadRequest(err, err.Error())
 	}
 
 	if activity.GetJSONLDId() == nil {
 		err = fmt.Errorf("incoming Activity %s did not have required id property set", activity.GetTypeName())
-		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		return nil, nil, gtserror.NewErrorBadRequest(err, err.Error())
 	}
 
 	// If activity Object is a Statusable, we'll want to replace the
@@ -291,7 +301,7 @@ func resolveActivity(ctx context.Context, r *http.Request) (pub.Activity, gtserr
 	// Likewise, if it's an Accountable, we'll normalize some fields on it.
 	ap.NormalizeIncomingActivityObject(activity, rawActivity)
 
-	return activity, nil
+	return activity, b, nil
 }
 
 /*
@@ -216,6 +216,20 @@ func (f *federatingDB) createNote(ctx context.Context, note vocab.ActivityStream
 		return fmt.Errorf("createNote: error converting note to status: %s", err)
 	}
 
+	if status.InReplyTo != nil && *status.InReplyTo.Local {
+		// This is a reply to one of our statuses;
+		// make sure its reply policy permits it.
+		replyable, err := f.filter.StatusReplyable(ctx, requestingAccount, status.InReplyTo)
+		if err != nil {
+			return fmt.Errorf("createNote: error checking replyability of status %s: %w", status.InReplyToID, err)
+		}
+
+		if !replyable {
+			l.Debugf("dropping reply to status %s not permitted by its reply policy", status.InReplyToID)
+			return nil
+		}
+	}
+
 	// id the status based on the time it was created
 	statusID, err := id.NewULIDFromTime(status.CreatedAt)
 	if err != nil {
@@ -285,11 +299,30 @@ func (f *federatingDB) activityLike(ctx context.Context, asType vocab.Type, rece
 		return errors.New("activityLike: could not convert type to like")
 	}
 
+	if ap.ExtractContent(like) != "" {
+		// A Like with content is an emoji reaction.
+		return f.activityReaction(ctx, like, receivingAccount)
+	}
+
 	fave, err := f.typeConverter.ASLikeToFave(ctx, like)
 	if err != nil {
 		return fmt.Errorf("activityLike: could not convert Like to fave: %w", err)
 	}
 
+	if *fave.Status.Local {
+		// This is a like of one of our statuses;
+		// make sure its like policy permits it.
+		likeable, err := f.filter.StatusLikeable(ctx, fave.Account, fave.Status)
+		if err != nil {
+			return fmt.Errorf("activityLike: error checking likeability of status %s: %w", fave.StatusID, err)
+		}
+
+		if !likeable {
+			log.Debugf(ctx, "dropping like of status %s not permitted by its like policy", fave.StatusID)
+			return nil
+		}
+	}
+
 	fave.ID = id.NewULID()
 
 	if err := f.state.DB.PutStatusFave(ctx, fave); err != nil {
@@ -312,6 +345,40 @@ func (f *federatingDB) activityLike(ctx context.Context, asType vocab.Type, rece
 	return nil
 }
 
+func (f *federatingDB) activityReaction(ctx context.Context, like vocab.ActivityStreamsLike, receivingAccount *gtsmodel.Account) error {
+	reaction, err := f.typeConverter.ASLikeToStatusReaction(ctx, like)
+	if err != nil {
+		return fmt.Errorf("activityReaction: could not convert Like to reaction: %w", err)
+	}
+
+	if *reaction.Status.Local {
+		// Reactions are subject to the
+		// same policy as likes/faves.
+		likeable, err := f.filter.StatusLikeable(ctx, reaction.Account, reaction.Status)
+		if err != nil {
+			return fmt.Errorf("activityReaction: error checking likeability of status %s: %w", reaction.StatusID, err)
+		}
+
+		if !likeable {
+			log.Debugf(ctx, "dropping reaction to status %s not permitted by its like policy", reaction.StatusID)
+			return nil
+		}
+	}
+
+	reaction.ID = id.NewULID()
+
+	// The reaction is stored by the processor, since
+	// a custom emoji may need to be dereferenced first.
+	f.state.Workers.EnqueueFederator(ctx, messages.FromFederator{
+		APObjectType:     ap.ActivityEmojiReact,
+		APActivityType:   ap.ActivityCreate,
+		GTSModel:         reaction,
+		ReceivingAccount: receivingAccount,
+	})
+
+	return nil
+}
+
 /*
 	FLAG HANDLERS
 */
@@ -337,6 +404,7 @@ func (f *federatingDB) activityFlag(ctx context.Context, asType vocab.Type, rece
 		APObjectType:     ap.ActivityFlag,
 		APActivityType:   ap.ActivityCreate,
 		GTSModel:         report,
+		APObjectModel:    flag,
 		ReceivingAccount: receivingAccount,
 	})
 
@@ -24,7 +24,9 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
@@ -128,6 +130,91 @@ func (suite *CreateTestSuite) TestCreateFlag1() {
 	}
 }
 
+func (suite *CreateTestSuite) rawToType(raw string) vocab.Type {
+	m := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(raw), &m); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	t, err := streams.ToType(context.Background(), m)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return t
+}
+
+func (suite *CreateTestSuite) TestCreateReplyNotPermittedByPolicy() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+	requestingAccount := suite.testAccounts["remote_account_1"]
+
+	// Only mentioned accounts may reply to this status.
+	repliedStatus := new(gtsmodel.Status)
+	*repliedStatus = *suite.testStatuses["local_account_1_status_1"]
+	repliedStatus.ReplyPolicy = gtsmodel.InteractionPolicyMentioned
+	if err := suite.db.UpdateStatus(context.Background(), repliedStatus, "reply_policy"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	noteURI := "http://fossbros-anonymous.io/users/foss_satan/statuses/01H7EJG4QN8WQ7KMRHN2VEF1CB"
+	create := suite.rawToType(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "actor": "` + requestingAccount.URI + `",
+  "id": "` + noteURI + `/activity",
+  "object": {
+    "attributedTo": "` + requestingAccount.URI + `",
+    "content": "i'm replying anyway lol",
+    "id": "` + noteURI + `",
+    "inReplyTo": "` + repliedStatus.URI + `",
+    "published": "2023-08-05T12:00:00Z",
+    "to": "https://www.w3.org/ns/activitystreams#Public",
+    "type": "Note"
+  },
+  "published": "2023-08-05T12:00:00Z",
+  "to": "https://www.w3.org/ns/activitystreams#Public",
+  "type": "Create"
+}`)
+
+	ctx := createTestContext(receivingAccount, requestingAccount)
+	if err := suite.federatingDB.Create(ctx, create); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Reply should have been dropped.
+	_, err := suite.db.GetStatusByURI(context.Background(), noteURI)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *CreateTestSuite) TestCreateLikeNotPermittedByPolicy() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+	requestingAccount := suite.testAccounts["remote_account_1"]
+
+	// Nobody may like this status.
+	likedStatus := new(gtsmodel.Status)
+	*likedStatus = *suite.testStatuses["local_account_1_status_1"]
+	likedStatus.LikePolicy = gtsmodel.InteractionPolicyNobody
+	if err := suite.db.UpdateStatus(context.Background(), likedStatus, "like_policy"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	like := suite.rawToType(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "actor": "` + requestingAccount.URI + `",
+  "id": "http://fossbros-anonymous.io/likes/01H7EJQ9Y1Q7YBXZQPT9N8Y1SM",
+  "object": "` + likedStatus.URI + `",
+  "type": "Like"
+}`)
+
+	ctx := createTestContext(receivingAccount, requestingAccount)
+	if err := suite.federatingDB.Create(ctx, like); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Like should have been dropped.
+	_, err := suite.db.GetStatusFave(context.Background(), requestingAccount.ID, likedStatus.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
 func TestCreateTestSuite(t *testing.T) {
 	suite.Run(t, &CreateTestSuite{})
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/visibility"
 )
 
 // DB wraps the pub.Database interface with a couple of custom functions for GoToSocial.
@@ -42,6 +43,7 @@ type federatingDB struct {
 	locks         mutexes.MutexMap
 	state         *state.State
 	typeConverter typeutils.TypeConverter
+	filter        *visibility.Filter
 }
 
 // New returns a DB interface using the given database and config
@@ -50,6 +52,7 @@ func New(state *state.State, tc typeutils.TypeConverter) DB {
 		locks:         mutexes.NewMap(-1, -1), // use defaults
 		state:         state,
 		typeConverter: tc,
+		filter:        visibility.NewFilter(state),
 	}
 	return &fdb
 }
@@ -56,18 +56,14 @@ func (f *federatingDB) Owns(ctx context.Context, id *url.URL) (bool, error) {
 	// apparently it belongs to this host, so what *is* it?
 	// check if it's a status, eg /users/example_username/statuses/SOME_UUID_OF_A_STATUS
 	if uris.IsStatusesPath(id) {
-		_, uid, err := uris.ParseStatusesPath(id)
-		if err != nil {
-			return false, fmt.Errorf("error parsing statuses path for url %s: %s", id.String(), err)
-		}
-		status, err := f.state.DB.GetStatusByURI(ctx, uid)
+		status, err := f.state.DB.GetStatusByURI(ctx, id.String())
 		if err != nil {
 			if err == db.ErrNoEntries {
 				// there are no entries for this status
 				return false, nil
 			}
 			// an actual error happened
-			return false, fmt.Errorf("database error fetching status with id %s: %s", uid, err)
+			return false, fmt.Errorf("database error fetching status with uri %s: %s", id.String(), err)
 		}
 		return *status.Local, nil
 	}
@@ -139,6 +139,11 @@ func (f *federatingDB) undoLike(
 		return nil
 	}
 
+	if ap.ExtractContent(Like) != "" {
+		// A Like with content is an emoji reaction.
+		return f.undoReaction(ctx, receivingAccount, Like)
+	}
+
 	fave, err := f.typeConverter.ASLikeToFave(ctx, Like)
 	if err != nil {
 		return fmt.Errorf("undoLike: error converting ActivityStreams Like to fave: %w", err)
@@ -175,6 +180,44 @@ func (f *federatingDB) undoLike(
 	return nil
 }
 
+func (f *federatingDB) undoReaction(
+	ctx context.Context,
+	receivingAccount *gtsmodel.Account,
+	Like vocab.ActivityStreamsLike,
+) error {
+	reaction, err := f.typeConverter.ASLikeToStatusReaction(ctx, Like)
+	if err != nil {
+		return fmt.Errorf("undoReaction: error converting ActivityStreams Like to reaction: %w", err)
+	}
+
+	// Ensure addressee is reaction target.
+	if reaction.TargetAccountID != receivingAccount.ID {
+		// Ignore this Activity.
+		return nil
+	}
+
+	// As with Likes, select using account, target
+	// status and emoji rather than the reaction URI.
+	reaction, err = f.state.DB.GetStatusReaction(gtscontext.SetBarebones(ctx), reaction.StatusID, reaction.AccountID, reaction.Name)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// We didn't have this
+			// reaction anyway, ignore.
+			return nil
+		}
+		// Real error.
+		return fmt.Errorf("undoReaction: db error getting reaction: %w", err)
+	}
+
+	// Delete the status reaction.
+	if err := f.state.DB.DeleteStatusReactionByID(ctx, reaction.ID); err != nil {
+		return fmt.Errorf("undoReaction: db error deleting reaction %s: %w", reaction.ID, err)
+	}
+
+	log.Debug(ctx, "EmojiReact undone")
+	return nil
+}
+
 func (f *federatingDB) undoBlock(
 	ctx context.Context,
 	receivingAccount *gtsmodel.Account,
@@ -33,6 +33,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
@@ -563,11 +564,239 @@ func (f *federator) MaxDeliveryRecursionDepth(ctx context.Context) int {
 //
 // The activity is provided as a reference for more intelligent
 // logic to be used, but the implementation must not modify it.
+//
+// Implementation note: the library would deliver the forwarded
+// activity straight to the items of each returned collection, but
+// our collections contain actor IRIs rather than inbox IRIs. So
+// instead we resolve the (filtered) inboxes and deliver here, and
+// return an empty slice to the library so it does nothing further.
+//
+// Like Mastodon, we only forward activities which reply to (or are
+// otherwise about) a local status, and only to the followers of that
+// status's author; the library on its own would happily forward to
+// the followers of any local account addressed by the activity.
 func (f *federator) FilterForwarding(ctx context.Context, potentialRecipients []*url.URL, a pub.Activity) ([]*url.URL, error) {
-	// TODO
+	requestingAccount := gtscontext.RequestingAccount(ctx)
+	if requestingAccount == nil {
+		// Not an inbox POST from a remote
+		// actor, so nothing to forward.
+		return []*url.URL{}, nil
+	}
+
+	l := log.WithContext(ctx).
+		WithFields(kv.Fields{
+			{"activity", a.GetJSONLDId().Get()},
+			{"requestingAccount", requestingAccount.URI},
+		}...)
+
+	body := gtscontext.ActivityBody(ctx)
+	if len(body) == 0 {
+		// We only ever forward the activity as
+		// we received it, so without the raw
+		// body there's nothing we can do.
+		l.Debug("not forwarding: no raw activity body")
+		return []*url.URL{}, nil
+	}
+
+	// Get the local accounts on whose behalf
+	// we may forward, keyed by followers URI.
+	authors, err := f.forwardingAuthors(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+
+	// Track inboxes we've already decided to
+	// forward to, so that the same activity
+	// isn't sent to an inbox more than once.
+	seen := make(map[string]struct{})
+
+	for _, iri := range potentialRecipients {
+		owner, ok := authors[iri.String()]
+		if !ok {
+			// Not the followers collection of
+			// the author of a status involved.
+			continue
+		}
+
+		// Don't forward anything on behalf of an owner
+		// who has blocked (or is blocked by) the sender.
+		blocked, err := f.db.IsEitherBlocked(ctx, owner.ID, requestingAccount.ID)
+		if err != nil {
+			return nil, gtserror.Newf("db error checking block: %w", err)
+		}
+
+		if blocked {
+			l.Debugf("not forwarding to %s: block exists between owner and requester", iri)
+			continue
+		}
+
+		recipients, err := f.forwardingInboxes(ctx, owner, requestingAccount, seen)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(recipients) == 0 {
+			continue
+		}
+
+		if err := f.forward(ctx, owner, body, recipients); err != nil {
+			return nil, err
+		}
+	}
+
 	return []*url.URL{}, nil
 }
 
+// forwardingAuthors returns the local authors of local statuses which
+// the given activity's object replies to, or (if the object is only an
+// IRI) which the object itself is. Authors are keyed by followers URI.
+func (f *federator) forwardingAuthors(ctx context.Context, a pub.Activity) (map[string]*gtsmodel.Account, error) {
+	authors := make(map[string]*gtsmodel.Account)
+
+	objectProp := a.GetActivityStreamsObject()
+	if objectProp == nil {
+		return authors, nil
+	}
+
+	for iter := objectProp.Begin(); iter != objectProp.End(); iter = iter.Next() {
+		var statusURI *url.URL
+
+		if t := iter.GetType(); t != nil {
+			// Embedded object, check if
+			// it's a reply to something.
+			replyToable, ok := t.(ap.ReplyToable)
+			if !ok {
+				continue
+			}
+			statusURI = ap.ExtractInReplyToURI(replyToable)
+		} else if iter.IsIRI() {
+			// Just an IRI, ie., an interaction
+			// with the object itself.
+			statusURI = iter.GetIRI()
+		}
+
+		if statusURI == nil ||
+			statusURI.Host != config.GetHost() ||
+			!uris.IsStatusesPath(statusURI) {
+			// Not a local status.
+			continue
+		}
+
+		status, err := f.db.GetStatusByURI(ctx, statusURI.String())
+		if err != nil {
+			if !errors.Is(err, db.ErrNoEntries) {
+				return nil, gtserror.Newf("db error getting status %s: %w", statusURI, err)
+			}
+			continue
+		}
+
+		author := status.Account
+		if author == nil {
+			author, err = f.db.GetAccountByID(ctx, status.AccountID)
+			if err != nil {
+				return nil, gtserror.Newf("db error getting author of status %s: %w", statusURI, err)
+			}
+		}
+
+		if !author.IsLocal() {
+			continue
+		}
+
+		authors[author.FollowersURI] = author
+	}
+
+	return authors, nil
+}
+
+// forwardingInboxes returns the inboxes of the followers of owner which an activity
+// sent by requestingAccount should be forwarded to. Local followers, followers on the
+// requester's own instance, followers on blocked domains, and followers with a block
+// in place towards the requester are all skipped, as are inboxes already in seen.
+func (f *federator) forwardingInboxes(
+	ctx context.Context,
+	owner *gtsmodel.Account,
+	requestingAccount *gtsmodel.Account,
+	seen map[string]struct{},
+) ([]*url.URL, error) {
+	follows, err := f.db.GetAccountFollowers(ctx, owner.ID, nil)
+	if err != nil {
+		return nil, gtserror.Newf("db error getting followers of %s: %w", owner.ID, err)
+	}
+
+	inboxes := make([]*url.URL, 0, len(follows))
+	for _, follow := range follows {
+		follower := follow.Account
+		if follower == nil || follower.IsLocal() {
+			// Missing account, or one of ours
+			// that already has the activity.
+			continue
+		}
+
+		if follower.Domain == requestingAccount.Domain {
+			// Originating instance
+			// already has the activity.
+			continue
+		}
+
+		blocked, err := f.db.IsDomainBlocked(ctx, follower.Domain)
+		if err != nil {
+			return nil, gtserror.Newf("db error checking domain block: %w", err)
+		}
+
+		if blocked {
+			continue
+		}
+
+		blocked, err = f.db.IsEitherBlocked(ctx, follower.ID, requestingAccount.ID)
+		if err != nil {
+			return nil, gtserror.Newf("db error checking block: %w", err)
+		}
+
+		if blocked {
+			continue
+		}
+
+		// Deliver to a shared inbox if we have that option.
+		inbox := follower.InboxURI
+		if config.GetInstanceDeliverToSharedInboxes() &&
+			follower.SharedInboxURI != nil && *follower.SharedInboxURI != "" {
+			inbox = *follower.SharedInboxURI
+		}
+
+		if _, ok := seen[inbox]; ok {
+			continue
+		}
+		seen[inbox] = struct{}{}
+
+		inboxIRI, err := url.Parse(inbox)
+		if err != nil {
+			return nil, gtserror.Newf("error parsing inbox uri %s: %w", inbox, err)
+		}
+
+		inboxes = append(inboxes, inboxIRI)
+	}
+
+	return inboxes, nil
+}
+
+// forward delivers the given raw activity body, exactly as it was received,
+// to the given inboxes on behalf of owner. Delivery happens asynchronously in
+// the federator worker pool.
+func (f *federator) forward(ctx context.Context, owner *gtsmodel.Account, body []byte, inboxes []*url.URL) error {
+	tp, err := f.transportController.NewTransportForUsername(ctx, owner.Username)
+	if err != nil {
+		return gtserror.Newf("error creating transport for %s: %w", owner.Username, err)
+	}
+
+	f.state.Workers.Federator.Enqueue(func(ctx context.Context) {
+		if err := tp.BatchDeliver(ctx, body, inboxes); err != nil {
+			log.Errorf(ctx, "error forwarding activity: %v", err)
+		}
+	})
+
+	return nil
+}
+
 // GetInbox returns the OrderedCollection inbox of the actor for this
 // context. It is up to the implementation to provide the correct
 // collection for the kind of authorization given in the request.
@@ -26,9 +26,11 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/go-fed/httpsig"
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -437,6 +439,250 @@ func (suite *FederatingProtocolTestSuite) TestBlockedRepliedStatus() {
 	suite.False(blocked)
 }
 
+func (suite *FederatingProtocolTestSuite) followZork(followingAccount *gtsmodel.Account) {
+	err := suite.state.DB.PutFollow(context.Background(), &gtsmodel.Follow{
+		ID:              "01H6QK0J7Q2T8B5JQ5PCNXVCZ9",
+		CreatedAt:       testrig.TimeMustParse("2022-06-02T12:22:21+02:00"),
+		UpdatedAt:       testrig.TimeMustParse("2022-06-02T12:22:21+02:00"),
+		AccountID:       followingAccount.ID,
+		TargetAccountID: suite.testAccounts["local_account_1"].ID,
+		ShowReblogs:     testrig.TrueBool(),
+		URI:             followingAccount.URI + "/follows/01H6QK0J7Q2T8B5JQ5PCNXVCZ9",
+		Notify:          testrig.FalseBool(),
+	})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+// noteForZork returns a Create from foss_satan, signed for delivery to
+// zork's inbox, of a note which cc's zork's followers. If inReplyTo is
+// set the note replies to it, otherwise the note only tags zork.
+// The raw body that the signature was generated over is also returned.
+func (suite *FederatingProtocolTestSuite) noteForZork(inReplyTo *url.URL) (testrig.ActivityWithSignature, []byte) {
+	var (
+		zork      = suite.testAccounts["local_account_1"]
+		fossSatan = suite.testAccounts["remote_account_1"]
+		noteID    = testrig.URLMustParse(fossSatan.URI + "/statuses/01H7GQ8M2C4N3Y0S7ZJ3B1Q9WD")
+		noteURL   = testrig.URLMustParse("http://fossbros-anonymous.io/@foss_satan/01H7GQ8M2C4N3Y0S7ZJ3B1Q9WD")
+		publicIRI = testrig.URLMustParse("https://www.w3.org/ns/activitystreams#Public")
+		zorkURI   = testrig.URLMustParse(zork.URI)
+		followers = testrig.URLMustParse(zork.FollowersURI)
+		actor     = testrig.URLMustParse(fossSatan.URI)
+		createdAt = testrig.TimeMustParse("2023-08-10T10:00:00+02:00")
+	)
+
+	note := testrig.NewAPNote(
+		noteID,
+		noteURL,
+		createdAt,
+		"@the_mighty_zork hey, your followers might want to see this",
+		"",
+		actor,
+		[]*url.URL{publicIRI},
+		[]*url.URL{followers, zorkURI},
+		false,
+		nil,
+		nil,
+		nil,
+	)
+
+	// Tag zork by IRI only, which is enough
+	// for the library to consider forwarding.
+	tagProp := streams.NewActivityStreamsTagProperty()
+	tagProp.AppendIRI(zorkURI)
+	note.SetActivityStreamsTag(tagProp)
+
+	if inReplyTo != nil {
+		inReplyToProp := streams.NewActivityStreamsInReplyToProperty()
+		inReplyToProp.AppendIRI(inReplyTo)
+		note.SetActivityStreamsInReplyTo(inReplyToProp)
+	}
+
+	create := testrig.WrapAPNoteInCreate(
+		testrig.URLMustParse(noteID.String()+"/activity"),
+		actor,
+		createdAt,
+		note,
+	)
+
+	m, err := create.Serialize()
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	sig, digest, date := testrig.GetSignatureForActivity(
+		create,
+		fossSatan.PublicKeyURI,
+		fossSatan.PrivateKey,
+		testrig.URLMustParse(zork.InboxURI),
+	)
+
+	return testrig.ActivityWithSignature{
+		Activity:        create,
+		SignatureHeader: sig,
+		DigestHeader:    digest,
+		DateHeader:      date,
+	}, b
+}
+
+// postInbox posts the given activity body to the inbox of
+// receivingAccount, via the federating actor, as the router would.
+func (suite *FederatingProtocolTestSuite) postInbox(
+	receivingAccount *gtsmodel.Account,
+	activity testrig.ActivityWithSignature,
+	body []byte,
+) {
+	request := httptest.NewRequest(http.MethodPost, receivingAccount.InboxURI, bytes.NewReader(body))
+	request.Header.Set("Content-Type", "application/activity+json")
+	request.Header.Set("Signature", activity.SignatureHeader)
+	request.Header.Set("Date", activity.DateHeader)
+	request.Header.Set("Digest", activity.DigestHeader)
+
+	verifier, err := httpsig.NewVerifier(request)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	ctx := context.Background()
+	ctx = gtscontext.SetReceivingAccount(ctx, receivingAccount)
+	ctx = gtscontext.SetHTTPSignatureVerifier(ctx, verifier)
+	ctx = gtscontext.SetHTTPSignature(ctx, activity.SignatureHeader)
+	ctx = gtscontext.SetHTTPSignaturePubKeyID(ctx, testrig.URLMustParse(verifier.KeyId()))
+
+	recorder := httptest.NewRecorder()
+	ok, err := suite.federator.FederatingActor().PostInboxScheme(ctx, recorder, request, "http")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(ok)
+}
+
+func (suite *FederatingProtocolTestSuite) TestFilterForwarding() {
+	var (
+		ctx               = context.Background()
+		zork              = suite.testAccounts["local_account_1"]
+		requestingAccount = suite.testAccounts["remote_account_1"]
+		follower          = suite.testAccounts["remote_account_2"]
+		activity, body    = suite.noteForZork(testrig.URLMustParse(suite.testStatuses["local_account_1_status_1"].URI))
+	)
+
+	suite.followZork(follower)
+	ctx = gtscontext.SetRequestingAccount(ctx, requestingAccount)
+	ctx = gtscontext.SetActivityBody(ctx, body)
+
+	toSend, err := suite.federator.FilterForwarding(ctx, []*url.URL{
+		testrig.URLMustParse(zork.FollowersURI),
+	}, activity.Activity)
+	suite.NoError(err)
+
+	// Nothing should be left for
+	// the library to deliver itself.
+	suite.Empty(toSend)
+
+	// Activity should have been forwarded to
+	// the remote follower's inbox (unmodified).
+	var sent [][]byte
+	if !testrig.WaitFor(func() bool {
+		v, ok := suite.httpClient.SentMessages.Load(follower.InboxURI)
+		if ok {
+			sent, _ = v.([][]byte)
+		}
+		return ok
+	}) {
+		suite.FailNow("timed out waiting for forwarded message")
+	}
+
+	suite.Len(sent, 1)
+	suite.Equal(body, sent[0])
+}
+
+func (suite *FederatingProtocolTestSuite) TestFilterForwardingBlocked() {
+	var (
+		ctx               = context.Background()
+		zork              = suite.testAccounts["local_account_1"]
+		requestingAccount = suite.testAccounts["remote_account_1"]
+		follower          = suite.testAccounts["remote_account_2"]
+		activity, body    = suite.noteForZork(testrig.URLMustParse(suite.testStatuses["local_account_1_status_1"].URI))
+	)
+
+	suite.followZork(follower)
+	ctx = gtscontext.SetRequestingAccount(ctx, requestingAccount)
+	ctx = gtscontext.SetActivityBody(ctx, body)
+
+	// Zork blocks the requester, so
+	// nothing should be forwarded.
+	if err := suite.state.DB.PutBlock(ctx, &gtsmodel.Block{
+		ID:              "01H6QK4RZ8V1R6ZW3Y2K0EXC4B",
+		URI:             zork.URI + "/blocks/01H6QK4RZ8V1R6ZW3Y2K0EXC4B",
+		AccountID:       zork.ID,
+		TargetAccountID: requestingAccount.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	toSend, err := suite.federator.FilterForwarding(ctx, []*url.URL{
+		testrig.URLMustParse(zork.FollowersURI),
+	}, activity.Activity)
+	suite.NoError(err)
+	suite.Empty(toSend)
+
+	_, ok := suite.httpClient.SentMessages.Load(follower.InboxURI)
+	suite.False(ok)
+}
+
+func (suite *FederatingProtocolTestSuite) TestInboxForwardingReply() {
+	var (
+		zork           = suite.testAccounts["local_account_1"]
+		follower       = suite.testAccounts["remote_account_2"]
+		activity, body = suite.noteForZork(testrig.URLMustParse(suite.testStatuses["local_account_1_status_1"].URI))
+	)
+
+	suite.followZork(follower)
+	suite.postInbox(zork, activity, body)
+
+	// A reply to one of zork's statuses should be
+	// forwarded to zork's followers, byte for byte.
+	var sent [][]byte
+	if !testrig.WaitFor(func() bool {
+		v, ok := suite.httpClient.SentMessages.Load(follower.InboxURI)
+		if ok {
+			sent, _ = v.([][]byte)
+		}
+		return ok
+	}) {
+		suite.FailNow("timed out waiting for forwarded message")
+	}
+
+	suite.Len(sent, 1)
+	suite.Equal(body, sent[0])
+}
+
+func (suite *FederatingProtocolTestSuite) TestInboxForwardingMention() {
+	var (
+		zork           = suite.testAccounts["local_account_1"]
+		follower       = suite.testAccounts["remote_account_2"]
+		activity, body = suite.noteForZork(nil)
+	)
+
+	suite.followZork(follower)
+	suite.postInbox(zork, activity, body)
+
+	// A note that only tags zork (while addressing
+	// zork's followers) must not be forwarded. Any
+	// delivery would have been queued by now, so
+	// give the worker a moment to pick it up.
+	time.Sleep(time.Second)
+
+	_, ok := suite.httpClient.SentMessages.Load(follower.InboxURI)
+	suite.False(ok)
+}
+
 func TestFederatingProtocolTestSuite(t *testing.T) {
 	suite.Run(t, new(FederatingProtocolTestSuite))
 }
@@ -58,6 +58,7 @@ type Federator interface {
 
 type federator struct {
 	db                  db.DB
+	state               *state.State
 	federatingDB        federatingdb.DB
 	clock               pub.Clock
 	typeConverter       typeutils.TypeConverter
@@ -74,6 +75,7 @@ func NewFederator(state *state.State, federatingDB federatingdb.DB, transportCon
 	clock := &Clock{}
 	f := &federator{
 		db:                  state.DB,
+		state:               state,
 		federatingDB:        federatingDB,
 		clock:               &Clock{},
 		typeConverter:       typeConverter,
@@ -42,6 +42,7 @@ const (
 	httpSigKey
 	httpSigPubKeyIDKey
 	dryRunKey
+	activityBodyKey
 )
 
 // DryRun returns whether the "dryrun" context key has been set. This can be
@@ -127,6 +128,20 @@ func SetOtherIRIs(ctx context.Context, iris []*url.URL) context.Context {
 	return context.WithValue(ctx, otherIRIsKey, iris)
 }
 
+// ActivityBody returns the raw body of the current ActivityPub inbox POST request, as
+// it was received. This is needed when forwarding the activity on to other inboxes,
+// so that any embedded signature on the original activity stays intact.
+func ActivityBody(ctx context.Context) []byte {
+	b, _ := ctx.Value(activityBodyKey).([]byte)
+	return b
+}
+
+// SetActivityBody stores the given raw request body and returns the wrapped context.
+// See ActivityBody() for further information on the raw request body value.
+func SetActivityBody(ctx context.Context, b []byte) context.Context {
+	return context.WithValue(ctx, activityBodyKey, b)
+}
+
 // HTTPSignatureVerifier returns an http signature verifier for the current ActivityPub
 // request chain. This verifier can be called to authenticate the current request.
 func HTTPSignatureVerifier(ctx context.Context) httpsig.Verifier {
@@ -0,0 +1,32 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// AccountDomainBlock refers to the blocking of an entire domain by one
+// (local) account. Unlike an instance-wide DomainBlock, it only affects
+// what the blocking account sees, and who can see the blocking account.
+type AccountDomainBlock struct {
+	ID        string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                 // id of this item in the database
+	CreatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`          // when was item created
+	UpdatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`          // when was item last updated
+	AccountID string    `validate:"required,ulid" bun:"type:CHAR(26),unique:accountdomainblock,notnull,nullzero"` // Who does this block originate from?
+	Account   *Account  `validate:"-" bun:"rel:belongs-to"`                                                       // Account corresponding to accountID
+	Domain    string    `validate:"required,fqdn" bun:",unique:accountdomainblock,notnull,nullzero"`              // Domain (punycode) which is blocked, including its subdomains.
+}
@@ -24,25 +24,32 @@ import (
 
 // AdminAccountAction models an action taken by an instance administrator on an account.
 type AdminAccountAction struct {
-	ID              string          `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
-	CreatedAt       time.Time       `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
-	UpdatedAt       time.Time       `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
-	AccountID       string          `validate:"required,ulid" bun:"type:CHAR(26),notnull,nullzero"`                  // Who performed this admin action.
-	Account         *Account        `validate:"-" bun:"rel:has-one"`                                                 // Account corresponding to accountID
-	TargetAccountID string          `validate:"required,ulid" bun:"type:CHAR(26),notnull,nullzero"`                  // Who is the target of this action
-	TargetAccount   *Account        `validate:"-" bun:"rel:has-one"`                                                 // Account corresponding to targetAccountID
-	Text            string          `validate:"-" bun:""`                                                            // text explaining why this action was taken
-	Type            AdminActionType `validate:"oneof=disable silence suspend" bun:",nullzero,notnull"`               // type of action that was taken
-	SendEmail       bool            `validate:"-" bun:""`                                                            // should an email be sent to the account owner to explain what happened
-	ReportID        string          `validate:",omitempty,ulid" bun:"type:CHAR(26),nullzero"`                        // id of a report connected to this action, if it exists
+	ID              string          `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                         // id of this item in the database
+	CreatedAt       time.Time       `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                  // when was item created
+	UpdatedAt       time.Time       `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                  // when was item last updated
+	AccountID       string          `validate:"required,ulid" bun:"type:CHAR(26),notnull,nullzero"`                                   // Who performed this admin action.
+	Account         *Account        `validate:"-" bun:"rel:has-one"`                                                                  // Account corresponding to accountID
+	TargetAccountID string          `validate:"required,ulid" bun:"type:CHAR(26),notnull,nullzero"`                                   // Who is the target of this action
+	TargetAccount   *Account        `validate:"-" bun:"rel:has-one"`                                                                  // Account corresponding to targetAccountID
+	Text            string          `validate:"-" bun:""`                                                                             // text explaining why this action was taken
+	Type            AdminActionType `validate:"oneof=none disable sensitive delete_statuses silence suspend" bun:",nullzero,notnull"` // type of action that was taken
+	SendEmail       bool            `validate:"-" bun:""`                                                                             // should an email be sent to the account owner to explain what happened
+	ReportID        string          `validate:",omitempty,ulid" bun:"type:CHAR(26),nullzero"`                                         // id of a report connected to this action, if it exists
+	StatusIDs       []string        `validate:"dive,ulid" bun:"statuses,array"`                                                       // database IDs of any statuses affected by this action
 }
 
 // AdminActionType describes a type of action taken on an entity by an admin
 type AdminActionType string
 
 const (
+	// AdminActionNone -- no action was taken on the account, but the account owner was warned.
+	AdminActionNone AdminActionType = "none"
 	// AdminActionDisable -- the account or application etc has been disabled but not deleted.
 	AdminActionDisable AdminActionType = "disable"
+	// AdminActionSensitive -- media attached to statuses of the account has been marked as sensitive.
+	AdminActionSensitive AdminActionType = "sensitive"
+	// AdminActionDeleteStatuses -- statuses of the account have been deleted.
+	AdminActionDeleteStatuses AdminActionType = "delete_statuses"
 	// AdminActionSilence -- the account or application etc has been silenced.
 	AdminActionSilence AdminActionType = "silence"
 	// AdminActionSuspend -- the account or application etc has been deleted.
@@ -67,4 +74,5 @@ type NewSignup struct {
 	EmailVerified bool   // Mark submitted email address as already verified (optional).
 	ExternalID    string // ID of this user in external OIDC system (optional).
 	Admin         bool   // Mark new user as an admin user (optional).
+	InviteID      string // ID of the invite used to sign up (optional).
 }
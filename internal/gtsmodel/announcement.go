@@ -0,0 +1,80 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Announcement models a server-wide announcement
+// made by an admin to all users of this instance.
+type Announcement struct {
+	ID          string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt   time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt   time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Text        string    `validate:"required" bun:",nullzero,notnull"`                                    // markdown text of the announcement, as submitted by the admin
+	Content     string    `validate:"-" bun:""`                                                            // html-formatted content of the announcement
+	StartsAt    time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // when to start showing the announcement; if not set, show straight away
+	EndsAt      time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // when to stop showing the announcement; if not set, show indefinitely
+	AllDay      *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // StartsAt and EndsAt should be treated as whole days rather than times
+	Published   *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // announcement is visible to users
+	PublishedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // when was the announcement (first) published
+	EmojiIDs    []string  `validate:"dive,ulid" bun:"emojis,array"`                                        // Database IDs of any emojis used in this announcement
+	Emojis      []*Emoji  `validate:"-" bun:"-"`                                                           // Emojis corresponding to emojiIDs
+	TagIDs      []string  `validate:"dive,ulid" bun:"tags,array"`                                          // Database IDs of any tags used in this announcement
+	Tags        []*Tag    `validate:"-" bun:"-"`                                                           // Tags corresponding to tagIDs
+}
+
+// IsActive returns whether the announcement
+// is published and within its display window
+// at the given time.
+func (a *Announcement) IsActive(now time.Time) bool {
+	if !*a.Published {
+		return false
+	}
+
+	if !a.StartsAt.IsZero() && now.Before(a.StartsAt) {
+		return false
+	}
+
+	if !a.EndsAt.IsZero() && !now.Before(a.EndsAt) {
+		return false
+	}
+
+	return true
+}
+
+// AnnouncementRead records that an account
+// has read (dismissed) the given announcement.
+type AnnouncementRead struct {
+	ID             string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`               // id of this item in the database
+	CreatedAt      time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`        // when was item created
+	AccountID      string    `validate:"required,ulid" bun:"type:CHAR(26),unique:announcementread,notnull,nullzero"` // Who read the announcement?
+	AnnouncementID string    `validate:"required,ulid" bun:"type:CHAR(26),unique:announcementread,notnull,nullzero"` // Which announcement was read?
+}
+
+// AnnouncementReaction is an emoji reaction
+// by an account to the given announcement.
+type AnnouncementReaction struct {
+	ID             string        `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                   // id of this item in the database
+	CreatedAt      time.Time     `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`            // when was item created
+	AccountID      string        `validate:"required,ulid" bun:"type:CHAR(26),unique:announcementreaction,notnull,nullzero"` // Who reacted?
+	AnnouncementID string        `validate:"required,ulid" bun:"type:CHAR(26),unique:announcementreaction,notnull,nullzero"` // Which announcement was reacted to?
+	Announcement   *Announcement `validate:"-" bun:"-"`                                                                      // Announcement corresponding to announcementID
+	Name           string        `validate:"required" bun:",unique:announcementreaction,notnull,nullzero"`                   // Unicode emoji, or shortcode of a local custom emoji
+	EmojiID        string        `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                                    // ID of the custom emoji, if Name is a shortcode
+	Emoji          *Emoji        `validate:"-" bun:"-"`                                                                      // Emoji corresponding to emojiID
+}
@@ -0,0 +1,55 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Card represents a preview card for a link, generated from
+// OpenGraph, Twitter card and / or oEmbed metadata found at the
+// linked URL. Cards are unique by URL, and may be shared by any
+// number of statuses that link to the same resource.
+type Card struct {
+	ID           string           `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt    time.Time        `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt    time.Time        `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	URL          string           `validate:"required,url" bun:",unique,nullzero,notnull"`                         // Location of the linked resource.
+	Title        string           `validate:"-" bun:",nullzero"`                                                   // Title of the linked resource.
+	Description  string           `validate:"-" bun:",nullzero"`                                                   // Description of the linked resource.
+	Type         CardType         `validate:"oneof=link photo video rich" bun:",nullzero,notnull"`                 // Type of preview card.
+	AuthorName   string           `validate:"-" bun:",nullzero"`                                                   // Author of the linked resource.
+	AuthorURL    string           `validate:"omitempty,url" bun:",nullzero"`                                       // Link to the author of the linked resource.
+	ProviderName string           `validate:"-" bun:",nullzero"`                                                   // Provider of the linked resource.
+	ProviderURL  string           `validate:"omitempty,url" bun:",nullzero"`                                       // Link to the provider of the linked resource.
+	HTML         string           `validate:"-" bun:",nullzero"`                                                   // Sanitized oEmbed HTML for rich / video embeds.
+	Width        int              `validate:"-" bun:",nullzero,notnull,default:0"`                                 // Width of the embed / image, in pixels.
+	Height       int              `validate:"-" bun:",nullzero,notnull,default:0"`                                 // Height of the embed / image, in pixels.
+	ImageID      string           `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                         // ID of the locally cached thumbnail media attachment, if any.
+	Image        *MediaAttachment `validate:"-" bun:"-"`                                                           // Thumbnail media attachment corresponding to imageID.
+	EmbedURL     string           `validate:"omitempty,url" bun:",nullzero"`                                       // Used for photo embeds, instead of custom html.
+}
+
+// CardType denotes the type of a preview card.
+type CardType string
+
+// CardType values.
+const (
+	CardTypeLink  CardType = "link"  // Plain link preview.
+	CardTypePhoto CardType = "photo" // Photo embed.
+	CardTypeVideo CardType = "video" // Video embed.
+	CardTypeRich  CardType = "rich"  // Rich (HTML) embed.
+)
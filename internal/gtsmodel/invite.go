@@ -0,0 +1,52 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package gtsmodel
+
+import "time"
+
+// Invite represents an invitation to sign up on this instance,
+// created by an existing user, and redeemable using its code.
+type Invite struct {
+	ID        string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Code      string    `validate:"required" bun:",nullzero,notnull,unique"`                             // Code to be given at sign up to redeem this invite
+	AccountID string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                  // Account ID of the creator of this invite
+	Account   *Account  `validate:"-" bun:"rel:belongs-to"`                                              // Account corresponding to accountID
+	MaxUses   int       `validate:"min=0" bun:",notnull,default:0"`                                      // How many times this invite can be used; 0 means unlimited
+	Uses      int       `validate:"min=0" bun:",notnull,default:0"`                                      // How many times this invite has been used so far
+	ExpiresAt time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // Time after which this invite can no longer be used; zero means never
+}
+
+// Expired returns true if this invite
+// had expired by the given time.
+func (i *Invite) Expired(now time.Time) bool {
+	return !i.ExpiresAt.IsZero() && !i.ExpiresAt.After(now)
+}
+
+// UsedUp returns true if this invite has
+// a limited number of uses, and they have
+// all been used.
+func (i *Invite) UsedUp() bool {
+	return i.MaxUses > 0 && i.Uses >= i.MaxUses
+}
+
+// Valid returns true if this invite can
+// still be used for signing up at the given time.
+func (i *Invite) Valid(now time.Time) bool {
+	return !i.Expired(now) && !i.UsedUp()
+}
@@ -0,0 +1,51 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package gtsmodel
+
+import "time"
+
+// IPBlock represents an IP address or range of IP addresses that the
+// server should deny sign-ups from, or deny access to altogether.
+type IPBlock struct {
+	ID                 string          `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt          time.Time       `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt          time.Time       `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	IP                 string          `validate:"required,cidr" bun:",nullzero,notnull,unique"`                        // IP address range to block in CIDR notation, eg. '192.0.2.0/24' or '2001:db8::1/128'
+	Severity           IPBlockSeverity `validate:"required,oneof=sign_up_block no_access" bun:",nullzero,notnull"`      // What this block prevents addresses in the range from doing
+	Comment            string          `validate:"-" bun:",nullzero"`                                                   // Private comment on this block, visible to admins
+	ExpiresAt          time.Time       `validate:"-" bun:"type:timestamptz,nullzero"`                                   // Time after which this block is no longer enforced; zero means never
+	CreatedByAccountID string          `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                  // Account ID of the creator of this block
+	CreatedByAccount   *Account        `validate:"-" bun:"rel:belongs-to"`                                              // Account corresponding to createdByAccountID
+}
+
+// IPBlockSeverity describes what an IP block prevents.
+type IPBlockSeverity string
+
+const (
+	// IPBlockSeveritySignUpBlock prevents new accounts being
+	// created from addresses in the range, but otherwise
+	// allows access as normal.
+	IPBlockSeveritySignUpBlock IPBlockSeverity = "sign_up_block"
+	// IPBlockSeverityNoAccess denies all access to the
+	// instance from addresses in the range.
+	IPBlockSeverityNoAccess IPBlockSeverity = "no_access"
+)
+
+// Expired returns true if this block has an expiry time that has passed.
+func (b *IPBlock) Expired(now time.Time) bool {
+	return !b.ExpiresAt.IsZero() && !b.ExpiresAt.After(now)
+}
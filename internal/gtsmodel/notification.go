@@ -21,17 +21,22 @@ import "time"
 
 // Notification models an alert/notification sent to an account about something like a reblog, like, new follow request, etc.
 type Notification struct {
-	ID               string           `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                                                                                                                                    // id of this item in the database
-	CreatedAt        time.Time        `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                                                                                                                             // when was item created
-	UpdatedAt        time.Time        `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                                                                                                                             // when was item last updated
-	NotificationType NotificationType `validate:"oneof=follow follow_request mention reblog favourite poll status" bun:",nullzero,notnull"`                                                                                                        // Type of this notification
-	TargetAccountID  string           `validate:"ulid" bun:"type:CHAR(26),nullzero,notnull"`                                                                                                                                                       // ID of the account targeted by the notification (ie., who will receive the notification?)
-	TargetAccount    *Account         `validate:"-" bun:"-"`                                                                                                                                                                                       // Account corresponding to TargetAccountID. Can be nil, always check first + select using ID if necessary.
-	OriginAccountID  string           `validate:"ulid" bun:"type:CHAR(26),nullzero,notnull"`                                                                                                                                                       // ID of the account that performed the action that created the notification.
-	OriginAccount    *Account         `validate:"-" bun:"-"`                                                                                                                                                                                       // Account corresponding to OriginAccountID. Can be nil, always check first + select using ID if necessary.
-	StatusID         string           `validate:"required_if=NotificationType mention,required_if=NotificationType reblog,required_if=NotificationType favourite,required_if=NotificationType status,omitempty,ulid" bun:"type:CHAR(26),nullzero"` // If the notification pertains to a status, what is the database ID of that status?
-	Status           *Status          `validate:"-" bun:"-"`                                                                                                                                                                                       // Status corresponding to StatusID. Can be nil, always check first + select using ID if necessary.
-	Read             *bool            `validate:"-" bun:",nullzero,notnull,default:false"`                                                                                                                                                         // Notification has been seen/read
+	ID               string              `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                                                                                                                                    // id of this item in the database
+	CreatedAt        time.Time           `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                                                                                                                             // when was item created
+	UpdatedAt        time.Time           `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                                                                                                                             // when was item last updated
+	NotificationType NotificationType    `validate:"oneof=follow follow_request mention reblog favourite pleroma:emoji_reaction poll status moderation_warning admin.report" bun:",nullzero,notnull"`                                                 // Type of this notification
+	TargetAccountID  string              `validate:"ulid" bun:"type:CHAR(26),nullzero,notnull"`                                                                                                                                                       // ID of the account targeted by the notification (ie., who will receive the notification?)
+	TargetAccount    *Account            `validate:"-" bun:"-"`                                                                                                                                                                                       // Account corresponding to TargetAccountID. Can be nil, always check first + select using ID if necessary.
+	OriginAccountID  string              `validate:"ulid" bun:"type:CHAR(26),nullzero,notnull"`                                                                                                                                                       // ID of the account that performed the action that created the notification.
+	OriginAccount    *Account            `validate:"-" bun:"-"`                                                                                                                                                                                       // Account corresponding to OriginAccountID. Can be nil, always check first + select using ID if necessary.
+	StatusID         string              `validate:"required_if=NotificationType mention,required_if=NotificationType reblog,required_if=NotificationType favourite,required_if=NotificationType status,omitempty,ulid" bun:"type:CHAR(26),nullzero"` // If the notification pertains to a status, what is the database ID of that status?
+	Status           *Status             `validate:"-" bun:"-"`                                                                                                                                                                                       // Status corresponding to StatusID. Can be nil, always check first + select using ID if necessary.
+	ReportID         string              `validate:"required_if=NotificationType admin.report,omitempty,ulid" bun:"type:CHAR(26),nullzero"`                                                                                                           // If the notification pertains to a new report, what is the database ID of that report?
+	Report           *Report             `validate:"-" bun:"-"`                                                                                                                                                                                       // Report corresponding to ReportID. Can be nil, always check first + select using ID if necessary.
+	AdminActionID    string              `validate:"required_if=NotificationType moderation_warning,omitempty,ulid" bun:"type:CHAR(26),nullzero"`                                                                                                     // If the notification pertains to a moderation action taken on the target account, what is the database ID of that action?
+	AdminAction      *AdminAccountAction `validate:"-" bun:"-"`                                                                                                                                                                                       // AdminAccountAction corresponding to AdminActionID. Can be nil, always check first + select using ID if necessary.
+	Read             *bool               `validate:"-" bun:",nullzero,notnull,default:false"`                                                                                                                                                         // Notification has been seen/read
+	Filtered         *bool               `validate:"-" bun:",nullzero,notnull,default:false"`                                                                                                                                                         // Notification was filtered by the target account's notification policy, and is only shown in its notification requests
 }
 
 // NotificationType describes the reason/type of this notification.
@@ -39,11 +44,14 @@ type NotificationType string
 
 // Notification Types
 const (
-	NotificationFollow        NotificationType = "follow"         // NotificationFollow -- someone followed you
-	NotificationFollowRequest NotificationType = "follow_request" // NotificationFollowRequest -- someone requested to follow you
-	NotificationMention       NotificationType = "mention"        // NotificationMention -- someone mentioned you in their status
-	NotificationReblog        NotificationType = "reblog"         // NotificationReblog -- someone boosted one of your statuses
-	NotificationFave          NotificationType = "favourite"      // NotificationFave -- someone faved/liked one of your statuses
-	NotificationPoll          NotificationType = "poll"           // NotificationPoll -- a poll you voted in or created has ended
-	NotificationStatus        NotificationType = "status"         // NotificationStatus -- someone you enabled notifications for has posted a status.
+	NotificationFollow            NotificationType = "follow"                 // NotificationFollow -- someone followed you
+	NotificationFollowRequest     NotificationType = "follow_request"         // NotificationFollowRequest -- someone requested to follow you
+	NotificationMention           NotificationType = "mention"                // NotificationMention -- someone mentioned you in their status
+	NotificationReblog            NotificationType = "reblog"                 // NotificationReblog -- someone boosted one of your statuses
+	NotificationFave              NotificationType = "favourite"              // NotificationFave -- someone faved/liked one of your statuses
+	NotificationReaction          NotificationType = "pleroma:emoji_reaction" // NotificationReaction -- someone reacted to one of your statuses with an emoji
+	NotificationPoll              NotificationType = "poll"                   // NotificationPoll -- a poll you voted in or created has ended
+	NotificationStatus            NotificationType = "status"                 // NotificationStatus -- someone you enabled notifications for has posted a status.
+	NotificationModerationWarning NotificationType = "moderation_warning"     // NotificationModerationWarning -- a moderator took action on your account.
+	NotificationAdminReport       NotificationType = "admin.report"           // NotificationAdminReport -- someone reported an account (only sent to moderators and admins).
 )
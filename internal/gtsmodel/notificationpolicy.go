@@ -0,0 +1,51 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// NotificationPolicy models a local account's preferences
+// for filtering notifications from accounts it doesn't know.
+// Notifications matching any of the enabled filters are kept
+// aside as a NotificationRequest rather than shown directly.
+type NotificationPolicy struct {
+	ID                    string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt             time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt             time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	AccountID             string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique"`           // Account this policy belongs to.
+	FilterNotFollowing    *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // Filter notifications from accounts the account doesn't follow.
+	FilterNotFollowers    *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // Filter notifications from accounts that don't follow the account.
+	FilterNewAccounts     *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // Filter notifications from accounts created in the last 30 days.
+	FilterPrivateMentions *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // Filter direct mentions from accounts the account doesn't follow, unless replying to the account.
+}
+
+// NotificationRequest models the collection of filtered
+// notifications sent to one local account from another account.
+type NotificationRequest struct {
+	ID                 string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                  // id of this item in the database
+	CreatedAt          time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`           // when was item created
+	UpdatedAt          time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`           // when was item last updated
+	AccountID          string    `validate:"required,ulid" bun:"type:CHAR(26),unique:notificationrequest,notnull,nullzero"` // Account whose notifications were filtered.
+	Account            *Account  `validate:"-" bun:"-"`                                                                     // Account corresponding to accountID
+	FromAccountID      string    `validate:"required,ulid" bun:"type:CHAR(26),unique:notificationrequest,notnull,nullzero"` // Account that the filtered notifications originated from.
+	FromAccount        *Account  `validate:"-" bun:"-"`                                                                     // Account corresponding to fromAccountID
+	LastStatusID       string    `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                                   // Most recent status referenced by a filtered notification, if any.
+	LastStatus         *Status   `validate:"-" bun:"-"`                                                                     // Status corresponding to lastStatusID
+	NotificationsCount int       `validate:"min=0" bun:",notnull,default:0"`                                                // Number of notifications filtered into this request.
+	Accepted           *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                                       // Request was accepted: future notifications from FromAccount will not be filtered.
+}
@@ -41,6 +41,8 @@ type Status struct {
 	Mentions                 []*Mention         `validate:"-" bun:"attached_mentions,rel:has-many"`                                                    // Mentions corresponding to mentionIDs
 	EmojiIDs                 []string           `validate:"dive,ulid" bun:"emojis,array"`                                                              // Database IDs of any emojis used in this status
 	Emojis                   []*Emoji           `validate:"-" bun:"attached_emojis,m2m:status_to_emojis"`                                              // Emojis corresponding to emojiIDs. https://bun.uptrace.dev/guide/relations.html#many-to-many-relation
+	CardID                   string             `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                                               // Database ID of the preview card for the first link in this status, if any
+	Card                     *Card              `validate:"-" bun:"-"`                                                                                 // Card corresponding to cardID
 	Local                    *bool              `validate:"-" bun:",nullzero,notnull,default:false"`                                                   // is this status from a local account?
 	AccountID                string             `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                                        // which account posted this status?
 	Account                  *Account           `validate:"-" bun:"rel:belongs-to"`                                                                    // account corresponding to accountID
@@ -66,6 +68,9 @@ type Status struct {
 	Boostable                *bool              `validate:"-" bun:",notnull"`                                                                          // This status can be boosted/reblogged
 	Replyable                *bool              `validate:"-" bun:",notnull"`                                                                          // This status can be replied to
 	Likeable                 *bool              `validate:"-" bun:",notnull"`                                                                          // This status can be liked/faved
+	ReplyPolicy              InteractionPolicy  `validate:"omitempty,oneof=anyone followers mentioned nobody" bun:",nullzero"`                         // Who may reply to this status; empty means anyone.
+	BoostPolicy              InteractionPolicy  `validate:"omitempty,oneof=anyone followers mentioned nobody" bun:",nullzero"`                         // Who may boost this status; empty means anyone.
+	LikePolicy               InteractionPolicy  `validate:"omitempty,oneof=anyone followers mentioned nobody" bun:",nullzero"`                         // Who may like this status; empty means anyone.
 }
 
 // GetID implements timeline.Timelineable{}.
@@ -250,6 +255,12 @@ func (s *Status) MentionsAccount(id string) bool {
 	return false
 }
 
+// IsLocalOnly returns whether this status is local-only,
+// ie., it should not be federated beyond this instance.
+func (s *Status) IsLocalOnly() bool {
+	return s.Federated != nil && !*s.Federated
+}
+
 // StatusToTag is an intermediate struct to facilitate the many2many relationship between a status and one or more tags.
 type StatusToTag struct {
 	StatusID string  `validate:"ulid,required" bun:"type:CHAR(26),unique:statustag,nullzero,notnull"`
@@ -283,3 +294,61 @@ const (
 	// VisibilityDefault is used when no other setting can be found.
 	VisibilityDefault Visibility = VisibilityUnlocked
 )
+
+// InteractionPolicy represents which accounts are
+// permitted to interact with a status in a given way,
+// ie., by replying to it, boosting it, or liking it.
+//
+// The author of a status may always interact with it.
+type InteractionPolicy string
+
+const (
+	// InteractionPolicyAnyone means anyone who can see the status may interact with it.
+	InteractionPolicyAnyone InteractionPolicy = "anyone"
+	// InteractionPolicyFollowers means followers of the author, and accounts mentioned in the status, may interact with it.
+	InteractionPolicyFollowers InteractionPolicy = "followers"
+	// InteractionPolicyMentioned means only accounts mentioned in the status may interact with it.
+	InteractionPolicyMentioned InteractionPolicy = "mentioned"
+	// InteractionPolicyNobody means nobody except the author may interact with the status.
+	InteractionPolicyNobody InteractionPolicy = "nobody"
+)
+
+// Restricted returns true if this policy permits
+// fewer accounts than InteractionPolicyAnyone.
+func (p InteractionPolicy) Restricted() bool {
+	return p != "" && p != InteractionPolicyAnyone
+}
+
+// EffectiveReplyPolicy returns the reply policy of this
+// status, taking account of the Replyable flag as well.
+func (s *Status) EffectiveReplyPolicy() InteractionPolicy {
+	return effectivePolicy(s.Replyable, s.ReplyPolicy)
+}
+
+// EffectiveBoostPolicy returns the boost policy of this
+// status, taking account of the Boostable flag and the
+// visibility of the status (non-public statuses can only
+// be boosted by their author) as well.
+func (s *Status) EffectiveBoostPolicy() InteractionPolicy {
+	switch s.Visibility {
+	case VisibilityFollowersOnly, VisibilityMutualsOnly, VisibilityDirect:
+		return InteractionPolicyNobody
+	}
+	return effectivePolicy(s.Boostable, s.BoostPolicy)
+}
+
+// EffectiveLikePolicy returns the like policy of this
+// status, taking account of the Likeable flag as well.
+func (s *Status) EffectiveLikePolicy() InteractionPolicy {
+	return effectivePolicy(s.Likeable, s.LikePolicy)
+}
+
+func effectivePolicy(flag *bool, policy InteractionPolicy) InteractionPolicy {
+	if flag != nil && !*flag {
+		return InteractionPolicyNobody
+	}
+	if policy == "" {
+		return InteractionPolicyAnyone
+	}
+	return policy
+}
@@ -0,0 +1,38 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// StatusReaction is an emoji reaction by an account
+// to a status, as used by Misskey, Pleroma and friends.
+type StatusReaction struct {
+	ID              string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`             // id of this item in the database
+	CreatedAt       time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`      // when was item created
+	UpdatedAt       time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`      // when was item last updated
+	AccountID       string    `validate:"required,ulid" bun:"type:CHAR(26),unique:statusreaction,nullzero,notnull"` // id of the account that reacted
+	Account         *Account  `validate:"-" bun:"-"`                                                                // account that reacted
+	TargetAccountID string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                       // id of the account owning the reacted-to status
+	TargetAccount   *Account  `validate:"-" bun:"-"`                                                                // account owning the reacted-to status
+	StatusID        string    `validate:"required,ulid" bun:"type:CHAR(26),unique:statusreaction,nullzero,notnull"` // database id of the status that was reacted to
+	Status          *Status   `validate:"-" bun:"-"`                                                                // the reacted-to status
+	Name            string    `validate:"required" bun:",unique:statusreaction,nullzero,notnull"`                   // Unicode emoji, or shortcode of a custom emoji (with @domain if remote)
+	EmojiID         string    `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                              // ID of the custom emoji, if Name is a shortcode
+	Emoji           *Emoji    `validate:"-" bun:"-"`                                                                // Emoji corresponding to emojiID
+	URI             string    `validate:"required,url" bun:",nullzero,notnull,unique"`                              // ActivityPub URI of this reaction
+}
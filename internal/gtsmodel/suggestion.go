@@ -0,0 +1,38 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// PinnedSuggestion is an account which an admin has chosen to
+// always suggest as a follow to users of this instance.
+type PinnedSuggestion struct {
+	ID        string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	AccountID string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique"`           // Which account is suggested?
+	Account   *Account  `validate:"-" bun:"rel:belongs-to"`                                              // Account corresponding to accountID
+}
+
+// SuggestionDismissal records that an account doesn't want
+// the target account to be suggested to them as a follow.
+type SuggestionDismissal struct {
+	ID              string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`               // id of this item in the database
+	CreatedAt       time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`        // when was item created
+	AccountID       string    `validate:"required,ulid" bun:"type:CHAR(26),unique:dismisssrctarget,notnull,nullzero"` // Who dismissed the suggestion?
+	TargetAccountID string    `validate:"required,ulid" bun:"type:CHAR(26),unique:dismisssrctarget,notnull,nullzero"` // Which suggested account was dismissed?
+}
@@ -39,4 +39,5 @@ type Token struct {
 	Refresh             string    `validate:"-" bun:",pk,nullzero,notnull,default:''"`                             // Refresh token, if present
 	RefreshCreateAt     time.Time `validate:"required_with=Refresh" bun:"type:timestamptz,nullzero"`               // Refresh created at, if refresh present
 	RefreshExpiresAt    time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // Refresh expires at -- null means the refresh token never expires
+	LastUsedAt          time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // Approximately when the access token was last used to authenticate a request, if ever
 }
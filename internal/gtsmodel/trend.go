@@ -0,0 +1,64 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Trend represents a tag, status or link (preview card) that is currently,
+// or was recently, trending on this instance. Trends are periodically scored
+// from recent activity, and must be approved by an admin before they are shown
+// to users. The review state is kept across re-scoring, so that once reviewed,
+// an item doesn't need to be reviewed again if it trends again later.
+type Trend struct {
+	ID         string     `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`              // id of this item in the database
+	CreatedAt  time.Time  `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`       // when was item created
+	UpdatedAt  time.Time  `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`       // when was item last updated
+	Type       TrendType  `validate:"oneof=tag status link" bun:",nullzero,notnull,unique:trendtypetarget"`      // Type of the trending item.
+	TargetID   string     `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique:trendtypetarget"` // ID of the trending tag, status or card.
+	Score      float64    `validate:"-" bun:",notnull,default:0"`                                                // Latest trend score; zero if no longer trending.
+	State      TrendState `validate:"oneof=pending approved rejected" bun:",nullzero,notnull,default:'pending'"` // Admin review state of this trend.
+	ReviewedAt time.Time  `validate:"-" bun:"type:timestamptz,nullzero"`                                         // When was this trend reviewed by an admin?
+}
+
+// TrendType denotes the type of a trending item.
+type TrendType string
+
+// TrendType values.
+const (
+	TrendTypeTag    TrendType = "tag"    // Trending hashtag.
+	TrendTypeStatus TrendType = "status" // Trending status.
+	TrendTypeLink   TrendType = "link"   // Trending link, ie., preview card.
+)
+
+// TrendState denotes the admin review state of a trend.
+type TrendState string
+
+// TrendState values.
+const (
+	TrendStatePending  TrendState = "pending"  // Not yet reviewed, not shown.
+	TrendStateApproved TrendState = "approved" // Approved, shown while trending.
+	TrendStateRejected TrendState = "rejected" // Rejected, never shown.
+)
+
+// TrendUse represents a single use of a trendable tag or
+// link by an account, for calculating usage history.
+// It is not stored in the database as its own table.
+type TrendUse struct {
+	AccountID string    `bun:"account_id"`
+	CreatedAt time.Time `bun:"created_at"`
+}
@@ -38,7 +38,7 @@ type User struct {
 	LastSignInAt           time.Time    `validate:"-" bun:"type:timestamptz,nullzero"`                                   // When did this user last sign in?
 	LastSignInIP           net.IP       `validate:"-" bun:",nullzero"`                                                   // What's the previous IP of this user?
 	SignInCount            int          `validate:"min=0" bun:",notnull,default:0"`                                      // How many times has this user signed in?
-	InviteID               string       `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                         // id of the user who invited this user (who let this joker in?)
+	InviteID               string       `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                         // id of the invite this user signed up with (who let this joker in?)
 	ChosenLanguages        []string     `validate:"-" bun:",nullzero"`                                                   // What languages does this user want to see?
 	FilteredLanguages      []string     `validate:"-" bun:",nullzero"`                                                   // What languages does this user not want to see?
 	Locale                 string       `validate:"-" bun:",nullzero"`                                                   // In what timezone/locale is this user located?
@@ -56,4 +56,16 @@ type User struct {
 	ResetPasswordToken     string       `validate:"required_with=ResetPasswordSentAt" bun:",nullzero"`                   // The generated token that the user can use to reset their password
 	ResetPasswordSentAt    time.Time    `validate:"required_with=ResetPasswordToken" bun:"type:timestamptz,nullzero"`    // When did we email the user their reset-password email?
 	ExternalID             string       `validate:"-" bun:",nullzero,unique"`                                            // If the login for the user is managed externally (e.g OIDC), we need to keep a stable reference to the external object (e.g OIDC sub claim)
+	TwoFactorSecret        string       `validate:"required_with=TwoFactorEnabledAt" bun:",nullzero"`                    // Base32-encoded TOTP secret for this user. Set but not yet in use until TwoFactorEnabledAt is set.
+	TwoFactorEnabledAt     time.Time    `validate:"-" bun:"type:timestamptz,nullzero"`                                   // When did this user confirm enrollment in two-factor authentication? Zero if 2FA is not enabled.
+	TwoFactorBackups       []string     `validate:"-" bun:",nullzero"`                                                   // Bcrypt hashes of unused one-time recovery codes that can be used in place of a TOTP code.
+	TwoFactorLastCounter   uint64       `validate:"-" bun:",nullzero"`                                                   // TOTP time step counter of the last code this user successfully used. Codes for this step or earlier are rejected, to prevent replay.
+	TwoFactorFailures      int          `validate:"-" bun:",nullzero"`                                                   // Number of incorrect two-factor codes entered for this user since the last successful one.
+	TwoFactorLockedUntil   time.Time    `validate:"-" bun:"type:timestamptz,nullzero"`                                   // Two-factor codes are not checked for this user until this time, because too many incorrect codes were entered.
+}
+
+// TwoFactorEnabled returns true if this user
+// has completed enrollment in two-factor auth.
+func (u *User) TwoFactorEnabled() bool {
+	return !u.TwoFactorEnabledAt.IsZero()
 }
@@ -0,0 +1,97 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// WebPushSubscription represents a subscription to Web Push notifications
+// created by one OAuth token of a local account. Each token may have at most
+// one subscription; creating a new one replaces any existing subscription.
+type WebPushSubscription struct {
+	ID                 string                    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt          time.Time                 `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`             // when was item created
+	UpdatedAt          time.Time                 `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`             // when was item last updated
+	AccountID          string                    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                              // Local account that owns this subscription.
+	TokenID            string                    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique"`                       // OAuth token this subscription was created with.
+	Endpoint           string                    `validate:"required,url" bun:",nullzero,notnull"`                                            // Push service URL that payloads are delivered to.
+	Auth               string                    `validate:"required" bun:",nullzero,notnull"`                                                // Base64 encoded user agent authentication secret.
+	P256dh             string                    `validate:"required" bun:",nullzero,notnull"`                                                // Base64 encoded user agent P-256 ECDH public key.
+	AlertFollow        *bool                     `validate:"-" bun:",nullzero,notnull,default:false"`                                         // Push notifications of new followers.
+	AlertFollowRequest *bool                     `validate:"-" bun:",nullzero,notnull,default:false"`                                         // Push notifications of new follow requests.
+	AlertFavourite     *bool                     `validate:"-" bun:",nullzero,notnull,default:false"`                                         // Push notifications of favourites.
+	AlertMention       *bool                     `validate:"-" bun:",nullzero,notnull,default:false"`                                         // Push notifications of mentions.
+	AlertReblog        *bool                     `validate:"-" bun:",nullzero,notnull,default:false"`                                         // Push notifications of boosts.
+	AlertPoll          *bool                     `validate:"-" bun:",nullzero,notnull,default:false"`                                         // Push notifications of ended polls.
+	AlertStatus        *bool                     `validate:"-" bun:",nullzero,notnull,default:false"`                                         // Push notifications of new statuses from accounts with notify enabled.
+	AlertAdminReport   *bool                     `validate:"-" bun:",nullzero,notnull,default:false"`                                         // Push notifications of new reports (moderators and admins only).
+	Policy             WebPushNotificationPolicy `validate:"required,oneof=all followed follower none" bun:",nullzero,notnull,default:'all'"` // Which accounts to receive push notifications from.
+}
+
+// WebPushNotificationPolicy determines which
+// accounts a subscription pushes notifications from.
+type WebPushNotificationPolicy string
+
+const (
+	WebPushNotificationPolicyAll      WebPushNotificationPolicy = "all"      // Push notifications from anyone.
+	WebPushNotificationPolicyFollowed WebPushNotificationPolicy = "followed" // Push notifications only from accounts the subscriber follows.
+	WebPushNotificationPolicyFollower WebPushNotificationPolicy = "follower" // Push notifications only from accounts following the subscriber.
+	WebPushNotificationPolicyNone     WebPushNotificationPolicy = "none"     // Push no notifications.
+)
+
+// AlertEnabled returns whether this subscription wants
+// to receive push notifications of the given type.
+func (s *WebPushSubscription) AlertEnabled(notificationType NotificationType) bool {
+	var alert *bool
+
+	switch notificationType {
+	case NotificationFollow:
+		alert = s.AlertFollow
+	case NotificationFollowRequest:
+		alert = s.AlertFollowRequest
+	case NotificationFave, NotificationReaction:
+		// Clients have no separate toggle for emoji
+		// reactions, so treat them like favourites.
+		alert = s.AlertFavourite
+	case NotificationMention:
+		alert = s.AlertMention
+	case NotificationReblog:
+		alert = s.AlertReblog
+	case NotificationPoll:
+		alert = s.AlertPoll
+	case NotificationStatus:
+		alert = s.AlertStatus
+	case NotificationAdminReport:
+		alert = s.AlertAdminReport
+	case NotificationModerationWarning:
+		// There's no client toggle for moderation
+		// warnings; they're too important to miss.
+		return true
+	}
+
+	return alert != nil && *alert
+}
+
+// VAPIDKeyPair is the instance's Voluntary Application Server
+// Identification key pair, used to sign Web Push deliveries.
+// There is only ever one, generated on first start.
+type VAPIDKeyPair struct {
+	ID        string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	Public    string    `validate:"required" bun:",nullzero,notnull"`                                    // Base64 URL encoded uncompressed P-256 public key.
+	Private   string    `validate:"required" bun:",nullzero,notnull"`                                    // Base64 URL encoded P-256 private key scalar.
+}
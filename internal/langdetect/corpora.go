@@ -0,0 +1,233 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package langdetect
+
+// corpora contains a sample of ordinary written text for each
+// language that can be told apart by trigram scoring. Profiles
+// are built from these on startup; they don't need to be long,
+// but they should use everyday vocabulary and common words.
+var corpora = map[string]string{
+	"en": `The weather was lovely this morning, so we decided to walk to the market instead of taking the bus.
+There were more people than usual, and everyone seemed to be in a good mood. I bought some fresh bread,
+a few apples and a bunch of flowers for the kitchen table. On the way back we stopped at the little cafe
+near the river and had a coffee while watching the boats go by. Does anyone know if the library is open
+on Sunday? I would like to return the books I borrowed last month before they charge me for them.
+Thank you all for the kind messages yesterday, it really made my day. I think this is one of the best
+things about being here: people actually take the time to help each other out. Which of these would you
+choose if you could only have one for the rest of your life? I have been working on a new project that
+should be ready in a couple of weeks, and I will share it with you as soon as it is finished.`,
+
+	"de": `Heute Morgen war das Wetter so schön, dass wir zu Fuß zum Markt gegangen sind, statt den Bus zu nehmen.
+Es waren mehr Leute unterwegs als sonst, und alle schienen gute Laune zu haben. Ich habe frisches Brot,
+ein paar Äpfel und einen Strauß Blumen für den Küchentisch gekauft. Auf dem Rückweg haben wir in dem kleinen
+Café am Fluss angehalten und einen Kaffee getrunken, während die Boote vorbeifuhren. Weiß jemand, ob die
+Bibliothek am Sonntag geöffnet ist? Ich möchte die Bücher zurückgeben, die ich letzten Monat ausgeliehen habe.
+Vielen Dank für die lieben Nachrichten gestern, das hat mich wirklich gefreut. Ich glaube, das ist eines der
+besten Dinge hier: Die Menschen nehmen sich tatsächlich die Zeit, einander zu helfen. Welches würdet ihr
+wählen, wenn ihr nur eines für den Rest eures Lebens haben könntet? Ich arbeite gerade an einem neuen Projekt,
+das in ein paar Wochen fertig sein sollte, und ich werde es mit euch teilen, sobald es soweit ist.`,
+
+	"fr": `Il faisait tellement beau ce matin que nous avons décidé d'aller au marché à pied au lieu de prendre le bus.
+Il y avait plus de monde que d'habitude, et tout le monde semblait de bonne humeur. J'ai acheté du pain frais,
+quelques pommes et un bouquet de fleurs pour la table de la cuisine. Sur le chemin du retour, nous nous sommes
+arrêtés au petit café près de la rivière et nous avons pris un café en regardant passer les bateaux. Est-ce que
+quelqu'un sait si la bibliothèque est ouverte le dimanche ? Je voudrais rendre les livres que j'ai empruntés
+le mois dernier. Merci à tous pour vos gentils messages hier, ça m'a vraiment fait plaisir. Je pense que c'est
+l'une des meilleures choses ici : les gens prennent vraiment le temps de s'entraider. Lequel choisiriez-vous
+si vous ne pouviez en avoir qu'un seul pour le reste de votre vie ? Je travaille sur un nouveau projet qui
+devrait être prêt dans quelques semaines, et je le partagerai avec vous dès qu'il sera terminé.`,
+
+	"es": `Esta mañana hacía tan buen tiempo que decidimos ir andando al mercado en lugar de coger el autobús.
+Había más gente de lo normal y todo el mundo parecía estar de buen humor. Compré pan recién hecho, unas
+cuantas manzanas y un ramo de flores para la mesa de la cocina. De vuelta a casa paramos en la pequeña
+cafetería que está junto al río y tomamos un café mientras veíamos pasar los barcos. ¿Alguien sabe si la
+biblioteca abre los domingos? Me gustaría devolver los libros que saqué el mes pasado. Muchas gracias a
+todos por los mensajes tan bonitos de ayer, de verdad me alegraron el día. Creo que esta es una de las
+mejores cosas de estar aquí: la gente de verdad se toma el tiempo para ayudarse unos a otros. ¿Cuál
+elegiríais si solo pudierais tener uno durante el resto de vuestra vida? Estoy trabajando en un proyecto
+nuevo que debería estar listo dentro de un par de semanas, y os lo enseñaré en cuanto esté terminado.`,
+
+	"it": `Stamattina il tempo era così bello che abbiamo deciso di andare al mercato a piedi invece di prendere
+l'autobus. C'era più gente del solito e tutti sembravano di buon umore. Ho comprato del pane fresco, qualche
+mela e un mazzo di fiori per il tavolo della cucina. Sulla strada del ritorno ci siamo fermati al piccolo bar
+vicino al fiume e abbiamo preso un caffè guardando passare le barche. Qualcuno sa se la biblioteca è aperta
+la domenica? Vorrei restituire i libri che ho preso in prestito il mese scorso. Grazie a tutti per i vostri
+messaggi gentili di ieri, mi avete davvero rallegrato la giornata. Penso che questa sia una delle cose più
+belle di questo posto: le persone si prendono davvero il tempo di aiutarsi a vicenda. Quale scegliereste se
+poteste averne solo uno per il resto della vostra vita? Sto lavorando a un nuovo progetto che dovrebbe essere
+pronto tra un paio di settimane, e ve lo farò vedere non appena sarà finito.`,
+
+	"pt": `Hoje de manhã o tempo estava tão bonito que decidimos ir a pé até a feira em vez de pegar o ônibus.
+Havia mais gente do que o normal, e todo mundo parecia estar de bom humor. Comprei pão fresco, algumas maçãs
+e um ramo de flores para a mesa da cozinha. No caminho de volta paramos no pequeno café perto do rio e tomamos
+um cafezinho enquanto víamos os barcos passarem. Alguém sabe se a biblioteca abre aos domingos? Eu queria
+devolver os livros que peguei emprestado no mês passado. Muito obrigado a todos pelas mensagens carinhosas
+de ontem, vocês realmente fizeram o meu dia. Acho que essa é uma das melhores coisas daqui: as pessoas de
+fato tiram um tempo para ajudar umas às outras. Qual vocês escolheriam se só pudessem ter um pelo resto da
+vida? Estou trabalhando num projeto novo que deve ficar pronto em algumas semanas, e vou compartilhar com
+vocês assim que estiver terminado. Não sei se isso é uma boa ideia, mas não custa tentar.`,
+
+	"nl": `Vanochtend was het zulk mooi weer dat we besloten om naar de markt te lopen in plaats van de bus te nemen.
+Er waren meer mensen dan normaal en iedereen leek in een goede bui te zijn. Ik heb vers brood gekocht, een
+paar appels en een bos bloemen voor de keukentafel. Op de terugweg zijn we gestopt bij het kleine café bij
+de rivier en hebben we koffie gedronken terwijl we de boten voorbij zagen varen. Weet iemand of de bibliotheek
+op zondag open is? Ik wil graag de boeken terugbrengen die ik vorige maand heb geleend. Bedankt allemaal voor
+de lieve berichten gisteren, daar werd ik echt blij van. Ik denk dat dit een van de beste dingen hier is: de
+mensen nemen echt de tijd om elkaar te helpen. Welke zouden jullie kiezen als je er maar één mocht hebben voor
+de rest van je leven? Ik werk op dit moment aan een nieuw project dat over een paar weken klaar zou moeten
+zijn, en ik zal het met jullie delen zodra het af is. Het is niet veel, maar ik ben er best trots op.`,
+
+	"sv": `I morse var vädret så fint att vi bestämde oss för att gå till torget i stället för att ta bussen.
+Det var mer folk än vanligt och alla verkade vara på gott humör. Jag köpte nybakat bröd, några äpplen och en
+bukett blommor till köksbordet. På vägen hem stannade vi vid det lilla kaféet vid ån och drack kaffe medan vi
+tittade på båtarna som åkte förbi. Vet någon om biblioteket har öppet på söndagar? Jag skulle vilja lämna
+tillbaka böckerna som jag lånade förra månaden. Tack alla för de fina meddelandena i går, det gjorde verkligen
+min dag. Jag tror att det här är en av de bästa sakerna med att vara här: folk tar sig faktiskt tid att hjälpa
+varandra. Vilken skulle ni välja om ni bara fick ha en för resten av livet? Jag håller på med ett nytt projekt
+som borde vara klart om ett par veckor, och jag ska dela det med er så snart det är färdigt. Det är inte mycket,
+men jag är ganska nöjd med det ändå.`,
+
+	"da": `I morges var vejret så dejligt, at vi besluttede at gå ned på torvet i stedet for at tage bussen.
+Der var flere mennesker end normalt, og alle virkede til at være i godt humør. Jeg købte friskbagt brød, nogle
+æbler og en buket blomster til køkkenbordet. På vej hjem stoppede vi ved den lille café ved åen og fik en kop
+kaffe, mens vi så bådene sejle forbi. Er der nogen, der ved, om biblioteket har åbent om søndagen? Jeg vil gerne
+aflevere de bøger, jeg lånte sidste måned. Tak til jer alle for de søde beskeder i går, det gjorde virkelig min
+dag. Jeg tror, at det er en af de bedste ting ved at være her: folk tager sig faktisk tid til at hjælpe
+hinanden. Hvilken ville I vælge, hvis I kun måtte have én resten af livet? Jeg arbejder på et nyt projekt,
+som gerne skulle være færdigt om et par uger, og jeg deler det med jer, så snart det er klar. Det er ikke
+meget, men jeg er egentlig ret glad for det.`,
+
+	"no": `I morges var været så fint at vi bestemte oss for å gå til torget i stedet for å ta bussen.
+Det var flere folk enn vanlig, og alle så ut til å være i godt humør. Jeg kjøpte nybakt brød, noen epler og
+en bukett blomster til kjøkkenbordet. På vei hjem stoppet vi ved den lille kafeen ved elva og tok en kopp
+kaffe mens vi så på båtene som kjørte forbi. Er det noen som vet om biblioteket er åpent på søndager? Jeg vil
+gjerne levere tilbake bøkene jeg lånte forrige måned. Takk til dere alle for de hyggelige meldingene i går,
+det gjorde virkelig dagen min. Jeg tror dette er noe av det beste med å være her: folk tar seg faktisk tid
+til å hjelpe hverandre. Hvilken ville dere valgt hvis dere bare kunne ha én for resten av livet? Jeg jobber
+med et nytt prosjekt som burde være ferdig om et par uker, og jeg skal dele det med dere så snart det er
+klart. Det er ikke mye, men jeg er ganske fornøyd med det likevel.`,
+
+	"pl": `Dziś rano pogoda była tak piękna, że postanowiliśmy pójść na targ pieszo, zamiast jechać autobusem.
+Było więcej ludzi niż zwykle i wszyscy wydawali się być w dobrym humorze. Kupiłem świeży chleb, kilka jabłek
+i bukiet kwiatów na stół w kuchni. W drodze powrotnej zatrzymaliśmy się w małej kawiarni nad rzeką i wypiliśmy
+kawę, patrząc na przepływające łodzie. Czy ktoś wie, czy biblioteka jest otwarta w niedzielę? Chciałbym oddać
+książki, które wypożyczyłem w zeszłym miesiącu. Dziękuję wszystkim za wczorajsze miłe wiadomości, naprawdę
+poprawiły mi dzień. Myślę, że to jedna z najlepszych rzeczy tutaj: ludzie naprawdę znajdują czas, żeby sobie
+nawzajem pomagać. Który byście wybrali, gdybyście mogli mieć tylko jeden do końca życia? Pracuję teraz nad
+nowym projektem, który powinien być gotowy za kilka tygodni, i podzielę się nim z wami, jak tylko go skończę.
+To nic wielkiego, ale jestem z niego całkiem zadowolony.`,
+
+	"cs": `Dnes ráno bylo tak krásné počasí, že jsme se rozhodli jít na trh pěšky místo toho, abychom jeli autobusem.
+Bylo tam víc lidí než obvykle a všichni vypadali, že mají dobrou náladu. Koupil jsem čerstvý chleba, pár jablek
+a kytici květin na kuchyňský stůl. Cestou zpátky jsme se zastavili v malé kavárně u řeky a dali si kávu, zatímco
+jsme se dívali na lodě, které proplouvaly kolem. Neví někdo, jestli má knihovna v neděli otevřeno? Chtěl bych
+vrátit knížky, které jsem si půjčil minulý měsíc. Děkuji vám všem za včerejší milé zprávy, opravdu mi udělaly
+radost. Myslím, že tohle je jedna z nejlepších věcí na tomhle místě: lidé si opravdu najdou čas, aby si navzájem
+pomohli. Kterou byste si vybrali, kdybyste mohli mít jen jednu po zbytek života? Pracuji na novém projektu,
+který by měl být hotový za pár týdnů, a jakmile bude dokončený, podělím se o něj s vámi. Není to nic velkého,
+ale jsem s tím docela spokojený.`,
+
+	"fi": `Tänä aamuna sää oli niin kaunis, että päätimme kävellä torille sen sijaan, että olisimme menneet bussilla.
+Ihmisiä oli enemmän kuin tavallisesti, ja kaikki näyttivät olevan hyvällä tuulella. Ostin tuoretta leipää,
+muutaman omenan ja kimpun kukkia keittiön pöydälle. Kotimatkalla pysähdyimme pieneen kahvilaan joen rannalla
+ja joimme kahvit samalla kun katselimme ohi kulkevia veneitä. Tietääkö joku, onko kirjasto auki sunnuntaisin?
+Haluaisin palauttaa kirjat, jotka lainasin viime kuussa. Kiitos kaikille eilisistä ystävällisistä viesteistä,
+ne todella piristivät päivääni. Luulen, että tämä on yksi parhaista asioista täällä: ihmiset todella ottavat
+aikaa auttaakseen toisiaan. Minkä te valitsisitte, jos saisitte pitää vain yhden koko loppuelämänne ajan?
+Työskentelen uuden projektin parissa, jonka pitäisi olla valmis parin viikon kuluttua, ja jaan sen teidän
+kanssanne heti kun se on valmis. Se ei ole mitään suurta, mutta olen siihen aika tyytyväinen.`,
+
+	"tr": `Bu sabah hava o kadar güzeldi ki otobüse binmek yerine pazara yürüyerek gitmeye karar verdik.
+Her zamankinden daha fazla insan vardı ve herkes keyifli görünüyordu. Taze ekmek, birkaç elma ve mutfak masası
+için bir demet çiçek aldım. Dönüş yolunda nehrin kenarındaki küçük kafede durduk ve geçen tekneleri izlerken
+kahve içtik. Kütüphanenin pazar günleri açık olup olmadığını bilen var mı? Geçen ay ödünç aldığım kitapları
+geri vermek istiyorum. Dünkü güzel mesajlarınız için hepinize çok teşekkür ederim, gerçekten günümü güzelleştirdi.
+Bence burada olmanın en güzel yanlarından biri bu: insanlar birbirlerine yardım etmek için gerçekten zaman
+ayırıyorlar. Hayatınızın geri kalanında sadece bir tanesine sahip olabilseydiniz hangisini seçerdiniz?
+Birkaç hafta içinde hazır olması gereken yeni bir proje üzerinde çalışıyorum ve biter bitmez sizinle
+paylaşacağım. Çok büyük bir şey değil ama yine de bundan oldukça memnunum.`,
+
+	"id": `Pagi ini cuacanya sangat cerah, jadi kami memutuskan untuk berjalan kaki ke pasar daripada naik bus.
+Orangnya lebih banyak dari biasanya dan semua orang kelihatannya sedang senang. Saya membeli roti yang masih
+segar, beberapa buah apel dan seikat bunga untuk meja dapur. Dalam perjalanan pulang kami mampir di kafe kecil
+di dekat sungai dan minum kopi sambil melihat perahu yang lewat. Apakah ada yang tahu apakah perpustakaan buka
+pada hari Minggu? Saya ingin mengembalikan buku yang saya pinjam bulan lalu. Terima kasih semuanya atas pesan
+yang baik kemarin, itu benar-benar membuat hari saya menyenangkan. Menurut saya ini adalah salah satu hal
+terbaik di sini: orang-orang benar-benar meluangkan waktu untuk saling membantu. Mana yang akan kalian pilih
+kalau hanya boleh punya satu untuk seumur hidup? Saya sedang mengerjakan proyek baru yang seharusnya selesai
+dalam beberapa minggu, dan saya akan membagikannya dengan kalian begitu sudah jadi.`,
+
+	"ca": `Aquest matí feia tan bon temps que hem decidit anar caminant al mercat en lloc d'agafar l'autobús.
+Hi havia més gent que de costum i tothom semblava estar de bon humor. He comprat pa acabat de fer, unes quantes
+pomes i un ram de flors per a la taula de la cuina. De tornada ens hem aturat a la cafeteria petita que hi ha
+al costat del riu i hem pres un cafè mentre miràvem com passaven les barques. Algú sap si la biblioteca obre
+els diumenges? M'agradaria tornar els llibres que vaig agafar el mes passat. Moltes gràcies a tothom pels
+missatges tan macos d'ahir, de debò que em van alegrar el dia. Crec que aquesta és una de les millors coses
+d'estar aquí: la gent de debò es pren el temps per ajudar-se els uns als altres. Quin triaríeu si només en
+poguéssiu tenir un per a la resta de la vostra vida? Estic treballant en un projecte nou que hauria d'estar
+llest d'aquí a un parell de setmanes, i us el compartiré tan aviat com estigui acabat.`,
+
+	"ro": `În dimineața asta vremea a fost atât de frumoasă încât am hotărât să mergem pe jos la piață în loc să
+luăm autobuzul. Erau mai mulți oameni decât de obicei și toată lumea părea să fie bine dispusă. Am cumpărat
+pâine proaspătă, câteva mere și un buchet de flori pentru masa din bucătărie. Pe drumul de întoarcere ne-am
+oprit la cafeneaua mică de lângă râu și am băut o cafea în timp ce ne uitam la bărcile care treceau. Știe
+cineva dacă biblioteca este deschisă duminica? Aș vrea să returnez cărțile pe care le-am împrumutat luna
+trecută. Vă mulțumesc tuturor pentru mesajele frumoase de ieri, chiar mi-ați făcut ziua mai bună. Cred că
+acesta este unul dintre cele mai bune lucruri de aici: oamenii chiar își fac timp să se ajute unii pe alții.
+Pe care l-ați alege dacă ați putea avea doar unul pentru tot restul vieții? Lucrez la un proiect nou care ar
+trebui să fie gata în câteva săptămâni și îl voi împărtăși cu voi imediat ce este terminat.`,
+
+	"hu": `Ma reggel olyan szép idő volt, hogy úgy döntöttünk, gyalog megyünk a piacra ahelyett, hogy busszal mennénk.
+Több ember volt ott, mint általában, és mindenki jó hangulatban volt. Vettem friss kenyeret, néhány almát és egy
+csokor virágot a konyhaasztalra. Hazafelé megálltunk a folyó melletti kis kávézóban, és megittunk egy kávét,
+miközben néztük az elhaladó hajókat. Tudja valaki, hogy a könyvtár nyitva van-e vasárnap? Szeretném visszavinni
+a könyveket, amiket a múlt hónapban kölcsönöztem ki. Köszönöm mindenkinek a tegnapi kedves üzeneteket, igazán
+feldobták a napomat. Szerintem ez az egyik legjobb dolog itt: az emberek tényleg időt szánnak arra, hogy
+segítsenek egymásnak. Melyiket választanátok, ha életetek végéig csak egy lehetne? Egy új projekten dolgozom,
+aminek néhány héten belül el kellene készülnie, és amint kész lesz, megosztom veletek. Nem nagy dolog, de
+azért elég elégedett vagyok vele.`,
+
+	"ru": `Сегодня утром погода была такой хорошей, что мы решили пойти на рынок пешком, а не ехать на автобусе.
+Людей было больше, чем обычно, и все, казалось, были в хорошем настроении. Я купил свежий хлеб, несколько яблок
+и букет цветов для кухонного стола. На обратном пути мы зашли в маленькое кафе у реки и выпили кофе, глядя на
+проплывающие мимо лодки. Кто-нибудь знает, работает ли библиотека по воскресеньям? Я хотел бы вернуть книги,
+которые взял в прошлом месяце. Спасибо всем за вчерашние добрые сообщения, они правда подняли мне настроение.
+Мне кажется, это одна из лучших вещей здесь: люди действительно находят время, чтобы помогать друг другу.
+Что бы вы выбрали, если бы могли оставить себе только одно на всю оставшуюся жизнь? Сейчас я работаю над новым
+проектом, который должен быть готов через пару недель, и я поделюсь им с вами, как только он будет закончен.
+Ничего особенного, но я всё равно им доволен.`,
+
+	"uk": `Сьогодні зранку погода була така гарна, що ми вирішили піти на ринок пішки, а не їхати автобусом.
+Людей було більше, ніж зазвичай, і всі, здавалося, були в гарному настрої. Я купив свіжий хліб, кілька яблук
+і букет квітів для кухонного столу. Дорогою назад ми зайшли в маленьку кав'ярню біля річки і випили кави,
+дивлячись на човни, що пропливали повз. Хтось знає, чи працює бібліотека в неділю? Я хотів би повернути книжки,
+які взяв минулого місяця. Дякую всім за вчорашні теплі повідомлення, вони справді підняли мені настрій. Мені
+здається, що це одна з найкращих речей тут: люди справді знаходять час, щоб допомагати одне одному. Що б ви
+обрали, якби могли залишити собі лише одне на все життя? Зараз я працюю над новим проєктом, який має бути
+готовий за кілька тижнів, і я поділюся ним з вами, щойно він буде завершений. Нічого особливого, але я все
+одно ним задоволений.`,
+
+	"bg": `Тази сутрин времето беше толкова хубаво, че решихме да отидем пеша до пазара, вместо да вземем автобуса.
+Имаше повече хора от обикновено и всички изглеждаха в добро настроение. Купих си пресен хляб, няколко ябълки и
+букет цветя за кухненската маса. На връщане се отбихме в малкото кафене до реката и пихме кафе, докато гледахме
+как минават лодките. Някой знае ли дали библиотеката работи в неделя? Бих искал да върна книгите, които взех
+миналия месец. Благодаря на всички за милите съобщения вчера, наистина ми оправихте деня. Мисля, че това е едно
+от най-хубавите неща тук: хората наистина отделят време, за да си помагат един на друг. Кое бихте избрали, ако
+можехте да имате само едно до края на живота си? В момента работя по нов проект, който трябва да е готов след
+няколко седмици, и ще го споделя с вас веднага щом бъде завършен. Нищо особено, но все пак съм доволен от него.`,
+}
@@ -0,0 +1,319 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package langdetect provides offline detection of the
+// language that a short piece of text is written in.
+//
+// Detection works in two steps: first the writing system
+// (script) of the text is determined, which is enough by itself
+// for languages with a script of their own, like Japanese or
+// Greek. Texts in scripts shared by several languages (Latin
+// and Cyrillic) are then scored against trigram profiles of
+// each of those languages, and the most likely one is picked.
+package langdetect
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+const (
+	// minScriptLetters is the minimum number of letters
+	// needed to guess the language from the script alone.
+	minScriptLetters = 3
+
+	// minTrigramLetters is the minimum number of letters needed
+	// to guess the language of a text by scoring its trigrams;
+	// anything shorter is just too unreliable to bother with.
+	minTrigramLetters = 20
+
+	// minMargin is the minimum difference in average trigram
+	// score between the best and the second best language for
+	// the best language to be returned as a confident guess.
+	minMargin = 0.03
+)
+
+// noise matches bits of text that don't tell us anything
+// about its language, or that might actively mislead us:
+// links, mentions, hashtags and custom emoji shortcodes.
+var noise = regexp.MustCompile(`https?://\S+|[@#]\S+|:[\w]+:`)
+
+// Detect returns the ISO 639-1 code of the language that the
+// given plaintext is most likely written in, or an empty string
+// if the language couldn't be detected with any confidence.
+func Detect(text string) string {
+	text = noise.ReplaceAllString(text, " ")
+	text = strings.ToLower(text)
+
+	switch script, letters := dominantScript(text); {
+	case letters < minScriptLetters:
+		return ""
+
+	case script == unicode.Latin:
+		if letters < minTrigramLetters {
+			return ""
+		}
+		return latin.detect(text)
+
+	case script == unicode.Cyrillic:
+		if letters < minTrigramLetters {
+			return ""
+		}
+		return cyrillic.detect(text)
+
+	case script == unicode.Han:
+		// Han characters are used by both Chinese and Japanese,
+		// but only Japanese uses the kana scripts alongside them.
+		if strings.IndexFunc(text, isKana) != -1 {
+			return "ja"
+		}
+		return "zh"
+
+	case script == unicode.Arabic:
+		// Persian and Urdu both use a handful of letters
+		// that aren't used in Arabic itself.
+		switch {
+		case strings.ContainsAny(text, "ٹڈڑںے"):
+			return "ur"
+		case strings.ContainsAny(text, "پچژگکی"):
+			return "fa"
+		default:
+			return "ar"
+		}
+
+	default:
+		return scriptLanguages[script]
+	}
+}
+
+// scriptLanguages maps scripts that are (mostly)
+// used by just one language to that language.
+var scriptLanguages = map[*unicode.RangeTable]string{
+	unicode.Hiragana:   "ja",
+	unicode.Katakana:   "ja",
+	unicode.Hangul:     "ko",
+	unicode.Greek:      "el",
+	unicode.Hebrew:     "he",
+	unicode.Thai:       "th",
+	unicode.Devanagari: "hi",
+	unicode.Bengali:    "bn",
+	unicode.Tamil:      "ta",
+	unicode.Georgian:   "ka",
+	unicode.Armenian:   "hy",
+}
+
+// scripts are all the scripts that we know what to do with.
+var scripts = []*unicode.RangeTable{
+	unicode.Latin,
+	unicode.Cyrillic,
+	unicode.Han,
+	unicode.Arabic,
+	unicode.Hiragana,
+	unicode.Katakana,
+	unicode.Hangul,
+	unicode.Greek,
+	unicode.Hebrew,
+	unicode.Thai,
+	unicode.Devanagari,
+	unicode.Bengali,
+	unicode.Tamil,
+	unicode.Georgian,
+	unicode.Armenian,
+}
+
+// dominantScript returns the script that most letters of
+// the text are written in, and the total count of letters.
+// Hiragana and katakana are counted as Han, so that mixed
+// Japanese text is seen as one script.
+func dominantScript(text string) (*unicode.RangeTable, int) {
+	counts := make(map[*unicode.RangeTable]int, len(scripts))
+	letters := 0
+
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+
+		for _, script := range scripts {
+			if !unicode.Is(script, r) {
+				continue
+			}
+
+			if isKana(r) {
+				script = unicode.Han
+			}
+
+			counts[script]++
+			break
+		}
+	}
+
+	var (
+		dominant *unicode.RangeTable
+		max      int
+	)
+
+	// Iterate in order to keep
+	// the result deterministic.
+	for _, script := range scripts {
+		if counts[script] > max {
+			dominant = script
+			max = counts[script]
+		}
+	}
+
+	return dominant, letters
+}
+
+// isKana returns whether r is a Japanese kana character.
+func isKana(r rune) bool {
+	return unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r)
+}
+
+// profileSet is a set of trigram profiles
+// for languages that share a script.
+type profileSet map[string]*profile
+
+// detect returns the language from the set that best matches the
+// text, or an empty string if no language is a clear winner.
+func (ps profileSet) detect(text string) string {
+	trigrams := trigrams(text)
+	if len(trigrams) == 0 {
+		return ""
+	}
+
+	var (
+		best        string
+		bestScore   = math.Inf(-1)
+		secondScore = math.Inf(-1)
+	)
+
+	for lang, p := range ps {
+		score := p.score(trigrams)
+
+		switch {
+		case score > bestScore:
+			best, bestScore, secondScore = lang, score, bestScore
+		case score > secondScore:
+			secondScore = score
+		}
+	}
+
+	if bestScore-secondScore < minMargin {
+		return ""
+	}
+
+	return best
+}
+
+// profile holds the log probability of each trigram
+// that occurs in the sample text of one language.
+type profile struct {
+	logProbs map[string]float64
+
+	// unseen is the log probability
+	// of a trigram not in the profile.
+	unseen float64
+}
+
+// newProfile builds a new trigram profile from the given sample
+// text, using add-one smoothing for trigrams that don't occur in it.
+func newProfile(sample string) *profile {
+	counts := make(map[string]int)
+	total := 0
+
+	for _, trigram := range trigrams(strings.ToLower(sample)) {
+		counts[trigram]++
+		total++
+	}
+
+	// Leave room in the denominator for
+	// trigrams that the sample doesn't have.
+	denominator := float64(total + len(counts) + 1)
+
+	p := &profile{
+		logProbs: make(map[string]float64, len(counts)),
+		unseen:   math.Log(1 / denominator),
+	}
+
+	for trigram, count := range counts {
+		p.logProbs[trigram] = math.Log(float64(count+1) / denominator)
+	}
+
+	return p
+}
+
+// score returns the average log probability
+// of the given trigrams according to the profile.
+func (p *profile) score(trigrams []string) float64 {
+	var sum float64
+
+	for _, trigram := range trigrams {
+		if logProb, ok := p.logProbs[trigram]; ok {
+			sum += logProb
+		} else {
+			sum += p.unseen
+		}
+	}
+
+	return sum / float64(len(trigrams))
+}
+
+// trigrams splits the given (lowercased) text into words,
+// and returns all trigrams of those words, each word padded
+// with a space on either side so that word boundaries count.
+func trigrams(text string) []string {
+	var trigrams []string
+
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+
+	for _, word := range words {
+		runes := []rune(" " + word + " ")
+		for i := 0; i+3 <= len(runes); i++ {
+			trigrams = append(trigrams, string(runes[i:i+3]))
+		}
+	}
+
+	return trigrams
+}
+
+var (
+	// latin contains profiles for languages written in Latin script.
+	latin = profileSet{}
+
+	// cyrillic contains profiles for languages written in Cyrillic script.
+	cyrillic = profileSet{}
+)
+
+func init() {
+	for lang, sample := range corpora {
+		script, _ := dominantScript(strings.ToLower(sample))
+		switch script {
+		case unicode.Latin:
+			latin[lang] = newProfile(sample)
+		case unicode.Cyrillic:
+			cyrillic[lang] = newProfile(sample)
+		default:
+			panic("langdetect: no profile set for sample of " + lang)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package langdetect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/langdetect"
+)
+
+type LangDetectTestSuite struct {
+	suite.Suite
+}
+
+func (suite *LangDetectTestSuite) TestDetect() {
+	for _, test := range []struct {
+		text     string
+		expected string
+	}{
+		{"Just finished reading a great book about the history of computers, highly recommend it to everyone", "en"},
+		{"Does anyone have a good recipe for soup? It is getting cold outside and I need something warm", "en"},
+		{"Hat jemand ein gutes Rezept für Suppe? Draußen wird es kalt und ich brauche etwas Warmes", "de"},
+		{"Quelqu'un a une bonne recette de soupe ? Il commence à faire froid dehors et j'ai besoin de quelque chose de chaud", "fr"},
+		{"¿Alguien tiene una buena receta de sopa? Empieza a hacer frío fuera y necesito algo calentito", "es"},
+		{"Ho appena finito di leggere un bel libro sulla storia dei computer, lo consiglio a tutti", "it"},
+		{"Acabei de ler um livro ótimo sobre a história dos computadores, recomendo para todo mundo", "pt"},
+		{"Heeft iemand een goed recept voor soep? Het wordt koud buiten en ik heb iets warms nodig", "nl"},
+		{"Właśnie skończyłem czytać świetną książkę o historii komputerów, polecam ją każdemu", "pl"},
+		{"Luin juuri loppuun hienon kirjan tietokoneiden historiasta, suosittelen sitä kaikille", "fi"},
+		{"Только что дочитал отличную книгу об истории компьютеров, всем очень рекомендую", "ru"},
+		{"Щойно дочитав чудову книжку про історію комп'ютерів, дуже рекомендую всім", "uk"},
+		{"コンピュータの歴史についての素晴らしい本を読み終えました", "ja"},
+		{"我刚读完一本关于计算机历史的好书，推荐给大家", "zh"},
+		{"컴퓨터의 역사에 관한 훌륭한 책을 막 다 읽었습니다", "ko"},
+		{"Μόλις τελείωσα ένα υπέροχο βιβλίο για την ιστορία των υπολογιστών", "el"},
+		{"@someone@example.org check this out https://example.org/some/long/path #GoToSocial :blobcat:", ""},
+		{"lol same", ""},
+		{"", ""},
+	} {
+		suite.Equal(test.expected, langdetect.Detect(test.text), test.text)
+	}
+}
+
+func TestLangDetectTestSuite(t *testing.T) {
+	suite.Run(t, new(LangDetectTestSuite))
+}
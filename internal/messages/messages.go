@@ -23,6 +23,31 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
+// Object types for messages that don't correspond to
+// an ActivityStreams object, for side effects that are
+// only processed locally and never federated.
+const (
+	// ObjectModerationWarning is a warning to a local account about
+	// a moderation action taken against it. GTSModel should be the
+	// *gtsmodel.AdminAccountAction, with APActivityType ap.ActivityCreate.
+	ObjectModerationWarning = "ModerationWarning"
+
+	// ObjectDomainBlock is a block of a remote domain by a local
+	// account. GTSModel should be the *gtsmodel.AccountDomainBlock,
+	// with APActivityType ap.ActivityCreate or ap.ActivityUndo.
+	ObjectDomainBlock = "DomainBlock"
+
+	// ObjectAnnouncement is a server-wide announcement by an admin.
+	// GTSModel should be the *gtsmodel.Announcement, with APActivityType
+	// ap.ActivityCreate, ap.ActivityUpdate, or ap.ActivityDelete.
+	ObjectAnnouncement = "Announcement"
+
+	// ObjectAnnouncementReaction is an emoji reaction to an announcement
+	// by a local account. GTSModel should be the *gtsmodel.AnnouncementReaction,
+	// with APActivityType ap.ActivityCreate or ap.ActivityUndo.
+	ObjectAnnouncementReaction = "AnnouncementReaction"
+)
+
 // FromClientAPI wraps a message that travels from the client API into the processor.
 type FromClientAPI struct {
 	APObjectType   string
@@ -37,7 +62,7 @@ type FromFederator struct {
 	APObjectType     string
 	APActivityType   string
 	APIri            *url.URL
-	APObjectModel    interface{}       // Optional AP model of the Object of the Activity. Should be Accountable or Statusable.
+	APObjectModel    interface{}       // Optional AP model of the Object of the Activity. Should be Accountable, Statusable, or Flaggable.
 	GTSModel         interface{}       // Optional GTS model of the Activity or Object.
 	ReceivingAccount *gtsmodel.Account // Local account which owns the inbox that this Activity was posted to.
 }
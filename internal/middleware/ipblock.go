@@ -0,0 +1,59 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/netip"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// IPBlock returns a gin middleware which aborts requests from client
+// IP addresses covered by an IP block with severity no_access, returning
+// code 403 - Forbidden.
+//
+// The client IP is as resolved by gin, taking into account the
+// trusted-proxies setting, so that requests forwarded by a reverse
+// proxy are checked against the address of the original client.
+func IPBlock(dbConn db.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		addr, err := netip.ParseAddr(c.ClientIP())
+		if err != nil {
+			// Nothing we can check.
+			return
+		}
+
+		blocked, err := dbConn.IsIPBlocked(c.Request.Context(), addr, gtsmodel.IPBlockSeverityNoAccess)
+		if err != nil {
+			// Don't lock everyone out
+			// because of a db hiccup.
+			log.Errorf(c.Request.Context(), "error checking ip block for %s: %v", addr, err)
+			return
+		}
+
+		if blocked {
+			code := http.StatusForbidden
+			err := errors.New(http.StatusText(code) + ": access from your ip address is not permitted")
+			c.AbortWithStatusJSON(code, gin.H{"error": err.Error()})
+		}
+	}
+}
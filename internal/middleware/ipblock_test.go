@@ -0,0 +1,110 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type IPBlockTestSuite struct {
+	suite.Suite
+	db    db.DB
+	state state.State
+}
+
+func (suite *IPBlockTestSuite) SetupTest() {
+	suite.state.Caches.Init()
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	suite.db = testrig.NewTestDB(&suite.state)
+	suite.state.DB = suite.db
+	testrig.StandardDBSetup(suite.db, nil)
+
+	if err := suite.db.PutIPBlock(context.Background(), &gtsmodel.IPBlock{
+		ID:                 id.NewULID(),
+		IP:                 "192.0.2.0/24",
+		Severity:           gtsmodel.IPBlockSeverityNoAccess,
+		CreatedByAccountID: testrig.NewTestAccounts()["admin_account"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.db.PutIPBlock(context.Background(), &gtsmodel.IPBlock{
+		ID:                 id.NewULID(),
+		IP:                 "198.51.100.0/24",
+		Severity:           gtsmodel.IPBlockSeveritySignUpBlock,
+		CreatedByAccountID: testrig.NewTestAccounts()["admin_account"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *IPBlockTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.db)
+}
+
+func (suite *IPBlockTestSuite) ipBlock(remoteAddr string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(recorder)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "http://localhost:8080/api/v1/instance", nil)
+	ctx.Request.RemoteAddr = remoteAddr
+
+	middleware.IPBlock(suite.db)(ctx)
+	if !ctx.IsAborted() {
+		ctx.Status(http.StatusOK)
+	}
+
+	return recorder
+}
+
+func (suite *IPBlockTestSuite) TestNoAccess() {
+	recorder := suite.ipBlock("192.0.2.55:4321")
+	suite.Equal(http.StatusForbidden, recorder.Code)
+	suite.Equal(`{"error":"Forbidden: access from your ip address is not permitted"}`, recorder.Body.String())
+}
+
+func (suite *IPBlockTestSuite) TestNoAccessIPv4Mapped() {
+	recorder := suite.ipBlock("[::ffff:192.0.2.55]:4321")
+	suite.Equal(http.StatusForbidden, recorder.Code)
+}
+
+func (suite *IPBlockTestSuite) TestSignUpBlockOnly() {
+	recorder := suite.ipBlock("198.51.100.55:4321")
+	suite.Equal(http.StatusOK, recorder.Code)
+}
+
+func (suite *IPBlockTestSuite) TestNotBlocked() {
+	recorder := suite.ipBlock("203.0.113.1:4321")
+	suite.Equal(http.StatusOK, recorder.Code)
+}
+
+func TestIPBlockTestSuite(t *testing.T) {
+	suite.Run(t, new(IPBlockTestSuite))
+}
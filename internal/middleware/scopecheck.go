@@ -0,0 +1,76 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/oauth2/v4"
+)
+
+// ScopeCheck returns a gin middleware which aborts requests with
+// 403 - Forbidden if the request bears an OAuth token (as set by
+// TokenCheck) whose scope doesn't permit any of the required scopes.
+//
+// Requests without a token are passed through untouched, since it's
+// up to the handler to decide whether it allows unauthenticated access.
+//
+// instanceGet is used to render the error page, if the caller prefers html.
+func ScopeCheck(
+	instanceGet func(ctx context.Context) (*apimodel.InstanceV1, gtserror.WithCode),
+	required ...oauth.Scope,
+) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		i, ok := c.Get(oauth.SessionAuthorizedToken)
+		if !ok {
+			// No token, nothing to check.
+			return
+		}
+
+		ti, ok := i.(oauth2.TokenInfo)
+		if !ok {
+			// Not a token we can check.
+			return
+		}
+
+		// Ignore any scopes on the token that we don't
+		// know about; they don't grant anything anyway.
+		scopes := oauth.ParseStoredScopes(ti.GetScope())
+		for _, r := range required {
+			if scopes.Permits(r) {
+				return
+			}
+		}
+
+		requiredStrs := make([]string, len(required))
+		for i, r := range required {
+			requiredStrs[i] = string(r)
+		}
+
+		err := errors.New("token does not have required scope " + strings.Join(requiredStrs, " or "))
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), instanceGet)
+		c.Abort()
+	}
+}
@@ -0,0 +1,109 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type ScopeCheckTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ScopeCheckTestSuite) scopeCheck(tokenScope string, required ...oauth.Scope) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(recorder)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "http://localhost:8080/api/v1/statuses", nil)
+
+	if tokenScope != "" {
+		token := oauth.DBTokenToToken(testrig.NewTestTokens()["local_account_1"])
+		token.SetScope(tokenScope)
+		ctx.Set(oauth.SessionAuthorizedToken, token)
+	}
+
+	instanceGet := func(context.Context) (*apimodel.InstanceV1, gtserror.WithCode) {
+		return &apimodel.InstanceV1{}, nil
+	}
+
+	middleware.ScopeCheck(instanceGet, required...)(ctx)
+	if !ctx.IsAborted() {
+		ctx.Status(http.StatusOK)
+	}
+
+	return recorder
+}
+
+func (suite *ScopeCheckTestSuite) TestNoToken() {
+	recorder := suite.scopeCheck("", oauth.ScopeWriteStatuses)
+	suite.Equal(http.StatusOK, recorder.Code)
+}
+
+func (suite *ScopeCheckTestSuite) TestTopLevelScope() {
+	recorder := suite.scopeCheck("read write", oauth.ScopeWriteStatuses)
+	suite.Equal(http.StatusOK, recorder.Code)
+}
+
+func (suite *ScopeCheckTestSuite) TestGranularScope() {
+	recorder := suite.scopeCheck("read:statuses", oauth.ScopeReadStatuses)
+	suite.Equal(http.StatusOK, recorder.Code)
+}
+
+func (suite *ScopeCheckTestSuite) TestInsufficientScope() {
+	recorder := suite.scopeCheck("read", oauth.ScopeWriteStatuses)
+	suite.Equal(http.StatusForbidden, recorder.Code)
+	suite.Equal(`{"error":"Forbidden: token does not have required scope write:statuses"}`, recorder.Body.String())
+}
+
+func (suite *ScopeCheckTestSuite) TestInsufficientAdminScope() {
+	recorder := suite.scopeCheck("read write follow push", oauth.ScopeAdminWriteReports)
+	suite.Equal(http.StatusForbidden, recorder.Code)
+}
+
+func (suite *ScopeCheckTestSuite) TestUnknownScope() {
+	// Unknown scopes on a stored token should just
+	// be ignored, not invalidate the whole token.
+	recorder := suite.scopeCheck("read:statuses write:everything", oauth.ScopeReadStatuses)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	recorder = suite.scopeCheck("read:statuses write:everything", oauth.ScopeWriteStatuses)
+	suite.Equal(http.StatusForbidden, recorder.Code)
+}
+
+func (suite *ScopeCheckTestSuite) TestAnyRequiredScope() {
+	recorder := suite.scopeCheck("profile", oauth.ScopeReadAccounts, oauth.ScopeProfile)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	recorder = suite.scopeCheck("read:statuses", oauth.ScopeReadAccounts, oauth.ScopeProfile)
+	suite.Equal(http.StatusForbidden, recorder.Code)
+	suite.Equal(`{"error":"Forbidden: token does not have required scope read:accounts or profile"}`, recorder.Body.String())
+}
+
+func TestScopeCheckTestSuite(t *testing.T) {
+	suite.Run(t, new(ScopeCheckTestSuite))
+}
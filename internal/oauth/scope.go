@@ -0,0 +1,226 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package oauth
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Scope represents one Mastodon-compatible OAuth scope,
+// either a top-level scope like "read", or a granular
+// scope like "read:statuses" or "admin:write:accounts".
+//
+// See https://docs.joinmastodon.org/api/oauth-scopes/
+type Scope string
+
+const (
+	ScopeRead   Scope = "read"   // Read all data.
+	ScopeWrite  Scope = "write"  // Write all data.
+	ScopeFollow Scope = "follow" // Deprecated: manage relationships; equivalent to read/write blocks, follows, mutes.
+	ScopePush   Scope = "push"   // Receive push notifications.
+	ScopeAdmin  Scope = "admin"  // All admin scopes (read and write).
+	ScopeUser   Scope = "user"   // Deprecated: all non-admin scopes, as requested by older versions of the settings panel.
+
+	ScopeProfile Scope = "profile" // Read only the authorized user's own profile, ie., verify credentials.
+
+	ScopeReadAccounts      Scope = "read:accounts"
+	ScopeReadBlocks        Scope = "read:blocks"
+	ScopeReadBookmarks     Scope = "read:bookmarks"
+	ScopeReadCustomEmojis  Scope = "read:custom_emojis"
+	ScopeReadFavourites    Scope = "read:favourites"
+	ScopeReadFilters       Scope = "read:filters"
+	ScopeReadFollows       Scope = "read:follows"
+	ScopeReadLists         Scope = "read:lists"
+	ScopeReadMutes         Scope = "read:mutes"
+	ScopeReadNotifications Scope = "read:notifications"
+	ScopeReadReports       Scope = "read:reports"
+	ScopeReadSearch        Scope = "read:search"
+	ScopeReadStatuses      Scope = "read:statuses"
+
+	ScopeWriteAccounts      Scope = "write:accounts"
+	ScopeWriteBlocks        Scope = "write:blocks"
+	ScopeWriteBookmarks     Scope = "write:bookmarks"
+	ScopeWriteConversations Scope = "write:conversations"
+	ScopeWriteFavourites    Scope = "write:favourites"
+	ScopeWriteFilters       Scope = "write:filters"
+	ScopeWriteFollows       Scope = "write:follows"
+	ScopeWriteLists         Scope = "write:lists"
+	ScopeWriteMedia         Scope = "write:media"
+	ScopeWriteMutes         Scope = "write:mutes"
+	ScopeWriteNotifications Scope = "write:notifications"
+	ScopeWriteReports       Scope = "write:reports"
+	ScopeWriteStatuses      Scope = "write:statuses"
+
+	ScopeAdminRead              Scope = "admin:read"
+	ScopeAdminReadAccounts      Scope = "admin:read:accounts"
+	ScopeAdminReadReports       Scope = "admin:read:reports"
+	ScopeAdminReadDomainBlocks  Scope = "admin:read:domain_blocks"
+	ScopeAdminWrite             Scope = "admin:write"
+	ScopeAdminWriteAccounts     Scope = "admin:write:accounts"
+	ScopeAdminWriteReports      Scope = "admin:write:reports"
+	ScopeAdminWriteDomainBlocks Scope = "admin:write:domain_blocks"
+
+	ScopeAdminReadEmailDomainBlocks  Scope = "admin:read:email_domain_blocks"
+	ScopeAdminReadIPBlocks           Scope = "admin:read:ip_blocks"
+	ScopeAdminWriteEmailDomainBlocks Scope = "admin:write:email_domain_blocks"
+	ScopeAdminWriteIPBlocks          Scope = "admin:write:ip_blocks"
+
+	ScopeAdminReadDomainAllows          Scope = "admin:read:domain_allows"
+	ScopeAdminReadCanonicalEmailBlocks  Scope = "admin:read:canonical_email_blocks"
+	ScopeAdminWriteDomainAllows         Scope = "admin:write:domain_allows"
+	ScopeAdminWriteCanonicalEmailBlocks Scope = "admin:write:canonical_email_blocks"
+)
+
+// knownScopes contains every scope
+// that we know how to parse.
+var knownScopes = map[Scope]struct{}{
+	ScopeRead: {}, ScopeWrite: {}, ScopeFollow: {}, ScopePush: {}, ScopeAdmin: {}, ScopeUser: {}, ScopeProfile: {},
+
+	ScopeReadAccounts: {}, ScopeReadBlocks: {}, ScopeReadBookmarks: {}, ScopeReadCustomEmojis: {}, ScopeReadFavourites: {},
+	ScopeReadFilters: {}, ScopeReadFollows: {}, ScopeReadLists: {}, ScopeReadMutes: {},
+	ScopeReadNotifications: {}, ScopeReadReports: {}, ScopeReadSearch: {}, ScopeReadStatuses: {},
+
+	ScopeWriteAccounts: {}, ScopeWriteBlocks: {}, ScopeWriteBookmarks: {}, ScopeWriteConversations: {},
+	ScopeWriteFavourites: {}, ScopeWriteFilters: {}, ScopeWriteFollows: {}, ScopeWriteLists: {},
+	ScopeWriteMedia: {}, ScopeWriteMutes: {}, ScopeWriteNotifications: {}, ScopeWriteReports: {},
+	ScopeWriteStatuses: {},
+
+	ScopeAdminRead: {}, ScopeAdminReadAccounts: {}, ScopeAdminReadReports: {}, ScopeAdminReadDomainBlocks: {},
+	ScopeAdminWrite: {}, ScopeAdminWriteAccounts: {}, ScopeAdminWriteReports: {}, ScopeAdminWriteDomainBlocks: {},
+	ScopeAdminReadEmailDomainBlocks: {}, ScopeAdminReadIPBlocks: {}, ScopeAdminWriteEmailDomainBlocks: {}, ScopeAdminWriteIPBlocks: {},
+	ScopeAdminReadDomainAllows: {}, ScopeAdminReadCanonicalEmailBlocks: {}, ScopeAdminWriteDomainAllows: {}, ScopeAdminWriteCanonicalEmailBlocks: {},
+}
+
+// followScopes are the granular
+// scopes implied by ScopeFollow.
+var followScopes = map[Scope]struct{}{
+	ScopeReadBlocks:   {},
+	ScopeWriteBlocks:  {},
+	ScopeReadFollows:  {},
+	ScopeWriteFollows: {},
+	ScopeReadMutes:    {},
+	ScopeWriteMutes:   {},
+}
+
+// Permits returns true if a token with this scope
+// may access a resource which requires scope required.
+//
+// A scope permits itself and any of its children, eg.,
+// "read" permits "read:statuses", and "admin:write"
+// permits "admin:write:accounts". Deprecated scopes
+// "follow" and "user" permit what they used to.
+func (s Scope) Permits(required Scope) bool {
+	if s == required {
+		return true
+	}
+
+	if strings.HasPrefix(string(required), string(s)+":") {
+		return true
+	}
+
+	switch s {
+	case ScopeFollow:
+		_, ok := followScopes[required]
+		return ok
+
+	case ScopeUser:
+		return !ScopeAdmin.Permits(required) &&
+			(ScopeRead.Permits(required) ||
+				ScopeWrite.Permits(required) ||
+				ScopeFollow.Permits(required) ||
+				ScopePush.Permits(required))
+	}
+
+	return false
+}
+
+// Scopes is a parsed list of OAuth scopes.
+type Scopes []Scope
+
+// ParseScopes parses the given space-separated scopes
+// string, as stored on tokens and applications, into
+// Scopes. An error is returned if a scope isn't known.
+func ParseScopes(scopes string) (Scopes, error) {
+	fields := strings.Fields(scopes)
+	parsed := make(Scopes, 0, len(fields))
+
+	for _, field := range fields {
+		scope := Scope(field)
+		if _, ok := knownScopes[scope]; !ok {
+			return nil, fmt.Errorf("scope %s not recognized", field)
+		}
+		parsed = append(parsed, scope)
+	}
+
+	return parsed, nil
+}
+
+// ParseStoredScopes parses the given space-separated scopes
+// string, as already stored on a token or application, into
+// Scopes. Unlike ParseScopes, scopes that aren't known are
+// quietly ignored rather than rejecting the whole string, so
+// that a stored token keeps working for the scopes we know.
+func ParseStoredScopes(scopes string) Scopes {
+	fields := strings.Fields(scopes)
+	parsed := make(Scopes, 0, len(fields))
+
+	for _, field := range fields {
+		scope := Scope(field)
+		if _, ok := knownScopes[scope]; !ok {
+			continue
+		}
+		parsed = append(parsed, scope)
+	}
+
+	return parsed
+}
+
+// Permits returns true if any of these
+// scopes permits the required scope.
+func (ss Scopes) Permits(required Scope) bool {
+	for _, s := range ss {
+		if s.Permits(required) {
+			return true
+		}
+	}
+	return false
+}
+
+// Narrows returns true if every one of these scopes
+// is permitted by the given (broader) scopes, ie.,
+// if these scopes are the same as or narrower than
+// the given scopes. This is used to check that scopes
+// requested for a token are allowed by the application.
+func (ss Scopes) Narrows(broader Scopes) bool {
+	for _, s := range ss {
+		if !broader.Permits(s) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the space-separated form of these scopes.
+func (ss Scopes) String() string {
+	strs := make([]string, len(ss))
+	for i, s := range ss {
+		strs[i] = string(s)
+	}
+	return strings.Join(strs, " ")
+}
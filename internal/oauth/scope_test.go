@@ -0,0 +1,97 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package oauth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+type ScopeTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ScopeTestSuite) TestParseScopes() {
+	scopes, err := oauth.ParseScopes("read write:statuses  admin:read:accounts")
+	suite.NoError(err)
+	suite.Equal(oauth.Scopes{
+		oauth.ScopeRead,
+		oauth.ScopeWriteStatuses,
+		oauth.ScopeAdminReadAccounts,
+	}, scopes)
+	suite.Equal("read write:statuses admin:read:accounts", scopes.String())
+}
+
+func (suite *ScopeTestSuite) TestParseScopesUnknown() {
+	scopes, err := oauth.ParseScopes("read write:everything")
+	suite.EqualError(err, "scope write:everything not recognized")
+	suite.Nil(scopes)
+}
+
+func (suite *ScopeTestSuite) TestParseStoredScopesUnknown() {
+	scopes := oauth.ParseStoredScopes("read write:everything profile admin:read:domain_allows")
+	suite.Equal(oauth.Scopes{
+		oauth.ScopeRead,
+		oauth.ScopeProfile,
+		oauth.ScopeAdminReadDomainAllows,
+	}, scopes)
+}
+
+func (suite *ScopeTestSuite) TestPermits() {
+	for _, test := range []struct {
+		scope    oauth.Scope
+		required oauth.Scope
+		permits  bool
+	}{
+		{oauth.ScopeRead, oauth.ScopeRead, true},
+		{oauth.ScopeRead, oauth.ScopeReadStatuses, true},
+		{oauth.ScopeReadStatuses, oauth.ScopeRead, false},
+		{oauth.ScopeRead, oauth.ScopeWriteStatuses, false},
+		{oauth.ScopeRead, oauth.ScopeAdminReadAccounts, false},
+		{oauth.ScopeWrite, oauth.ScopeWriteMedia, true},
+		{oauth.ScopeFollow, oauth.ScopeWriteFollows, true},
+		{oauth.ScopeFollow, oauth.ScopeReadBlocks, true},
+		{oauth.ScopeFollow, oauth.ScopeWriteStatuses, false},
+		{oauth.ScopeAdmin, oauth.ScopeAdminWriteReports, true},
+		{oauth.ScopeAdminRead, oauth.ScopeAdminReadReports, true},
+		{oauth.ScopeAdminRead, oauth.ScopeAdminWriteReports, false},
+		{oauth.ScopeUser, oauth.ScopeWriteStatuses, true},
+		{oauth.ScopeUser, oauth.ScopeAdminRead, false},
+	} {
+		suite.Equal(test.permits, test.scope.Permits(test.required), "%s permits %s", test.scope, test.required)
+	}
+}
+
+func (suite *ScopeTestSuite) TestNarrows() {
+	registered, err := oauth.ParseScopes("read write follow push")
+	suite.NoError(err)
+
+	requested, err := oauth.ParseScopes("read:statuses write:follows")
+	suite.NoError(err)
+	suite.True(requested.Narrows(registered))
+
+	requested, err = oauth.ParseScopes("read admin:read")
+	suite.NoError(err)
+	suite.False(requested.Narrows(registered))
+}
+
+func TestScopeTestSuite(t *testing.T) {
+	suite.Run(t, new(ScopeTestSuite))
+}
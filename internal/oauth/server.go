@@ -19,13 +19,16 @@ package oauth
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/oauth2/v4"
 	oautherr "github.com/superseriousbusiness/oauth2/v4/errors"
@@ -57,12 +60,13 @@ const (
 	// HelpfulAdvice is a handy hint to users;
 	// particularly important during the login flow
 	HelpfulAdvice      = "If you arrived at this error during a login/oauth flow, please try clearing your session cookies and logging in again; if problems persist, make sure you're using the correct credentials"
-	HelpfulAdviceGrant = "If you arrived at this error during a login/oauth flow, your client is trying to use an unsupported OAuth grant type. Supported grant types are: authorization_code, client_credentials; please reach out to developer of your client"
+	HelpfulAdviceGrant = "If you arrived at this error during a login/oauth flow, your client is trying to use an unsupported OAuth grant type. Supported grant types are: authorization_code, client_credentials, and refresh_token if enabled on this instance; please reach out to developer of your client"
 )
 
 // Server wraps some oauth2 server functions in an interface, exposing only what is needed
 type Server interface {
 	HandleTokenRequest(r *http.Request) (map[string]interface{}, gtserror.WithCode)
+	HandleRevokeRequest(ctx context.Context, clientID string, clientSecret string, token string) gtserror.WithCode
 	HandleAuthorizeRequest(w http.ResponseWriter, r *http.Request) gtserror.WithCode
 	ValidationBearerToken(r *http.Request) (oauth2.TokenInfo, error)
 	GenerateUserAccessToken(ctx context.Context, ti oauth2.TokenInfo, clientSecret string, userID string) (accessToken oauth2.TokenInfo, err error)
@@ -72,34 +76,56 @@ type Server interface {
 // s fulfils the Server interface using the underlying oauth2 server
 type s struct {
 	server *server.Server
+	db     db.DB
 }
 
 // New returns a new oauth server that implements the Server interface
-func New(ctx context.Context, database db.Basic) Server {
+func New(ctx context.Context, database db.DB) Server {
 	ts := newTokenStore(ctx, database)
 	cs := NewClientStore(database)
 
 	manager := manage.NewDefaultManager()
 	manager.MapTokenStorage(ts)
 	manager.MapClientStorage(cs)
+	var (
+		accessTokenExp  = config.GetOAuthAccessTokenExpiry()  // if 0, access tokens don't expire -- they must be revoked
+		refreshTokenExp = config.GetOAuthRefreshTokenExpiry() // if 0, refresh tokens don't expire
+		refreshTokens   = config.GetOAuthRefreshTokens()
+	)
 	manager.SetAuthorizeCodeTokenCfg(&manage.Config{
-		AccessTokenExp:    0,     // access tokens don't expire -- they must be revoked
-		IsGenerateRefresh: false, // don't use refresh tokens
+		AccessTokenExp:    accessTokenExp,
+		RefreshTokenExp:   refreshTokenExp,
+		IsGenerateRefresh: refreshTokens,
 	})
+	manager.SetRefreshTokenCfg(&manage.RefreshingConfig{
+		AccessTokenExp:     accessTokenExp,
+		RefreshTokenExp:    refreshTokenExp,
+		IsGenerateRefresh:  true, // rotate refresh tokens on use
+		IsResetRefreshTime: true,
+		IsRemoveAccess:     true,
+		IsRemoveRefreshing: true,
+	})
+
+	// Allow:
+	// - Authorization Code (for first & third parties)
+	// - Client Credentials (for applications)
+	// - Refresh Token (if enabled)
+	allowedGrantTypes := []oauth2.GrantType{
+		oauth2.AuthorizationCode,
+		oauth2.ClientCredentials,
+	}
+	if refreshTokens {
+		allowedGrantTypes = append(allowedGrantTypes, oauth2.Refreshing)
+	}
+
 	sc := &server.Config{
 		TokenType: "Bearer",
 		// Must follow the spec.
 		AllowGetAccessRequest: false,
 		// Support only the non-implicit flow.
-		AllowedResponseTypes: []oauth2.ResponseType{oauth2.Code},
-		// Allow:
-		// - Authorization Code (for first & third parties)
-		// - Client Credentials (for applications)
-		AllowedGrantTypes: []oauth2.GrantType{
-			oauth2.AuthorizationCode,
-			oauth2.ClientCredentials,
-		},
-		AllowedCodeChallengeMethods: []oauth2.CodeChallengeMethod{oauth2.CodeChallengePlain},
+		AllowedResponseTypes:        []oauth2.ResponseType{oauth2.Code},
+		AllowedGrantTypes:           allowedGrantTypes,
+		AllowedCodeChallengeMethods: []oauth2.CodeChallengeMethod{oauth2.CodeChallengeS256, oauth2.CodeChallengePlain},
 	}
 
 	srv := server.NewServer(sc, manager)
@@ -120,8 +146,21 @@ func New(ctx context.Context, database db.Basic) Server {
 		return userID, nil
 	})
 	srv.SetClientInfoHandler(server.ClientFormHandler)
+	srv.SetClientScopeHandler(func(tgr *oauth2.TokenGenerateRequest) (bool, error) {
+		return clientScopeAllowed(ctx, database, tgr.ClientID, tgr.Scope)
+	})
+	srv.SetRefreshingScopeHandler(func(tgr *oauth2.TokenGenerateRequest, oldScope string) (bool, error) {
+		// A refreshed token can only narrow the scope of the original.
+		requested, err := ParseScopes(tgr.Scope)
+		if err != nil {
+			return false, nil
+		}
+		previous := ParseStoredScopes(oldScope)
+		return requested.Narrows(previous), nil
+	})
 	return &s{
 		server: srv,
+		db:     database,
 	}
 }
 
@@ -139,6 +178,21 @@ func (s *s) HandleTokenRequest(r *http.Request) (map[string]interface{}, gtserro
 		return nil, gtserror.NewErrorBadRequest(err, help, adv)
 	}
 
+	if gt == oauth2.AuthorizationCode && tgr.ClientSecret == "" && tgr.CodeVerifier != "" {
+		// Public client (eg., a native or single page app) which can't keep
+		// a secret, and instead relies on PKCE to prove that it's the same
+		// client that started the authorization flow. The code verifier is
+		// checked against the stored code challenge when the code is swapped
+		// for a token, and that fails if the code was issued without one, so
+		// it's safe to treat the client as authenticated here.
+		client, err := s.server.Manager.GetClient(ctx, tgr.ClientID)
+		if err != nil {
+			help := fmt.Sprintf("could not get client %s: %s", tgr.ClientID, err)
+			return nil, gtserror.NewErrorUnauthorized(oautherr.ErrInvalidClient, help, HelpfulAdvice)
+		}
+		tgr.ClientSecret = client.GetSecret()
+	}
+
 	ti, err := s.server.GetAccessToken(ctx, gt, tgr)
 	if err != nil {
 		help := fmt.Sprintf("could not get access token: %s", err)
@@ -168,6 +222,74 @@ func (s *s) HandleTokenRequest(r *http.Request) (map[string]interface{}, gtserro
 	return data, nil
 }
 
+// HandleRevokeRequest revokes the given access or refresh token, as per RFC 7009.
+// See: https://datatracker.ietf.org/doc/html/rfc7009
+//
+// Every client is issued a secret when it's registered, so clients must
+// authenticate with their ID and secret, as per section 2.1 of the RFC. A
+// client can only revoke tokens that were issued to it. As per the RFC, an
+// unknown or already-revoked token is not an error, since the client's goal
+// of the token being unusable has been achieved.
+func (s *s) HandleRevokeRequest(ctx context.Context, clientID string, clientSecret string, token string) gtserror.WithCode {
+	client, err := s.server.Manager.GetClient(ctx, clientID)
+	if err != nil {
+		return gtserror.NewErrorUnauthorized(oautherr.ErrInvalidClient, err.Error())
+	}
+
+	if clientSecret == "" {
+		err := errors.New("client secret was not provided")
+		return gtserror.NewErrorUnauthorized(oautherr.ErrInvalidClient, err.Error())
+	}
+
+	if subtle.ConstantTimeCompare([]byte(client.GetSecret()), []byte(clientSecret)) != 1 {
+		err := errors.New("client secret did not match")
+		return gtserror.NewErrorUnauthorized(oautherr.ErrInvalidClient, err.Error())
+	}
+
+	// Token may be either an access or a refresh token, and
+	// the token_type_hint is optional; so just try both.
+	var dbToken *gtsmodel.Token
+	for _, key := range []string{"access", "refresh"} {
+		t := &gtsmodel.Token{}
+		err := s.db.GetWhere(ctx, []db.Where{{Key: key, Value: token}}, t)
+		if err == nil {
+			dbToken = t
+			break
+		}
+
+		if !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting token: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if dbToken == nil {
+		// Nothing to do.
+		return nil
+	}
+
+	if dbToken.ClientID != client.GetID() {
+		err := fmt.Errorf("token was not issued to client %s", clientID)
+		return gtserror.NewErrorForbidden(oautherr.ErrUnauthorizedClient, err.Error())
+	}
+
+	// Deleting the token entry revokes both
+	// the access token and any refresh token.
+	if err := s.db.DeleteByID(ctx, dbToken.ID, dbToken); err != nil {
+		err := gtserror.Newf("db error deleting token: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	// Web push subscriptions are tied to the
+	// token they were made with, so drop it too.
+	if err := s.db.DeleteWebPushSubscriptionByTokenID(ctx, dbToken.ID); err != nil {
+		err := gtserror.Newf("db error deleting web push subscription: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
 func (s *s) errorOrRedirect(err error, w http.ResponseWriter, req *server.AuthorizeRequest) gtserror.WithCode {
 	if req == nil {
 		return gtserror.NewErrorUnauthorized(err, HelpfulAdvice)
@@ -299,3 +421,25 @@ func (s *s) GenerateUserAccessToken(ctx context.Context, ti oauth2.TokenInfo, cl
 func (s *s) LoadAccessToken(ctx context.Context, access string) (accessToken oauth2.TokenInfo, err error) {
 	return s.server.Manager.LoadAccessToken(ctx, access)
 }
+
+// clientScopeAllowed returns true if the requested scope is
+// the same as or narrower than the scopes registered by the
+// application with the given client ID.
+func clientScopeAllowed(ctx context.Context, database db.Basic, clientID string, scope string) (bool, error) {
+	requested, err := ParseScopes(scope)
+	if err != nil {
+		// Unknown scope(s) requested.
+		return false, nil
+	}
+
+	app := &gtsmodel.Application{}
+	if err := database.GetWhere(ctx, []db.Where{{Key: "client_id", Value: clientID}}, app); err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	registered := ParseStoredScopes(app.Scopes)
+	return requested.Narrows(registered), nil
+}
@@ -65,6 +65,10 @@ func newTokenStore(ctx context.Context, db db.Basic) oauth2.TokenStore {
 	return ts
 }
 
+// lastUsedGranularity is how out of date the LastUsedAt
+// of a token may be, to avoid a db write on every request.
+const lastUsedGranularity = 1 * time.Hour
+
 // sweep clears out old tokens that have expired; it should be run on a loop about once per minute or so.
 func (ts *tokenStore) sweep(ctx context.Context) error {
 	// select *all* tokens from the db
@@ -77,10 +81,7 @@ func (ts *tokenStore) sweep(ctx context.Context) error {
 	// iterate through and remove expired tokens
 	now := time.Now()
 	for _, dbt := range *tokens {
-		// The zero value of a time.Time is 00:00 january 1 1970, which will always be before now. So:
-		// we only want to check if a token expired before now if the expiry time is *not zero*;
-		// ie., if it's been explicity set.
-		if !dbt.CodeExpiresAt.IsZero() && dbt.CodeExpiresAt.Before(now) || !dbt.RefreshExpiresAt.IsZero() && dbt.RefreshExpiresAt.Before(now) || !dbt.AccessExpiresAt.IsZero() && dbt.AccessExpiresAt.Before(now) {
+		if tokenExpired(dbt, now) {
 			if err := ts.db.DeleteByID(ctx, dbt.ID, dbt); err != nil {
 				return err
 			}
@@ -90,6 +91,28 @@ func (ts *tokenStore) sweep(ctx context.Context) error {
 	return nil
 }
 
+// tokenExpired returns true if the given token can no longer be
+// used for anything. An access token that has expired is still kept
+// around if it has a refresh token which can be used to renew it.
+//
+// The zero value of a time.Time is 00:00 january 1 1970, which will always be before now. So:
+// we only want to check if a token expired before now if the expiry time is *not zero*;
+// ie., if it's been explicity set.
+func tokenExpired(dbt *gtsmodel.Token, now time.Time) bool {
+	expired := func(t time.Time) bool {
+		return !t.IsZero() && t.Before(now)
+	}
+
+	switch {
+	case dbt.Refresh != "":
+		return expired(dbt.RefreshExpiresAt)
+	case dbt.Access != "":
+		return expired(dbt.AccessExpiresAt)
+	default:
+		return expired(dbt.CodeExpiresAt)
+	}
+}
+
 // Create creates and store the new token information.
 // For the original implementation, see https://github.com/superseriousbusiness/oauth2/blob/master/store/token.go#L34
 func (ts *tokenStore) Create(ctx context.Context, info oauth2.TokenInfo) error {
@@ -153,6 +176,16 @@ func (ts *tokenStore) GetByAccess(ctx context.Context, access string) (oauth2.To
 	if err := ts.db.GetWhere(ctx, []db.Where{{Key: "access", Value: access}}, dbt); err != nil {
 		return nil, err
 	}
+
+	// Looking up by access token means it's being
+	// used, so mark it as such if not done recently.
+	if now := time.Now(); now.Sub(dbt.LastUsedAt) > lastUsedGranularity {
+		dbt.LastUsedAt = now
+		if err := ts.db.UpdateByID(ctx, dbt, dbt.ID, "last_used_at"); err != nil {
+			log.Errorf(ctx, "error updating token last used: %v", err)
+		}
+	}
+
 	return DBTokenToToken(dbt), nil
 }
 
@@ -176,26 +209,12 @@ func (ts *tokenStore) GetByRefresh(ctx context.Context, refresh string) (oauth2.
 
 // TokenToDBToken is a lil util function that takes a gotosocial token and gives back a token for inserting into a database.
 func TokenToDBToken(tkn *models.Token) *gtsmodel.Token {
-	now := time.Now()
-
-	// For the following, we want to make sure we're not adding a time.Now() to an *empty* ExpiresIn, otherwise that's
+	// For the following, we want to make sure we're not adding a time to an *empty* ExpiresIn, otherwise that's
 	// going to cause all sorts of interesting problems. So check first to make sure that the ExpiresIn is not equal
 	// to the zero value of a time.Duration, which is 0s. If it *is* empty/nil, just leave the ExpiresAt at nil as well.
-
-	cea := time.Time{}
-	if tkn.CodeExpiresIn != 0*time.Second {
-		cea = now.Add(tkn.CodeExpiresIn)
-	}
-
-	aea := time.Time{}
-	if tkn.AccessExpiresIn != 0*time.Second {
-		aea = now.Add(tkn.AccessExpiresIn)
-	}
-
-	rea := time.Time{}
-	if tkn.RefreshExpiresIn != 0*time.Second {
-		rea = now.Add(tkn.RefreshExpiresIn)
-	}
+	cea := expiresAt(tkn.CodeCreateAt, tkn.CodeExpiresIn)
+	aea := expiresAt(tkn.AccessCreateAt, tkn.AccessExpiresIn)
+	rea := expiresAt(tkn.RefreshCreateAt, tkn.RefreshExpiresIn)
 
 	return &gtsmodel.Token{
 		ClientID:            tkn.ClientID,
@@ -218,22 +237,11 @@ func TokenToDBToken(tkn *models.Token) *gtsmodel.Token {
 
 // DBTokenToToken is a lil util function that takes a database token and gives back a gotosocial token
 func DBTokenToToken(dbt *gtsmodel.Token) *models.Token {
-	now := time.Now()
-
-	var codeExpiresIn time.Duration
-	if !dbt.CodeExpiresAt.IsZero() {
-		codeExpiresIn = dbt.CodeExpiresAt.Sub(now)
-	}
-
-	var accessExpiresIn time.Duration
-	if !dbt.AccessExpiresAt.IsZero() {
-		accessExpiresIn = dbt.AccessExpiresAt.Sub(now)
-	}
-
-	var refreshExpiresIn time.Duration
-	if !dbt.RefreshExpiresAt.IsZero() {
-		refreshExpiresIn = dbt.RefreshExpiresAt.Sub(now)
-	}
+	// ExpiresIn is relative to CreateAt, since that's what
+	// the oauth2 lib adds it to when checking for expiry.
+	codeExpiresIn := expiresIn(dbt.CodeCreateAt, dbt.CodeExpiresAt)
+	accessExpiresIn := expiresIn(dbt.AccessCreateAt, dbt.AccessExpiresAt)
+	refreshExpiresIn := expiresIn(dbt.RefreshCreateAt, dbt.RefreshExpiresAt)
 
 	return &models.Token{
 		ClientID:            dbt.ClientID,
@@ -253,3 +261,27 @@ func DBTokenToToken(dbt *gtsmodel.Token) *models.Token {
 		RefreshExpiresIn:    refreshExpiresIn,
 	}
 }
+
+// expiresAt converts a token lifetime into the time it expires,
+// or returns zero time if the lifetime is zero, ie., no expiry.
+func expiresAt(createdAt time.Time, expiresIn time.Duration) time.Time {
+	if expiresIn == 0 {
+		return time.Time{}
+	}
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	return createdAt.Add(expiresIn)
+}
+
+// expiresIn converts the time a token expires into its lifetime,
+// or returns zero if the expiry time is zero, ie., no expiry.
+func expiresIn(createdAt time.Time, expiresAt time.Time) time.Duration {
+	if expiresAt.IsZero() {
+		return 0
+	}
+	if createdAt.IsZero() {
+		return time.Until(expiresAt)
+	}
+	return expiresAt.Sub(createdAt)
+}
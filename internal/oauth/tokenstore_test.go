@@ -14,7 +14,49 @@
 //
 // You should have received a copy of the GNU Affero General Public License
 // along with this program.  If not, see <http://www.gnu.org/licenses/>.
-
 package oauth_test
 
-// TODO: write tests
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/oauth2/v4/models"
+)
+
+type TokenStoreTestSuite struct {
+	suite.Suite
+}
+
+func (suite *TokenStoreTestSuite) TestTokenRoundTrip() {
+	createdAt := time.Now().Add(-1 * time.Hour).Truncate(time.Second)
+
+	tkn := &models.Token{
+		ClientID:         "01F8MGV8AC3NGSJW0FE8W1BV70",
+		UserID:           "01F8MGVGPHQ2D3P3X0454H54Z5",
+		Access:           "some access token",
+		AccessCreateAt:   createdAt,
+		AccessExpiresIn:  2 * time.Hour,
+		Refresh:          "some refresh token",
+		RefreshCreateAt:  createdAt,
+		RefreshExpiresIn: 0, // never expires
+	}
+
+	dbt := oauth.TokenToDBToken(tkn)
+	suite.Equal(createdAt.Add(2*time.Hour), dbt.AccessExpiresAt)
+	suite.Zero(dbt.RefreshExpiresAt)
+	suite.Zero(dbt.CodeExpiresAt)
+
+	// Converting back should give the same lifetime, relative
+	// to the creation time, not to the current time; otherwise
+	// tokens would be treated as expired before they should be.
+	back := oauth.DBTokenToToken(dbt)
+	suite.Equal(2*time.Hour, back.AccessExpiresIn)
+	suite.Zero(back.RefreshExpiresIn)
+	suite.False(back.GetAccessCreateAt().Add(back.GetAccessExpiresIn()).Before(time.Now()))
+}
+
+func TestTokenStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(TokenStoreTestSuite))
+}
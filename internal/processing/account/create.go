@@ -19,18 +19,29 @@ package account
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/oauth2/v4"
 )
 
+// mxLookupTimeout is how long to wait for a DNS
+// lookup of the mail servers of an email domain.
+const mxLookupTimeout = 5 * time.Second
+
 // Create processes the given form for creating a new account,
 // returning an oauth token for that account if successful.
 //
@@ -42,6 +53,11 @@ func (p *Processor) Create(
 	app *gtsmodel.Application,
 	form *apimodel.AccountCreateRequest,
 ) (*apimodel.Token, gtserror.WithCode) {
+	errWithCode := p.signUpAllowed(ctx, form)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	emailAvailable, err := p.state.DB.IsEmailAvailable(ctx, form.Email)
 	if err != nil {
 		err := fmt.Errorf("db error checking email availability: %w", err)
@@ -62,6 +78,17 @@ func (p *Processor) Create(
 		return nil, gtserror.NewErrorConflict(err, err.Error())
 	}
 
+	// Redeem the invite, if one was given, only
+	// once we're otherwise sure the sign up will
+	// go ahead, so as not to waste uses of it.
+	var invite *gtsmodel.Invite
+	if form.InviteCode != "" {
+		invite, errWithCode = p.redeemInvite(ctx, form.InviteCode)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
 	// Only store reason if one is required.
 	var reason string
 	if config.GetAccountsReasonRequired() {
@@ -73,12 +100,21 @@ func (p *Processor) Create(
 		Email:       form.Email,
 		Password:    form.Password,
 		Reason:      text.SanitizePlaintext(reason),
-		PreApproved: !config.GetAccountsApprovalRequired(), // Mark as approved if no approval required.
+		PreApproved: invite != nil || !config.GetAccountsApprovalRequired(), // Mark as approved if invited or no approval required.
 		SignUpIP:    form.IP,
 		Locale:      form.Locale,
 		AppID:       app.ID,
+		InviteID:    inviteID(invite),
 	})
 	if err != nil {
+		if invite != nil {
+			// Give back the invite use
+			// we took, since it's wasted.
+			if err := p.state.DB.UnuseInvite(ctx, invite.ID); err != nil {
+				log.Errorf(ctx, "db error giving back use of invite %s: %v", invite.ID, err)
+			}
+		}
+
 		err := fmt.Errorf("db error creating new signup: %w", err)
 		return nil, gtserror.NewErrorInternalError(err)
 	}
@@ -107,3 +143,138 @@ func (p *Processor) Create(
 		CreatedAt:   accessToken.GetAccessCreateAt().Unix(),
 	}, nil
 }
+
+// signUpAllowed checks the sign-up IP and email address of
+// the given form against IP blocks and email domain blocks.
+func (p *Processor) signUpAllowed(ctx context.Context, form *apimodel.AccountCreateRequest) gtserror.WithCode {
+	if addr, ok := netip.AddrFromSlice(form.IP); ok {
+		blocked, err := p.state.DB.IsIPBlocked(ctx, addr, gtsmodel.IPBlockSeveritySignUpBlock)
+		if err != nil {
+			err := fmt.Errorf("db error checking ip block for %s: %w", addr, err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		if blocked {
+			err := fmt.Errorf("sign-ups from ip %s are blocked", addr)
+			return gtserror.NewErrorForbidden(err, "sign-ups from your IP address are not permitted")
+		}
+	}
+
+	_, domain, ok := strings.Cut(form.Email, "@")
+	if !ok {
+		// Should have been caught
+		// by validation already.
+		err := fmt.Errorf("email address %s has no domain", form.Email)
+		return gtserror.NewErrorBadRequest(err, err.Error())
+	}
+	domain = strings.ToLower(domain)
+
+	blocked, err := p.emailDomainBlocked(ctx, domain)
+	if err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if blocked {
+		err := fmt.Errorf("email domain %s is blocked", domain)
+		return gtserror.NewErrorUnprocessableEntity(err, "sign-ups with email addresses at "+domain+" are not permitted")
+	}
+
+	return nil
+}
+
+// emailDomainBlocked returns true if the given email domain, or a parent
+// of it, is blocked; or if any of the domain's mail servers are at a blocked
+// domain. The latter catches throwaway email domains which share mail servers
+// with an already-blocked domain.
+func (p *Processor) emailDomainBlocked(ctx context.Context, domain string) (bool, error) {
+	blocked, err := p.state.DB.IsEmailDomainBlocked(ctx, domain)
+	if err != nil {
+		return false, fmt.Errorf("db error checking email domain block for %s: %w", domain, err)
+	}
+
+	if blocked {
+		return true, nil
+	}
+
+	// Only bother looking up mail
+	// servers if anything's blocked.
+	blocks, err := p.state.DB.GetEmailDomainBlocks(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, fmt.Errorf("db error getting email domain blocks: %w", err)
+	}
+
+	if len(blocks) == 0 {
+		return false, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, mxLookupTimeout)
+	defer cancel()
+
+	mxs, err := net.DefaultResolver.LookupMX(ctx, domain)
+	if err != nil {
+		// Don't fail the sign-up just
+		// because DNS is misbehaving.
+		log.Debugf(ctx, "error looking up mx records for %s: %v", domain, err)
+		return false, nil
+	}
+
+	for _, mx := range mxs {
+		host := strings.TrimSuffix(strings.ToLower(mx.Host), ".")
+
+		blocked, err := p.state.DB.IsEmailDomainBlocked(ctx, host)
+		if err != nil {
+			return false, fmt.Errorf("db error checking email domain block for mx %s: %w", host, err)
+		}
+
+		if blocked {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// redeemInvite checks that the invite with the given
+// code can be used to sign up, and uses it up by one.
+func (p *Processor) redeemInvite(ctx context.Context, code string) (*gtsmodel.Invite, gtserror.WithCode) {
+	const help = "invite code is invalid, used up, or expired"
+
+	invite, err := p.state.DB.GetInviteByCode(ctx, code)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			err := fmt.Errorf("db error getting invite: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		err := fmt.Errorf("invite %s not found", code)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, help)
+	}
+
+	if !invite.Valid(time.Now()) {
+		err := fmt.Errorf("invite %s is no longer valid", invite.ID)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, help)
+	}
+
+	if err := p.state.DB.UseInvite(ctx, invite.ID); err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			err := fmt.Errorf("db error using invite: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		// Someone else took the last use, or it
+		// expired, while we were checking it over.
+		err := fmt.Errorf("invite %s used up or expired", invite.ID)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, help)
+	}
+
+	return invite, nil
+}
+
+// inviteID returns the ID of the given
+// invite, or an empty string if it's nil.
+func inviteID(invite *gtsmodel.Invite) string {
+	if invite == nil {
+		return ""
+	}
+	return invite.ID
+}
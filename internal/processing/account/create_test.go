@@ -0,0 +1,237 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package account_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/invite"
+)
+
+type CreateTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *CreateTestSuite) signUpForm(email string, ip string) *apimodel.AccountCreateRequest {
+	return &apimodel.AccountCreateRequest{
+		Username:  "new_account",
+		Email:     email,
+		Password:  "a very good password indeed 12345",
+		Agreement: true,
+		Locale:    "en",
+		IP:        net.ParseIP(ip),
+	}
+}
+
+func (suite *CreateTestSuite) TestCreateIPBlocked() {
+	ctx := context.Background()
+	admin := suite.testAccounts["admin_account"]
+
+	if err := suite.db.PutIPBlock(ctx, &gtsmodel.IPBlock{
+		ID:                 id.NewULID(),
+		IP:                 "192.0.2.0/24",
+		Severity:           gtsmodel.IPBlockSeveritySignUpBlock,
+		CreatedByAccountID: admin.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	token, errWithCode := suite.accountProcessor.Create(ctx, nil, nil, suite.signUpForm("someone@example.org", "192.0.2.1"))
+	suite.Nil(token)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+	suite.Equal("Forbidden: sign-ups from your IP address are not permitted", errWithCode.Safe())
+}
+
+func (suite *CreateTestSuite) TestCreateIPBlockExpired() {
+	ctx := context.Background()
+	admin := suite.testAccounts["admin_account"]
+
+	if err := suite.db.PutIPBlock(ctx, &gtsmodel.IPBlock{
+		ID:                 id.NewULID(),
+		IP:                 "192.0.2.0/24",
+		Severity:           gtsmodel.IPBlockSeveritySignUpBlock,
+		ExpiresAt:          time.Now().Add(-time.Hour),
+		CreatedByAccountID: admin.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// The block has expired, so
+	// sign-up should go ahead.
+	token, errWithCode := suite.accountProcessor.Create(ctx,
+		oauth.DBTokenToToken(suite.testTokens["local_account_1"]),
+		suite.testApplications["application_1"],
+		suite.signUpForm("someone@example.org", "192.0.2.1"),
+	)
+	suite.NoError(errWithCode)
+	suite.NotEmpty(token.AccessToken)
+
+	if _, err := suite.db.GetAccountByUsernameDomain(ctx, "new_account", ""); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *CreateTestSuite) TestCreateEmailDomainBlocked() {
+	ctx := context.Background()
+	admin := suite.testAccounts["admin_account"]
+
+	if err := suite.db.CreateEmailDomainBlock(ctx, &gtsmodel.EmailDomainBlock{
+		ID:                 id.NewULID(),
+		Domain:             "spam.example.org",
+		CreatedByAccountID: admin.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	for _, email := range []string{
+		"someone@spam.example.org",
+		"someone@SPAM.example.org",
+		"someone@mail.spam.example.org",
+	} {
+		token, errWithCode := suite.accountProcessor.Create(ctx, nil, nil, suite.signUpForm(email, "192.0.2.1"))
+		suite.Nil(token)
+		suite.NotNil(errWithCode, email)
+		suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code(), email)
+	}
+}
+
+func (suite *CreateTestSuite) putInvite(maxUses int, expiresIn time.Duration) *gtsmodel.Invite {
+	newInvite, err := invite.NewInvite(suite.testAccounts["admin_account"].ID, maxUses, expiresIn)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.db.PutInvite(context.Background(), newInvite); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return newInvite
+}
+
+func (suite *CreateTestSuite) TestCreateWithInvite() {
+	ctx := context.Background()
+	newInvite := suite.putInvite(1, time.Hour)
+
+	// Approval is required in the test config,
+	// but the invite should let us skip it.
+	suite.True(config.GetAccountsApprovalRequired())
+
+	form := suite.signUpForm("someone@example.org", "192.0.2.1")
+	form.InviteCode = newInvite.Code
+
+	token, errWithCode := suite.accountProcessor.Create(ctx,
+		oauth.DBTokenToToken(suite.testTokens["local_account_1"]),
+		suite.testApplications["application_1"],
+		form,
+	)
+	suite.NoError(errWithCode)
+	suite.NotEmpty(token.AccessToken)
+
+	account, err := suite.db.GetAccountByUsernameDomain(ctx, "new_account", "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	user, err := suite.db.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(newInvite.ID, user.InviteID)
+	suite.True(*user.Approved)
+
+	dbInvite, err := suite.db.GetInviteByID(ctx, newInvite.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(1, dbInvite.Uses)
+
+	// The invite is used up now.
+	form = suite.signUpForm("someone_else@example.org", "192.0.2.1")
+	form.Username = "another_new_account"
+	form.InviteCode = newInvite.Code
+
+	token, errWithCode = suite.accountProcessor.Create(ctx,
+		oauth.DBTokenToToken(suite.testTokens["local_account_1"]),
+		suite.testApplications["application_1"],
+		form,
+	)
+	suite.Nil(token)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	suite.Equal("Unprocessable Entity: invite code is invalid, used up, or expired", errWithCode.Safe())
+}
+
+func (suite *CreateTestSuite) TestCreateWithoutInvite() {
+	ctx := context.Background()
+
+	token, errWithCode := suite.accountProcessor.Create(ctx,
+		oauth.DBTokenToToken(suite.testTokens["local_account_1"]),
+		suite.testApplications["application_1"],
+		suite.signUpForm("someone@example.org", "192.0.2.1"),
+	)
+	suite.NoError(errWithCode)
+	suite.NotEmpty(token.AccessToken)
+
+	account, err := suite.db.GetAccountByUsernameDomain(ctx, "new_account", "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	user, err := suite.db.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(user.InviteID)
+	suite.False(*user.Approved)
+}
+
+func (suite *CreateTestSuite) TestCreateWithBadInvite() {
+	ctx := context.Background()
+	expired := suite.putInvite(0, 0)
+	expired.ExpiresAt = time.Now().Add(-time.Minute)
+	if err := suite.db.UpdateInvite(ctx, expired, "expires_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	for _, code := range []string{expired.Code, "notarealcode"} {
+		form := suite.signUpForm("someone@example.org", "192.0.2.1")
+		form.InviteCode = code
+
+		token, errWithCode := suite.accountProcessor.Create(ctx, nil, nil, form)
+		suite.Nil(token)
+		suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code(), code)
+	}
+
+	// Nobody should have been signed up.
+	available, err := suite.db.IsUsernameAvailable(ctx, "new_account")
+	suite.NoError(err)
+	suite.True(available)
+}
+
+func TestCreateTestSuite(t *testing.T) {
+	suite.Run(t, &CreateTestSuite{})
+}
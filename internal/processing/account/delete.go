@@ -104,8 +104,9 @@ func (p *Processor) DeleteSelf(ctx context.Context, account *gtsmodel.Account) g
 	return nil
 }
 
-// deleteUserAndTokensForAccount deletes the gtsmodel.User and
-// any OAuth tokens and applications for the given account.
+// deleteUserAndTokensForAccount deletes the gtsmodel.User and any
+// OAuth tokens, applications and web push subscriptions for the
+// given account.
 //
 // Callers to this function should already have checked that
 // this is a local account, or else it won't have a user associated
@@ -138,6 +139,11 @@ func (p *Processor) deleteUserAndTokensForAccount(ctx context.Context, account *
 		}
 	}
 
+	// Delete any web push subscriptions made with those tokens.
+	if err := p.state.DB.DeleteWebPushSubscriptionsByAccountID(ctx, account.ID); err != nil {
+		return gtserror.Newf("db error deleting web push subscriptions: %w", err)
+	}
+
 	columns, err := stubbifyUser(user)
 	if err != nil {
 		return gtserror.Newf("error stubbifying user: %w", err)
@@ -157,7 +163,7 @@ func (p *Processor) deleteUserAndTokensForAccount(ctx context.Context, account *
 //   - Follow requests created by account.
 func (p *Processor) deleteAccountFollows(ctx context.Context, account *gtsmodel.Account) error {
 	// Delete follows targeting this account.
-	followedBy, err := p.state.DB.GetAccountFollowers(ctx, account.ID)
+	followedBy, err := p.state.DB.GetAccountFollowers(ctx, account.ID, nil)
 	if err != nil && !errors.Is(err, db.ErrNoEntries) {
 		return gtserror.Newf("db error getting follows targeting account %s: %w", account.ID, err)
 	}
@@ -190,7 +196,7 @@ func (p *Processor) deleteAccountFollows(ctx context.Context, account *gtsmodel.
 	)
 
 	// Delete follows originating from this account.
-	following, err := p.state.DB.GetAccountFollows(ctx, account.ID)
+	following, err := p.state.DB.GetAccountFollows(ctx, account.ID, nil)
 	if err != nil && !errors.Is(err, db.ErrNoEntries) {
 		return gtserror.Newf("db error getting follows owned by account %s: %w", account.ID, err)
 	}
@@ -280,6 +286,11 @@ func (p *Processor) deleteAccountBlocks(ctx context.Context, account *gtsmodel.A
 	if err := p.state.DB.DeleteAccountBlocks(ctx, account.ID); err != nil {
 		return gtserror.Newf("db error deleting account blocks for %s: %w", account.ID, err)
 	}
+
+	if err := p.state.DB.DeleteAccountDomainBlocks(ctx, account.ID); err != nil {
+		return gtserror.Newf("db error deleting account domain blocks for %s: %w", account.ID, err)
+	}
+
 	return nil
 }
 
@@ -382,6 +393,12 @@ func (p *Processor) deleteAccountNotifications(ctx context.Context, account *gts
 		return err
 	}
 
+	// Delete notification policy of given account, and
+	// notification requests made to or originating from it.
+	if err := p.state.DB.DeleteAccountNotificationPolicyData(ctx, account.ID); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
 	return nil
 }
 
@@ -410,6 +427,26 @@ func (p *Processor) deleteAccountPeripheral(ctx context.Context, account *gtsmod
 		return err
 	}
 
+	// Delete all emoji reactions owned by / targeting given account.
+	if err := p.state.DB.DeleteStatusReactionsForAccount(ctx, account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	// Delete pinned suggestion of given account,
+	// and suggestion dismissals by / targeting it.
+	if err := p.state.DB.DeleteAccountSuggestions(ctx, account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	// Delete announcement reads
+	// and reactions by given account.
+	if err := p.state.DB.DeleteAccountAnnouncementData(ctx, account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
 	// TODO: add status mutes here when they're implemented.
 
 	return nil
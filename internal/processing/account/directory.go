@@ -0,0 +1,70 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// DirectoryGet returns accounts from the profile directory which are visible
+// to the requesting account (if any). Accounts are ordered by most recent
+// activity, or by newest account if byNewest is set.
+func (p *Processor) DirectoryGet(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	byNewest bool,
+	localOnly bool,
+	limit int,
+	offset int,
+) ([]*apimodel.Account, gtserror.WithCode) {
+	accounts, err := p.state.DB.GetDirectoryAccounts(ctx, byNewest, localOnly, limit, offset)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting directory accounts: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiAccounts := make([]*apimodel.Account, 0, len(accounts))
+	for _, account := range accounts {
+		visible, err := p.filter.AccountVisible(ctx, requestingAccount, account)
+		if err != nil {
+			log.Errorf(ctx, "error checking account visibility: %v", err)
+			continue
+		}
+
+		if !visible {
+			continue
+		}
+
+		apiAccount, err := p.tc.AccountToAPIAccountPublic(ctx, account)
+		if err != nil {
+			log.Errorf(ctx, "error converting account %s to api account: %v", account.ID, err)
+			continue
+		}
+
+		apiAccounts = append(apiAccounts, apiAccount)
+	}
+
+	return apiAccounts, nil
+}
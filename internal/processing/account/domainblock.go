@@ -0,0 +1,264 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// DomainBlocksGet returns a page of the domains blocked by requestingAccount.
+func (p *Processor) DomainBlocksGet(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	page paging.Pager,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	blocks, err := p.state.DB.GetAccountDomainBlocks(ctx,
+		requestingAccount.ID,
+		&page,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Check for zero length.
+	count := len(blocks)
+	if count == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	items := make([]interface{}, 0, count)
+	for _, block := range blocks {
+		items = append(items, block.Domain)
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:          items,
+		Path:           "/api/v1/domain_blocks",
+		NextMaxIDKey:   "max_id",
+		PrevMinIDKey:   "since_id",
+		NextMaxIDValue: blocks[count-1].ID,
+		PrevMinIDValue: blocks[0].ID,
+		Limit:          page.Limit,
+	})
+}
+
+// DomainBlockCreate handles the blocking of the given domain by requestingAccount.
+// All follows and follow requests between requestingAccount and accounts on the
+// domain are removed, in both directions.
+func (p *Processor) DomainBlockCreate(ctx context.Context, requestingAccount *gtsmodel.Account, domain string) gtserror.WithCode {
+	domain, existingBlock, errWithCode := p.getDomainBlockTarget(ctx, requestingAccount, domain)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if existingBlock != nil {
+		// Block already exists, nothing to do.
+		return nil
+	}
+
+	// Create and store a new domain block.
+	block := &gtsmodel.AccountDomainBlock{
+		ID:        id.NewULID(),
+		AccountID: requestingAccount.ID,
+		Account:   requestingAccount,
+		Domain:    domain,
+	}
+
+	if err := p.state.DB.PutAccountDomainBlock(ctx, block); err != nil {
+		err = fmt.Errorf("DomainBlockCreate: error creating domain block in db: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	// Gather every account on the blocked domain
+	// that has a follow (request) to / from us.
+	targetAccounts, err := p.domainBlockTargetAccounts(ctx, requestingAccount, domain)
+	if err != nil {
+		err = fmt.Errorf("DomainBlockCreate: error getting accounts on domain: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	var msgs []messages.FromClientAPI
+
+	for _, targetAccount := range targetAccounts {
+		// As with account blocks, we only care about
+		// processing unfollow side effects from the
+		// requesting account -> target account.
+		unfollowMsgs, err := p.unfollow(ctx, requestingAccount, targetAccount)
+		if err != nil {
+			err = fmt.Errorf("DomainBlockCreate: error unfollowing: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+		msgs = append(msgs, unfollowMsgs...)
+
+		// Ensure unfollowed in other direction;
+		// ignore/don't process returned messages.
+		if _, err := p.unfollow(ctx, targetAccount, requestingAccount); err != nil {
+			err = fmt.Errorf("DomainBlockCreate: error unfollowing: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	// Process domain block side effects (timelines etc).
+	msgs = append(msgs, messages.FromClientAPI{
+		APObjectType:   messages.ObjectDomainBlock,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       block,
+		OriginAccount:  requestingAccount,
+	})
+
+	// Batch queue accreted client api messages.
+	p.state.Workers.EnqueueClientAPI(ctx, msgs...)
+
+	return nil
+}
+
+// DomainBlockRemove handles the removal of a block of the given domain by requestingAccount.
+func (p *Processor) DomainBlockRemove(ctx context.Context, requestingAccount *gtsmodel.Account, domain string) gtserror.WithCode {
+	_, existingBlock, errWithCode := p.getDomainBlockTarget(ctx, requestingAccount, domain)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if existingBlock == nil {
+		// Already not blocked, nothing to do.
+		return nil
+	}
+
+	// We got a block, remove it from the db.
+	if err := p.state.DB.DeleteAccountDomainBlockByID(ctx, existingBlock.ID); err != nil {
+		err := fmt.Errorf("DomainBlockRemove: error removing domain block from db: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	// Process domain block removal side effects (timelines etc).
+	p.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   messages.ObjectDomainBlock,
+		APActivityType: ap.ActivityUndo,
+		GTSModel:       existingBlock,
+		OriginAccount:  requestingAccount,
+	})
+
+	return nil
+}
+
+// getDomainBlockTarget normalizes and checks the given domain, and
+// returns it along with requestingAccount's existing block of it, if any.
+func (p *Processor) getDomainBlockTarget(ctx context.Context, requestingAccount *gtsmodel.Account, domain string) (string, *gtsmodel.AccountDomainBlock, gtserror.WithCode) {
+	domain, err := util.Punify(strings.TrimSpace(domain))
+	if err != nil {
+		err = fmt.Errorf("getDomainBlockTarget: invalid domain %s: %w", domain, err)
+		return "", nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if domain == "" {
+		err := errors.New("getDomainBlockTarget: no domain provided")
+		return "", nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// Account should not block or unblock its own instance.
+	if domain == config.GetHost() || domain == config.GetAccountDomain() {
+		err := fmt.Errorf("getDomainBlockTarget: account %s cannot block or unblock its own domain", requestingAccount.ID)
+		return "", nil, gtserror.NewErrorNotAcceptable(err, err.Error())
+	}
+
+	// Check if currently blocked.
+	block, err := p.state.DB.GetAccountDomainBlock(ctx, requestingAccount.ID, domain)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = fmt.Errorf("getDomainBlockTarget: db error checking existing domain block: %w", err)
+		return "", nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return domain, block, nil
+}
+
+// domainBlockTargetAccounts returns all accounts on the given domain (or
+// its subdomains) which follow, are followed by, or have a pending follow
+// request to / from the given account.
+func (p *Processor) domainBlockTargetAccounts(ctx context.Context, account *gtsmodel.Account, domain string) ([]*gtsmodel.Account, error) {
+	var (
+		accounts []*gtsmodel.Account
+		seen     = make(map[string]struct{})
+	)
+
+	// addIfOnDomain appends the given account
+	// to accounts if it's on the blocked domain.
+	addIfOnDomain := func(a *gtsmodel.Account) {
+		if a == nil || a.IsLocal() {
+			return
+		}
+
+		if a.Domain != domain && !strings.HasSuffix(a.Domain, "."+domain) {
+			return
+		}
+
+		if _, ok := seen[a.ID]; ok {
+			return
+		}
+
+		seen[a.ID] = struct{}{}
+		accounts = append(accounts, a)
+	}
+
+	follows, err := p.state.DB.GetAccountFollows(ctx, account.ID, nil)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, err
+	}
+	for _, follow := range follows {
+		addIfOnDomain(follow.TargetAccount)
+	}
+
+	followers, err := p.state.DB.GetAccountFollowers(ctx, account.ID, nil)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, err
+	}
+	for _, follow := range followers {
+		addIfOnDomain(follow.Account)
+	}
+
+	followRequesting, err := p.state.DB.GetAccountFollowRequesting(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, err
+	}
+	for _, followReq := range followRequesting {
+		addIfOnDomain(followReq.TargetAccount)
+	}
+
+	followRequests, err := p.state.DB.GetAccountFollowRequests(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, err
+	}
+	for _, followReq := range followRequests {
+		addIfOnDomain(followReq.Account)
+	}
+
+	return accounts, nil
+}
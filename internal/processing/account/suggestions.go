@@ -0,0 +1,200 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// Sources of follow suggestions, and the
+// (deprecated) single source they map to.
+const (
+	suggestionSourceFeatured         = "featured"
+	suggestionSourceFriendsOfFriends = "friends_of_friends"
+	suggestionSourceMostFollowed     = "most_followed"
+)
+
+var suggestionSourceV1 = map[string]string{
+	suggestionSourceFeatured:         "staff",
+	suggestionSourceFriendsOfFriends: "past_interactions",
+	suggestionSourceMostFollowed:     "global",
+}
+
+// suggestion is a suggested
+// account, and why it's suggested.
+type suggestion struct {
+	account *gtsmodel.Account
+	sources []string
+}
+
+// SuggestionsGet returns up to limit follow suggestions for the requesting account.
+//
+// Suggestions are accounts pinned by an admin, followed by accounts that the requesting
+// account follows, and popular local accounts, in that order. Accounts which the requesting
+// account already follows (or requested to follow), which it has dismissed as suggestions,
+// or which are not visible to it, are never suggested.
+func (p *Processor) SuggestionsGet(ctx context.Context, requestingAccount *gtsmodel.Account, limit int) ([]*apimodel.Suggestion, gtserror.WithCode) {
+	dismissedIDs, err := p.state.DB.GetDismissedSuggestionIDs(ctx, requestingAccount.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting dismissed suggestions: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	var (
+		suggestions []*suggestion
+		seen        = make(map[string]*suggestion)
+		skip        = make(map[string]bool, len(dismissedIDs)+1)
+	)
+
+	skip[requestingAccount.ID] = true
+	for _, targetID := range dismissedIDs {
+		skip[targetID] = true
+	}
+
+	// add appends the given accounts as suggestions from the given
+	// source, or adds the source to the account if already suggested.
+	add := func(accounts []*gtsmodel.Account, source string) {
+		for _, account := range accounts {
+			if s, ok := seen[account.ID]; ok {
+				s.sources = append(s.sources, source)
+				continue
+			}
+
+			if skip[account.ID] {
+				continue
+			}
+
+			suggestable, err := p.suggestable(ctx, requestingAccount, account)
+			if err != nil {
+				log.Errorf(ctx, "error checking whether account %s is suggestable: %v", account.ID, err)
+				continue
+			}
+
+			if !suggestable {
+				skip[account.ID] = true
+				continue
+			}
+
+			s := &suggestion{account: account, sources: []string{source}}
+			seen[account.ID] = s
+			suggestions = append(suggestions, s)
+		}
+	}
+
+	pinned, err := p.state.DB.GetPinnedSuggestions(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting pinned suggestions: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	pinnedAccounts := make([]*gtsmodel.Account, 0, len(pinned))
+	for _, pin := range pinned {
+		pinnedAccounts = append(pinnedAccounts, pin.Account)
+	}
+	add(pinnedAccounts, suggestionSourceFeatured)
+
+	friendsOfFriends, err := p.state.DB.GetAccountsFollowedByFollows(ctx, requestingAccount.ID, limit)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting accounts followed by follows: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	add(friendsOfFriends, suggestionSourceFriendsOfFriends)
+
+	popular, err := p.state.DB.GetPopularLocalAccounts(ctx, limit)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting popular accounts: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	add(popular, suggestionSourceMostFollowed)
+
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+
+	apiSuggestions := make([]*apimodel.Suggestion, 0, len(suggestions))
+	for _, s := range suggestions {
+		apiAccount, err := p.tc.AccountToAPIAccountPublic(ctx, s.account)
+		if err != nil {
+			log.Errorf(ctx, "error converting account %s to api account: %v", s.account.ID, err)
+			continue
+		}
+
+		apiSuggestions = append(apiSuggestions, &apimodel.Suggestion{
+			Source:  suggestionSourceV1[s.sources[0]],
+			Sources: s.sources,
+			Account: apiAccount,
+		})
+	}
+
+	return apiSuggestions, nil
+}
+
+// suggestable returns whether the given account may be suggested
+// to the requesting account, ie., that it's visible and not already
+// followed or requested to be followed by the requesting account.
+func (p *Processor) suggestable(ctx context.Context, requestingAccount *gtsmodel.Account, account *gtsmodel.Account) (bool, error) {
+	visible, err := p.filter.AccountVisible(ctx, requestingAccount, account)
+	if err != nil || !visible {
+		return false, err
+	}
+
+	following, err := p.state.DB.IsFollowing(ctx, requestingAccount.ID, account.ID)
+	if err != nil || following {
+		return false, err
+	}
+
+	requested, err := p.state.DB.IsFollowRequested(ctx, requestingAccount.ID, account.ID)
+	if err != nil || requested {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// SuggestionDismiss stops the given target account from
+// being suggested to the requesting account as a follow.
+func (p *Processor) SuggestionDismiss(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string) gtserror.WithCode {
+	if _, err := p.state.DB.GetAccountByID(ctx, targetAccountID); err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("account %s not found", targetAccountID)
+			return gtserror.NewErrorNotFound(err, err.Error())
+		}
+		err = gtserror.Newf("db error getting account %s: %w", targetAccountID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.state.DB.PutSuggestionDismissal(ctx, &gtsmodel.SuggestionDismissal{
+		ID:              id.NewULID(),
+		AccountID:       requestingAccount.ID,
+		TargetAccountID: targetAccountID,
+	}); err != nil && !errors.Is(err, db.ErrAlreadyExists) {
+		err = gtserror.Newf("db error putting suggestion dismissal: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,289 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+)
+
+// AnnouncementsGet returns all announcements, including
+// unpublished and expired ones, newest first.
+func (p *Processor) AnnouncementsGet(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.Announcement, gtserror.WithCode) {
+	announcements, err := p.state.DB.GetAnnouncements(ctx)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiAnnouncements := make([]*apimodel.Announcement, 0, len(announcements))
+	for _, announcement := range announcements {
+		apiAnnouncement, err := p.tc.AnnouncementToAPIAnnouncement(ctx, announcement, account)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		apiAnnouncements = append(apiAnnouncements, apiAnnouncement)
+	}
+
+	return apiAnnouncements, nil
+}
+
+// AnnouncementGet returns one announcement with the given ID.
+func (p *Processor) AnnouncementGet(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.Announcement, gtserror.WithCode) {
+	announcement, errWithCode := p.getAnnouncement(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiAnnouncement(ctx, announcement, account)
+}
+
+// AnnouncementCreate creates a new announcement using the given form.
+// If the announcement is published, it will be streamed to users.
+func (p *Processor) AnnouncementCreate(ctx context.Context, account *gtsmodel.Account, form *apimodel.AnnouncementCreateRequest) (*apimodel.Announcement, gtserror.WithCode) {
+	announcement := &gtsmodel.Announcement{
+		ID:        id.NewULID(),
+		AllDay:    &form.AllDay,
+		Published: new(bool),
+	}
+
+	startsAt, err := parseAnnouncementTime(form.StartsAt)
+	if err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+	announcement.StartsAt = startsAt
+
+	endsAt, err := parseAnnouncementTime(form.EndsAt)
+	if err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+	announcement.EndsAt = endsAt
+
+	if errWithCode := validateAnnouncementTimes(announcement); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	p.formatAnnouncement(ctx, account, announcement, form.Text)
+
+	if form.Published == nil || *form.Published {
+		*announcement.Published = true
+		announcement.PublishedAt = time.Now()
+	}
+
+	if err := p.state.DB.PutAnnouncement(ctx, announcement); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if *announcement.Published {
+		p.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
+			APObjectType:   messages.ObjectAnnouncement,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       announcement,
+			OriginAccount:  account,
+		})
+	}
+
+	return p.apiAnnouncement(ctx, announcement, account)
+}
+
+// AnnouncementUpdate updates the announcement with the given ID using the
+// given form. If the announcement is or was published, the changes will
+// be streamed to users.
+func (p *Processor) AnnouncementUpdate(ctx context.Context, account *gtsmodel.Account, id string, form *apimodel.AnnouncementUpdateRequest) (*apimodel.Announcement, gtserror.WithCode) {
+	announcement, errWithCode := p.getAnnouncement(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	var (
+		wasPublished = *announcement.Published
+		columns      []string
+	)
+
+	if form.StartsAt != nil {
+		startsAt, err := parseAnnouncementTime(*form.StartsAt)
+		if err != nil {
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		announcement.StartsAt = startsAt
+		columns = append(columns, "starts_at")
+	}
+
+	if form.EndsAt != nil {
+		endsAt, err := parseAnnouncementTime(*form.EndsAt)
+		if err != nil {
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		announcement.EndsAt = endsAt
+		columns = append(columns, "ends_at")
+	}
+
+	if errWithCode := validateAnnouncementTimes(announcement); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if form.AllDay != nil {
+		announcement.AllDay = form.AllDay
+		columns = append(columns, "all_day")
+	}
+
+	if form.Text != nil {
+		p.formatAnnouncement(ctx, account, announcement, *form.Text)
+		columns = append(columns, "text", "content", "emojis", "tags")
+	}
+
+	if form.Published != nil {
+		announcement.Published = form.Published
+		columns = append(columns, "published")
+
+		if *announcement.Published && announcement.PublishedAt.IsZero() {
+			announcement.PublishedAt = time.Now()
+			columns = append(columns, "published_at")
+		}
+	}
+
+	if len(columns) == 0 {
+		// Nothing to do.
+		return p.apiAnnouncement(ctx, announcement, account)
+	}
+
+	if err := p.state.DB.UpdateAnnouncement(ctx, announcement, columns...); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if wasPublished || *announcement.Published {
+		p.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
+			APObjectType:   messages.ObjectAnnouncement,
+			APActivityType: ap.ActivityUpdate,
+			GTSModel:       announcement,
+			OriginAccount:  account,
+		})
+	}
+
+	return p.apiAnnouncement(ctx, announcement, account)
+}
+
+// AnnouncementDelete deletes the announcement with the given ID,
+// along with all reads and reactions of it.
+func (p *Processor) AnnouncementDelete(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.Announcement, gtserror.WithCode) {
+	announcement, errWithCode := p.getAnnouncement(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Convert before deletion,
+	// while reactions still exist.
+	apiAnnouncement, errWithCode := p.apiAnnouncement(ctx, announcement, account)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteAnnouncementByID(ctx, announcement.ID); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if *announcement.Published {
+		p.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
+			APObjectType:   messages.ObjectAnnouncement,
+			APActivityType: ap.ActivityDelete,
+			GTSModel:       announcement,
+			OriginAccount:  account,
+		})
+	}
+
+	return apiAnnouncement, nil
+}
+
+func (p *Processor) getAnnouncement(ctx context.Context, id string) (*gtsmodel.Announcement, gtserror.WithCode) {
+	announcement, err := p.state.DB.GetAnnouncementByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return announcement, nil
+}
+
+func (p *Processor) apiAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement, account *gtsmodel.Account) (*apimodel.Announcement, gtserror.WithCode) {
+	apiAnnouncement, err := p.tc.AnnouncementToAPIAnnouncement(ctx, announcement, account)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiAnnouncement, nil
+}
+
+// formatAnnouncement sets the text of the given announcement,
+// and parses it as markdown to set content, emojis, and tags.
+func (p *Processor) formatAnnouncement(ctx context.Context, account *gtsmodel.Account, announcement *gtsmodel.Announcement, text string) {
+	formatResult := p.formatter.FromMarkdown(ctx, p.parseMention, account.ID, "", text)
+
+	announcement.Text = text
+	announcement.Content = formatResult.HTML
+
+	announcement.Emojis = formatResult.Emojis
+	announcement.EmojiIDs = make([]string, 0, len(formatResult.Emojis))
+	for _, emoji := range formatResult.Emojis {
+		announcement.EmojiIDs = append(announcement.EmojiIDs, emoji.ID)
+	}
+
+	announcement.Tags = formatResult.Tags
+	announcement.TagIDs = make([]string, 0, len(formatResult.Tags))
+	for _, tag := range formatResult.Tags {
+		announcement.TagIDs = append(announcement.TagIDs, tag.ID)
+	}
+}
+
+// parseAnnouncementTime parses the given RFC3339 time
+// string, returning a zero time if the string is empty.
+func parseAnnouncementTime(in string) (time.Time, error) {
+	if in == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, in)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error parsing announcement time %s: %w", in, err)
+	}
+
+	return t, nil
+}
+
+func validateAnnouncementTimes(announcement *gtsmodel.Announcement) gtserror.WithCode {
+	if announcement.StartsAt.IsZero() || announcement.EndsAt.IsZero() {
+		return nil
+	}
+
+	if !announcement.EndsAt.After(announcement.StartsAt) {
+		err := errors.New("announcement ends_at must be after starts_at")
+		return gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// EmailDomainBlockCreate creates a block on sign-ups using email addresses
+// at the given domain, or at any of its subdomains.
+//
+// If a block already exists for the domain, the existing block is returned.
+func (p *Processor) EmailDomainBlockCreate(ctx context.Context, account *gtsmodel.Account, domain string) (*apimodel.EmailDomainBlock, gtserror.WithCode) {
+	// Be lenient about what admins paste in,
+	// eg., "@Example.org" should be accepted.
+	domain = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(domain), "@"))
+	if domain == "" || strings.ContainsAny(domain, "@/: ") {
+		err := fmt.Errorf("invalid email domain %q", domain)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	domain, err := util.Punify(domain)
+	if err != nil {
+		err := fmt.Errorf("invalid email domain %q: %w", domain, err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// Check if a block already exists for this domain.
+	emailDomainBlock, err := p.state.DB.GetEmailDomainBlock(ctx, domain)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		// Something went wrong in the DB.
+		err = gtserror.Newf("db error getting email domain block %s: %w", domain, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if emailDomainBlock == nil {
+		// No block exists yet, create it.
+		emailDomainBlock = &gtsmodel.EmailDomainBlock{
+			ID:                 id.NewULID(),
+			Domain:             domain,
+			CreatedByAccountID: account.ID,
+		}
+
+		if err := p.state.DB.CreateEmailDomainBlock(ctx, emailDomainBlock); err != nil {
+			err = gtserror.Newf("db error putting email domain block %s: %w", domain, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	return p.apiEmailDomainBlock(ctx, emailDomainBlock)
+}
+
+// EmailDomainBlocksGet returns all existing email domain blocks.
+func (p *Processor) EmailDomainBlocksGet(ctx context.Context) ([]*apimodel.EmailDomainBlock, gtserror.WithCode) {
+	emailDomainBlocks, err := p.state.DB.GetEmailDomainBlocks(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting email domain blocks: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiEmailDomainBlocks := make([]*apimodel.EmailDomainBlock, 0, len(emailDomainBlocks))
+	for _, emailDomainBlock := range emailDomainBlocks {
+		apiEmailDomainBlock, errWithCode := p.apiEmailDomainBlock(ctx, emailDomainBlock)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		apiEmailDomainBlocks = append(apiEmailDomainBlocks, apiEmailDomainBlock)
+	}
+
+	return apiEmailDomainBlocks, nil
+}
+
+// EmailDomainBlockGet returns one email domain block with the given id.
+func (p *Processor) EmailDomainBlockGet(ctx context.Context, id string) (*apimodel.EmailDomainBlock, gtserror.WithCode) {
+	emailDomainBlock, errWithCode := p.getEmailDomainBlock(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiEmailDomainBlock(ctx, emailDomainBlock)
+}
+
+// EmailDomainBlockDelete removes one email domain block with the given ID.
+func (p *Processor) EmailDomainBlockDelete(ctx context.Context, id string) (*apimodel.EmailDomainBlock, gtserror.WithCode) {
+	emailDomainBlock, errWithCode := p.getEmailDomainBlock(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Prepare the block to return, *before* the deletion goes through.
+	apiEmailDomainBlock, errWithCode := p.apiEmailDomainBlock(ctx, emailDomainBlock)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteEmailDomainBlock(ctx, emailDomainBlock.Domain); err != nil {
+		err = gtserror.Newf("db error deleting email domain block %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiEmailDomainBlock, nil
+}
+
+func (p *Processor) getEmailDomainBlock(ctx context.Context, id string) (*gtsmodel.EmailDomainBlock, gtserror.WithCode) {
+	emailDomainBlock, err := p.state.DB.GetEmailDomainBlockByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("no email domain block exists with id %s", id)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+
+		// Something went wrong in the DB.
+		err = gtserror.Newf("db error getting email domain block %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return emailDomainBlock, nil
+}
+
+func (p *Processor) apiEmailDomainBlock(ctx context.Context, emailDomainBlock *gtsmodel.EmailDomainBlock) (*apimodel.EmailDomainBlock, gtserror.WithCode) {
+	apiEmailDomainBlock, err := p.tc.EmailDomainBlockToAPIEmailDomainBlock(ctx, emailDomainBlock)
+	if err != nil {
+		err = gtserror.Newf("error converting email domain block %s to api model: %w", emailDomainBlock.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiEmailDomainBlock, nil
+}
@@ -0,0 +1,184 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+// IPBlockCreate creates a block on sign-ups from, or all access
+// from, the IP address or CIDR range given in the form.
+func (p *Processor) IPBlockCreate(ctx context.Context, account *gtsmodel.Account, form *apimodel.IPBlockCreateRequest) (*apimodel.IPBlock, gtserror.WithCode) {
+	prefix, err := parseIPBlock(form.IP)
+	if err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	severity := gtsmodel.IPBlockSeverity(form.Severity)
+	switch severity {
+	case gtsmodel.IPBlockSeveritySignUpBlock, gtsmodel.IPBlockSeverityNoAccess:
+		// Fine.
+	default:
+		err := fmt.Errorf("severity must be one of %s or %s, was %q",
+			gtsmodel.IPBlockSeveritySignUpBlock, gtsmodel.IPBlockSeverityNoAccess, form.Severity)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if form.ExpiresIn < 0 {
+		err := errors.New("expires_in must not be negative")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// Check if a block already exists for this range.
+	existing, err := p.state.DB.GetIPBlock(ctx, prefix.String())
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting ip block %s: %w", prefix, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if existing != nil {
+		err := fmt.Errorf("an ip block already exists for %s with id %s", prefix, existing.ID)
+		return nil, gtserror.NewErrorConflict(err, err.Error())
+	}
+
+	ipBlock := &gtsmodel.IPBlock{
+		ID:                 id.NewULID(),
+		IP:                 prefix.String(),
+		Severity:           severity,
+		Comment:            text.SanitizePlaintext(form.Comment),
+		CreatedByAccountID: account.ID,
+	}
+
+	if form.ExpiresIn > 0 {
+		ipBlock.ExpiresAt = time.Now().Add(time.Duration(form.ExpiresIn) * time.Second)
+	}
+
+	if err := p.state.DB.PutIPBlock(ctx, ipBlock); err != nil {
+		err = gtserror.Newf("db error putting ip block %s: %w", prefix, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.tc.IPBlockToAPIIPBlock(ipBlock), nil
+}
+
+// IPBlocksGet returns all existing IP blocks, newest first.
+func (p *Processor) IPBlocksGet(ctx context.Context) ([]*apimodel.IPBlock, gtserror.WithCode) {
+	ipBlocks, err := p.state.DB.GetIPBlocks(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting ip blocks: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiIPBlocks := make([]*apimodel.IPBlock, 0, len(ipBlocks))
+	for _, ipBlock := range ipBlocks {
+		apiIPBlocks = append(apiIPBlocks, p.tc.IPBlockToAPIIPBlock(ipBlock))
+	}
+
+	return apiIPBlocks, nil
+}
+
+// IPBlockGet returns one IP block with the given id.
+func (p *Processor) IPBlockGet(ctx context.Context, id string) (*apimodel.IPBlock, gtserror.WithCode) {
+	ipBlock, errWithCode := p.getIPBlock(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.tc.IPBlockToAPIIPBlock(ipBlock), nil
+}
+
+// IPBlockDelete removes one IP block with the given ID.
+func (p *Processor) IPBlockDelete(ctx context.Context, id string) (*apimodel.IPBlock, gtserror.WithCode) {
+	ipBlock, errWithCode := p.getIPBlock(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteIPBlockByID(ctx, ipBlock.ID); err != nil {
+		err = gtserror.Newf("db error deleting ip block %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.tc.IPBlockToAPIIPBlock(ipBlock), nil
+}
+
+func (p *Processor) getIPBlock(ctx context.Context, id string) (*gtsmodel.IPBlock, gtserror.WithCode) {
+	ipBlock, err := p.state.DB.GetIPBlockByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("no ip block exists with id %s", id)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+
+		// Something went wrong in the DB.
+		err = gtserror.Newf("db error getting ip block %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return ipBlock, nil
+}
+
+// parseIPBlock parses the given string as either a CIDR
+// range or a single IP address, normalizing it to a masked
+// range so that eg., '192.0.2.1' becomes '192.0.2.1/32',
+// and '192.0.2.1/24' becomes '192.0.2.0/24'.
+func parseIPBlock(s string) (netip.Prefix, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return netip.Prefix{}, errors.New("empty ip provided")
+	}
+
+	if strings.Contains(s, "/") {
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid ip range %q: %w", s, err)
+		}
+
+		addr, bits := prefix.Addr(), prefix.Bits()
+		if addr.Is4In6() {
+			// Store IPv4-mapped IPv6 ranges as
+			// IPv4 ranges, since that's how
+			// incoming addresses are matched.
+			addr, bits = addr.Unmap(), bits-96
+			if bits < 0 {
+				return netip.Prefix{}, fmt.Errorf("invalid ip range %q: too wide for an ipv4-mapped range", s)
+			}
+		}
+
+		return netip.PrefixFrom(addr, bits).Masked(), nil
+	}
+
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid ip %q: %w", s, err)
+	}
+	addr = addr.WithZone("").Unmap()
+
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
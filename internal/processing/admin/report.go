@@ -19,6 +19,7 @@ package admin
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
@@ -28,6 +29,8 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
@@ -106,9 +109,17 @@ func (p *Processor) ReportGet(ctx context.Context, account *gtsmodel.Account, id
 
 // ReportResolve marks a report with the given id as resolved,
 // and stores the provided actionTakenComment (if not null).
+//
+// Moderation actions requested in the form (deleting or marking
+// reported statuses as sensitive, silencing or suspending the
+// reported account, or just warning it) are applied, and each
+// of them is recorded as an admin action linked to the report.
+// If the reported account is from this instance, it will receive
+// a notification warning it about the action(s) taken.
+//
 // If the report creator is from this instance, an email will
 // be sent to them to let them know that the report is resolved.
-func (p *Processor) ReportResolve(ctx context.Context, account *gtsmodel.Account, id string, actionTakenComment *string) (*apimodel.AdminReport, gtserror.WithCode) {
+func (p *Processor) ReportResolve(ctx context.Context, account *gtsmodel.Account, id string, form *apimodel.AdminReportResolveRequest) (*apimodel.AdminReport, gtserror.WithCode) {
 	report, err := p.state.DB.GetReportByID(ctx, id)
 	if err != nil {
 		if err == db.ErrNoEntries {
@@ -117,26 +128,81 @@ func (p *Processor) ReportResolve(ctx context.Context, account *gtsmodel.Account
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
-	columns := []string{
-		"action_taken_at",
-		"action_taken_by_account_id",
+	if !report.ActionTakenAt.IsZero() {
+		const text = "report has already been resolved"
+		return nil, gtserror.NewErrorConflict(errors.New(text), text)
+	}
+
+	// Check requested actions before
+	// changing anything on the report.
+	actionTypes, errWithCode := reportActionTypes(report, form)
+	if errWithCode != nil {
+		return nil, errWithCode
 	}
 
+	if form.ActionTakenComment != nil {
+		// Set this before taking any actions,
+		// since they use it as their text.
+		report.ActionTaken = *form.ActionTakenComment
+	}
+
+	// Claim the report before taking any actions,
+	// so that if another moderator is resolving it
+	// at the same time, only one of us acts on it.
 	report.ActionTakenAt = time.Now()
 	report.ActionTakenByAccountID = account.ID
 
-	if actionTakenComment != nil {
-		report.ActionTaken = *actionTakenComment
-		columns = append(columns, "action_taken")
+	if err := p.state.DB.ResolveReport(ctx, report); err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			const text = "report has already been resolved"
+			return nil, gtserror.NewErrorConflict(errors.New(text), text)
+		}
+		return nil, gtserror.NewErrorInternalError(err)
 	}
 
-	updatedReport, err := p.state.DB.UpdateReport(ctx, report, columns...)
-	if err != nil {
-		return nil, gtserror.NewErrorInternalError(err)
+	// Take all the actions, and only send
+	// side effects once everything's stored.
+	var (
+		lastAction *gtsmodel.AdminAccountAction
+		msgs       []messages.FromClientAPI
+	)
+
+	for _, actionType := range actionTypes {
+		var actionMsgs []messages.FromClientAPI
+		lastAction, actionMsgs, errWithCode = p.reportAction(ctx, account, report, actionType)
+		if errWithCode != nil {
+			// Give back the claim, so that a failure
+			// part way through leaves the report open
+			// to try again.
+			if err := p.state.DB.UnresolveReport(ctx, report.ID); err != nil {
+				log.Errorf(ctx, "db error unresolving report %s: %v", report.ID, err)
+			}
+			return nil, errWithCode
+		}
+		msgs = append(msgs, actionMsgs...)
+	}
+
+	if form.ActionTakenComment != nil {
+		if _, err := p.state.DB.UpdateReport(ctx, report, "action_taken"); err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if lastAction != nil && lastAction.Type != gtsmodel.AdminActionSuspend {
+		// Warn the target account about the most severe
+		// action taken. Suspended accounts are removed,
+		// so there's no point in warning them.
+		msgs = append(msgs, messages.FromClientAPI{
+			APObjectType:   messages.ObjectModerationWarning,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       lastAction,
+			OriginAccount:  account,
+			TargetAccount:  report.TargetAccount,
+		})
 	}
 
 	// Process side effects of closing the report.
-	p.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
+	msgs = append(msgs, messages.FromClientAPI{
 		APObjectType:   ap.ActivityFlag,
 		APActivityType: ap.ActivityUpdate,
 		GTSModel:       report,
@@ -144,10 +210,146 @@ func (p *Processor) ReportResolve(ctx context.Context, account *gtsmodel.Account
 		TargetAccount:  report.Account,
 	})
 
-	apimodelReport, err := p.tc.ReportToAdminAPIReport(ctx, updatedReport, account)
+	p.state.Workers.EnqueueClientAPI(ctx, msgs...)
+
+	apimodelReport, err := p.tc.ReportToAdminAPIReport(ctx, report, account)
 	if err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
 	return apimodelReport, nil
 }
+
+// reportActionTypes checks the moderation actions requested
+// in the given form against the given report, and returns
+// the types of admin action to take, from least to most severe.
+func reportActionTypes(report *gtsmodel.Report, form *apimodel.AdminReportResolveRequest) ([]gtsmodel.AdminActionType, gtserror.WithCode) {
+	var actionTypes []gtsmodel.AdminActionType
+
+	if form.DeleteStatuses || form.MarkStatusesSensitive {
+		if len(report.StatusIDs) == 0 {
+			const text = "report does not contain any statuses to delete or mark as sensitive"
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		if form.DeleteStatuses && form.MarkStatusesSensitive {
+			const text = "delete_statuses and mark_statuses_sensitive cannot be used together"
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+	}
+
+	if form.SendWarning {
+		actionTypes = append(actionTypes, gtsmodel.AdminActionNone)
+	}
+
+	if form.MarkStatusesSensitive {
+		actionTypes = append(actionTypes, gtsmodel.AdminActionSensitive)
+	}
+
+	if form.DeleteStatuses {
+		actionTypes = append(actionTypes, gtsmodel.AdminActionDeleteStatuses)
+	}
+
+	switch form.AccountAction {
+	case "", string(gtsmodel.AdminActionNone):
+		// Nothing to do.
+	case string(gtsmodel.AdminActionSilence):
+		actionTypes = append(actionTypes, gtsmodel.AdminActionSilence)
+	case string(gtsmodel.AdminActionSuspend):
+		actionTypes = append(actionTypes, gtsmodel.AdminActionSuspend)
+	default:
+		text := fmt.Sprintf("account_action %s not recognized, valid options are 'none', 'silence', 'suspend'", form.AccountAction)
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	return actionTypes, nil
+}
+
+// reportAction applies one moderation action of the given
+// type on the target of the given report, and records it
+// as an admin action linked to the report. Side effects
+// of the action are returned as messages for the caller
+// to enqueue, rather than being enqueued straight away.
+func (p *Processor) reportAction(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	report *gtsmodel.Report,
+	actionType gtsmodel.AdminActionType,
+) (*gtsmodel.AdminAccountAction, []messages.FromClientAPI, gtserror.WithCode) {
+	adminAction := &gtsmodel.AdminAccountAction{
+		ID:              id.NewULID(),
+		AccountID:       account.ID,
+		TargetAccountID: report.TargetAccountID,
+		Text:            report.ActionTaken,
+		Type:            actionType,
+		ReportID:        report.ID,
+	}
+
+	var msgs []messages.FromClientAPI
+
+	switch actionType {
+	case gtsmodel.AdminActionSensitive:
+		adminAction.StatusIDs = report.StatusIDs
+		for _, status := range report.Statuses {
+			if *status.Sensitive {
+				continue
+			}
+
+			sensitive := true
+			status.Sensitive = &sensitive
+			if err := p.state.DB.UpdateStatus(ctx, status, "sensitive"); err != nil {
+				err := gtserror.Newf("db error marking status %s as sensitive: %w", status.ID, err)
+				return nil, nil, gtserror.NewErrorInternalError(err)
+			}
+
+			// Pass the status update through the client
+			// api channel, to uncache it from timelines
+			// and send the change out to followers.
+			msgs = append(msgs, messages.FromClientAPI{
+				APObjectType:   ap.ObjectNote,
+				APActivityType: ap.ActivityUpdate,
+				GTSModel:       status,
+				OriginAccount:  account,
+				TargetAccount:  report.TargetAccount,
+			})
+		}
+
+	case gtsmodel.AdminActionDeleteStatuses:
+		adminAction.StatusIDs = report.StatusIDs
+		for _, status := range report.Statuses {
+			// Pass the status delete through the
+			// client api channel for processing.
+			msgs = append(msgs, messages.FromClientAPI{
+				APObjectType:   ap.ObjectNote,
+				APActivityType: ap.ActivityDelete,
+				GTSModel:       status,
+				OriginAccount:  account,
+				TargetAccount:  report.TargetAccount,
+			})
+		}
+
+	case gtsmodel.AdminActionSilence:
+		report.TargetAccount.SilencedAt = time.Now()
+		if err := p.state.DB.UpdateAccount(ctx, report.TargetAccount, "silenced_at"); err != nil {
+			err := gtserror.Newf("db error silencing account %s: %w", report.TargetAccountID, err)
+			return nil, nil, gtserror.NewErrorInternalError(err)
+		}
+
+	case gtsmodel.AdminActionSuspend:
+		// Pass the account delete through the
+		// client api channel for processing.
+		msgs = append(msgs, messages.FromClientAPI{
+			APObjectType:   ap.ActorPerson,
+			APActivityType: ap.ActivityDelete,
+			OriginAccount:  account,
+			TargetAccount:  report.TargetAccount,
+		})
+	}
+
+	if err := p.state.DB.Put(ctx, adminAction); err != nil {
+		err := gtserror.Newf("db error storing admin action: %w", err)
+		return nil, nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return adminAction, msgs, nil
+}
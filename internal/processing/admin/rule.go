@@ -0,0 +1,145 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+// RulesGet returns all active instance rules, in order.
+func (p *Processor) RulesGet(ctx context.Context) ([]apimodel.InstanceRule, gtserror.WithCode) {
+	rules, err := p.state.DB.GetActiveRules(ctx)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.tc.RulesToAPIRules(rules), nil
+}
+
+// RuleGet returns one active instance rule, with the given ID.
+func (p *Processor) RuleGet(ctx context.Context, id string) (*apimodel.InstanceRule, gtserror.WithCode) {
+	rule, errWithCode := p.getActiveRule(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiRule := p.tc.RuleToAPIRule(rule)
+	return &apiRule, nil
+}
+
+// RuleCreate adds a new rule to the end of the list of instance rules.
+func (p *Processor) RuleCreate(ctx context.Context, form *apimodel.InstanceRuleCreateRequest) (*apimodel.InstanceRule, gtserror.WithCode) {
+	rules, err := p.state.DB.GetActiveRules(ctx)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	order := uint(len(rules))
+	rule := &gtsmodel.Rule{
+		ID:      id.NewULID(),
+		Text:    form.Text,
+		Order:   &order,
+		Deleted: new(bool),
+	}
+
+	if err := p.state.DB.PutRule(ctx, rule); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiRule := p.tc.RuleToAPIRule(rule)
+	return &apiRule, nil
+}
+
+// RuleUpdate updates the text of the instance rule with the given ID.
+func (p *Processor) RuleUpdate(ctx context.Context, id string, form *apimodel.InstanceRuleUpdateRequest) (*apimodel.InstanceRule, gtserror.WithCode) {
+	rule, errWithCode := p.getActiveRule(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	rule.Text = form.Text
+	if err := p.state.DB.UpdateRule(ctx, rule, "text"); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiRule := p.tc.RuleToAPIRule(rule)
+	return &apiRule, nil
+}
+
+// RuleDelete marks the instance rule with the given ID as deleted.
+// The rule is kept in the database so that it can still be shown
+// on historic reports, but it won't be shown to users anymore.
+func (p *Processor) RuleDelete(ctx context.Context, id string) (*apimodel.InstanceRule, gtserror.WithCode) {
+	rule, errWithCode := p.getActiveRule(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	deleted := true
+	rule.Deleted = &deleted
+	if err := p.state.DB.UpdateRule(ctx, rule, "deleted"); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Shift the order of remaining
+	// rules to close the gap.
+	rules, err := p.state.DB.GetActiveRules(ctx)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	for i, r := range rules {
+		if *r.Order == uint(i) {
+			continue
+		}
+
+		order := uint(i)
+		r.Order = &order
+		if err := p.state.DB.UpdateRule(ctx, r, "order"); err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	apiRule := p.tc.RuleToAPIRule(rule)
+	return &apiRule, nil
+}
+
+func (p *Processor) getActiveRule(ctx context.Context, id string) (*gtsmodel.Rule, gtserror.WithCode) {
+	rule, err := p.state.DB.GetRuleByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if *rule.Deleted {
+		err := fmt.Errorf("rule %s has been deleted", id)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return rule, nil
+}
@@ -0,0 +1,111 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+// SuggestionsGet returns the accounts pinned as follow
+// suggestions for users of this instance, newest first.
+func (p *Processor) SuggestionsGet(ctx context.Context) ([]*apimodel.Account, gtserror.WithCode) {
+	pinned, err := p.state.DB.GetPinnedSuggestions(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiAccounts := make([]*apimodel.Account, 0, len(pinned))
+	for _, pin := range pinned {
+		apiAccount, err := p.tc.AccountToAPIAccountPublic(ctx, pin.Account)
+		if err != nil {
+			err = fmt.Errorf("error converting account %s to api account: %w", pin.AccountID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		apiAccounts = append(apiAccounts, apiAccount)
+	}
+
+	return apiAccounts, nil
+}
+
+// SuggestionPin pins the account with the given ID as a follow suggestion
+// for users of this instance. Pinning an already pinned account is a no-op.
+func (p *Processor) SuggestionPin(ctx context.Context, accountID string) (*apimodel.Account, gtserror.WithCode) {
+	account, errWithCode := p.getSuggestionAccount(ctx, accountID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if !account.SuspendedAt.IsZero() {
+		err := fmt.Errorf("account %s is suspended", accountID)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if err := p.state.DB.PutPinnedSuggestion(ctx, &gtsmodel.PinnedSuggestion{
+		ID:        id.NewULID(),
+		AccountID: account.ID,
+		Account:   account,
+	}); err != nil && !errors.Is(err, db.ErrAlreadyExists) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiSuggestionAccount(ctx, account)
+}
+
+// SuggestionUnpin unpins the account with the given ID as a follow
+// suggestion. Unpinning an account which isn't pinned is a no-op.
+func (p *Processor) SuggestionUnpin(ctx context.Context, accountID string) (*apimodel.Account, gtserror.WithCode) {
+	account, errWithCode := p.getSuggestionAccount(ctx, accountID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeletePinnedSuggestionByAccountID(ctx, account.ID); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiSuggestionAccount(ctx, account)
+}
+
+func (p *Processor) getSuggestionAccount(ctx context.Context, accountID string) (*gtsmodel.Account, gtserror.WithCode) {
+	account, err := p.state.DB.GetAccountByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("account %s not found", accountID)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	return account, nil
+}
+
+func (p *Processor) apiSuggestionAccount(ctx context.Context, account *gtsmodel.Account) (*apimodel.Account, gtserror.WithCode) {
+	apiAccount, err := p.tc.AccountToAPIAccountPublic(ctx, account)
+	if err != nil {
+		err = fmt.Errorf("error converting account %s to api account: %w", account.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	return apiAccount, nil
+}
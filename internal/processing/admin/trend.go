@@ -0,0 +1,103 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// TrendsGet returns currently trending items of the given type, most trending
+// first, for admin review. If state is set, only trends in that state are returned.
+func (p *Processor) TrendsGet(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	trendType gtsmodel.TrendType,
+	state gtsmodel.TrendState,
+	limit int,
+	offset int,
+) ([]*apimodel.AdminTrend, gtserror.WithCode) {
+	trends, err := p.state.DB.GetTrends(ctx, trendType, state, limit, offset)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiTrends := make([]*apimodel.AdminTrend, 0, len(trends))
+	for _, t := range trends {
+		apiTrend, err := p.tc.TrendToAdminAPITrend(ctx, t, account)
+		if err != nil {
+			// Target may have been deleted since
+			// trends were last scored; just skip it.
+			log.Errorf(ctx, "error converting trend %s to api: %v", t.ID, err)
+			continue
+		}
+		apiTrends = append(apiTrends, apiTrend)
+	}
+
+	return apiTrends, nil
+}
+
+// TrendApprove approves the trend of the given type with the given
+// ID, so that the trending item is shown to users while it is trending.
+func (p *Processor) TrendApprove(ctx context.Context, account *gtsmodel.Account, trendType gtsmodel.TrendType, id string) (*apimodel.AdminTrend, gtserror.WithCode) {
+	return p.trendReview(ctx, account, trendType, id, gtsmodel.TrendStateApproved)
+}
+
+// TrendReject rejects the trend of the given type with the given
+// ID, so that the trending item is never shown to users.
+func (p *Processor) TrendReject(ctx context.Context, account *gtsmodel.Account, trendType gtsmodel.TrendType, id string) (*apimodel.AdminTrend, gtserror.WithCode) {
+	return p.trendReview(ctx, account, trendType, id, gtsmodel.TrendStateRejected)
+}
+
+func (p *Processor) trendReview(ctx context.Context, account *gtsmodel.Account, trendType gtsmodel.TrendType, id string, state gtsmodel.TrendState) (*apimodel.AdminTrend, gtserror.WithCode) {
+	trend, err := p.state.DB.GetTrendByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if trend.Type != trendType {
+		err := fmt.Errorf("trend %s is not of type %s", id, trendType)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	trend.State = state
+	trend.ReviewedAt = time.Now()
+
+	if err := p.state.DB.UpdateTrend(ctx, trend, "state", "reviewed_at"); err != nil {
+		err = fmt.Errorf("error updating trend %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiTrend, err := p.tc.TrendToAdminAPITrend(ctx, trend, account)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiTrend, nil
+}
@@ -0,0 +1,46 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package announcements
+
+import (
+	"context"
+	"errors"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+// Dismiss marks the announcement with the given ID as read by the given account.
+func (p *Processor) Dismiss(ctx context.Context, account *gtsmodel.Account, announcementID string) gtserror.WithCode {
+	announcement, errWithCode := p.getActiveAnnouncement(ctx, announcementID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.state.DB.PutAnnouncementRead(ctx, &gtsmodel.AnnouncementRead{
+		ID:             id.NewULID(),
+		AccountID:      account.ID,
+		AnnouncementID: announcement.ID,
+	}); err != nil && !errors.Is(err, db.ErrAlreadyExists) {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package announcements
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/regexes"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// AddReaction adds a reaction with the given name by the given account
+// to the announcement with the given ID. Name should be either a unicode
+// emoji, or the shortcode of an enabled custom emoji of this instance.
+// Adding a reaction that already exists is a no-op.
+func (p *Processor) AddReaction(ctx context.Context, account *gtsmodel.Account, announcementID string, name string) gtserror.WithCode {
+	announcement, errWithCode := p.getActiveAnnouncement(ctx, announcementID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	emoji, errWithCode := p.getReactionEmoji(ctx, name)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	reaction := &gtsmodel.AnnouncementReaction{
+		ID:             id.NewULID(),
+		AccountID:      account.ID,
+		AnnouncementID: announcement.ID,
+		Announcement:   announcement,
+		Name:           name,
+	}
+
+	if emoji != nil {
+		reaction.EmojiID = emoji.ID
+		reaction.Emoji = emoji
+	}
+
+	if err := p.state.DB.PutAnnouncementReaction(ctx, reaction); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			// Already reacted, nothing to do.
+			return nil
+		}
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	p.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   messages.ObjectAnnouncementReaction,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       reaction,
+		OriginAccount:  account,
+	})
+
+	return nil
+}
+
+// RemoveReaction removes the reaction with the given name by the given
+// account from the announcement with the given ID, if it exists.
+func (p *Processor) RemoveReaction(ctx context.Context, account *gtsmodel.Account, announcementID string, name string) gtserror.WithCode {
+	announcement, errWithCode := p.getActiveAnnouncement(ctx, announcementID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	reaction, err := p.state.DB.GetAnnouncementReaction(ctx, announcement.ID, account.ID, name)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Never reacted, nothing to do.
+			return nil
+		}
+		return gtserror.NewErrorInternalError(err)
+	}
+	reaction.Announcement = announcement
+
+	if err := p.state.DB.DeleteAnnouncementReactionByID(ctx, reaction.ID); err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	p.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   messages.ObjectAnnouncementReaction,
+		APActivityType: ap.ActivityUndo,
+		GTSModel:       reaction,
+		OriginAccount:  account,
+	})
+
+	return nil
+}
+
+// getReactionEmoji checks that the given reaction name is either
+// a unicode emoji, in which case it returns nil, or the shortcode
+// of an enabled local custom emoji, in which case it returns that.
+func (p *Processor) getReactionEmoji(ctx context.Context, name string) (*gtsmodel.Emoji, gtserror.WithCode) {
+	if regexes.EmojiShortcode.FindString(name) != name {
+		// Not a shortcode,
+		// must be unicode.
+		if err := validate.UnicodeEmoji(name); err != nil {
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		return nil, nil
+	}
+
+	emoji, err := p.state.DB.GetEmojiByShortcodeDomain(ctx, name, "")
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if emoji == nil || *emoji.Disabled {
+		err := fmt.Errorf("custom emoji %s not found", name)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	return emoji, nil
+}
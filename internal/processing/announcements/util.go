@@ -0,0 +1,63 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package announcements
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// getActiveAnnouncement is a shortcut to get one announcement
+// from the database and check that it's currently active. Will
+// return appropriate errors so caller doesn't need to bother.
+func (p *Processor) getActiveAnnouncement(ctx context.Context, id string) (*gtsmodel.Announcement, gtserror.WithCode) {
+	announcement, err := p.state.DB.GetAnnouncementByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Announcement doesn't seem to exist.
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		// Real database error.
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if !announcement.IsActive(time.Now()) {
+		err = fmt.Errorf("announcement %s is not active", id)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return announcement, nil
+}
+
+// apiAnnouncement is a shortcut to return the API version of the given
+// announcement, or return an appropriate error if conversion fails.
+func (p *Processor) apiAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement, account *gtsmodel.Account) (*apimodel.Announcement, gtserror.WithCode) {
+	apiAnnouncement, err := p.tc.AnnouncementToAPIAnnouncement(ctx, announcement, account)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting announcement to api: %w", err))
+	}
+
+	return apiAnnouncement, nil
+}
@@ -19,13 +19,17 @@ package processing
 
 import (
 	"context"
+	"errors"
+	"sort"
 
 	"github.com/google/uuid"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 func (p *Processor) AppCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.ApplicationCreateRequest) (*apimodel.Application, gtserror.WithCode) {
@@ -37,6 +41,11 @@ func (p *Processor) AppCreate(ctx context.Context, authed *oauth.Auth, form *api
 		scopes = form.Scopes
 	}
 
+	// make sure we know how to handle all requested scopes
+	if _, err := oauth.ParseScopes(scopes); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
 	// generate new IDs for this application and its associated client
 	clientID, err := id.NewRandomULID()
 	if err != nil {
@@ -86,3 +95,132 @@ func (p *Processor) AppCreate(ctx context.Context, authed *oauth.Auth, form *api
 
 	return apiApp, nil
 }
+
+// AppsAuthorizedGet returns the applications which currently hold
+// access tokens for the requesting user, oldest authorization first.
+func (p *Processor) AppsAuthorizedGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.AuthorizedApplication, gtserror.WithCode) {
+	tokens := []*gtsmodel.Token{}
+	if err := p.state.DB.GetWhere(ctx, []db.Where{{Key: "user_id", Value: authed.User.ID}}, &tokens); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting tokens: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].CreatedAt.Before(tokens[j].CreatedAt)
+	})
+
+	// Collate tokens by the client they were issued to.
+	var (
+		clientIDs      []string
+		tokensByClient = make(map[string][]*gtsmodel.Token)
+	)
+	for _, t := range tokens {
+		if t.Access == "" {
+			// Authorization code that hasn't
+			// been swapped for a token yet.
+			continue
+		}
+		if _, ok := tokensByClient[t.ClientID]; !ok {
+			clientIDs = append(clientIDs, t.ClientID)
+		}
+		tokensByClient[t.ClientID] = append(tokensByClient[t.ClientID], t)
+	}
+
+	apiApps := make([]*apimodel.AuthorizedApplication, 0, len(clientIDs))
+	for _, clientID := range clientIDs {
+		app := &gtsmodel.Application{}
+		if err := p.state.DB.GetWhere(ctx, []db.Where{{Key: "client_id", Value: clientID}}, app); err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				// App was deleted out from under its tokens.
+				continue
+			}
+			err := gtserror.Newf("db error getting application for client %s: %w", clientID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		var (
+			scopes     oauth.Scopes
+			lastUsedAt *string
+			lastUsed   = tokensByClient[clientID][0].LastUsedAt
+		)
+		for _, t := range tokensByClient[clientID] {
+			tokenScopes := oauth.ParseStoredScopes(t.Scope)
+			for _, scope := range tokenScopes {
+				if !scopes.Permits(scope) {
+					scopes = append(scopes, scope)
+				}
+			}
+			if t.LastUsedAt.After(lastUsed) {
+				lastUsed = t.LastUsedAt
+			}
+		}
+		if !lastUsed.IsZero() {
+			lastUsedAtStr := util.FormatISO8601(lastUsed)
+			lastUsedAt = &lastUsedAtStr
+		}
+
+		scopeStrs := make([]string, 0, len(scopes))
+		for _, scope := range scopes {
+			scopeStrs = append(scopeStrs, string(scope))
+		}
+
+		apiApps = append(apiApps, &apimodel.AuthorizedApplication{
+			ID:         app.ID,
+			Name:       app.Name,
+			Website:    app.Website,
+			Scopes:     scopeStrs,
+			CreatedAt:  util.FormatISO8601(tokensByClient[clientID][0].CreatedAt),
+			LastUsedAt: lastUsedAt,
+		})
+	}
+
+	return apiApps, nil
+}
+
+// AppAuthorizedRevoke revokes the authorization of the given application
+// to act on behalf of the requesting user, by deleting all of the tokens
+// which were issued to that application for the user.
+func (p *Processor) AppAuthorizedRevoke(ctx context.Context, authed *oauth.Auth, appID string) gtserror.WithCode {
+	app := &gtsmodel.Application{}
+	if err := p.state.DB.GetByID(ctx, appID, app); err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("application %s not found", appID)
+			return gtserror.NewErrorNotFound(err)
+		}
+		err := gtserror.Newf("db error getting application: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	where := []db.Where{
+		{Key: "user_id", Value: authed.User.ID},
+		{Key: "client_id", Value: app.ClientID},
+	}
+
+	tokens := []*gtsmodel.Token{}
+	if err := p.state.DB.GetWhere(ctx, where, &tokens); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting tokens: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if len(tokens) == 0 {
+		// Don't leak the existence of apps
+		// not authorized by this user.
+		err := gtserror.Newf("application %s not authorized by user %s", appID, authed.User.ID)
+		return gtserror.NewErrorNotFound(err)
+	}
+
+	if err := p.state.DB.DeleteWhere(ctx, where, &[]*gtsmodel.Token{}); err != nil {
+		err := gtserror.Newf("db error deleting tokens: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	// Drop web push subscriptions made with those tokens.
+	for _, token := range tokens {
+		if err := p.state.DB.DeleteWebPushSubscriptionByTokenID(ctx, token.ID); err != nil {
+			err := gtserror.Newf("db error deleting web push subscription: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	return nil
+}
@@ -22,11 +22,13 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"net/url"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 )
 
 // InboxPost handles POST requests to a user's inbox for new activitypub messages.
@@ -83,11 +85,20 @@ func (p *Processor) OutboxGet(ctx context.Context, requestedUsername string, pag
 
 	// scenario 2 -- get the requested page
 	// limit pages to 30 entries per page
-	publicStatuses, err := p.state.DB.GetAccountStatuses(ctx, requestedAccount.ID, 30, true, true, maxID, minID, false, true)
+	statuses, err := p.state.DB.GetAccountStatuses(ctx, requestedAccount.ID, 30, true, true, maxID, minID, false, true)
 	if err != nil && !errors.Is(err, db.ErrNoEntries) {
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	// Local-only statuses are
+	// never served to remotes.
+	publicStatuses := make([]*gtsmodel.Status, 0, len(statuses))
+	for _, status := range statuses {
+		if !status.IsLocalOnly() {
+			publicStatuses = append(publicStatuses, status)
+		}
+	}
+
 	outboxPage, err := p.tc.StatusesToASOutboxPage(ctx, requestedAccount.OutboxURI, maxID, minID, publicStatuses)
 	if err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
@@ -102,49 +113,143 @@ func (p *Processor) OutboxGet(ctx context.Context, requestedUsername string, pag
 
 // FollowersGet handles the getting of a fedi/activitypub representation of a user/account's followers, performing appropriate
 // authentication before returning a JSON serializable interface to the caller.
-func (p *Processor) FollowersGet(ctx context.Context, requestedUsername string) (interface{}, gtserror.WithCode) {
+//
+// If page is false, an OrderedCollection without items is returned, linking to the first page of the collection.
+// If page is true, an OrderedCollectionPage is returned, containing followers older than the given maxID (if set).
+func (p *Processor) FollowersGet(ctx context.Context, requestedUsername string, page bool, maxID string) (interface{}, gtserror.WithCode) {
 	requestedAccount, _, errWithCode := p.authenticate(ctx, requestedUsername)
 	if errWithCode != nil {
 		return nil, errWithCode
 	}
 
-	requestedAccountURI, err := url.Parse(requestedAccount.URI)
-	if err != nil {
-		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error parsing url %s: %s", requestedAccount.URI, err))
+	return p.followCollectionGet(ctx, requestedAccount, true, page, maxID)
+}
+
+// FollowingGet handles the getting of a fedi/activitypub representation of a user/account's following, performing appropriate
+// authentication before returning a JSON serializable interface to the caller.
+//
+// If page is false, an OrderedCollection without items is returned, linking to the first page of the collection.
+// If page is true, an OrderedCollectionPage is returned, containing follows older than the given maxID (if set).
+func (p *Processor) FollowingGet(ctx context.Context, requestedUsername string, page bool, maxID string) (interface{}, gtserror.WithCode) {
+	requestedAccount, _, errWithCode := p.authenticate(ctx, requestedUsername)
+	if errWithCode != nil {
+		return nil, errWithCode
 	}
 
-	requestedFollowers, err := p.federator.FederatingDB().Followers(ctx, requestedAccountURI)
-	if err != nil {
-		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error fetching followers for uri %s: %s", requestedAccountURI.String(), err))
+	return p.followCollectionGet(ctx, requestedAccount, false, page, maxID)
+}
+
+// followCollectionGet contains the common logic for serving the
+// followers (or following, if followers is false) collection of
+// the given account, using follow IDs as paging cursors.
+//
+// The total count of items in the collection is always given,
+// but if the account hides its collections, only the count
+// is exposed: no link to the first page, and no pages.
+func (p *Processor) followCollectionGet(
+	ctx context.Context,
+	requestedAccount *gtsmodel.Account,
+	followers bool,
+	page bool,
+	maxID string,
+) (interface{}, gtserror.WithCode) {
+	var (
+		collectionID string
+		totalItems   int
+		err          error
+	)
+
+	if followers {
+		collectionID = requestedAccount.FollowersURI
+		totalItems, err = p.state.DB.CountAccountFollowers(ctx, requestedAccount.ID)
+	} else {
+		collectionID = requestedAccount.FollowingURI
+		totalItems, err = p.state.DB.CountAccountFollows(ctx, requestedAccount.ID)
 	}
 
-	data, err := ap.Serialize(requestedFollowers)
-	if err != nil {
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("error counting items in %s: %w", collectionID, err)
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
-	return data, nil
-}
+	hidden := requestedAccount.HideCollections != nil && *requestedAccount.HideCollections
 
-// FollowingGet handles the getting of a fedi/activitypub representation of a user/account's following, performing appropriate
-// authentication before returning a JSON serializable interface to the caller.
-func (p *Processor) FollowingGet(ctx context.Context, requestedUsername string) (interface{}, gtserror.WithCode) {
-	requestedAccount, _, errWithCode := p.authenticate(ctx, requestedUsername)
-	if errWithCode != nil {
-		return nil, errWithCode
+	var data map[string]interface{}
+	// There are two scenarios:
+	// 1. we're asked for the whole collection and not a page -- we can just return the collection, with no items, but a link to 'first' page.
+	// 2. we're asked for a specific page; this can be either the first page or any other page
+
+	if !page {
+		// scenario 1: return the collection with no items
+		collection, err := p.tc.AccountsToASCollection(ctx, collectionID, totalItems, hidden)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		data, err = ap.Serialize(collection)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		return data, nil
 	}
 
-	requestedAccountURI, err := url.Parse(requestedAccount.URI)
-	if err != nil {
-		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error parsing url %s: %s", requestedAccount.URI, err))
+	if hidden {
+		err := fmt.Errorf("collection %s is hidden", collectionID)
+		return nil, gtserror.NewErrorForbidden(err)
+	}
+
+	// scenario 2 -- get the requested page
+	// limit pages to 40 entries per page,
+	// fetching one extra entry to check
+	// whether there's a next page or not.
+	const limit = 40
+	pager := &paging.Pager{
+		MaxID: maxID,
+		Limit: limit + 1,
 	}
 
-	requestedFollowing, err := p.federator.FederatingDB().Following(ctx, requestedAccountURI)
+	var follows []*gtsmodel.Follow
+	if followers {
+		follows, err = p.state.DB.GetAccountFollowers(ctx, requestedAccount.ID, pager)
+	} else {
+		follows, err = p.state.DB.GetAccountFollows(ctx, requestedAccount.ID, pager)
+	}
+
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("error getting items in %s: %w", collectionID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	var nextMaxID string
+	if len(follows) > limit {
+		follows = follows[:limit]
+		nextMaxID = follows[limit-1].ID
+	}
+
+	accounts := make([]*gtsmodel.Account, 0, len(follows))
+	for _, follow := range follows {
+		account := follow.TargetAccount
+		if followers {
+			account = follow.Account
+		}
+
+		if account == nil {
+			// Follow account no longer exists,
+			// for some reason. Skip this one.
+			log.WithContext(ctx).WithField("follow", follow).Warn("follow missing account")
+			continue
+		}
+
+		accounts = append(accounts, account)
+	}
+
+	collectionPage, err := p.tc.AccountsToASCollectionPage(ctx, collectionID, maxID, nextMaxID, totalItems, accounts)
 	if err != nil {
-		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error fetching following for uri %s: %s", requestedAccountURI.String(), err))
+		return nil, gtserror.NewErrorInternalError(err)
 	}
 
-	data, err := ap.Serialize(requestedFollowing)
+	data, err = ap.Serialize(collectionPage)
 	if err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
 	}
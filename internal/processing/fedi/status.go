@@ -46,6 +46,11 @@ func (p *Processor) StatusGet(ctx context.Context, requestedUsername string, req
 		return nil, gtserror.NewErrorNotFound(err)
 	}
 
+	if status.IsLocalOnly() {
+		err := fmt.Errorf("status with id %s is local-only", status.ID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
 	visible, err := p.filter.StatusVisible(ctx, requestingAccount, status)
 	if err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
@@ -86,6 +91,10 @@ func (p *Processor) StatusRepliesGet(ctx context.Context, requestedUsername stri
 		return nil, gtserror.NewErrorNotFound(fmt.Errorf("status with id %s does not belong to account with id %s", status.ID, requestedAccount.ID))
 	}
 
+	if status.IsLocalOnly() {
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("status with id %s is local-only", status.ID))
+	}
+
 	visible, err := p.filter.StatusVisible(ctx, requestedAccount, status)
 	if err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
@@ -69,12 +69,27 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, clientMsg messages
 		case ap.ActivityLike:
 			// CREATE LIKE/FAVE
 			return p.processCreateFaveFromClientAPI(ctx, clientMsg)
+		case ap.ActivityEmojiReact:
+			// CREATE EMOJI REACTION
+			return p.processCreateReactionFromClientAPI(ctx, clientMsg)
 		case ap.ActivityAnnounce:
 			// CREATE BOOST/ANNOUNCE
 			return p.processCreateAnnounceFromClientAPI(ctx, clientMsg)
 		case ap.ActivityBlock:
 			// CREATE BLOCK
 			return p.processCreateBlockFromClientAPI(ctx, clientMsg)
+		case messages.ObjectModerationWarning:
+			// CREATE MODERATION WARNING
+			return p.processWarnAccountFromClientAPI(ctx, clientMsg)
+		case messages.ObjectDomainBlock:
+			// CREATE DOMAIN BLOCK
+			return p.processCreateDomainBlockFromClientAPI(ctx, clientMsg)
+		case messages.ObjectAnnouncement:
+			// CREATE ANNOUNCEMENT
+			return p.processCreateAnnouncementFromClientAPI(ctx, clientMsg)
+		case messages.ObjectAnnouncementReaction:
+			// CREATE ANNOUNCEMENT REACTION
+			return p.processCreateAnnouncementReactionFromClientAPI(ctx, clientMsg)
 		}
 	case ap.ActivityUpdate:
 		// UPDATE
@@ -82,9 +97,15 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, clientMsg messages
 		case ap.ObjectProfile, ap.ActorPerson:
 			// UPDATE ACCOUNT/PROFILE
 			return p.processUpdateAccountFromClientAPI(ctx, clientMsg)
+		case ap.ObjectNote:
+			// UPDATE STATUS/NOTE
+			return p.processUpdateStatusFromClientAPI(ctx, clientMsg)
 		case ap.ActivityFlag:
 			// UPDATE A FLAG/REPORT (mark as resolved/closed)
 			return p.processUpdateReportFromClientAPI(ctx, clientMsg)
+		case messages.ObjectAnnouncement:
+			// UPDATE ANNOUNCEMENT
+			return p.processUpdateAnnouncementFromClientAPI(ctx, clientMsg)
 		}
 	case ap.ActivityAccept:
 		// ACCEPT
@@ -110,9 +131,18 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, clientMsg messages
 		case ap.ActivityLike:
 			// UNDO LIKE/FAVE
 			return p.processUndoFaveFromClientAPI(ctx, clientMsg)
+		case ap.ActivityEmojiReact:
+			// UNDO EMOJI REACTION
+			return p.processUndoReactionFromClientAPI(ctx, clientMsg)
 		case ap.ActivityAnnounce:
 			// UNDO ANNOUNCE/BOOST
 			return p.processUndoAnnounceFromClientAPI(ctx, clientMsg)
+		case messages.ObjectDomainBlock:
+			// UNDO DOMAIN BLOCK
+			return p.processUndoDomainBlockFromClientAPI(ctx, clientMsg)
+		case messages.ObjectAnnouncementReaction:
+			// UNDO ANNOUNCEMENT REACTION
+			return p.processUndoAnnouncementReactionFromClientAPI(ctx, clientMsg)
 		}
 	case ap.ActivityDelete:
 		// DELETE
@@ -123,6 +153,9 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, clientMsg messages
 		case ap.ObjectProfile, ap.ActorPerson:
 			// DELETE ACCOUNT/PROFILE
 			return p.processDeleteAccountFromClientAPI(ctx, clientMsg)
+		case messages.ObjectAnnouncement:
+			// DELETE ANNOUNCEMENT
+			return p.processDeleteAnnouncementFromClientAPI(ctx, clientMsg)
 		}
 	case ap.ActivityFlag:
 		// FLAG
@@ -165,6 +198,9 @@ func (p *Processor) processCreateStatusFromClientAPI(ctx context.Context, client
 		return gtserror.Newf("error timelining status: %w", err)
 	}
 
+	// Fetch link preview card, if any, in the background.
+	p.federator.FetchStatusCardAsync(ctx, status)
+
 	if status.InReplyToID != "" {
 		// Interaction counts changed on the replied status;
 		// uncache the prepared version from all timelines.
@@ -212,6 +248,27 @@ func (p *Processor) processCreateFaveFromClientAPI(ctx context.Context, clientMs
 	return nil
 }
 
+func (p *Processor) processCreateReactionFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	reaction, ok := clientMsg.GTSModel.(*gtsmodel.StatusReaction)
+	if !ok {
+		return gtserror.New("reaction was not parseable as *gtsmodel.StatusReaction")
+	}
+
+	if err := p.notifyReaction(ctx, reaction); err != nil {
+		return gtserror.Newf("error notifying status reaction: %w", err)
+	}
+
+	// Reactions changed on the status;
+	// uncache the prepared version from all timelines.
+	p.invalidateStatusFromTimelines(ctx, reaction.StatusID)
+
+	if err := p.federateReaction(ctx, reaction, clientMsg.OriginAccount, clientMsg.TargetAccount); err != nil {
+		return gtserror.Newf("error federating status reaction: %w", err)
+	}
+
+	return nil
+}
+
 func (p *Processor) processCreateAnnounceFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
 	status, ok := clientMsg.GTSModel.(*gtsmodel.Status)
 	if !ok {
@@ -258,6 +315,35 @@ func (p *Processor) processCreateBlockFromClientAPI(ctx context.Context, clientM
 	return p.federateBlock(ctx, block)
 }
 
+func (p *Processor) processCreateDomainBlockFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	block, ok := clientMsg.GTSModel.(*gtsmodel.AccountDomainBlock)
+	if !ok {
+		return errors.New("domain block was not parseable as *gtsmodel.AccountDomainBlock")
+	}
+
+	// Drop the blocking account's home timeline so that it's
+	// re-indexed without any statuses from the blocked domain.
+	return p.state.Timelines.Home.RemoveTimeline(ctx, block.AccountID)
+}
+
+func (p *Processor) processCreateAnnouncementFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	announcement, ok := clientMsg.GTSModel.(*gtsmodel.Announcement)
+	if !ok {
+		return errors.New("announcement was not parseable as *gtsmodel.Announcement")
+	}
+
+	return p.streamAnnouncement(ctx, announcement)
+}
+
+func (p *Processor) processCreateAnnouncementReactionFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	reaction, ok := clientMsg.GTSModel.(*gtsmodel.AnnouncementReaction)
+	if !ok {
+		return errors.New("reaction was not parseable as *gtsmodel.AnnouncementReaction")
+	}
+
+	return p.streamAnnouncementReaction(ctx, reaction)
+}
+
 func (p *Processor) processUpdateAccountFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
 	account, ok := clientMsg.GTSModel.(*gtsmodel.Account)
 	if !ok {
@@ -267,6 +353,19 @@ func (p *Processor) processUpdateAccountFromClientAPI(ctx context.Context, clien
 	return p.federateAccountUpdate(ctx, account, clientMsg.OriginAccount)
 }
 
+func (p *Processor) processUpdateStatusFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	status, ok := clientMsg.GTSModel.(*gtsmodel.Status)
+	if !ok {
+		return gtserror.New("status was not parseable as *gtsmodel.Status")
+	}
+
+	// Status has changed; uncache the
+	// prepared version from all timelines.
+	p.invalidateStatusFromTimelines(ctx, status.ID)
+
+	return p.federateStatusUpdate(ctx, status)
+}
+
 func (p *Processor) processUpdateReportFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
 	report, ok := clientMsg.GTSModel.(*gtsmodel.Report)
 	if !ok {
@@ -282,6 +381,27 @@ func (p *Processor) processUpdateReportFromClientAPI(ctx context.Context, client
 	return p.emailReportClosed(ctx, report)
 }
 
+func (p *Processor) processUpdateAnnouncementFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	announcement, ok := clientMsg.GTSModel.(*gtsmodel.Announcement)
+	if !ok {
+		return errors.New("announcement was not parseable as *gtsmodel.Announcement")
+	}
+
+	// Streams the updated announcement if it's still
+	// active, or its removal if it's been unpublished
+	// or its display window has been changed.
+	return p.streamAnnouncement(ctx, announcement)
+}
+
+func (p *Processor) processWarnAccountFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	adminAction, ok := clientMsg.GTSModel.(*gtsmodel.AdminAccountAction)
+	if !ok {
+		return errors.New("admin action was not parseable as *gtsmodel.AdminAccountAction")
+	}
+
+	return p.notifyModerationWarning(ctx, adminAction)
+}
+
 func (p *Processor) processAcceptFollowFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
 	follow, ok := clientMsg.GTSModel.(*gtsmodel.Follow)
 	if !ok {
@@ -320,6 +440,26 @@ func (p *Processor) processUndoBlockFromClientAPI(ctx context.Context, clientMsg
 	return p.federateUnblock(ctx, block)
 }
 
+func (p *Processor) processUndoDomainBlockFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	block, ok := clientMsg.GTSModel.(*gtsmodel.AccountDomainBlock)
+	if !ok {
+		return errors.New("undo was not parseable as *gtsmodel.AccountDomainBlock")
+	}
+
+	// Drop the unblocking account's home timeline so that it's
+	// re-indexed including statuses from the unblocked domain.
+	return p.state.Timelines.Home.RemoveTimeline(ctx, block.AccountID)
+}
+
+func (p *Processor) processUndoAnnouncementReactionFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	reaction, ok := clientMsg.GTSModel.(*gtsmodel.AnnouncementReaction)
+	if !ok {
+		return errors.New("undo was not parseable as *gtsmodel.AnnouncementReaction")
+	}
+
+	return p.streamAnnouncementReaction(ctx, reaction)
+}
+
 func (p *Processor) processUndoFaveFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
 	statusFave, ok := clientMsg.GTSModel.(*gtsmodel.StatusFave)
 	if !ok {
@@ -337,6 +477,23 @@ func (p *Processor) processUndoFaveFromClientAPI(ctx context.Context, clientMsg
 	return nil
 }
 
+func (p *Processor) processUndoReactionFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	reaction, ok := clientMsg.GTSModel.(*gtsmodel.StatusReaction)
+	if !ok {
+		return gtserror.New("reaction was not parseable as *gtsmodel.StatusReaction")
+	}
+
+	// Reactions changed on the status;
+	// uncache the prepared version from all timelines.
+	p.invalidateStatusFromTimelines(ctx, reaction.StatusID)
+
+	if err := p.federateUnreact(ctx, reaction, clientMsg.OriginAccount, clientMsg.TargetAccount); err != nil {
+		return gtserror.Newf("error federating status reaction undo: %w", err)
+	}
+
+	return nil
+}
+
 func (p *Processor) processUndoAnnounceFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
 	status, ok := clientMsg.GTSModel.(*gtsmodel.Status)
 	if !ok {
@@ -411,6 +568,15 @@ func (p *Processor) processDeleteAccountFromClientAPI(ctx context.Context, clien
 	return p.account.Delete(ctx, clientMsg.TargetAccount, origin)
 }
 
+func (p *Processor) processDeleteAnnouncementFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	announcement, ok := clientMsg.GTSModel.(*gtsmodel.Announcement)
+	if !ok {
+		return errors.New("announcement was not parseable as *gtsmodel.Announcement")
+	}
+
+	return p.stream.AnnouncementDelete(announcement.ID)
+}
+
 func (p *Processor) processReportAccountFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
 	report, ok := clientMsg.GTSModel.(*gtsmodel.Report)
 	if !ok {
@@ -424,6 +590,10 @@ func (p *Processor) processReportAccountFromClientAPI(ctx context.Context, clien
 	}
 
 	if err := p.emailReport(ctx, report); err != nil {
+		return fmt.Errorf("processReportAccountFromClientAPI: error emailing report: %w", err)
+	}
+
+	if err := p.notifyReport(ctx, report); err != nil {
 		return fmt.Errorf("processReportAccountFromClientAPI: error notifying report: %w", err)
 	}
 
@@ -523,7 +693,50 @@ func (p *Processor) federateStatus(ctx context.Context, status *gtsmodel.Status)
 	return err
 }
 
+func (p *Processor) federateStatusUpdate(ctx context.Context, status *gtsmodel.Status) error {
+	// do nothing if the status shouldn't be federated
+	if !*status.Federated {
+		return nil
+	}
+
+	if status.Account == nil {
+		statusAccount, err := p.state.DB.GetAccountByID(ctx, status.AccountID)
+		if err != nil {
+			return fmt.Errorf("federateStatusUpdate: error fetching status author account: %s", err)
+		}
+		status.Account = statusAccount
+	}
+
+	// Do nothing if this isn't our activity.
+	if !status.Account.IsLocal() {
+		return nil
+	}
+
+	asStatus, err := p.tc.StatusToAS(ctx, status)
+	if err != nil {
+		return fmt.Errorf("federateStatusUpdate: error converting status to as format: %s", err)
+	}
+
+	update, err := p.tc.WrapNoteInUpdate(asStatus, status.Account)
+	if err != nil {
+		return fmt.Errorf("federateStatusUpdate: error wrapping status in update: %s", err)
+	}
+
+	outboxIRI, err := url.Parse(status.Account.OutboxURI)
+	if err != nil {
+		return fmt.Errorf("federateStatusUpdate: error parsing outboxURI %s: %s", status.Account.OutboxURI, err)
+	}
+
+	_, err = p.federator.FederatingActor().Send(ctx, outboxIRI, update)
+	return err
+}
+
 func (p *Processor) federateStatusDelete(ctx context.Context, status *gtsmodel.Status) error {
+	// do nothing if the status was never federated
+	if status.IsLocalOnly() {
+		return nil
+	}
+
 	if status.Account == nil {
 		statusAccount, err := p.state.DB.GetAccountByID(ctx, status.AccountID)
 		if err != nil {
@@ -653,12 +866,56 @@ func (p *Processor) federateUnfave(ctx context.Context, fave *gtsmodel.StatusFav
 	return err
 }
 
+func (p *Processor) federateUnreact(ctx context.Context, reaction *gtsmodel.StatusReaction, originAccount *gtsmodel.Account, targetAccount *gtsmodel.Account) error {
+	// Do nothing if both accounts are local.
+	if originAccount.IsLocal() && targetAccount.IsLocal() {
+		return nil
+	}
+
+	// create the AS reaction
+	asReaction, err := p.tc.StatusReactionToAS(ctx, reaction)
+	if err != nil {
+		return fmt.Errorf("federateUnreact: error converting reaction to as format: %s", err)
+	}
+
+	targetAccountURI, err := url.Parse(targetAccount.URI)
+	if err != nil {
+		return fmt.Errorf("error parsing uri %s: %s", targetAccount.URI, err)
+	}
+
+	// create an Undo and set the appropriate actor on it
+	undo := streams.NewActivityStreamsUndo()
+	undo.SetActivityStreamsActor(asReaction.GetActivityStreamsActor())
+
+	// Set the reaction as the 'object' property.
+	undoObject := streams.NewActivityStreamsObjectProperty()
+	undoObject.AppendActivityStreamsLike(asReaction)
+	undo.SetActivityStreamsObject(undoObject)
+
+	// Set the To of the undo as the target of the reaction
+	undoTo := streams.NewActivityStreamsToProperty()
+	undoTo.AppendIRI(targetAccountURI)
+	undo.SetActivityStreamsTo(undoTo)
+
+	outboxIRI, err := url.Parse(originAccount.OutboxURI)
+	if err != nil {
+		return fmt.Errorf("federateUnreact: error parsing outboxURI %s: %s", originAccount.OutboxURI, err)
+	}
+	_, err = p.federator.FederatingActor().Send(ctx, outboxIRI, undo)
+	return err
+}
+
 func (p *Processor) federateUnannounce(ctx context.Context, boost *gtsmodel.Status, originAccount *gtsmodel.Account, targetAccount *gtsmodel.Account) error {
 	// Do nothing if this isn't our activity.
 	if !originAccount.IsLocal() {
 		return nil
 	}
 
+	// Do nothing if the boost was never federated.
+	if boost.IsLocalOnly() {
+		return nil
+	}
+
 	asAnnounce, err := p.tc.BoostToAS(ctx, boost, originAccount, targetAccount)
 	if err != nil {
 		return fmt.Errorf("federateUnannounce: error converting status to announce: %s", err)
@@ -847,7 +1104,32 @@ func (p *Processor) federateFave(ctx context.Context, fave *gtsmodel.StatusFave,
 	return err
 }
 
+func (p *Processor) federateReaction(ctx context.Context, reaction *gtsmodel.StatusReaction, originAccount *gtsmodel.Account, targetAccount *gtsmodel.Account) error {
+	// Do nothing if both accounts are local.
+	if originAccount.IsLocal() && targetAccount.IsLocal() {
+		return nil
+	}
+
+	// create the AS reaction
+	asReaction, err := p.tc.StatusReactionToAS(ctx, reaction)
+	if err != nil {
+		return fmt.Errorf("federateReaction: error converting reaction to as format: %s", err)
+	}
+
+	outboxIRI, err := url.Parse(originAccount.OutboxURI)
+	if err != nil {
+		return fmt.Errorf("federateReaction: error parsing outboxURI %s: %s", originAccount.OutboxURI, err)
+	}
+	_, err = p.federator.FederatingActor().Send(ctx, outboxIRI, asReaction)
+	return err
+}
+
 func (p *Processor) federateAnnounce(ctx context.Context, boostWrapperStatus *gtsmodel.Status, boostingAccount *gtsmodel.Account, boostedAccount *gtsmodel.Account) error {
+	// Boosts of local-only statuses stay local.
+	if boostWrapperStatus.IsLocalOnly() {
+		return nil
+	}
+
 	announce, err := p.tc.BoostToAS(ctx, boostWrapperStatus, boostingAccount, boostedAccount)
 	if err != nil {
 		return fmt.Errorf("federateAnnounce: error converting status to announce: %s", err)
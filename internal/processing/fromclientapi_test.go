@@ -21,13 +21,16 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/stream"
 	"github.com/superseriousbusiness/gotosocial/testrig"
@@ -267,6 +270,249 @@ func (suite *FromClientAPITestSuite) TestProcessNewStatusWithNotification() {
 	suite.Equal(newStatus.ID, notif.Status.ID)
 }
 
+// This test ensures that when an admin marks a local status as
+// sensitive while resolving a report about it, an Update for the
+// status is sent out to the followers of its author.
+func (suite *FromClientAPITestSuite) TestReportResolveMarkSensitiveFederates() {
+	var (
+		ctx           = context.Background()
+		adminAccount  = suite.testAccounts["admin_account"]
+		targetAccount = suite.testAccounts["local_account_1"]
+		remoteAccount = suite.testAccounts["remote_account_1"]
+		status        = suite.testStatuses["local_account_1_status_5"]
+	)
+
+	// Remote account follows the target, so
+	// should receive updates to their statuses.
+	if err := suite.db.PutFollow(ctx, &gtsmodel.Follow{
+		ID:              "01H6ZM1EQCNM9SP1BTPYKHDBXA",
+		URI:             remoteAccount.URI + "/follows/01H6ZM1EQCNM9SP1BTPYKHDBXA",
+		AccountID:       remoteAccount.ID,
+		TargetAccountID: targetAccount.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	report := &gtsmodel.Report{
+		ID:              "01H6ZM3A1XPF8YS1DW2FQ7B3QG",
+		URI:             remoteAccount.URI + "/reports/01H6ZM3A1XPF8YS1DW2FQ7B3QG",
+		AccountID:       remoteAccount.ID,
+		TargetAccountID: targetAccount.ID,
+		StatusIDs:       []string{status.ID},
+	}
+	if err := suite.db.PutReport(ctx, report); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, errWithCode := suite.processor.Admin().ReportResolve(ctx, adminAccount, report.ID, &apimodel.AdminReportResolveRequest{
+		MarkStatusesSensitive: true,
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	update := &struct {
+		Actor  string `json:"actor"`
+		Type   string `json:"type"`
+		Object struct {
+			ID        string `json:"id"`
+			Sensitive bool   `json:"sensitive"`
+		} `json:"object"`
+	}{}
+
+	if !testrig.WaitFor(func() bool {
+		sentI, ok := suite.httpClient.SentMessages.Load(*remoteAccount.SharedInboxURI)
+		if !ok {
+			return false
+		}
+		for _, sent := range sentI.([][]byte) {
+			if err := json.Unmarshal(sent, update); err == nil && update.Type == ap.ActivityUpdate {
+				return true
+			}
+		}
+		return false
+	}) {
+		suite.FailNow("timed out waiting for update")
+	}
+
+	suite.Equal(targetAccount.URI, update.Actor)
+	suite.Equal(status.URI, update.Object.ID)
+	suite.True(update.Object.Sensitive)
+}
+
+func (suite *FromClientAPITestSuite) TestLocalOnlyNotFederated() {
+	var (
+		ctx            = context.Background()
+		postingAccount = suite.testAccounts["local_account_1"]
+		remoteAccount  = suite.testAccounts["remote_account_1"]
+		application    = suite.testApplications["application_1"]
+		localOnlyBoost = suite.testStatuses["local_account_1_status_2"]
+		federated      = false
+	)
+
+	// Remote account follows the poster, so
+	// would normally receive their statuses.
+	if err := suite.db.PutFollow(ctx, &gtsmodel.Follow{
+		ID:              "01HA2Q4ZTJM8XH8ZDQ3TJ4KH5C",
+		URI:             remoteAccount.URI + "/follows/01HA2Q4ZTJM8XH8ZDQ3TJ4KH5C",
+		AccountID:       remoteAccount.ID,
+		TargetAccountID: postingAccount.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Post a public local-only status.
+	localOnly, errWithCode := suite.processor.Status().Create(ctx, postingAccount, application, &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "this should stay on the instance",
+			Visibility:  apimodel.VisibilityPublic,
+			Language:    "en",
+			ContentType: apimodel.StatusContentTypePlain,
+		},
+		AdvancedVisibilityFlagsForm: apimodel.AdvancedVisibilityFlagsForm{
+			Federated: &federated,
+		},
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Boost an existing local-only status.
+	boost, errWithCode := suite.processor.Status().BoostCreate(ctx, postingAccount, application, localOnlyBoost.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Post a normal public status, which
+	// should be delivered to the remote.
+	federatedStatus, errWithCode := suite.processor.Status().Create(ctx, postingAccount, application, &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "this one can go out",
+			Visibility:  apimodel.VisibilityPublic,
+			Language:    "en",
+			ContentType: apimodel.StatusContentTypePlain,
+		},
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	if !testrig.WaitFor(func() bool {
+		sentI, ok := suite.httpClient.SentMessages.Load(*remoteAccount.SharedInboxURI)
+		if !ok {
+			return false
+		}
+		for _, s := range sentI.([][]byte) {
+			if strings.Contains(string(s), federatedStatus.URI) {
+				return true
+			}
+		}
+		return false
+	}) {
+		suite.FailNow("timed out waiting for federated status")
+	}
+
+	// Give any stray deliveries time to land.
+	time.Sleep(1 * time.Second)
+	sentI, _ := suite.httpClient.SentMessages.Load(*remoteAccount.SharedInboxURI)
+	for _, s := range sentI.([][]byte) {
+		suite.NotContains(string(s), localOnly.URI)
+		suite.NotContains(string(s), boost.URI)
+		suite.NotContains(string(s), localOnlyBoost.URI)
+	}
+}
+
+func (suite *FromClientAPITestSuite) TestProcessWarnAccountWebPush() {
+	var (
+		ctx           = context.Background()
+		admin         = suite.testAccounts["admin_account"]
+		targetAccount = suite.testAccounts["local_account_1"]
+	)
+
+	// Every alert is disabled, but moderation
+	// warnings should be pushed regardless.
+	sub := suite.subscribeWebPush("local_account_1", "local_account_1", nil)
+
+	adminAction := &gtsmodel.AdminAccountAction{
+		ID:              id.NewULID(),
+		AccountID:       admin.ID,
+		TargetAccountID: targetAccount.ID,
+		Text:            "please stop posting so well",
+		Type:            gtsmodel.AdminActionNone,
+	}
+
+	if err := suite.db.Put(ctx, adminAction); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.processor.ProcessFromClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   messages.ObjectModerationWarning,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       adminAction,
+		OriginAccount:  admin,
+		TargetAccount:  targetAccount,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	pushed, ok := suite.webPushed(sub)
+	if !ok {
+		suite.FailNow("moderation_warning notification was not pushed")
+	}
+	suite.Equal("moderation_warning", pushed.NotificationType)
+	suite.Equal("A moderator has taken action on your account", pushed.Title)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessAnnouncementStartsLater() {
+	var (
+		ctx        = context.Background()
+		admin      = suite.testAccounts["admin_account"]
+		streams    = suite.openStreams(ctx, suite.testAccounts["local_account_1"], nil)
+		homeStream = streams[stream.TimelineHome]
+	)
+
+	announcement := &gtsmodel.Announcement{
+		ID:          id.NewULID(),
+		Text:        "coming soon",
+		Content:     "<p>coming soon</p>",
+		StartsAt:    time.Now().Add(2 * time.Second),
+		AllDay:      testrig.FalseBool(),
+		Published:   testrig.TrueBool(),
+		PublishedAt: time.Now(),
+	}
+	if err := suite.db.PutAnnouncement(ctx, announcement); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.processor.ProcessFromClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   messages.ObjectAnnouncement,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       announcement,
+		OriginAccount:  admin,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Not active yet, so a delete is streamed.
+	msg := <-homeStream.Messages
+	suite.Equal(stream.EventTypeAnnouncementDelete, msg.Event)
+	suite.Equal(announcement.ID, msg.Payload)
+
+	// Once it starts, the announcement itself is streamed.
+	select {
+	case msg = <-homeStream.Messages:
+	case <-time.After(10 * time.Second):
+		suite.FailNow("timed out waiting for announcement to be streamed")
+	}
+	suite.Equal(stream.EventTypeAnnouncement, msg.Event)
+
+	apiAnnouncement := &apimodel.Announcement{}
+	if err := json.Unmarshal([]byte(msg.Payload), apiAnnouncement); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(announcement.ID, apiAnnouncement.ID)
+}
+
 func TestFromClientAPITestSuite(t *testing.T) {
 	suite.Run(t, &FromClientAPITestSuite{})
 }
@@ -21,7 +21,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"codeberg.org/gruf/go-runners"
+	"codeberg.org/gruf/go-sched"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/email"
@@ -290,6 +294,21 @@ func (p *Processor) notifyFave(ctx context.Context, fave *gtsmodel.StatusFave) e
 	)
 }
 
+func (p *Processor) notifyReaction(ctx context.Context, reaction *gtsmodel.StatusReaction) error {
+	if reaction.TargetAccountID == reaction.AccountID {
+		// Self-reaction, nothing to do.
+		return nil
+	}
+
+	return p.notify(
+		ctx,
+		gtsmodel.NotificationReaction,
+		reaction.TargetAccountID,
+		reaction.AccountID,
+		reaction.StatusID,
+	)
+}
+
 func (p *Processor) notifyAnnounce(ctx context.Context, status *gtsmodel.Status) error {
 	if status.BoostOfID == "" {
 		// Not a boost, nothing to do.
@@ -327,6 +346,23 @@ func (p *Processor) notify(
 		return nil
 	}
 
+	originAccount, err := p.state.DB.GetAccountByID(gtscontext.SetBarebones(ctx), originAccountID)
+	if err != nil {
+		return fmt.Errorf("notify: error getting origin account %s: %w", originAccountID, err)
+	}
+
+	// Make sure target account hasn't
+	// blocked the origin account's domain.
+	blocked, err := p.state.DB.IsAccountDomainBlocked(ctx, targetAccountID, originAccount.Domain)
+	if err != nil {
+		return fmt.Errorf("notify: error checking domain block: %w", err)
+	}
+
+	if blocked {
+		// Nothing to do.
+		return nil
+	}
+
 	// Make sure a notification doesn't
 	// already exist with these params.
 	if _, err := p.state.DB.GetNotification(
@@ -343,6 +379,13 @@ func (p *Processor) notify(
 		return fmt.Errorf("notify: error checking existence of notification: %w", err)
 	}
 
+	// Check whether the target account's notification
+	// policy says this notification should be filtered.
+	filtered, err := p.filterNotification(ctx, notificationType, targetAccount, originAccount, statusID)
+	if err != nil {
+		return fmt.Errorf("notify: error checking notification policy: %w", err)
+	}
+
 	// Notification doesn't yet exist, so
 	// we need to create + store one.
 	notif := &gtsmodel.Notification{
@@ -351,20 +394,257 @@ func (p *Processor) notify(
 		TargetAccountID:  targetAccountID,
 		OriginAccountID:  originAccountID,
 		StatusID:         statusID,
+		Filtered:         &filtered,
 	}
 
 	if err := p.state.DB.PutNotification(ctx, notif); err != nil {
 		return fmt.Errorf("notify: error putting notification in database: %w", err)
 	}
 
-	// Stream notification to the user.
+	if filtered {
+		// Keep the notification aside in the target account's
+		// notification requests, without streaming or pushing it.
+		if err := p.putNotificationRequest(ctx, notif); err != nil {
+			return fmt.Errorf("notify: error updating notification request: %w", err)
+		}
+
+		return nil
+	}
+
+	if err := p.sendNotification(ctx, notif, targetAccount); err != nil {
+		return fmt.Errorf("notify: %w", err)
+	}
+
+	return nil
+}
+
+// sendNotification streams the given stored notification to
+// the target account, and pushes it to their devices.
+func (p *Processor) sendNotification(ctx context.Context, notif *gtsmodel.Notification, targetAccount *gtsmodel.Account) error {
 	apiNotif, err := p.tc.NotificationToAPINotification(ctx, notif)
 	if err != nil {
-		return fmt.Errorf("notify: error converting notification to api representation: %w", err)
+		return gtserror.Newf("error converting notification to api representation: %w", err)
 	}
 
 	if err := p.stream.Notify(apiNotif, targetAccount); err != nil {
-		return fmt.Errorf("notify: error streaming notification to account: %w", err)
+		return gtserror.Newf("error streaming notification to account: %w", err)
+	}
+
+	if err := p.webPush.Send(ctx, notif, apiNotif); err != nil {
+		return gtserror.Newf("error pushing notification to account: %w", err)
+	}
+
+	return nil
+}
+
+// newAccountAge is the age below which accounts are
+// considered new by the FilterNewAccounts notification policy.
+const newAccountAge = 30 * 24 * time.Hour
+
+// filterNotification returns whether a notification of the given type from
+// originAccount to targetAccount should be filtered according to targetAccount's
+// notification policy, rather than being shown to targetAccount directly.
+func (p *Processor) filterNotification(
+	ctx context.Context,
+	notificationType gtsmodel.NotificationType,
+	targetAccount *gtsmodel.Account,
+	originAccount *gtsmodel.Account,
+	statusID string,
+) (bool, error) {
+	switch notificationType {
+	case gtsmodel.NotificationMention,
+		gtsmodel.NotificationFollow,
+		gtsmodel.NotificationFave,
+		gtsmodel.NotificationReaction,
+		gtsmodel.NotificationReblog:
+		// These can be filtered.
+	default:
+		return false, nil
+	}
+
+	policy, err := p.state.DB.GetNotificationPolicy(ctx, targetAccount.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// No policy set, so
+			// nothing is filtered.
+			return false, nil
+		}
+		return false, fmt.Errorf("error getting notification policy: %w", err)
+	}
+
+	// Notifications from accounts whose requests
+	// have been accepted are never filtered again.
+	request, err := p.state.DB.GetNotificationRequest(gtscontext.SetBarebones(ctx), targetAccount.ID, originAccount.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, fmt.Errorf("error getting notification request: %w", err)
+	}
+
+	if request != nil && *request.Accepted {
+		return false, nil
+	}
+
+	following, err := p.state.DB.IsFollowing(ctx, targetAccount.ID, originAccount.ID)
+	if err != nil {
+		return false, fmt.Errorf("error checking following: %w", err)
+	}
+
+	if *policy.FilterNotFollowing && !following {
+		return true, nil
+	}
+
+	if *policy.FilterNotFollowers {
+		followedBy, err := p.state.DB.IsFollowing(ctx, originAccount.ID, targetAccount.ID)
+		if err != nil {
+			return false, fmt.Errorf("error checking followed by: %w", err)
+		}
+
+		if !followedBy {
+			return true, nil
+		}
+	}
+
+	if *policy.FilterNewAccounts &&
+		time.Since(originAccount.CreatedAt) < newAccountAge {
+		return true, nil
+	}
+
+	if *policy.FilterPrivateMentions &&
+		notificationType == gtsmodel.NotificationMention &&
+		!following {
+		status, err := p.state.DB.GetStatusByID(gtscontext.SetBarebones(ctx), statusID)
+		if err != nil {
+			return false, fmt.Errorf("error getting status %s: %w", statusID, err)
+		}
+
+		// Direct mentions from strangers are filtered,
+		// unless they're in reply to the target account.
+		if status.Visibility == gtsmodel.VisibilityDirect &&
+			status.InReplyToAccountID != targetAccount.ID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// putNotificationRequest adds the given filtered notification to
+// the notification request of its target account for notifications
+// originating from its origin account, creating one if necessary.
+func (p *Processor) putNotificationRequest(ctx context.Context, notif *gtsmodel.Notification) error {
+	request, err := p.state.DB.GetNotificationRequest(
+		gtscontext.SetBarebones(ctx),
+		notif.TargetAccountID,
+		notif.OriginAccountID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return fmt.Errorf("error getting notification request: %w", err)
+	}
+
+	if request == nil {
+		// First filtered notification
+		// from this account, create it.
+		accepted := false
+		request = &gtsmodel.NotificationRequest{
+			ID:                 id.NewULID(),
+			AccountID:          notif.TargetAccountID,
+			FromAccountID:      notif.OriginAccountID,
+			LastStatusID:       notif.StatusID,
+			NotificationsCount: 1,
+			Accepted:           &accepted,
+		}
+
+		return p.state.DB.PutNotificationRequest(ctx, request)
+	}
+
+	columns := []string{"notifications_count"}
+	request.NotificationsCount++
+
+	if notif.StatusID != "" {
+		columns = append(columns, "last_status_id")
+		request.LastStatusID = notif.StatusID
+	}
+
+	return p.state.DB.UpdateNotificationRequest(ctx, request, columns...)
+}
+
+// notifyModerationWarning notifies the target of the given
+// admin action that a moderator took action against them.
+// Notifications for moderation warnings come from the instance
+// account, so that the acting moderator isn't revealed.
+func (p *Processor) notifyModerationWarning(ctx context.Context, adminAction *gtsmodel.AdminAccountAction) error {
+	targetAccount, err := p.state.DB.GetAccountByID(ctx, adminAction.TargetAccountID)
+	if err != nil {
+		return fmt.Errorf("notifyModerationWarning: error getting target account %s: %w", adminAction.TargetAccountID, err)
+	}
+
+	if !targetAccount.IsLocal() {
+		// Nothing to do.
+		return nil
+	}
+
+	instanceAccount, err := p.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		return fmt.Errorf("notifyModerationWarning: error getting instance account: %w", err)
+	}
+
+	notif := &gtsmodel.Notification{
+		ID:               id.NewULID(),
+		NotificationType: gtsmodel.NotificationModerationWarning,
+		TargetAccountID:  targetAccount.ID,
+		TargetAccount:    targetAccount,
+		OriginAccountID:  instanceAccount.ID,
+		OriginAccount:    instanceAccount,
+		AdminActionID:    adminAction.ID,
+		AdminAction:      adminAction,
+	}
+
+	if err := p.state.DB.PutNotification(ctx, notif); err != nil {
+		return fmt.Errorf("notifyModerationWarning: error putting notification in database: %w", err)
+	}
+
+	if err := p.sendNotification(ctx, notif, targetAccount); err != nil {
+		return fmt.Errorf("notifyModerationWarning: %w", err)
+	}
+
+	return nil
+}
+
+// notifyReport notifies all active moderators
+// and admins of this instance about a new report.
+func (p *Processor) notifyReport(ctx context.Context, report *gtsmodel.Report) error {
+	moderatorIDs, err := p.state.DB.GetInstanceModeratorAccountIDs(ctx)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// No active moderators.
+			return nil
+		}
+		return fmt.Errorf("notifyReport: error getting instance moderator account ids: %w", err)
+	}
+
+	for _, moderatorID := range moderatorIDs {
+		moderator, err := p.state.DB.GetAccountByID(ctx, moderatorID)
+		if err != nil {
+			return fmt.Errorf("notifyReport: error getting moderator account %s: %w", moderatorID, err)
+		}
+
+		notif := &gtsmodel.Notification{
+			ID:               id.NewULID(),
+			NotificationType: gtsmodel.NotificationAdminReport,
+			TargetAccountID:  moderator.ID,
+			TargetAccount:    moderator,
+			OriginAccountID:  report.AccountID,
+			OriginAccount:    report.Account,
+			ReportID:         report.ID,
+			Report:           report,
+		}
+
+		if err := p.state.DB.PutNotification(ctx, notif); err != nil {
+			return fmt.Errorf("notifyReport: error putting notification in database: %w", err)
+		}
+
+		if err := p.sendNotification(ctx, notif, moderator); err != nil {
+			return fmt.Errorf("notifyReport: %w", err)
+		}
 	}
 
 	return nil
@@ -417,6 +697,11 @@ func (p *Processor) wipeStatus(ctx context.Context, statusToDelete *gtsmodel.Sta
 		return err
 	}
 
+	// delete all emoji reactions to this status
+	if err := p.state.DB.DeleteStatusReactionsForStatus(ctx, statusToDelete.ID); err != nil {
+		return err
+	}
+
 	// delete all boosts for this status + remove them from timelines
 	if boosts, err := p.state.DB.GetStatusReblogs(ctx, statusToDelete); err == nil {
 		for _, b := range boosts {
@@ -565,3 +850,90 @@ func (p *Processor) emailReportClosed(ctx context.Context, report *gtsmodel.Repo
 
 	return p.emailSender.SendReportClosedEmail(user.Email, reportClosedData)
 }
+
+// streamAnnouncement streams the given announcement to all users
+// if it's currently active, or streams its removal if it's not.
+//
+// If the announcement's display window starts or ends later on,
+// it's streamed again at that time. Scheduled streams don't survive
+// a restart, so clients should still fetch announcements on connect.
+func (p *Processor) streamAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement) error {
+	now := time.Now()
+	p.scheduleAnnouncementStream(announcement, now)
+
+	if !announcement.IsActive(now) {
+		return p.stream.AnnouncementDelete(announcement.ID)
+	}
+
+	apiAnnouncement, err := p.tc.AnnouncementToAPIAnnouncement(ctx, announcement, nil)
+	if err != nil {
+		return fmt.Errorf("streamAnnouncement: error converting announcement %s: %w", announcement.ID, err)
+	}
+
+	return p.stream.Announcement(apiAnnouncement)
+}
+
+// scheduleAnnouncementStream schedules the given announcement to be
+// streamed again at the next start or end of its display window, if
+// that's after now. The announcement is refetched when the job runs,
+// as it may have been changed or deleted in the meantime; streaming
+// it more than once is harmless, since clients replace by ID.
+func (p *Processor) scheduleAnnouncementStream(announcement *gtsmodel.Announcement, now time.Time) {
+	var next time.Time
+	switch {
+	case !*announcement.Published:
+		return
+	case announcement.StartsAt.After(now):
+		next = announcement.StartsAt
+	case announcement.EndsAt.After(now):
+		next = announcement.EndsAt
+	default:
+		return
+	}
+
+	// Get ctx associated with scheduler run state.
+	done := p.state.Workers.Scheduler.Done()
+	doneCtx := runners.CancelCtx(done)
+
+	announcementID := announcement.ID
+	p.state.Workers.Scheduler.Schedule(sched.NewJob(func(time.Time) {
+		announcement, err := p.state.DB.GetAnnouncementByID(doneCtx, announcementID)
+		if err != nil {
+			if !errors.Is(err, db.ErrNoEntries) {
+				log.Errorf(doneCtx, "error getting announcement %s: %v", announcementID, err)
+			}
+			return
+		}
+
+		if err := p.streamAnnouncement(doneCtx, announcement); err != nil {
+			log.Errorf(doneCtx, "error streaming announcement %s: %v", announcementID, err)
+		}
+	}).At(next))
+}
+
+// streamAnnouncementReaction streams the current count of reactions
+// with the same name as the given reaction to all users.
+func (p *Processor) streamAnnouncementReaction(ctx context.Context, reaction *gtsmodel.AnnouncementReaction) error {
+	reactions, err := p.state.DB.GetAnnouncementReactions(ctx, reaction.AnnouncementID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return fmt.Errorf("streamAnnouncementReaction: error getting reactions for announcement %s: %w", reaction.AnnouncementID, err)
+	}
+
+	apiReaction := &apimodel.AnnouncementReaction{
+		Name:           reaction.Name,
+		AnnouncementID: reaction.AnnouncementID,
+	}
+
+	if reaction.Emoji != nil {
+		apiReaction.URL = reaction.Emoji.ImageURL
+		apiReaction.StaticURL = reaction.Emoji.ImageStaticURL
+	}
+
+	for _, r := range reactions {
+		if r.Name == reaction.Name {
+			apiReaction.Count++
+		}
+	}
+
+	return p.stream.AnnouncementReaction(apiReaction)
+}
@@ -21,15 +21,21 @@ import (
 	"context"
 	"errors"
 	"net/url"
+	"time"
 
 	"codeberg.org/gruf/go-kv"
 	"codeberg.org/gruf/go-logger/v2/level"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/media"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/regexes"
+	"golang.org/x/exp/slices"
 )
 
 // ProcessFromFederator reads the APActivityType and APObjectType of an incoming message from the federator,
@@ -71,6 +77,9 @@ func (p *Processor) ProcessFromFederator(ctx context.Context, federatorMsg messa
 		case ap.ActivityLike:
 			// CREATE A FAVE
 			return p.processCreateFaveFromFederator(ctx, federatorMsg)
+		case ap.ActivityEmojiReact:
+			// CREATE AN EMOJI REACTION
+			return p.processCreateReactionFromFederator(ctx, federatorMsg)
 		case ap.ActivityFollow:
 			// CREATE A FOLLOW REQUEST
 			return p.processCreateFollowRequestFromFederator(ctx, federatorMsg)
@@ -155,6 +164,21 @@ func (p *Processor) processCreateStatusFromFederator(ctx context.Context, federa
 		return err
 	}
 
+	if status.InReplyTo != nil && *status.InReplyTo.Local {
+		// This is a reply to one of our statuses, perhaps
+		// forwarded to us; don't surface it if the reply
+		// policy of the replied status doesn't permit it.
+		replyable, err := p.filter.StatusReplyable(ctx, status.Account, status.InReplyTo)
+		if err != nil {
+			return gtserror.Newf("error checking replyability of status %s: %w", status.InReplyToID, err)
+		}
+
+		if !replyable {
+			log.Debugf(ctx, "not surfacing reply to status %s not permitted by its reply policy", status.InReplyToID)
+			return nil
+		}
+	}
+
 	if status.InReplyToID != "" {
 		// Interaction counts changed on the replied status;
 		// uncache the prepared version from all timelines.
@@ -165,6 +189,9 @@ func (p *Processor) processCreateStatusFromFederator(ctx context.Context, federa
 		return gtserror.Newf("error timelining status: %w", err)
 	}
 
+	// Fetch link preview card, if any, in the background.
+	p.federator.FetchStatusCardAsync(ctx, status)
+
 	return nil
 }
 
@@ -238,6 +265,79 @@ func (p *Processor) processCreateFaveFromFederator(ctx context.Context, federato
 	return nil
 }
 
+// processCreateReactionFromFederator handles Activity Create with Object EmojiReact.
+func (p *Processor) processCreateReactionFromFederator(ctx context.Context, federatorMsg messages.FromFederator) error {
+	reaction, ok := federatorMsg.GTSModel.(*gtsmodel.StatusReaction)
+	if !ok {
+		return gtserror.New("EmojiReact was not parseable as *gtsmodel.StatusReaction")
+	}
+
+	if reaction.Emoji != nil && reaction.Emoji.ID == "" {
+		// Custom emoji reaction: make sure
+		// we've got the emoji stored locally.
+		emoji, err := p.reactionEmoji(ctx, reaction.Emoji, federatorMsg.ReceivingAccount.Username)
+		if err != nil {
+			return gtserror.Newf("error getting reaction emoji: %w", err)
+		}
+		reaction.Emoji = emoji
+		reaction.EmojiID = emoji.ID
+	}
+
+	if err := p.state.DB.PutStatusReaction(ctx, reaction); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			// We've already handled this reaction.
+			return nil
+		}
+		return gtserror.Newf("db error inserting reaction: %w", err)
+	}
+
+	if err := p.notifyReaction(ctx, reaction); err != nil {
+		return gtserror.Newf("error notifying status reaction: %w", err)
+	}
+
+	// Reactions changed on the status;
+	// uncache the prepared version from all timelines.
+	p.invalidateStatusFromTimelines(ctx, reaction.StatusID)
+
+	return nil
+}
+
+// reactionEmoji returns the stored version of the given
+// minimal emoji, dereferencing it if we don't have it yet.
+func (p *Processor) reactionEmoji(ctx context.Context, e *gtsmodel.Emoji, requestingUsername string) (*gtsmodel.Emoji, error) {
+	emoji, err := p.state.DB.GetEmojiByShortcodeDomain(ctx, e.Shortcode, e.Domain)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, err
+	}
+
+	if emoji != nil {
+		return emoji, nil
+	}
+
+	if e.Domain == "" {
+		// Local emojis can't be dereferenced.
+		return nil, gtserror.Newf("no local emoji with shortcode %s", e.Shortcode)
+	}
+
+	emojiID, err := id.NewRandomULID()
+	if err != nil {
+		return nil, err
+	}
+
+	processingEmoji, err := p.federator.GetRemoteEmoji(ctx, requestingUsername, e.ImageRemoteURL, e.Shortcode, e.Domain, emojiID, e.URI, &media.AdditionalEmojiInfo{
+		Domain:               &e.Domain,
+		ImageRemoteURL:       &e.ImageRemoteURL,
+		ImageStaticRemoteURL: &e.ImageStaticRemoteURL,
+		Disabled:             e.Disabled,
+		VisibleInPicker:      e.VisibleInPicker,
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return processingEmoji.LoadEmoji(ctx)
+}
+
 // processCreateFollowRequestFromFederator handles Activity Create and Object Follow
 func (p *Processor) processCreateFollowRequestFromFederator(ctx context.Context, federatorMsg messages.FromFederator) error {
 	followRequest, ok := federatorMsg.GTSModel.(*gtsmodel.FollowRequest)
@@ -310,6 +410,20 @@ func (p *Processor) processCreateAnnounceFromFederator(ctx context.Context, fede
 		return gtserror.Newf("error dereferencing announce: %w", err)
 	}
 
+	if *status.BoostOf.Local {
+		// This is a boost of one of our statuses;
+		// make sure its boost policy permits it.
+		boostable, err := p.filter.StatusBoostable(ctx, status.Account, status.BoostOf)
+		if err != nil {
+			return gtserror.Newf("error checking boostability of status %s: %w", status.BoostOfID, err)
+		}
+
+		if !boostable {
+			log.Debugf(ctx, "dropping boost of status %s not permitted by its boost policy", status.BoostOfID)
+			return nil
+		}
+	}
+
 	// Generate an ID for the boost wrapper status.
 	statusID, err := id.NewULIDFromTime(status.CreatedAt)
 	if err != nil {
@@ -413,10 +527,190 @@ func (p *Processor) processCreateFlagFromFederator(ctx context.Context, federato
 		return errors.New("flag was not parseable as *gtsmodel.Report")
 	}
 
-	// TODO: handle additional side effects of flag creation:
-	// - notify admins by dm / notification
+	if flaggable, ok := federatorMsg.APObjectModel.(ap.Flaggable); ok {
+		// Statuses reported by URI that weren't yet known
+		// to us when the flag came in weren't attached to
+		// the report, so try to dereference them now.
+		if err := p.dereferenceReportStatuses(ctx, federatorMsg.ReceivingAccount, incomingReport, flaggable); err != nil {
+			log.Errorf(ctx, "error dereferencing reported statuses: %v", err)
+		}
+	}
+
+	if config.GetInstanceAutoCloseSuspendedReports() {
+		closed, err := p.autoCloseSuspendedReport(ctx, incomingReport)
+		if err != nil {
+			return gtserror.Newf("error auto-closing report: %w", err)
+		}
+
+		if closed {
+			// Nothing left for
+			// moderators to do.
+			return nil
+		}
+	}
+
+	if err := p.emailReport(ctx, incomingReport); err != nil {
+		return gtserror.Newf("error emailing report: %w", err)
+	}
+
+	if err := p.notifyReport(ctx, incomingReport); err != nil {
+		return gtserror.Newf("error notifying report: %w", err)
+	}
+
+	return nil
+}
+
+// maxReportStatuses is the maximum number of statuses referenced
+// by an incoming flag that will be dereferenced, so that a flag
+// can't be used to make us send an unbounded number of requests.
+const maxReportStatuses = 20
+
+// dereferenceReportStatuses dereferences remote statuses
+// referenced by the given flag, and attaches any of them
+// authored by the target of the report to the report.
+//
+// Only statuses on the host of the reporting instance or
+// of the reported account are dereferenced, up to a total
+// of maxReportStatuses.
+func (p *Processor) dereferenceReportStatuses(
+	ctx context.Context,
+	receivingAccount *gtsmodel.Account,
+	report *gtsmodel.Report,
+	flaggable ap.Flaggable,
+) error {
+	objects, err := ap.ExtractObjectURIs(flaggable)
+	if err != nil {
+		return gtserror.Newf("error extracting objects: %w", err)
+	}
+
+	// Misskey includes the URLs of reported
+	// notes in the content of the flag instead.
+	content := ap.ExtractContent(flaggable)
+	for _, match := range regexes.MisskeyReportNotes.FindAllStringSubmatch(content, -1) {
+		if uri, err := url.Parse(match[1]); err == nil {
+			objects = append(objects, uri)
+		}
+	}
+
+	hosts, err := p.reportHosts(ctx, report)
+	if err != nil {
+		return err
+	}
+
+	uris := make([]*url.URL, 0, len(objects))
+	for _, uri := range objects {
+		if uri.Host == config.GetHost() || uri.Host == config.GetAccountDomain() {
+			// Local statuses are already
+			// attached when converting flag.
+			continue
+		}
+
+		if !slices.Contains(hosts, uri.Host) {
+			// Not from an instance involved
+			// in the report, don't go there.
+			log.Debugf(ctx, "not dereferencing reported object %s from uninvolved host", uri)
+			continue
+		}
+
+		if len(uris) == maxReportStatuses {
+			log.Debugf(ctx, "flag referenced more than %d statuses, ignoring the rest", maxReportStatuses)
+			break
+		}
+
+		uris = append(uris, uri)
+	}
+
+	var updated bool
+	for _, uri := range uris {
+		status, _, err := p.federator.GetStatusByURI(ctx, receivingAccount.Username, uri)
+		if err != nil {
+			// Not a status, or not reachable.
+			log.Debugf(ctx, "couldn't dereference reported object %s as status: %v", uri, err)
+			continue
+		}
+
+		if status.AccountID != report.TargetAccountID ||
+			slices.Contains(report.StatusIDs, status.ID) {
+			// Not relevant to this report.
+			continue
+		}
+
+		report.StatusIDs = append(report.StatusIDs, status.ID)
+		report.Statuses = append(report.Statuses, status)
+		updated = true
+	}
+
+	if !updated {
+		return nil
+	}
+
+	if _, err := p.state.DB.UpdateReport(ctx, report, "statuses"); err != nil {
+		return gtserror.Newf("db error updating report statuses: %w", err)
+	}
+
+	return nil
+}
+
+// reportHosts returns the hosts of the account that
+// created the given report, and of the reported account.
+func (p *Processor) reportHosts(ctx context.Context, report *gtsmodel.Report) ([]string, error) {
+	hosts := make([]string, 0, 2)
+	for _, accountID := range []string{
+		report.AccountID,
+		report.TargetAccountID,
+	} {
+		account, err := p.state.DB.GetAccountByID(ctx, accountID)
+		if err != nil {
+			return nil, gtserror.Newf("db error getting account %s: %w", accountID, err)
+		}
+
+		uri, err := url.Parse(account.URI)
+		if err != nil {
+			return nil, gtserror.Newf("error parsing account uri %s: %w", account.URI, err)
+		}
+
+		hosts = append(hosts, uri.Host)
+	}
+
+	return hosts, nil
+}
+
+// autoCloseSuspendedReport marks the given report as resolved
+// by the instance account if the target of the report has
+// already been suspended, returning whether it was closed.
+func (p *Processor) autoCloseSuspendedReport(ctx context.Context, report *gtsmodel.Report) (bool, error) {
+	if report.TargetAccount == nil {
+		var err error
+		report.TargetAccount, err = p.state.DB.GetAccountByID(ctx, report.TargetAccountID)
+		if err != nil {
+			return false, gtserror.Newf("db error getting report target account: %w", err)
+		}
+	}
+
+	if report.TargetAccount.SuspendedAt.IsZero() {
+		// Not suspended,
+		// leave report open.
+		return false, nil
+	}
+
+	instanceAccount, err := p.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		return false, gtserror.Newf("db error getting instance account: %w", err)
+	}
+
+	report.ActionTakenAt = time.Now()
+	report.ActionTakenByAccountID = instanceAccount.ID
+	report.ActionTaken = "Report automatically resolved: reported account was already suspended."
+
+	if _, err := p.state.DB.UpdateReport(ctx, report,
+		"action_taken_at",
+		"action_taken_by_account_id",
+		"action_taken",
+	); err != nil {
+		return false, gtserror.Newf("db error closing report: %w", err)
+	}
 
-	return p.emailReport(ctx, incomingReport)
+	return true, nil
 }
 
 // processUpdateAccountFromFederator handles Activity Update and Object Profile
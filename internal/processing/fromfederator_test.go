@@ -25,12 +25,15 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/superseriousbusiness/gotosocial/internal/stream"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
@@ -306,7 +309,10 @@ func (suite *FromFederatorTestSuite) TestProcessFaveWithDifferentReceivingAccoun
 func (suite *FromFederatorTestSuite) TestProcessAccountDelete() {
 	ctx := context.Background()
 
-	deletedAccount := suite.testAccounts["remote_account_1"]
+	// Take a copy of the deleted account, since the delete
+	// will alter it, and other tests use the test model.
+	deletedAccount := new(gtsmodel.Account)
+	*deletedAccount = *suite.testAccounts["remote_account_1"]
 	receivingAccount := suite.testAccounts["local_account_1"]
 
 	// before doing the delete....
@@ -559,6 +565,221 @@ func (suite *FromFederatorTestSuite) TestCreateStatusFromIRI() {
 	suite.Equal(statusCreator.URI, s.AccountURI)
 }
 
+func (suite *FromFederatorTestSuite) TestProcessFlagNotifyModerators() {
+	ctx := context.Background()
+
+	receivingAccount := suite.testAccounts["local_account_1"]
+	report := &gtsmodel.Report{
+		ID:              id.NewULID(),
+		URI:             "http://fossbros-anonymous.io/db22128d-884e-4358-9935-6a7c3940535d",
+		AccountID:       suite.testAccounts["remote_account_1"].ID,
+		TargetAccountID: receivingAccount.ID,
+		Comment:         "this person is too good at posting",
+		StatusIDs:       []string{},
+		Forwarded:       testrig.FalseBool(),
+	}
+
+	err := suite.db.PutReport(ctx, report)
+	suite.NoError(err)
+
+	err = suite.processor.ProcessFromFederator(ctx, messages.FromFederator{
+		APObjectType:     ap.ActivityFlag,
+		APActivityType:   ap.ActivityCreate,
+		GTSModel:         report,
+		ReceivingAccount: receivingAccount,
+	})
+	suite.NoError(err)
+
+	// the admin should have been notified
+	notifs, err := suite.db.GetAccountNotifications(ctx, suite.testAccounts["admin_account"].ID, &paging.Pager{Limit: 20}, nil, nil, "")
+	suite.NoError(err)
+	var notif *gtsmodel.Notification
+	for _, n := range notifs {
+		if n.NotificationType == gtsmodel.NotificationAdminReport {
+			notif = n
+		}
+	}
+	if notif == nil {
+		suite.FailNow("no admin.report notification for admin account")
+	}
+	suite.Equal(report.ID, notif.ReportID)
+
+	apiNotif, err := suite.typeconverter.NotificationToAPINotification(ctx, notif)
+	suite.NoError(err)
+	suite.Equal("admin.report", apiNotif.Type)
+	suite.Equal(report.ID, apiNotif.Report.ID)
+	suite.Equal(receivingAccount.ID, apiNotif.Report.TargetAccount.ID)
+}
+
+func (suite *FromFederatorTestSuite) TestProcessFlagWebPush() {
+	ctx := context.Background()
+
+	// The admin wants pushes of new reports.
+	sub := suite.subscribeWebPush("admin_account", "admin_account", func(sub *gtsmodel.WebPushSubscription) {
+		sub.AlertAdminReport = testrig.TrueBool()
+	})
+
+	receivingAccount := suite.testAccounts["local_account_1"]
+	report := &gtsmodel.Report{
+		ID:              id.NewULID(),
+		URI:             "http://fossbros-anonymous.io/db22128d-884e-4358-9935-6a7c3940535d",
+		AccountID:       suite.testAccounts["remote_account_1"].ID,
+		TargetAccountID: receivingAccount.ID,
+		Comment:         "this person is too good at posting",
+		StatusIDs:       []string{},
+		Forwarded:       testrig.FalseBool(),
+	}
+
+	err := suite.db.PutReport(ctx, report)
+	suite.NoError(err)
+
+	err = suite.processor.ProcessFromFederator(ctx, messages.FromFederator{
+		APObjectType:     ap.ActivityFlag,
+		APActivityType:   ap.ActivityCreate,
+		GTSModel:         report,
+		ReceivingAccount: receivingAccount,
+	})
+	suite.NoError(err)
+
+	pushed, ok := suite.webPushed(sub)
+	if !ok {
+		suite.FailNow("admin.report notification was not pushed")
+	}
+	suite.Equal("admin.report", pushed.NotificationType)
+	suite.Equal(suite.testTokens["admin_account"].Access, pushed.AccessToken)
+}
+
+func (suite *FromFederatorTestSuite) TestProcessFlagAutoCloseSuspended() {
+	ctx := context.Background()
+	config.SetInstanceAutoCloseSuspendedReports(true)
+
+	// suspend the target of the report
+	targetAccount := &gtsmodel.Account{}
+	*targetAccount = *suite.testAccounts["local_account_1"]
+	targetAccount.SuspendedAt = time.Now()
+	err := suite.db.UpdateAccount(ctx, targetAccount, "suspended_at")
+	suite.NoError(err)
+
+	report := &gtsmodel.Report{
+		ID:              id.NewULID(),
+		URI:             "http://fossbros-anonymous.io/db22128d-884e-4358-9935-6a7c3940535d",
+		AccountID:       suite.testAccounts["remote_account_1"].ID,
+		TargetAccountID: targetAccount.ID,
+		Comment:         "this person is too good at posting",
+		StatusIDs:       []string{},
+		Forwarded:       testrig.FalseBool(),
+	}
+
+	err = suite.db.PutReport(ctx, report)
+	suite.NoError(err)
+
+	err = suite.processor.ProcessFromFederator(ctx, messages.FromFederator{
+		APObjectType:     ap.ActivityFlag,
+		APActivityType:   ap.ActivityCreate,
+		GTSModel:         report,
+		ReceivingAccount: targetAccount,
+	})
+	suite.NoError(err)
+
+	// the report should be resolved by the instance account
+	dbReport, err := suite.db.GetReportByID(ctx, report.ID)
+	suite.NoError(err)
+	suite.WithinDuration(time.Now(), dbReport.ActionTakenAt, 1*time.Minute)
+	suite.Equal(suite.testAccounts["instance_account"].ID, dbReport.ActionTakenByAccountID)
+
+	// and the admin should not have been notified
+	notifs, err := suite.db.GetAccountNotifications(ctx, suite.testAccounts["admin_account"].ID, &paging.Pager{Limit: 20}, nil, nil, "")
+	suite.NoError(err)
+	for _, n := range notifs {
+		suite.NotEqual(gtsmodel.NotificationAdminReport, n.NotificationType)
+	}
+}
+
+func (suite *FromFederatorTestSuite) TestProcessFlagDereferenceStatuses() {
+	ctx := context.Background()
+
+	receivingAccount := suite.testAccounts["local_account_1"]
+	targetAccount := suite.testAccounts["remote_account_2"]
+	statusURI := testrig.URLMustParse("http://example.org/users/Some_User/statuses/afaba698-5740-4e32-a702-af61aa543bc1")
+
+	report := &gtsmodel.Report{
+		ID:              id.NewULID(),
+		URI:             "http://fossbros-anonymous.io/db22128d-884e-4358-9935-6a7c3940535d",
+		AccountID:       suite.testAccounts["remote_account_1"].ID,
+		TargetAccountID: targetAccount.ID,
+		StatusIDs:       []string{},
+		Forwarded:       testrig.FalseBool(),
+	}
+
+	err := suite.db.PutReport(ctx, report)
+	suite.NoError(err)
+
+	// flag references a status we don't know about yet
+	flag := streams.NewActivityStreamsFlag()
+	objectProp := streams.NewActivityStreamsObjectProperty()
+	objectProp.AppendIRI(testrig.URLMustParse(targetAccount.URI))
+	objectProp.AppendIRI(statusURI)
+	flag.SetActivityStreamsObject(objectProp)
+
+	err = suite.processor.ProcessFromFederator(ctx, messages.FromFederator{
+		APObjectType:     ap.ActivityFlag,
+		APActivityType:   ap.ActivityCreate,
+		GTSModel:         report,
+		APObjectModel:    flag,
+		ReceivingAccount: receivingAccount,
+	})
+	suite.NoError(err)
+
+	// status should now be dereferenced and attached to the report
+	status, err := suite.db.GetStatusByURI(ctx, statusURI.String())
+	suite.NoError(err)
+
+	dbReport, err := suite.db.GetReportByID(ctx, report.ID)
+	suite.NoError(err)
+	suite.Equal([]string{status.ID}, dbReport.StatusIDs)
+}
+
+func (suite *FromFederatorTestSuite) TestProcessFlagDereferenceStatusesOtherHost() {
+	ctx := context.Background()
+
+	receivingAccount := suite.testAccounts["local_account_1"]
+	targetAccount := suite.testAccounts["remote_account_2"]
+	statusURI := testrig.URLMustParse("https://unknown-instance.com/users/brand_new_person/statuses/01FE4NTHKWW7THT67EF10EB839")
+
+	report := &gtsmodel.Report{
+		ID:              id.NewULID(),
+		URI:             "http://fossbros-anonymous.io/2b9a1b3e-7f2e-4c69-9bd2-26b81d2c94d6",
+		AccountID:       suite.testAccounts["remote_account_1"].ID,
+		TargetAccountID: targetAccount.ID,
+		StatusIDs:       []string{},
+		Forwarded:       testrig.FalseBool(),
+	}
+
+	err := suite.db.PutReport(ctx, report)
+	suite.NoError(err)
+
+	// flag references a status on an instance
+	// that has nothing to do with the report
+	flag := streams.NewActivityStreamsFlag()
+	objectProp := streams.NewActivityStreamsObjectProperty()
+	objectProp.AppendIRI(testrig.URLMustParse(targetAccount.URI))
+	objectProp.AppendIRI(statusURI)
+	flag.SetActivityStreamsObject(objectProp)
+
+	err = suite.processor.ProcessFromFederator(ctx, messages.FromFederator{
+		APObjectType:     ap.ActivityFlag,
+		APActivityType:   ap.ActivityCreate,
+		GTSModel:         report,
+		APObjectModel:    flag,
+		ReceivingAccount: receivingAccount,
+	})
+	suite.NoError(err)
+
+	// status should not have been dereferenced
+	_, err = suite.db.GetStatusByURI(ctx, statusURI.String())
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
 func TestFromFederatorTestSuite(t *testing.T) {
 	suite.Run(t, &FromFederatorTestSuite{})
 }
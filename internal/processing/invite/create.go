@@ -0,0 +1,96 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package invite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+const (
+	defaultMaxUses   = 1
+	defaultExpiresIn = 7 * 24 * time.Hour
+
+	// Invites let people skip sign-up approval,
+	// so limit invites created by non-admins.
+	userMaxUses   = 10
+	userExpiresIn = 30 * 24 * time.Hour
+)
+
+// Create creates a new invite on behalf of the given user.
+//
+// Admins can always create invites; other users can only
+// create invites if accounts-allow-user-invites is set, and
+// their invites must have limited uses and an expiry.
+func (p *Processor) Create(ctx context.Context, user *gtsmodel.User, form *apimodel.InviteCreateRequest) (*apimodel.Invite, gtserror.WithCode) {
+	if !*user.Admin && !config.GetAccountsAllowUserInvites() {
+		err := errors.New("only admins can create invites on this instance")
+		return nil, gtserror.NewErrorForbidden(err, err.Error())
+	}
+
+	maxUses := defaultMaxUses
+	if form.MaxUses != nil {
+		maxUses = *form.MaxUses
+	}
+
+	if maxUses < 0 {
+		err := errors.New("max_uses must not be negative")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	expiresIn := defaultExpiresIn
+	if form.ExpiresIn != nil {
+		expiresIn = time.Duration(*form.ExpiresIn) * time.Second
+	}
+
+	if expiresIn < 0 {
+		err := errors.New("expires_in must not be negative")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if !*user.Admin {
+		if maxUses == 0 || maxUses > userMaxUses {
+			err := fmt.Errorf("max_uses must be between 1 and %d", userMaxUses)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		if expiresIn == 0 || expiresIn > userExpiresIn {
+			err := fmt.Errorf("expires_in must be between 1 and %d seconds", int(userExpiresIn.Seconds()))
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+	}
+
+	invite, err := NewInvite(user.AccountID, maxUses, expiresIn)
+	if err != nil {
+		err := gtserror.Newf("error generating invite: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.state.DB.PutInvite(ctx, invite); err != nil {
+		err := gtserror.Newf("db error putting invite: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.tc.InviteToAPIInvite(invite), nil
+}
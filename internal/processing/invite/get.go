@@ -0,0 +1,59 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package invite
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// GetAll returns all invites created by the given user, newest first.
+func (p *Processor) GetAll(ctx context.Context, user *gtsmodel.User) ([]*apimodel.Invite, gtserror.WithCode) {
+	invites, err := p.state.DB.GetAccountInvites(ctx, user.AccountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting invites: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiInvites := make([]*apimodel.Invite, 0, len(invites))
+	for _, invite := range invites {
+		apiInvites = append(apiInvites, p.tc.InviteToAPIInvite(invite))
+	}
+
+	return apiInvites, nil
+}
+
+// GetByCode returns the invite with the given code, if it exists.
+func (p *Processor) GetByCode(ctx context.Context, code string) (*apimodel.Invite, gtserror.WithCode) {
+	invite, err := p.state.DB.GetInviteByCode(ctx, code)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting invite: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		err := errors.New("invite not found")
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return p.tc.InviteToAPIInvite(invite), nil
+}
@@ -0,0 +1,92 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package invite
+
+import (
+	"crypto/rand"
+	"math/big"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+const (
+	// codeLength is the length of generated invite codes.
+	codeLength = 8
+
+	// codeAlphabet is the set of characters used in invite codes.
+	// Characters which are easily confused with one another when
+	// read aloud or written down (0/O, 1/l/I) are left out.
+	codeAlphabet = "23456789abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ"
+)
+
+type Processor struct {
+	state *state.State
+	tc    typeutils.TypeConverter
+}
+
+func New(state *state.State, tc typeutils.TypeConverter) Processor {
+	return Processor{
+		state: state,
+		tc:    tc,
+	}
+}
+
+// NewInvite returns a new invite created by the given account, with
+// a freshly generated code. A maxUses of 0 means the invite can be
+// used any number of times, and an expiresIn of 0 means it never
+// expires. The invite is not stored in the database.
+func NewInvite(accountID string, maxUses int, expiresIn time.Duration) (*gtsmodel.Invite, error) {
+	code, err := newCode()
+	if err != nil {
+		return nil, err
+	}
+
+	invite := &gtsmodel.Invite{
+		ID:        id.NewULID(),
+		Code:      code,
+		AccountID: accountID,
+		MaxUses:   maxUses,
+	}
+
+	if expiresIn > 0 {
+		invite.ExpiresAt = time.Now().Add(expiresIn)
+	}
+
+	return invite, nil
+}
+
+// newCode generates a random invite code.
+func newCode() (string, error) {
+	var (
+		code = make([]byte, codeLength)
+		max  = big.NewInt(int64(len(codeAlphabet)))
+	)
+
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = codeAlphabet[n.Int64()]
+	}
+
+	return string(code), nil
+}
@@ -0,0 +1,61 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package invite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Revoke revokes the invite with the given id, so that it
+// can no longer be used to sign up. Users can revoke their
+// own invites; admins can revoke anyone's invites.
+//
+// The invite is kept rather than deleted, since users who
+// already signed up with it still refer to it.
+func (p *Processor) Revoke(ctx context.Context, user *gtsmodel.User, id string) (*apimodel.Invite, gtserror.WithCode) {
+	invite, err := p.state.DB.GetInviteByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting invite: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if invite == nil || (invite.AccountID != user.AccountID && !*user.Admin) {
+		// Don't let on that someone
+		// else's invite exists.
+		err := fmt.Errorf("invite %s not found", id)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	now := time.Now()
+	if !invite.Expired(now) {
+		invite.ExpiresAt = now
+		if err := p.state.DB.UpdateInvite(ctx, invite, "expires_at"); err != nil {
+			err := gtserror.Newf("db error updating invite: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	return p.tc.InviteToAPIInvite(invite), nil
+}
@@ -18,6 +18,7 @@
 package processing
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
@@ -34,6 +35,10 @@ func (p *Processor) OAuthHandleTokenRequest(r *http.Request) (map[string]interfa
 	return p.oauthServer.HandleTokenRequest(r)
 }
 
+func (p *Processor) OAuthHandleRevokeRequest(ctx context.Context, clientID string, clientSecret string, token string) gtserror.WithCode {
+	return p.oauthServer.HandleRevokeRequest(ctx, clientID, clientSecret, token)
+}
+
 func (p *Processor) OAuthValidateBearerToken(r *http.Request) (oauth2.TokenInfo, error) {
 	// todo: some kind of metrics stuff here
 	return p.oauthServer.ValidationBearerToken(r)
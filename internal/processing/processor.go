@@ -28,19 +28,25 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/account"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/admin"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/announcements"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/fedi"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/invite"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/list"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/markers"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/media"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/push"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/report"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/search"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/status"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/stream"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/timeline"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/trends"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/user"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/translate"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/visibility"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
 )
 
 type Processor struct {
@@ -50,24 +56,29 @@ type Processor struct {
 	mediaManager *mm.Manager
 	state        *state.State
 	emailSender  email.Sender
+	webPush      webpush.Sender
 	filter       *visibility.Filter
 
 	/*
 		SUB-PROCESSORS
 	*/
 
-	account  account.Processor
-	admin    admin.Processor
-	fedi     fedi.Processor
-	list     list.Processor
-	markers  markers.Processor
-	media    media.Processor
-	report   report.Processor
-	search   search.Processor
-	status   status.Processor
-	stream   stream.Processor
-	timeline timeline.Processor
-	user     user.Processor
+	account       account.Processor
+	admin         admin.Processor
+	announcements announcements.Processor
+	fedi          fedi.Processor
+	invite        invite.Processor
+	list          list.Processor
+	markers       markers.Processor
+	media         media.Processor
+	push          push.Processor
+	report        report.Processor
+	search        search.Processor
+	status        status.Processor
+	stream        stream.Processor
+	timeline      timeline.Processor
+	trends        trends.Processor
+	user          user.Processor
 }
 
 func (p *Processor) Account() *account.Processor {
@@ -78,10 +89,18 @@ func (p *Processor) Admin() *admin.Processor {
 	return &p.admin
 }
 
+func (p *Processor) Announcements() *announcements.Processor {
+	return &p.announcements
+}
+
 func (p *Processor) Fedi() *fedi.Processor {
 	return &p.fedi
 }
 
+func (p *Processor) Invite() *invite.Processor {
+	return &p.invite
+}
+
 func (p *Processor) List() *list.Processor {
 	return &p.list
 }
@@ -94,6 +113,10 @@ func (p *Processor) Media() *media.Processor {
 	return &p.media
 }
 
+func (p *Processor) Push() *push.Processor {
+	return &p.push
+}
+
 func (p *Processor) Report() *report.Processor {
 	return &p.report
 }
@@ -114,6 +137,10 @@ func (p *Processor) Timeline() *timeline.Processor {
 	return &p.timeline
 }
 
+func (p *Processor) Trends() *trends.Processor {
+	return &p.trends
+}
+
 func (p *Processor) User() *user.Processor {
 	return &p.user
 }
@@ -126,11 +153,21 @@ func NewProcessor(
 	mediaManager *mm.Manager,
 	state *state.State,
 	emailSender email.Sender,
+	webPushSender webpush.Sender,
 ) *Processor {
 	parseMentionFunc := GetParseMentionFunc(state.DB, federator)
 
 	filter := visibility.NewFilter(state)
 
+	// Translation config is checked in config.Validate,
+	// so this should only fail if that was skipped. Don't
+	// fall back to noop here, as the instance model
+	// would still advertise translation as enabled.
+	translator, err := translate.New()
+	if err != nil {
+		log.Panicf(nil, "error creating translator: %v", err)
+	}
+
 	processor := &Processor{
 		federator:    federator,
 		tc:           tc,
@@ -139,19 +176,24 @@ func NewProcessor(
 		state:        state,
 		filter:       filter,
 		emailSender:  emailSender,
+		webPush:      webPushSender,
 	}
 
 	// Instantiate sub processors.
 	processor.account = account.New(state, tc, mediaManager, oauthServer, federator, filter, parseMentionFunc)
-	processor.admin = admin.New(state, tc, mediaManager, federator.TransportController(), emailSender)
+	processor.admin = admin.New(state, tc, mediaManager, federator.TransportController(), emailSender, parseMentionFunc)
+	processor.announcements = announcements.New(state, tc)
 	processor.fedi = fedi.New(state, tc, federator, filter)
+	processor.invite = invite.New(state, tc)
 	processor.list = list.New(state, tc)
 	processor.markers = markers.New(state, tc)
 	processor.media = media.New(state, tc, mediaManager, federator.TransportController())
+	processor.push = push.New(state, tc)
 	processor.report = report.New(state, tc)
 	processor.timeline = timeline.New(state, tc, filter)
+	processor.trends = trends.New(state, tc, filter)
 	processor.search = search.New(state, federator, tc, filter)
-	processor.status = status.New(state, federator, tc, filter, parseMentionFunc)
+	processor.status = status.New(state, federator, tc, filter, parseMentionFunc, translator)
 	processor.stream = stream.New(state, oauthServer)
 	processor.user = user.New(state, emailSender)
 
@@ -19,12 +19,16 @@ package processing_test
 
 import (
 	"context"
+	"encoding/json"
+	"sync"
 
 	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/email"
 	"github.com/superseriousbusiness/gotosocial/internal/federation"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
@@ -34,6 +38,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/visibility"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -51,6 +56,10 @@ type ProcessingStandardTestSuite struct {
 	oauthServer         oauth.Server
 	emailSender         email.Sender
 
+	// web push payloads delivered during
+	// a test, keyed by subscription ID
+	webPushes *sync.Map
+
 	// standard suite models
 	testTokens       map[string]*gtsmodel.Token
 	testClients      map[string]*gtsmodel.Client
@@ -122,7 +131,13 @@ func (suite *ProcessingStandardTestSuite) SetupTest() {
 	suite.oauthServer = testrig.NewTestOauthServer(suite.db)
 	suite.emailSender = testrig.NewEmailSender("../../web/template/", nil)
 
-	suite.processor = processing.NewProcessor(suite.typeconverter, suite.federator, suite.oauthServer, suite.mediaManager, &suite.state, suite.emailSender)
+	suite.webPushes = new(sync.Map)
+	webPushSender := webpush.NewNoopSender(&suite.state, func(_ context.Context, sub *gtsmodel.WebPushSubscription, payload []byte) error {
+		suite.webPushes.Store(sub.ID, payload)
+		return nil
+	})
+
+	suite.processor = processing.NewProcessor(suite.typeconverter, suite.federator, suite.oauthServer, suite.mediaManager, &suite.state, suite.emailSender, webPushSender)
 	suite.state.Workers.EnqueueClientAPI = suite.processor.EnqueueClientAPI
 	suite.state.Workers.EnqueueFederator = suite.processor.EnqueueFederator
 
@@ -136,6 +151,62 @@ func (suite *ProcessingStandardTestSuite) TearDownTest() {
 	testrig.StopWorkers(&suite.state)
 }
 
+// subscribeWebPush stores a web push subscription for the given
+// test account, made with the given test token, which has every
+// alert disabled and no policy restrictions. Callers can enable
+// the alerts they need with the given alter function.
+func (suite *ProcessingStandardTestSuite) subscribeWebPush(accountKey string, tokenKey string, alter func(*gtsmodel.WebPushSubscription)) *gtsmodel.WebPushSubscription {
+	sub := &gtsmodel.WebPushSubscription{
+		ID:                 id.NewULID(),
+		AccountID:          suite.testAccounts[accountKey].ID,
+		TokenID:            suite.testTokens[tokenKey].ID,
+		Endpoint:           "https://push.example.org/push/" + accountKey,
+		Auth:               "ZnJlc2hseSBnZW5lcmF0ZWQ",
+		P256dh:             "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4",
+		AlertFollow:        testrig.FalseBool(),
+		AlertFollowRequest: testrig.FalseBool(),
+		AlertFavourite:     testrig.FalseBool(),
+		AlertMention:       testrig.FalseBool(),
+		AlertReblog:        testrig.FalseBool(),
+		AlertPoll:          testrig.FalseBool(),
+		AlertStatus:        testrig.FalseBool(),
+		AlertAdminReport:   testrig.FalseBool(),
+		Policy:             gtsmodel.WebPushNotificationPolicyAll,
+	}
+
+	if alter != nil {
+		alter(sub)
+	}
+
+	if err := suite.db.PutWebPushSubscription(context.Background(), sub); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return sub
+}
+
+// webPushed waits for a web push payload to be delivered to
+// the given subscription, and returns it decoded from JSON.
+func (suite *ProcessingStandardTestSuite) webPushed(sub *gtsmodel.WebPushSubscription) (*apimodel.WebPushNotification, bool) {
+	// Pushes are delivered asynchronously,
+	// so give them a moment to arrive.
+	var v any
+	if !testrig.WaitFor(func() bool {
+		var ok bool
+		v, ok = suite.webPushes.Load(sub.ID)
+		return ok
+	}) {
+		return nil, false
+	}
+
+	pushed := &apimodel.WebPushNotification{}
+	if err := json.Unmarshal(v.([]byte), pushed); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return pushed, true
+}
+
 func (suite *ProcessingStandardTestSuite) openStreams(ctx context.Context, account *gtsmodel.Account, listIDs []string) map[string]*stream.Stream {
 	streams := make(map[string]*stream.Stream)
 
@@ -0,0 +1,125 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
+)
+
+// Create creates a web push subscription for the given access
+// token, replacing any existing subscription for that token.
+func (p *Processor) Create(ctx context.Context, account *gtsmodel.Account, accessToken string, form *apimodel.WebPushSubscriptionCreateRequest) (*apimodel.WebPushSubscription, gtserror.WithCode) {
+	if form.Subscription == nil {
+		err := errors.New("subscription must be provided")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	endpoint, err := url.Parse(form.Subscription.Endpoint)
+	if err != nil || endpoint.Scheme != "https" || endpoint.Host == "" {
+		err := errors.New("subscription endpoint must be an https URL")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	keys := form.Subscription.Keys
+	if err := webpush.ValidateKeys(keys.P256dh, keys.Auth); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	token, errWithCode := p.getToken(ctx, account, accessToken)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// There can only be one subscription per
+	// token, so remove any existing one first.
+	if err := p.state.DB.DeleteWebPushSubscriptionByTokenID(ctx, token.ID); err != nil {
+		err = fmt.Errorf("db error deleting existing push subscription: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	sub := &gtsmodel.WebPushSubscription{
+		ID:        id.NewULID(),
+		AccountID: account.ID,
+		TokenID:   token.ID,
+		Endpoint:  endpoint.String(),
+		Auth:      keys.Auth,
+		P256dh:    keys.P256dh,
+		Policy:    gtsmodel.WebPushNotificationPolicyAll,
+	}
+
+	if errWithCode := applyData(sub, form.Data); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.PutWebPushSubscription(ctx, sub); err != nil {
+		err = fmt.Errorf("db error putting push subscription: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiSub, err := p.tc.WebPushSubscriptionToAPIWebPushSubscription(ctx, sub)
+	if err != nil {
+		err = fmt.Errorf("error converting push subscription to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiSub, nil
+}
+
+// applyData sets the alerts and policy from the given
+// request data on sub. Unset alerts are disabled.
+func applyData(sub *gtsmodel.WebPushSubscription, data *apimodel.WebPushSubscriptionRequestData) gtserror.WithCode {
+	alerts := &apimodel.WebPushSubscriptionAlerts{}
+	if data != nil && data.Alerts != nil {
+		alerts = data.Alerts
+	}
+
+	sub.AlertFollow = &alerts.Follow
+	sub.AlertFollowRequest = &alerts.FollowRequest
+	sub.AlertFavourite = &alerts.Favourite
+	sub.AlertMention = &alerts.Mention
+	sub.AlertReblog = &alerts.Reblog
+	sub.AlertPoll = &alerts.Poll
+	sub.AlertStatus = &alerts.Status
+	sub.AlertAdminReport = &alerts.AdminReport
+
+	if data == nil || data.Policy == nil {
+		return nil
+	}
+
+	switch policy := gtsmodel.WebPushNotificationPolicy(*data.Policy); policy {
+	case gtsmodel.WebPushNotificationPolicyAll,
+		gtsmodel.WebPushNotificationPolicyFollowed,
+		gtsmodel.WebPushNotificationPolicyFollower,
+		gtsmodel.WebPushNotificationPolicyNone:
+		sub.Policy = policy
+	default:
+		err := fmt.Errorf("policy %s not recognized, must be one of all, followed, follower, none", policy)
+		return gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"context"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Get returns the web push subscription created
+// with the given access token, if there is one.
+func (p *Processor) Get(ctx context.Context, account *gtsmodel.Account, accessToken string) (*apimodel.WebPushSubscription, gtserror.WithCode) {
+	token, errWithCode := p.getToken(ctx, account, accessToken)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	sub, errWithCode := p.getSubscription(ctx, token)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiSub, err := p.tc.WebPushSubscriptionToAPIWebPushSubscription(ctx, sub)
+	if err != nil {
+		err = fmt.Errorf("error converting push subscription to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiSub, nil
+}
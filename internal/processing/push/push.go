@@ -0,0 +1,85 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+type Processor struct {
+	state *state.State
+	tc    typeutils.TypeConverter
+}
+
+func New(state *state.State, tc typeutils.TypeConverter) Processor {
+	return Processor{
+		state: state,
+		tc:    tc,
+	}
+}
+
+// getToken returns the token with the given
+// access code, which must belong to account.
+func (p *Processor) getToken(ctx context.Context, account *gtsmodel.Account, accessToken string) (*gtsmodel.Token, gtserror.WithCode) {
+	token := &gtsmodel.Token{}
+	if err := p.state.DB.GetWhere(ctx, []db.Where{{Key: "access", Value: accessToken}}, token); err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err := errors.New("token not found")
+			return nil, gtserror.NewErrorUnauthorized(err, err.Error())
+		}
+		err = fmt.Errorf("db error getting token: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	user, err := p.state.DB.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		err = fmt.Errorf("db error getting user: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if token.UserID != user.ID {
+		err := errors.New("token does not belong to account")
+		return nil, gtserror.NewErrorUnauthorized(err, err.Error())
+	}
+
+	return token, nil
+}
+
+// getSubscription returns the web push subscription
+// created with the given token, or 404 if there's none.
+func (p *Processor) getSubscription(ctx context.Context, token *gtsmodel.Token) (*gtsmodel.WebPushSubscription, gtserror.WithCode) {
+	sub, err := p.state.DB.GetWebPushSubscriptionByTokenID(ctx, token.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err := errors.New("push subscription not found")
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+		err = fmt.Errorf("db error getting push subscription: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return sub, nil
+}
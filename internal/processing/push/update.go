@@ -0,0 +1,58 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"context"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Update updates the alerts and policy of the web
+// push subscription created with the given access token.
+func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, accessToken string, form *apimodel.WebPushSubscriptionUpdateRequest) (*apimodel.WebPushSubscription, gtserror.WithCode) {
+	token, errWithCode := p.getToken(ctx, account, accessToken)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	sub, errWithCode := p.getSubscription(ctx, token)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if errWithCode := applyData(sub, form.Data); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.UpdateWebPushSubscription(ctx, sub); err != nil {
+		err = fmt.Errorf("db error updating push subscription: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiSub, err := p.tc.WebPushSubscriptionToAPIWebPushSubscription(ctx, sub)
+	if err != nil {
+		err = fmt.Errorf("error converting push subscription to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiSub, nil
+}
@@ -64,6 +64,16 @@ func (p *Processor) Create(ctx context.Context, account *gtsmodel.Account, form
 		}
 	}
 
+	category, rules, errWithCode := p.reportCategoryAndRules(ctx, form.Category, form.RuleIDs)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	ruleIDs := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		ruleIDs = append(ruleIDs, rule.ID)
+	}
+
 	reportID := id.NewULID()
 	report := &gtsmodel.Report{
 		ID:              reportID,
@@ -73,8 +83,11 @@ func (p *Processor) Create(ctx context.Context, account *gtsmodel.Account, form
 		TargetAccountID: form.AccountID,
 		TargetAccount:   targetAccount,
 		Comment:         form.Comment,
+		Category:        category,
 		StatusIDs:       form.StatusIDs,
 		Statuses:        statuses,
+		RuleIDs:         ruleIDs,
+		Rules:           rules,
 		Forwarded:       &form.Forward,
 	}
 
@@ -98,3 +111,69 @@ func (p *Processor) Create(ctx context.Context, account *gtsmodel.Account, form
 
 	return apiReport, nil
 }
+
+// reportCategoryAndRules parses the given report category, and fetches
+// the given rules, returning an error if either is not valid.
+//
+// If category is not set, it will default to 'violation' if any
+// rule IDs were given, and 'other' otherwise. Rules are only
+// kept if the category is 'violation'.
+func (p *Processor) reportCategoryAndRules(
+	ctx context.Context,
+	categoryStr string,
+	ruleIDs []string,
+) (gtsmodel.ReportCategory, []*gtsmodel.Rule, gtserror.WithCode) {
+	category := gtsmodel.ReportCategory(categoryStr)
+	switch category {
+	case "":
+		if len(ruleIDs) != 0 {
+			category = gtsmodel.ReportCategoryViolation
+		} else {
+			category = gtsmodel.ReportCategoryOther
+		}
+	case gtsmodel.ReportCategorySpam,
+		gtsmodel.ReportCategoryViolation,
+		gtsmodel.ReportCategoryLegal,
+		gtsmodel.ReportCategoryOther:
+		// No problem.
+	default:
+		err := fmt.Errorf("category %s not recognized, valid options are 'spam', 'violation', 'legal', 'other'", categoryStr)
+		return "", nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if category != gtsmodel.ReportCategoryViolation {
+		// Rules only make
+		// sense for violations.
+		return category, nil, nil
+	}
+
+	if len(ruleIDs) == 0 {
+		err := errors.New("at least one rule ID must be provided for category 'violation'")
+		return "", nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	rules, err := p.state.DB.GetRulesByIDs(ctx, ruleIDs)
+	if err != nil {
+		err = fmt.Errorf("db error fetching report rules: %w", err)
+		return "", nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Make sure every given rule exists
+	// and is currently active.
+	for _, id := range ruleIDs {
+		var found bool
+		for _, rule := range rules {
+			if rule.ID == id && !*rule.Deleted {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			err := fmt.Errorf("rule with ID %s does not exist", id)
+			return "", nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+	}
+
+	return category, rules, nil
+}
@@ -30,10 +30,12 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/langdetect"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/visibility"
 )
 
 // Create processes the given form to create a new status, returning the api model representation of that status if it's OK.
@@ -60,7 +62,7 @@ func (p *Processor) Create(ctx context.Context, account *gtsmodel.Account, appli
 		Text:                     form.Status,
 	}
 
-	if errWithCode := processReplyToID(ctx, p.state.DB, form, account.ID, newStatus); errWithCode != nil {
+	if errWithCode := processReplyToID(ctx, p.state.DB, p.filter, form, account, newStatus); errWithCode != nil {
 		return nil, errWithCode
 	}
 
@@ -96,7 +98,7 @@ func (p *Processor) Create(ctx context.Context, account *gtsmodel.Account, appli
 	return p.apiStatus(ctx, newStatus, account)
 }
 
-func processReplyToID(ctx context.Context, dbService db.DB, form *apimodel.AdvancedStatusCreateForm, thisAccountID string, status *gtsmodel.Status) gtserror.WithCode {
+func processReplyToID(ctx context.Context, dbService db.DB, filter *visibility.Filter, form *apimodel.AdvancedStatusCreateForm, thisAccount *gtsmodel.Account, status *gtsmodel.Status) gtserror.WithCode {
 	if form.InReplyToID == "" {
 		return nil
 	}
@@ -104,7 +106,7 @@ func processReplyToID(ctx context.Context, dbService db.DB, form *apimodel.Advan
 	// If this status is a reply to another status, we need to do a bit of work to establish whether or not this status can be posted:
 	//
 	// 1. Does the replied status exist in the database?
-	// 2. Is the replied status marked as replyable?
+	// 2. Is the replied status replyable by the current account, according to its flags + reply policy?
 	// 3. Does a block exist between either the current account or the account that posted the status it's replying to?
 	//
 	// If this is all OK, then we fetch the repliedStatus and the repliedAccount for later processing.
@@ -119,7 +121,10 @@ func processReplyToID(ctx context.Context, dbService db.DB, form *apimodel.Advan
 		err := fmt.Errorf("db error fetching status with id %s: %s", form.InReplyToID, err)
 		return gtserror.NewErrorInternalError(err)
 	}
-	if !*repliedStatus.Replyable {
+	if replyable, err := filter.StatusReplyable(ctx, thisAccount, repliedStatus); err != nil {
+		err := fmt.Errorf("error checking whether status with id %s is replyable: %w", form.InReplyToID, err)
+		return gtserror.NewErrorInternalError(err)
+	} else if !replyable {
 		err := fmt.Errorf("status with id %s is marked as not replyable", form.InReplyToID)
 		return gtserror.NewErrorForbidden(err, err.Error())
 	}
@@ -133,7 +138,7 @@ func processReplyToID(ctx context.Context, dbService db.DB, form *apimodel.Advan
 		return gtserror.NewErrorInternalError(err)
 	}
 
-	if blocked, err := dbService.IsEitherBlocked(ctx, thisAccountID, repliedAccount.ID); err != nil {
+	if blocked, err := dbService.IsEitherBlocked(ctx, thisAccount.ID, repliedAccount.ID); err != nil {
 		err := fmt.Errorf("db error checking block: %s", err)
 		return gtserror.NewErrorInternalError(err)
 	} else if blocked {
@@ -144,6 +149,7 @@ func processReplyToID(ctx context.Context, dbService db.DB, form *apimodel.Advan
 	status.InReplyToID = repliedStatus.ID
 	status.InReplyToURI = repliedStatus.URI
 	status.InReplyToAccountID = repliedAccount.ID
+	status.InReplyTo = repliedStatus
 
 	return nil
 }
@@ -212,8 +218,10 @@ func processVisibility(ctx context.Context, form *apimodel.AdvancedStatusCreateF
 
 	switch vis {
 	case gtsmodel.VisibilityPublic:
-		// for public, there's no need to change any of the advanced flags from true regardless of what the user filled out
-		break
+		// for public, the only advanced flag that can be changed is federated, so that local-only public posts are possible
+		if form.Federated != nil {
+			federated = *form.Federated
+		}
 	case gtsmodel.VisibilityUnlocked:
 		// for unlocked the user can set any combination of flags they like so look at them all to see if they're set and then apply them
 		if form.Federated != nil {
@@ -256,18 +264,38 @@ func processVisibility(ctx context.Context, form *apimodel.AdvancedStatusCreateF
 		likeable = true
 	}
 
+	// Replies to local-only statuses must stay local too,
+	// otherwise the reply would leak the thread to remotes.
+	if status.InReplyTo != nil && status.InReplyTo.IsLocalOnly() {
+		federated = false
+	}
+
 	status.Visibility = vis
 	status.Federated = &federated
 	status.Boostable = &boostable
 	status.Replyable = &replyable
 	status.Likeable = &likeable
+
+	// Interaction policies can be set on top of
+	// the above flags for any visibility level.
+	status.ReplyPolicy = gtsmodel.InteractionPolicy(form.ReplyPolicy)
+	status.BoostPolicy = gtsmodel.InteractionPolicy(form.BoostPolicy)
+	status.LikePolicy = gtsmodel.InteractionPolicy(form.LikePolicy)
 	return nil
 }
 
 func processLanguage(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, accountDefaultLanguage string, status *gtsmodel.Status) error {
-	if form.Language != "" {
+	switch {
+	case form.Language != "":
 		status.Language = form.Language
-	} else {
+	case form.Status != "":
+		// Client didn't say, so try to figure it out
+		// from the text, falling back to account default.
+		status.Language = langdetect.Detect(form.SpoilerText + "\n" + form.Status)
+		if status.Language == "" {
+			status.Language = accountDefaultLanguage
+		}
+	default:
 		status.Language = accountDefaultLanguage
 	}
 	if status.Language == "" {
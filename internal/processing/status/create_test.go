@@ -19,6 +19,7 @@ package status_test
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -208,6 +209,143 @@ func (suite *StatusCreateTestSuite) TestProcessMediaDescriptionTooShort() {
 	suite.Nil(apiStatus)
 }
 
+func (suite *StatusCreateTestSuite) TestProcessInteractionPolicies() {
+	ctx := context.Background()
+
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	statusCreateForm := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "only my followers can reply to this, and nobody can like it",
+			Visibility:  apimodel.VisibilityPublic,
+			Language:    "en",
+			ContentType: apimodel.StatusContentTypePlain,
+		},
+		AdvancedVisibilityFlagsForm: apimodel.AdvancedVisibilityFlagsForm{
+			ReplyPolicy: "followers",
+			LikePolicy:  "nobody",
+		},
+	}
+
+	apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+	suite.NoError(err)
+	suite.NotNil(apiStatus)
+	suite.Equal(apimodel.StatusInteractionPolicy{
+		CanReply:     "followers",
+		CanReblog:    "anyone",
+		CanFavourite: "nobody",
+	}, apiStatus.InteractionPolicy)
+
+	dbStatus, dbErr := suite.db.GetStatusByID(ctx, apiStatus.ID)
+	suite.NoError(dbErr)
+	suite.Equal(gtsmodel.InteractionPolicyFollowers, dbStatus.ReplyPolicy)
+	suite.Equal(gtsmodel.InteractionPolicy(""), dbStatus.BoostPolicy)
+	suite.Equal(gtsmodel.InteractionPolicyNobody, dbStatus.LikePolicy)
+}
+
+func (suite *StatusCreateTestSuite) TestReplyNotPermittedByPolicy() {
+	ctx := context.Background()
+
+	// Only mentioned accounts may reply to this
+	// status, and it doesn't mention local_account_2.
+	repliedStatus := new(gtsmodel.Status)
+	*repliedStatus = *suite.testStatuses["local_account_1_status_1"]
+	repliedStatus.ReplyPolicy = gtsmodel.InteractionPolicyMentioned
+	if err := suite.db.UpdateStatus(ctx, repliedStatus, "reply_policy"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	statusCreateForm := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "hey zork",
+			InReplyToID: repliedStatus.ID,
+			Visibility:  apimodel.VisibilityPublic,
+			Language:    "en",
+			ContentType: apimodel.StatusContentTypePlain,
+		},
+	}
+
+	apiStatus, errWithCode := suite.status.Create(ctx,
+		suite.testAccounts["local_account_2"],
+		suite.testApplications["application_1"],
+		statusCreateForm,
+	)
+	suite.Nil(apiStatus)
+	suite.EqualError(errWithCode, "status with id "+repliedStatus.ID+" is marked as not replyable")
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	// Author can still reply to their own status though.
+	apiStatus, errWithCode = suite.status.Create(ctx,
+		suite.testAccounts["local_account_1"],
+		suite.testApplications["application_1"],
+		statusCreateForm,
+	)
+	suite.NoError(errWithCode)
+	suite.NotNil(apiStatus)
+}
+
+func (suite *StatusCreateTestSuite) TestProcessPublicLocalOnly() {
+	ctx := context.Background()
+
+	federated := false
+	statusCreateForm := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "this one stays on the instance",
+			Visibility:  apimodel.VisibilityPublic,
+			Language:    "en",
+			ContentType: apimodel.StatusContentTypePlain,
+		},
+		AdvancedVisibilityFlagsForm: apimodel.AdvancedVisibilityFlagsForm{
+			Federated: &federated,
+		},
+	}
+
+	apiStatus, errWithCode := suite.status.Create(ctx,
+		suite.testAccounts["local_account_1"],
+		suite.testApplications["application_1"],
+		statusCreateForm,
+	)
+	suite.NoError(errWithCode)
+	suite.True(apiStatus.LocalOnly)
+
+	dbStatus, err := suite.db.GetStatusByID(ctx, apiStatus.ID)
+	suite.NoError(err)
+	suite.Equal(gtsmodel.VisibilityPublic, dbStatus.Visibility)
+	suite.True(dbStatus.IsLocalOnly())
+}
+
+func (suite *StatusCreateTestSuite) TestReplyToLocalOnlyIsLocalOnly() {
+	ctx := context.Background()
+
+	// This status is local-only.
+	repliedStatus := suite.testStatuses["local_account_1_status_2"]
+
+	// Reply doesn't ask to be local-only,
+	// but should inherit it from the parent.
+	statusCreateForm := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "replying to a local-only post",
+			InReplyToID: repliedStatus.ID,
+			Visibility:  apimodel.VisibilityPublic,
+			Language:    "en",
+			ContentType: apimodel.StatusContentTypePlain,
+		},
+	}
+
+	apiStatus, errWithCode := suite.status.Create(ctx,
+		suite.testAccounts["local_account_2"],
+		suite.testApplications["application_1"],
+		statusCreateForm,
+	)
+	suite.NoError(errWithCode)
+	suite.True(apiStatus.LocalOnly)
+
+	dbStatus, err := suite.db.GetStatusByID(ctx, apiStatus.ID)
+	suite.NoError(err)
+	suite.True(dbStatus.IsLocalOnly())
+}
+
 func TestStatusCreateTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusCreateTestSuite))
 }
@@ -152,7 +152,13 @@ func (p *Processor) getFaveTarget(ctx context.Context, requestingAccount *gtsmod
 		return nil, nil, errWithCode
 	}
 
-	if !*targetStatus.Likeable {
+	likeable, err := p.filter.StatusLikeable(ctx, requestingAccount, targetStatus)
+	if err != nil {
+		err = fmt.Errorf("getFaveTarget: error checking status likeability: %w", err)
+		return nil, nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if !likeable {
 		err := errors.New("status is not faveable")
 		return nil, nil, gtserror.NewErrorForbidden(err, err.Error())
 	}
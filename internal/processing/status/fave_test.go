@@ -0,0 +1,57 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package status_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type StatusFaveTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusFaveTestSuite) TestFaveNotPermittedByPolicy() {
+	ctx := context.Background()
+
+	// Only followers of admin_account may like this
+	// status, and local_account_2 doesn't follow them.
+	targetStatus := new(gtsmodel.Status)
+	*targetStatus = *suite.testStatuses["admin_account_status_1"]
+	targetStatus.LikePolicy = gtsmodel.InteractionPolicyFollowers
+	if err := suite.db.UpdateStatus(ctx, targetStatus, "like_policy"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiStatus, errWithCode := suite.status.FaveCreate(ctx, suite.testAccounts["local_account_2"], targetStatus.ID)
+	suite.Nil(apiStatus)
+	suite.EqualError(errWithCode, "status is not faveable")
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	// local_account_1 follows admin_account though.
+	apiStatus, errWithCode = suite.status.FaveCreate(ctx, suite.testAccounts["local_account_1"], targetStatus.ID)
+	suite.NoError(errWithCode)
+	suite.True(apiStatus.Favourited)
+}
+
+func TestStatusFaveTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusFaveTestSuite))
+}
@@ -0,0 +1,189 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/regexes"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// ReactionCreate adds an emoji reaction for the requestingAccount, targeting the given status
+// (no-op if the reaction already exists). Emoji should be either a unicode emoji, the shortcode
+// of a local custom emoji, or shortcode@domain of a remote custom emoji known to this instance.
+func (p *Processor) ReactionCreate(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string, emoji string) (*apimodel.Status, gtserror.WithCode) {
+	targetStatus, name, existingReaction, errWithCode := p.getReactionTarget(ctx, requestingAccount, targetStatusID, emoji)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if existingReaction != nil {
+		// Already reacted with this emoji.
+		return p.apiStatus(ctx, targetStatus, requestingAccount)
+	}
+
+	customEmoji, errWithCode := p.getReactionEmoji(ctx, name)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Create and store a new reaction.
+	reactionID := id.NewULID()
+	reaction := &gtsmodel.StatusReaction{
+		ID:              reactionID,
+		AccountID:       requestingAccount.ID,
+		Account:         requestingAccount,
+		TargetAccountID: targetStatus.AccountID,
+		TargetAccount:   targetStatus.Account,
+		StatusID:        targetStatus.ID,
+		Status:          targetStatus,
+		Name:            name,
+		URI:             uris.GenerateURIForLike(requestingAccount.Username, reactionID),
+	}
+
+	if customEmoji != nil {
+		reaction.EmojiID = customEmoji.ID
+		reaction.Emoji = customEmoji
+	}
+
+	if err := p.state.DB.PutStatusReaction(ctx, reaction); err != nil {
+		err = fmt.Errorf("ReactionCreate: error putting reaction in database: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Process new status reaction side effects.
+	p.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ActivityEmojiReact,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       reaction,
+		OriginAccount:  requestingAccount,
+		TargetAccount:  targetStatus.Account,
+	})
+
+	return p.apiStatus(ctx, targetStatus, requestingAccount)
+}
+
+// ReactionRemove removes an emoji reaction for the requesting account, targeting
+// the given status (no-op if the reaction doesn't exist).
+func (p *Processor) ReactionRemove(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string, emoji string) (*apimodel.Status, gtserror.WithCode) {
+	targetStatus, _, existingReaction, errWithCode := p.getReactionTarget(ctx, requestingAccount, targetStatusID, emoji)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if existingReaction == nil {
+		// Never reacted with this emoji.
+		return p.apiStatus(ctx, targetStatus, requestingAccount)
+	}
+
+	// We have a reaction to remove.
+	if err := p.state.DB.DeleteStatusReactionByID(ctx, existingReaction.ID); err != nil {
+		err = fmt.Errorf("ReactionRemove: error removing status reaction: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Process remove status reaction side effects.
+	p.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ActivityEmojiReact,
+		APActivityType: ap.ActivityUndo,
+		GTSModel:       existingReaction,
+		OriginAccount:  requestingAccount,
+		TargetAccount:  targetStatus.Account,
+	})
+
+	return p.apiStatus(ctx, targetStatus, requestingAccount)
+}
+
+// getReactionTarget checks that the requesting account may react to the
+// target status, and returns it along with the normalized reaction name,
+// and the requesting account's existing reaction with that name, if any.
+func (p *Processor) getReactionTarget(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string, emoji string) (*gtsmodel.Status, string, *gtsmodel.StatusReaction, gtserror.WithCode) {
+	targetStatus, errWithCode := p.getVisibleStatus(ctx, requestingAccount, targetStatusID)
+	if errWithCode != nil {
+		return nil, "", nil, errWithCode
+	}
+
+	// Reactions are subject to
+	// the status' like policy.
+	likeable, err := p.filter.StatusLikeable(ctx, requestingAccount, targetStatus)
+	if err != nil {
+		err = fmt.Errorf("getReactionTarget: error checking status likeability: %w", err)
+		return nil, "", nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if !likeable {
+		err := errors.New("status does not accept reactions")
+		return nil, "", nil, gtserror.NewErrorForbidden(err, err.Error())
+	}
+
+	// Clients may wrap shortcodes in colons.
+	name := strings.Trim(strings.TrimSpace(emoji), ":")
+	if name == "" {
+		err := errors.New("emoji must be provided")
+		return nil, "", nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	reaction, err := p.state.DB.GetStatusReaction(ctx, targetStatus.ID, requestingAccount.ID, name)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = fmt.Errorf("getReactionTarget: error checking existing reaction: %w", err)
+		return nil, "", nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return targetStatus, name, reaction, nil
+}
+
+// getReactionEmoji checks that the given reaction name is either
+// a unicode emoji, in which case it returns nil, or the shortcode
+// of an enabled custom emoji known to this instance, optionally
+// suffixed with @domain for remote emojis, in which case it
+// returns that emoji.
+func (p *Processor) getReactionEmoji(ctx context.Context, name string) (*gtsmodel.Emoji, gtserror.WithCode) {
+	shortcode, domain, _ := strings.Cut(name, "@")
+	if regexes.EmojiShortcode.FindString(shortcode) != shortcode {
+		// Not a shortcode,
+		// must be unicode.
+		if err := validate.UnicodeEmoji(name); err != nil {
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		return nil, nil
+	}
+
+	emoji, err := p.state.DB.GetEmojiByShortcodeDomain(ctx, shortcode, domain)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if emoji == nil || *emoji.Disabled {
+		err := fmt.Errorf("custom emoji %s not found", name)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	return emoji, nil
+}
@@ -0,0 +1,83 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type StatusReactionTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusReactionTestSuite) TestReactionCreateRemove() {
+	ctx := context.Background()
+
+	requestingAccount := suite.testAccounts["local_account_1"]
+	targetStatus := suite.testStatuses["admin_account_status_1"]
+	emoji := suite.testEmojis["rainbow"]
+
+	_, errWithCode := suite.status.ReactionCreate(ctx, requestingAccount, targetStatus.ID, "🐕")
+	suite.NoError(errWithCode)
+
+	// Colons around the shortcode should be ignored.
+	_, errWithCode = suite.status.ReactionCreate(ctx, requestingAccount, targetStatus.ID, ":"+emoji.Shortcode+":")
+	suite.NoError(errWithCode)
+
+	// Reacting again with the same emoji is a no-op.
+	apiStatus, errWithCode := suite.status.ReactionCreate(ctx, requestingAccount, targetStatus.ID, "🐕")
+	suite.NoError(errWithCode)
+
+	if suite.Len(apiStatus.EmojiReactions, 2) {
+		suite.Equal("🐕", apiStatus.EmojiReactions[0].Name)
+		suite.Equal(1, apiStatus.EmojiReactions[0].Count)
+		suite.True(apiStatus.EmojiReactions[0].Me)
+		suite.Empty(apiStatus.EmojiReactions[0].URL)
+		suite.Equal(emoji.Shortcode, apiStatus.EmojiReactions[1].Name)
+		suite.Equal(emoji.ImageURL, apiStatus.EmojiReactions[1].URL)
+	}
+
+	apiStatus, errWithCode = suite.status.ReactionRemove(ctx, requestingAccount, targetStatus.ID, "🐕")
+	suite.NoError(errWithCode)
+	if suite.Len(apiStatus.EmojiReactions, 1) {
+		suite.Equal(emoji.Shortcode, apiStatus.EmojiReactions[0].Name)
+	}
+}
+
+func (suite *StatusReactionTestSuite) TestReactionCreateInvalid() {
+	ctx := context.Background()
+
+	requestingAccount := suite.testAccounts["local_account_1"]
+	targetStatus := suite.testStatuses["admin_account_status_1"]
+
+	apiStatus, errWithCode := suite.status.ReactionCreate(ctx, requestingAccount, targetStatus.ID, "not an emoji")
+	suite.Nil(apiStatus)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+
+	apiStatus, errWithCode = suite.status.ReactionCreate(ctx, requestingAccount, targetStatus.ID, ":does_not_exist:")
+	suite.Nil(apiStatus)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func TestStatusReactionTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusReactionTestSuite))
+}
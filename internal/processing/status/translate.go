@@ -0,0 +1,118 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/translate"
+)
+
+// Translate translates the content, content warning, and media descriptions
+// of the target status into the target language, which should be a BCP 47
+// language tag. If target language is empty, the language of the requesting
+// account will be used instead. Results are cached per status and language.
+func (p *Processor) Translate(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string, targetLang string) (*apimodel.Translation, gtserror.WithCode) {
+	if !p.translator.Enabled() {
+		err := errors.New("translation is not enabled on this instance")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	targetStatus, errWithCode := p.getVisibleStatus(ctx, requestingAccount, targetStatusID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Only translate statuses that are public or unlisted,
+	// so that we never send private content to a third party.
+	if targetStatus.Visibility != gtsmodel.VisibilityPublic &&
+		targetStatus.Visibility != gtsmodel.VisibilityUnlocked {
+		err := gtserror.Newf("status %s is not public or unlisted", targetStatus.ID)
+		return nil, gtserror.NewErrorForbidden(err, "only public or unlisted statuses can be translated")
+	}
+
+	if targetLang == "" {
+		targetLang = requestingAccount.Language
+	}
+
+	if targetLang == "" {
+		targetLang = "en"
+	}
+
+	// Compare base languages, so that we don't
+	// "translate" en-GB into en, or vice versa.
+	if translate.BaseLanguage(targetLang) == translate.BaseLanguage(targetStatus.Language) {
+		err := gtserror.Newf("status %s is already in language %s", targetStatus.ID, targetLang)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, "status is already in the target language")
+	}
+
+	cacheKey := targetStatus.ID + "/" + targetLang
+	if translation, ok := p.state.Caches.GTS.Translation().Get(cacheKey); ok {
+		return translation, nil
+	}
+
+	// Gather everything translatable into one slice of texts,
+	// so that the whole status is translated in a single request.
+	// Texts are ordered content, content warning, then descriptions;
+	// only the content is html, everything else is plain text.
+	texts := make([]translate.Text, 0, 2+len(targetStatus.Attachments))
+	texts = append(texts,
+		translate.Text{Text: targetStatus.Content, HTML: true},
+		translate.Text{Text: targetStatus.ContentWarning},
+	)
+	for _, attachment := range targetStatus.Attachments {
+		texts = append(texts, translate.Text{Text: attachment.Description})
+	}
+
+	result, err := p.translator.Translate(ctx, texts, targetStatus.Language, targetLang)
+	if err != nil {
+		err := gtserror.Newf("error translating status %s: %w", targetStatus.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if len(result.Texts) != len(texts) {
+		err := gtserror.Newf("translator returned %d texts, expected %d", len(result.Texts), len(texts))
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Translations come back from a third party,
+	// so sanitize them like any other user input.
+	translation := &apimodel.Translation{
+		Content:                text.SanitizeHTML(result.Texts[0]),
+		SpoilerText:            text.SanitizePlaintext(result.Texts[1]),
+		MediaAttachments:       make([]apimodel.TranslationAttachment, 0, len(targetStatus.Attachments)),
+		DetectedSourceLanguage: result.SourceLanguage,
+		Provider:               result.Provider,
+	}
+
+	for i, attachment := range targetStatus.Attachments {
+		translation.MediaAttachments = append(translation.MediaAttachments, apimodel.TranslationAttachment{
+			ID:          attachment.ID,
+			Description: text.SanitizePlaintext(result.Texts[2+i]),
+		})
+	}
+
+	p.state.Caches.GTS.Translation().Set(cacheKey, translation)
+
+	return translation, nil
+}
@@ -0,0 +1,126 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/status"
+	"github.com/superseriousbusiness/gotosocial/internal/translate"
+	"github.com/superseriousbusiness/gotosocial/internal/visibility"
+)
+
+type StatusTranslateTestSuite struct {
+	StatusStandardTestSuite
+}
+
+// fakeTranslator "translates" texts by wrapping
+// them in a script tag, and records what it was given.
+type fakeTranslator struct {
+	texts []translate.Text
+}
+
+func (f *fakeTranslator) Enabled() bool {
+	return true
+}
+
+func (f *fakeTranslator) Translate(_ context.Context, texts []translate.Text, _ string, _ string) (*translate.Translation, error) {
+	f.texts = texts
+
+	translated := make([]string, len(texts))
+	for i, t := range texts {
+		translated[i] = t.Text + "<script>alert('translated')</script>"
+	}
+
+	return &translate.Translation{
+		Texts:          translated,
+		SourceLanguage: "en",
+		Provider:       "Fake",
+	}, nil
+}
+
+func (suite *StatusTranslateTestSuite) statusWithTranslator(translator translate.Translator) *status.Processor {
+	p := status.New(
+		&suite.state,
+		suite.federator,
+		suite.typeConverter,
+		visibility.NewFilter(&suite.state),
+		processing.GetParseMentionFunc(suite.db, suite.federator),
+		translator,
+	)
+	return &p
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslate() {
+	ctx := context.Background()
+
+	translator := &fakeTranslator{}
+	requestingAccount := suite.testAccounts["local_account_1"]
+	targetStatus := suite.testStatuses["admin_account_status_1"]
+
+	translation, errWithCode := suite.statusWithTranslator(translator).Translate(ctx, requestingAccount, targetStatus.ID, "de")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Only the content should be sent as html.
+	suite.Len(translator.texts, 2)
+	suite.True(translator.texts[0].HTML)
+	suite.False(translator.texts[1].HTML)
+
+	// Whatever comes back should be sanitized.
+	suite.NotContains(translation.Content, "<script>")
+	suite.NotContains(translation.SpoilerText, "<script>")
+	suite.Equal("Fake", translation.Provider)
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslateSameBaseLanguage() {
+	ctx := context.Background()
+
+	translator := &fakeTranslator{}
+	requestingAccount := suite.testAccounts["local_account_1"]
+	targetStatus := suite.testStatuses["admin_account_status_1"]
+
+	// Status is in "en", so there's
+	// nothing to translate for "en-GB".
+	translation, errWithCode := suite.statusWithTranslator(translator).Translate(ctx, requestingAccount, targetStatus.ID, "en-GB")
+	suite.Nil(translation)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	suite.Nil(translator.texts)
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslateNotEnabled() {
+	ctx := context.Background()
+
+	requestingAccount := suite.testAccounts["local_account_1"]
+	targetStatus := suite.testStatuses["admin_account_status_1"]
+
+	translation, errWithCode := suite.status.Translate(ctx, requestingAccount, targetStatus.ID, "de")
+	suite.Nil(translation)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+}
+
+func TestStatusTranslateTestSuite(t *testing.T) {
+	suite.Run(t, &StatusTranslateTestSuite{})
+}
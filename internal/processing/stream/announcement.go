@@ -0,0 +1,51 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package stream
+
+import (
+	"encoding/json"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/stream"
+)
+
+// Announcement streams the given new or updated announcement to *ALL* open user streams.
+func (p *Processor) Announcement(a *apimodel.Announcement) error {
+	bytes, err := json.Marshal(a)
+	if err != nil {
+		return fmt.Errorf("error marshalling announcement to json: %s", err)
+	}
+
+	return p.toAllAccounts(string(bytes), stream.EventTypeAnnouncement, []string{stream.TimelineHome})
+}
+
+// AnnouncementReaction streams the given changed announcement reaction to *ALL* open user streams.
+func (p *Processor) AnnouncementReaction(r *apimodel.AnnouncementReaction) error {
+	bytes, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("error marshalling announcement reaction to json: %s", err)
+	}
+
+	return p.toAllAccounts(string(bytes), stream.EventTypeAnnouncementReaction, []string{stream.TimelineHome})
+}
+
+// AnnouncementDelete streams the delete of the given announcementID to *ALL* open user streams.
+func (p *Processor) AnnouncementDelete(announcementID string) error {
+	return p.toAllAccounts(announcementID, stream.EventTypeAnnouncementDelete, []string{stream.TimelineHome})
+}
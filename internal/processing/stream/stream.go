@@ -18,6 +18,8 @@
 package stream
 
 import (
+	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
@@ -77,3 +79,32 @@ func (p *Processor) toAccount(payload string, event string, streamTypes []string
 
 	return nil
 }
+
+// toAllAccounts streams the given payload with the given event type to any streams currently open for any account.
+func (p *Processor) toAllAccounts(payload string, event string, streamTypes []string) error {
+	errs := []string{}
+
+	// get all account IDs with open streams
+	accountIDs := []string{}
+	p.streamMap.Range(func(k interface{}, _ interface{}) bool {
+		key, ok := k.(string)
+		if !ok {
+			panic("streamMap key was not a string (account id)")
+		}
+
+		accountIDs = append(accountIDs, key)
+		return true
+	})
+
+	for _, accountID := range accountIDs {
+		if err := p.toAccount(payload, event, streamTypes, accountID); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	if len(errs) != 0 {
+		return fmt.Errorf("one or more errors streaming %s: %s", event, strings.Join(errs, ";"))
+	}
+
+	return nil
+}
@@ -24,27 +24,65 @@ import (
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
-func (p *Processor) NotificationsGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, minID string, limit int, excludeTypes []string) (*apimodel.PageableResponse, gtserror.WithCode) {
-	notifs, err := p.state.DB.GetAccountNotifications(ctx, authed.Account.ID, maxID, sinceID, minID, limit, excludeTypes)
+func (p *Processor) NotificationsGet(ctx context.Context, authed *oauth.Auth, page *paging.Pager, types []string, excludeTypes []string, accountID string) (*apimodel.PageableResponse, gtserror.WithCode) {
+	notifs, nextMaxIDValue, prevMinIDValue, errWithCode := p.getNotifications(ctx, authed, page, types, excludeTypes, accountID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if nextMaxIDValue == "" {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	items := make([]interface{}, 0, len(notifs))
+	for _, n := range notifs {
+		items = append(items, n)
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:          items,
+		Path:           "api/v1/notifications",
+		NextMaxIDValue: nextMaxIDValue,
+		PrevMinIDValue: prevMinIDValue,
+		Limit:          page.Limit,
+	})
+}
+
+// getNotifications fetches a page of notifications targeting the authed account,
+// filters them for visibility and converts them to their API representation.
+// The returned next max ID and prev min ID values are taken from the page before
+// filtering, so that the caller can still page properly; they are empty if the
+// page contained no notifications at all.
+func (p *Processor) getNotifications(
+	ctx context.Context,
+	authed *oauth.Auth,
+	page *paging.Pager,
+	types []string,
+	excludeTypes []string,
+	accountID string,
+) ([]*apimodel.Notification, string, string, gtserror.WithCode) {
+	notifs, err := p.state.DB.GetAccountNotifications(ctx, authed.Account.ID, page, types, excludeTypes, accountID)
 	if err != nil && !errors.Is(err, db.ErrNoEntries) {
-		err = fmt.Errorf("NotificationsGet: db error getting notifications: %w", err)
-		return nil, gtserror.NewErrorInternalError(err)
+		err = fmt.Errorf("getNotifications: db error getting notifications: %w", err)
+		return nil, "", "", gtserror.NewErrorInternalError(err)
 	}
 
 	count := len(notifs)
 	if count == 0 {
-		return util.EmptyPageableResponse(), nil
+		return nil, "", "", nil
 	}
 
 	var (
-		items          = make([]interface{}, 0, count)
+		items          = make([]*apimodel.Notification, 0, count)
 		nextMaxIDValue string
 		prevMinIDValue string
 	)
@@ -96,13 +134,7 @@ func (p *Processor) NotificationsGet(ctx context.Context, authed *oauth.Auth, ma
 		items = append(items, item)
 	}
 
-	return util.PackagePageableResponse(util.PageableResponseParams{
-		Items:          items,
-		Path:           "api/v1/notifications",
-		NextMaxIDValue: nextMaxIDValue,
-		PrevMinIDValue: prevMinIDValue,
-		Limit:          limit,
-	})
+	return items, nextMaxIDValue, prevMinIDValue, nil
 }
 
 func (p *Processor) NotificationGet(ctx context.Context, account *gtsmodel.Account, targetNotifID string) (*apimodel.Notification, gtserror.WithCode) {
@@ -142,3 +174,57 @@ func (p *Processor) NotificationsClear(ctx context.Context, authed *oauth.Auth)
 
 	return nil
 }
+
+// NotificationDismiss deletes the notification with the given
+// ID, if it exists and targets the given account.
+func (p *Processor) NotificationDismiss(ctx context.Context, account *gtsmodel.Account, targetNotifID string) gtserror.WithCode {
+	notif, err := p.state.DB.GetNotificationByID(gtscontext.SetBarebones(ctx), targetNotifID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return gtserror.NewErrorNotFound(err)
+		}
+
+		// Real error.
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if notifTargetAccountID := notif.TargetAccountID; notifTargetAccountID != account.ID {
+		err = fmt.Errorf("account %s does not have permission to dismiss notification belonging to account %s", account.ID, notifTargetAccountID)
+		return gtserror.NewErrorNotFound(err)
+	}
+
+	if err := p.state.DB.DeleteNotificationByID(ctx, notif.ID); err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+// NotificationsUnreadCount returns the number of notifications targeting the
+// authed account that are newer than its notifications timeline marker, up to
+// limit. If the account has no notifications marker, all notifications count.
+func (p *Processor) NotificationsUnreadCount(ctx context.Context, authed *oauth.Auth, limit int, types []string, excludeTypes []string) (*apimodel.NotificationsUnreadCount, gtserror.WithCode) {
+	var lastReadID string
+
+	marker, err := p.state.DB.GetMarker(ctx, authed.Account.ID, gtsmodel.MarkerNameNotifications)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting notifications marker: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if marker != nil {
+		lastReadID = marker.LastReadID
+	}
+
+	count, err := p.state.DB.CountAccountNotifications(ctx, authed.Account.ID, lastReadID, types, excludeTypes)
+	if err != nil {
+		err = gtserror.Newf("db error counting notifications: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if limit > 0 && count > limit {
+		count = limit
+	}
+
+	return &apimodel.NotificationsUnreadCount{Count: count}, nil
+}
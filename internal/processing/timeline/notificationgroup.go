@@ -0,0 +1,157 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package timeline
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"golang.org/x/exp/slices"
+)
+
+// maxGroupSampleAccounts is the maximum number
+// of sample account IDs included in one group.
+const maxGroupSampleAccounts = 8
+
+// NotificationsGetGrouped returns a page of notifications targeting the authed
+// account, with faves and boosts of the same status collapsed into one group.
+//
+// Grouping is done within the requested page of notifications, so the page
+// boundaries (and Link header) are still given by notification IDs.
+func (p *Processor) NotificationsGetGrouped(ctx context.Context, authed *oauth.Auth, page *paging.Pager, types []string, excludeTypes []string, accountID string) (*apimodel.GroupedNotificationsResults, string, gtserror.WithCode) {
+	notifs, nextMaxIDValue, prevMinIDValue, errWithCode := p.getNotifications(ctx, authed, page, types, excludeTypes, accountID)
+	if errWithCode != nil {
+		return nil, "", errWithCode
+	}
+
+	results := groupNotifications(notifs)
+	if nextMaxIDValue == "" || len(results.NotificationGroups) == 0 {
+		return results, "", nil
+	}
+
+	items := make([]interface{}, 0, len(results.NotificationGroups))
+	for _, group := range results.NotificationGroups {
+		items = append(items, group)
+	}
+
+	resp, errWithCode := util.PackagePageableResponse(util.PageableResponseParams{
+		Items:          items,
+		Path:           "api/v2/notifications",
+		NextMaxIDValue: nextMaxIDValue,
+		PrevMinIDValue: prevMinIDValue,
+		Limit:          page.Limit,
+	})
+	if errWithCode != nil {
+		return nil, "", errWithCode
+	}
+
+	return results, resp.LinkHeader, nil
+}
+
+// groupNotifications groups the given notifications, which
+// must be sorted by ID descending. Faves and boosts of the same
+// status are collapsed into one group; all other notifications
+// get a group of their own. Groups are returned ordered by their
+// most recent notification, and accounts + statuses are deduplicated.
+func groupNotifications(notifs []*apimodel.Notification) *apimodel.GroupedNotificationsResults {
+	var (
+		results = &apimodel.GroupedNotificationsResults{
+			Accounts:           make([]*apimodel.Account, 0, len(notifs)),
+			Statuses:           make([]*apimodel.Status, 0, len(notifs)),
+			NotificationGroups: make([]*apimodel.NotificationGroup, 0, len(notifs)),
+		}
+		groups   = make(map[string]*apimodel.NotificationGroup, len(notifs))
+		accounts = make(map[string]struct{}, len(notifs))
+		statuses = make(map[string]struct{}, len(notifs))
+	)
+
+	for _, n := range notifs {
+		var statusID string
+		if n.Status != nil {
+			statusID = n.Status.ID
+		}
+
+		var groupKey string
+		if statusID != "" && groupableNotificationType(n.Type) {
+			groupKey = n.Type + "-" + statusID
+		} else {
+			groupKey = "ungrouped-" + n.ID
+		}
+
+		group, ok := groups[groupKey]
+		if !ok {
+			// First (ie., newest) notification
+			// in this group, create the group.
+			group = &apimodel.NotificationGroup{
+				GroupKey:                 groupKey,
+				Type:                     n.Type,
+				MostRecentNotificationID: n.ID,
+				PageMaxID:                n.ID,
+				LatestPageNotificationAt: n.CreatedAt,
+				SampleAccountIDs:         make([]string, 0, 1),
+				StatusID:                 statusID,
+				ModerationWarning:        n.ModerationWarning,
+				Report:                   n.Report,
+			}
+			groups[groupKey] = group
+			results.NotificationGroups = append(results.NotificationGroups, group)
+		}
+
+		// Notifs are sorted newest
+		// first, so this is the oldest
+		// one we've seen in the group.
+		group.PageMinID = n.ID
+		group.NotificationsCount++
+
+		if n.Account != nil &&
+			len(group.SampleAccountIDs) < maxGroupSampleAccounts &&
+			!slices.Contains(group.SampleAccountIDs, n.Account.ID) {
+			group.SampleAccountIDs = append(group.SampleAccountIDs, n.Account.ID)
+
+			if _, ok := accounts[n.Account.ID]; !ok {
+				accounts[n.Account.ID] = struct{}{}
+				results.Accounts = append(results.Accounts, n.Account)
+			}
+		}
+
+		if n.Status != nil {
+			if _, ok := statuses[statusID]; !ok {
+				statuses[statusID] = struct{}{}
+				results.Statuses = append(results.Statuses, n.Status)
+			}
+		}
+	}
+
+	return results
+}
+
+// groupableNotificationType returns whether notifications of
+// the given type about the same status should be grouped together.
+func groupableNotificationType(notifType string) bool {
+	switch gtsmodel.NotificationType(notifType) {
+	case gtsmodel.NotificationFave, gtsmodel.NotificationReaction, gtsmodel.NotificationReblog:
+		return true
+	default:
+		return false
+	}
+}
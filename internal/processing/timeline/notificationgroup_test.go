@@ -0,0 +1,73 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package timeline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type NotificationGroupTestSuite struct {
+	suite.Suite
+}
+
+func (suite *NotificationGroupTestSuite) TestGroupNotifications() {
+	var (
+		status   = &apimodel.Status{ID: "01H7QZC0Q5Y2FHQ6QJ3Y5J3QG0"}
+		account1 = &apimodel.Account{ID: "01F8MH17FWEB39HZJ76B6VXSKF"}
+		account2 = &apimodel.Account{ID: "01F8MH5NBDF2MV7CTC4Q5128HF"}
+	)
+
+	// Sorted newest first.
+	notifs := []*apimodel.Notification{
+		{ID: "06", Type: "favourite", Account: account1, Status: status},
+		{ID: "05", Type: "mention", Account: account2, Status: status},
+		{ID: "04", Type: "favourite", Account: account2, Status: status},
+		{ID: "03", Type: "follow", Account: account2},
+		{ID: "02", Type: "reblog", Account: account1, Status: status},
+		{ID: "01", Type: "follow", Account: account1},
+	}
+
+	results := groupNotifications(notifs)
+	suite.Len(results.Accounts, 2)
+	suite.Len(results.Statuses, 1)
+
+	groups := results.NotificationGroups
+	if !suite.Len(groups, 5) {
+		suite.FailNow("")
+	}
+
+	suite.Equal("favourite-"+status.ID, groups[0].GroupKey)
+	suite.Equal(2, groups[0].NotificationsCount)
+	suite.Equal("06", groups[0].MostRecentNotificationID)
+	suite.Equal("06", groups[0].PageMaxID)
+	suite.Equal("04", groups[0].PageMinID)
+	suite.Equal([]string{account1.ID, account2.ID}, groups[0].SampleAccountIDs)
+
+	suite.Equal("ungrouped-05", groups[1].GroupKey)
+	suite.Equal("ungrouped-03", groups[2].GroupKey)
+	suite.Equal("reblog-"+status.ID, groups[3].GroupKey)
+	suite.Equal("ungrouped-01", groups[4].GroupKey)
+	suite.Empty(groups[4].StatusID)
+}
+
+func TestNotificationGroupTestSuite(t *testing.T) {
+	suite.Run(t, new(NotificationGroupTestSuite))
+}
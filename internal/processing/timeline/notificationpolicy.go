@@ -0,0 +1,253 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package timeline
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// NotificationPolicyGet returns the notification policy of the given
+// account, along with a summary of its pending notification requests.
+func (p *Processor) NotificationPolicyGet(ctx context.Context, account *gtsmodel.Account) (*apimodel.NotificationPolicy, gtserror.WithCode) {
+	policy, err := p.getNotificationPolicy(ctx, account)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiNotificationPolicy(ctx, policy)
+}
+
+// NotificationPolicyUpdate updates the notification policy
+// of the given account with any fields set in form.
+func (p *Processor) NotificationPolicyUpdate(ctx context.Context, account *gtsmodel.Account, form *apimodel.NotificationPolicyUpdateRequest) (*apimodel.NotificationPolicy, gtserror.WithCode) {
+	policy, err := p.getNotificationPolicy(ctx, account)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	var columns []string
+
+	if form.FilterNotFollowing != nil {
+		policy.FilterNotFollowing = form.FilterNotFollowing
+		columns = append(columns, "filter_not_following")
+	}
+
+	if form.FilterNotFollowers != nil {
+		policy.FilterNotFollowers = form.FilterNotFollowers
+		columns = append(columns, "filter_not_followers")
+	}
+
+	if form.FilterNewAccounts != nil {
+		policy.FilterNewAccounts = form.FilterNewAccounts
+		columns = append(columns, "filter_new_accounts")
+	}
+
+	if form.FilterPrivateMentions != nil {
+		policy.FilterPrivateMentions = form.FilterPrivateMentions
+		columns = append(columns, "filter_private_mentions")
+	}
+
+	if policy.ID == "" {
+		// Account had no policy
+		// stored yet, create it.
+		policy.ID = id.NewULID()
+		if err := p.state.DB.PutNotificationPolicy(ctx, policy); err != nil {
+			err = gtserror.Newf("db error putting notification policy: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	} else if len(columns) != 0 {
+		if err := p.state.DB.UpdateNotificationPolicy(ctx, policy, columns...); err != nil {
+			err = gtserror.Newf("db error updating notification policy: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	return p.apiNotificationPolicy(ctx, policy)
+}
+
+// getNotificationPolicy returns the stored notification policy of the given
+// account, or a new (unstored, ID-less) default policy if it has none yet.
+func (p *Processor) getNotificationPolicy(ctx context.Context, account *gtsmodel.Account) (*gtsmodel.NotificationPolicy, error) {
+	policy, err := p.state.DB.GetNotificationPolicy(ctx, account.ID)
+	if err == nil {
+		return policy, nil
+	}
+
+	if !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting notification policy: %w", err)
+	}
+
+	falseBool := func() *bool { b := false; return &b }
+	return &gtsmodel.NotificationPolicy{
+		AccountID:             account.ID,
+		FilterNotFollowing:    falseBool(),
+		FilterNotFollowers:    falseBool(),
+		FilterNewAccounts:     falseBool(),
+		FilterPrivateMentions: falseBool(),
+	}, nil
+}
+
+func (p *Processor) apiNotificationPolicy(ctx context.Context, policy *gtsmodel.NotificationPolicy) (*apimodel.NotificationPolicy, gtserror.WithCode) {
+	requests, err := p.state.DB.GetAccountNotificationRequests(ctx, policy.AccountID, nil)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting notification requests: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	summary := apimodel.NotificationPolicySummary{
+		PendingRequestsCount: len(requests),
+	}
+
+	for _, request := range requests {
+		summary.PendingNotificationsCount += request.NotificationsCount
+	}
+
+	return &apimodel.NotificationPolicy{
+		FilterNotFollowing:    *policy.FilterNotFollowing,
+		FilterNotFollowers:    *policy.FilterNotFollowers,
+		FilterNewAccounts:     *policy.FilterNewAccounts,
+		FilterPrivateMentions: *policy.FilterPrivateMentions,
+		Summary:               summary,
+	}, nil
+}
+
+// NotificationRequestsGet returns a page of the pending
+// notification requests made to the given account.
+func (p *Processor) NotificationRequestsGet(ctx context.Context, account *gtsmodel.Account, page *paging.Pager) (*apimodel.PageableResponse, gtserror.WithCode) {
+	requests, err := p.state.DB.GetAccountNotificationRequests(ctx, account.ID, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting notification requests: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(requests)
+	if count == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	items := make([]interface{}, 0, count)
+	for _, request := range requests {
+		item, err := p.tc.NotificationRequestToAPINotificationRequest(ctx, request)
+		if err != nil {
+			log.Errorf(ctx, "error converting notification request %s to api: %v", request.ID, err)
+			continue
+		}
+
+		items = append(items, item)
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:          items,
+		Path:           "api/v1/notifications/requests",
+		NextMaxIDValue: requests[count-1].ID,
+		PrevMinIDValue: requests[0].ID,
+		Limit:          page.Limit,
+	})
+}
+
+// NotificationRequestGet returns one pending notification request made to the given account.
+func (p *Processor) NotificationRequestGet(ctx context.Context, account *gtsmodel.Account, requestID string) (*apimodel.NotificationRequest, gtserror.WithCode) {
+	request, errWithCode := p.getNotificationRequest(ctx, account, requestID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiRequest, err := p.tc.NotificationRequestToAPINotificationRequest(ctx, request)
+	if err != nil {
+		err = gtserror.Newf("error converting notification request to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiRequest, nil
+}
+
+// NotificationRequestAccept accepts one pending notification request made to the
+// given account: its filtered notifications are moved into the account's regular
+// notifications, and future notifications from the same account won't be filtered.
+func (p *Processor) NotificationRequestAccept(ctx context.Context, account *gtsmodel.Account, requestID string) gtserror.WithCode {
+	request, errWithCode := p.getNotificationRequest(ctx, account, requestID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.state.DB.UnfilterNotifications(ctx, account.ID, request.FromAccountID); err != nil {
+		err = gtserror.Newf("db error unfiltering notifications: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	accepted := true
+	request.Accepted = &accepted
+	if err := p.state.DB.UpdateNotificationRequest(ctx, request, "accepted"); err != nil {
+		err = gtserror.Newf("db error updating notification request: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+// NotificationRequestDismiss dismisses one pending notification request made
+// to the given account, deleting the request and its filtered notifications.
+func (p *Processor) NotificationRequestDismiss(ctx context.Context, account *gtsmodel.Account, requestID string) gtserror.WithCode {
+	request, errWithCode := p.getNotificationRequest(ctx, account, requestID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.state.DB.DeleteFilteredNotifications(ctx, account.ID, request.FromAccountID); err != nil {
+		err = gtserror.Newf("db error deleting filtered notifications: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.state.DB.DeleteNotificationRequestByID(ctx, request.ID); err != nil {
+		err = gtserror.Newf("db error deleting notification request: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+// getNotificationRequest gets the pending notification request with the given
+// ID, returning 404 if it doesn't exist, isn't pending or isn't made to account.
+func (p *Processor) getNotificationRequest(ctx context.Context, account *gtsmodel.Account, requestID string) (*gtsmodel.NotificationRequest, gtserror.WithCode) {
+	request, err := p.state.DB.GetNotificationRequestByID(ctx, requestID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+
+		err = gtserror.Newf("db error getting notification request: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if request.AccountID != account.ID || *request.Accepted {
+		err := gtserror.Newf("notification request %s not pending for account %s", requestID, account.ID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return request, nil
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
@@ -42,6 +43,12 @@ func (p *Processor) PublicTimelineGet(ctx context.Context, authed *oauth.Auth, m
 		return util.EmptyPageableResponse(), nil
 	}
 
+	// Languages the user wants to see, if any.
+	var chosenLanguages []string
+	if authed.User != nil {
+		chosenLanguages = authed.User.ChosenLanguages
+	}
+
 	var (
 		items = make([]interface{}, 0, count)
 
@@ -62,6 +69,10 @@ func (p *Processor) PublicTimelineGet(ctx context.Context, authed *oauth.Auth, m
 			continue
 		}
 
+		if !languageChosen(chosenLanguages, s.Language) {
+			continue
+		}
+
 		apiStatus, err := p.tc.StatusToAPIStatus(ctx, s, authed.Account)
 		if err != nil {
 			log.Errorf(ctx, "error convert to api status: %v", err)
@@ -79,3 +90,26 @@ func (p *Processor) PublicTimelineGet(ctx context.Context, authed *oauth.Auth, m
 		Limit:          limit,
 	})
 }
+
+// languageChosen returns whether a status in the given
+// language should be shown to a user who has chosen to
+// see only the given languages. Statuses are always
+// shown if no languages were chosen, or if the language
+// of the status is not known.
+func languageChosen(chosenLanguages []string, lang string) bool {
+	if len(chosenLanguages) == 0 || lang == "" {
+		return true
+	}
+
+	// Compare only the base language,
+	// so that eg., "en-GB" matches "en".
+	lang, _, _ = strings.Cut(lang, "-")
+
+	for _, chosen := range chosenLanguages {
+		if strings.EqualFold(chosen, lang) {
+			return true
+		}
+	}
+
+	return false
+}
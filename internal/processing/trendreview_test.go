@@ -0,0 +1,113 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package processing_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type TrendReviewTestSuite struct {
+	ProcessingStandardTestSuite
+}
+
+func (suite *TrendReviewTestSuite) putTrend(tagKey string) *gtsmodel.Trend {
+	trend := &gtsmodel.Trend{
+		ID:       id.NewULID(),
+		Type:     gtsmodel.TrendTypeTag,
+		TargetID: suite.testTags[tagKey].ID,
+		Score:    2,
+		State:    gtsmodel.TrendStatePending,
+	}
+
+	if err := suite.db.PutTrend(context.Background(), trend); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return trend
+}
+
+func (suite *TrendReviewTestSuite) TestTrendApprove() {
+	ctx := context.Background()
+	admin := suite.testAccounts["admin_account"]
+	trend := suite.putTrend("welcome")
+
+	// Pending, so not shown yet.
+	tags, errWithCode := suite.processor.Trends().TagsGet(ctx, 10, 0)
+	suite.NoError(errWithCode)
+	suite.Empty(tags)
+
+	apiTrend, errWithCode := suite.processor.Admin().TrendApprove(ctx, admin, gtsmodel.TrendTypeTag, trend.ID)
+	suite.NoError(errWithCode)
+	suite.Equal("approved", apiTrend.State)
+
+	dbTrend, err := suite.db.GetTrendByID(ctx, trend.ID)
+	suite.NoError(err)
+	suite.Equal(gtsmodel.TrendStateApproved, dbTrend.State)
+	suite.False(dbTrend.ReviewedAt.IsZero())
+
+	// Approved, so now shown.
+	tags, errWithCode = suite.processor.Trends().TagsGet(ctx, 10, 0)
+	suite.NoError(errWithCode)
+	suite.Len(tags, 1)
+	suite.Equal("welcome", tags[0].Name)
+}
+
+func (suite *TrendReviewTestSuite) TestTrendReject() {
+	ctx := context.Background()
+	admin := suite.testAccounts["admin_account"]
+	trend := suite.putTrend("welcome")
+
+	apiTrend, errWithCode := suite.processor.Admin().TrendReject(ctx, admin, gtsmodel.TrendTypeTag, trend.ID)
+	suite.NoError(errWithCode)
+	suite.Equal("rejected", apiTrend.State)
+
+	// Rejected, so never shown.
+	tags, errWithCode := suite.processor.Trends().TagsGet(ctx, 10, 0)
+	suite.NoError(errWithCode)
+	suite.Empty(tags)
+
+	// But still listed for admins.
+	apiTrends, errWithCode := suite.processor.Admin().TrendsGet(ctx, admin, gtsmodel.TrendTypeTag, gtsmodel.TrendStateRejected, 10, 0)
+	suite.NoError(errWithCode)
+	suite.Len(apiTrends, 1)
+}
+
+func (suite *TrendReviewTestSuite) TestTrendReviewWrongType() {
+	ctx := context.Background()
+	admin := suite.testAccounts["admin_account"]
+	trend := suite.putTrend("welcome")
+
+	_, errWithCode := suite.processor.Admin().TrendApprove(ctx, admin, gtsmodel.TrendTypeStatus, trend.ID)
+	suite.Error(errWithCode)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	// State is unchanged.
+	dbTrend, err := suite.db.GetTrendByID(ctx, trend.ID)
+	suite.NoError(err)
+	suite.Equal(gtsmodel.TrendStatePending, dbTrend.State)
+}
+
+func TestTrendReviewTestSuite(t *testing.T) {
+	suite.Run(t, new(TrendReviewTestSuite))
+}
@@ -0,0 +1,134 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package trends
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// TagsGet returns approved trending tags, most trending first.
+func (p *Processor) TagsGet(ctx context.Context, limit int, offset int) ([]apimodel.Tag, gtserror.WithCode) {
+	trends, errWithCode := p.getApprovedTrends(ctx, gtsmodel.TrendTypeTag, limit, offset)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiTags := make([]apimodel.Tag, 0, len(trends))
+	for _, trend := range trends {
+		tag, err := p.state.DB.GetTag(ctx, trend.TargetID)
+		if err != nil {
+			log.Errorf(ctx, "error getting trending tag %s: %v", trend.TargetID, err)
+			continue
+		}
+
+		apiTag, err := p.tc.TagToAPITag(ctx, tag, true)
+		if err != nil {
+			log.Errorf(ctx, "error converting tag %s to api tag: %v", tag.ID, err)
+			continue
+		}
+
+		apiTags = append(apiTags, apiTag)
+	}
+
+	return apiTags, nil
+}
+
+// StatusesGet returns approved trending statuses visible
+// to the requesting account (if any), most trending first.
+func (p *Processor) StatusesGet(ctx context.Context, requestingAccount *gtsmodel.Account, limit int, offset int) ([]*apimodel.Status, gtserror.WithCode) {
+	trends, errWithCode := p.getApprovedTrends(ctx, gtsmodel.TrendTypeStatus, limit, offset)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiStatuses := make([]*apimodel.Status, 0, len(trends))
+	for _, trend := range trends {
+		status, err := p.state.DB.GetStatusByID(ctx, trend.TargetID)
+		if err != nil {
+			if !errors.Is(err, db.ErrNoEntries) {
+				log.Errorf(ctx, "error getting trending status %s: %v", trend.TargetID, err)
+			}
+			// Status may have been deleted
+			// since trends were last scored.
+			continue
+		}
+
+		visible, err := p.filter.StatusVisible(ctx, requestingAccount, status)
+		if err != nil {
+			log.Errorf(ctx, "error checking status visibility: %v", err)
+			continue
+		}
+
+		if !visible {
+			continue
+		}
+
+		apiStatus, err := p.tc.StatusToAPIStatus(ctx, status, requestingAccount)
+		if err != nil {
+			log.Errorf(ctx, "error converting status %s to api status: %v", status.ID, err)
+			continue
+		}
+
+		apiStatuses = append(apiStatuses, apiStatus)
+	}
+
+	return apiStatuses, nil
+}
+
+// LinksGet returns approved trending links, most trending first.
+func (p *Processor) LinksGet(ctx context.Context, limit int, offset int) ([]*apimodel.TrendsLink, gtserror.WithCode) {
+	trends, errWithCode := p.getApprovedTrends(ctx, gtsmodel.TrendTypeLink, limit, offset)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiLinks := make([]*apimodel.TrendsLink, 0, len(trends))
+	for _, trend := range trends {
+		card, err := p.state.DB.GetCardByID(ctx, trend.TargetID)
+		if err != nil {
+			log.Errorf(ctx, "error getting trending link %s: %v", trend.TargetID, err)
+			continue
+		}
+
+		apiLink, err := p.tc.CardToAPITrendsLink(ctx, card)
+		if err != nil {
+			log.Errorf(ctx, "error converting card %s to api link: %v", card.ID, err)
+			continue
+		}
+
+		apiLinks = append(apiLinks, apiLink)
+	}
+
+	return apiLinks, nil
+}
+
+func (p *Processor) getApprovedTrends(ctx context.Context, trendType gtsmodel.TrendType, limit int, offset int) ([]*gtsmodel.Trend, gtserror.WithCode) {
+	trends, err := p.state.DB.GetTrends(ctx, trendType, gtsmodel.TrendStateApproved, limit, offset)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting %s trends: %w", trendType, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	return trends, nil
+}
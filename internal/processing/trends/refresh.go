@@ -0,0 +1,179 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package trends
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+const (
+	// scoreWindow is the period of
+	// recent activity used for scoring.
+	scoreWindow = 24 * time.Hour
+
+	// minAccounts is the minimum number of distinct
+	// accounts that must have used a tag or link
+	// in the score window for it to be trending.
+	minAccounts = 2
+
+	// minInteractions is the minimum number of faves
+	// and boosts that a status must have received in
+	// the score window for it to be trending.
+	minInteractions = 2
+
+	// staleTrendAge is how long a pending trend
+	// can go without trending before it's dropped
+	// from the admin review queue entirely.
+	staleTrendAge = 7 * 24 * time.Hour
+)
+
+// Refresh recalculates the scores of trending tags, statuses and links
+// from recent activity. Newly trending items are added to the admin review
+// queue; items which are no longer trending have their score set to zero,
+// and are deleted after staleTrendAge if they were never reviewed.
+func (p *Processor) Refresh(ctx context.Context) error {
+	now := time.Now()
+	since := now.Add(-scoreWindow)
+
+	var errs gtserror.MultiError
+
+	// Tags are scored by number of distinct accounts using them.
+	tagCounts, err := p.state.DB.CountRecentTagAccounts(ctx, since)
+	if err != nil {
+		errs.Appendf("error counting tag uses: %v", err)
+	} else if err := p.updateTrends(ctx, gtsmodel.TrendTypeTag, accountScores(tagCounts)); err != nil {
+		errs.Append(err)
+	}
+
+	// Statuses are scored by velocity of faves and boosts.
+	interactions, err := p.state.DB.CountRecentStatusInteractions(ctx, since)
+	if err != nil {
+		errs.Appendf("error counting status interactions: %v", err)
+	} else if err := p.updateTrends(ctx, gtsmodel.TrendTypeStatus, p.statusScores(ctx, interactions, now)); err != nil {
+		errs.Append(err)
+	}
+
+	// Links are scored by number of distinct accounts sharing them.
+	cardCounts, err := p.state.DB.CountRecentCardAccounts(ctx, since)
+	if err != nil {
+		errs.Appendf("error counting link uses: %v", err)
+	} else if err := p.updateTrends(ctx, gtsmodel.TrendTypeLink, accountScores(cardCounts)); err != nil {
+		errs.Append(err)
+	}
+
+	// Drop unreviewed items that haven't trended in a while.
+	if err := p.state.DB.DeleteStaleTrends(ctx, now.Add(-staleTrendAge)); err != nil {
+		errs.Appendf("error deleting stale trends: %v", err)
+	}
+
+	return errs.Combine()
+}
+
+// accountScores converts the given counts of distinct
+// accounts into trend scores, dropping any below minAccounts.
+func accountScores(counts map[string]int) map[string]float64 {
+	scores := make(map[string]float64, len(counts))
+	for targetID, count := range counts {
+		if count < minAccounts {
+			continue
+		}
+		scores[targetID] = float64(count)
+	}
+	return scores
+}
+
+// statusScores converts the given counts of recent interactions into
+// trend scores, dropping any below minInteractions. Each count is divided
+// by the age of the status in hours, so that new statuses which are picking
+// up interactions quickly outrank older statuses trickling along.
+func (p *Processor) statusScores(ctx context.Context, interactions map[string]int, now time.Time) map[string]float64 {
+	scores := make(map[string]float64, len(interactions))
+	for statusID, count := range interactions {
+		if count < minInteractions {
+			continue
+		}
+
+		status, err := p.state.DB.GetStatusByID(gtscontext.SetBarebones(ctx), statusID)
+		if err != nil {
+			log.Errorf(ctx, "error getting status %s: %v", statusID, err)
+			continue
+		}
+
+		hours := math.Max(1, now.Sub(status.CreatedAt).Hours())
+		scores[statusID] = float64(count) / hours
+	}
+	return scores
+}
+
+// updateTrends updates trends of the given type with the given scores, keyed
+// by target ID. Trends for new targets are created pending admin review, and
+// any currently trending items not in scores are set to zero, ie., no longer trending.
+func (p *Processor) updateTrends(ctx context.Context, trendType gtsmodel.TrendType, scores map[string]float64) error {
+	targetIDs := make([]string, 0, len(scores))
+	for targetID := range scores {
+		targetIDs = append(targetIDs, targetID)
+	}
+
+	// Zero items that have stopped trending; those
+	// still trending are updated with new scores below.
+	if err := p.state.DB.ZeroTrendScores(ctx, trendType, targetIDs); err != nil {
+		return gtserror.Newf("error zeroing %s trend scores: %w", trendType, err)
+	}
+
+	for targetID, score := range scores {
+		trend, err := p.state.DB.GetTrendByTarget(ctx, trendType, targetID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("error getting %s trend for %s: %w", trendType, targetID, err)
+		}
+
+		if trend == nil {
+			// Not trended before, add
+			// to admin review queue.
+			trend = &gtsmodel.Trend{
+				ID:       id.NewULID(),
+				Type:     trendType,
+				TargetID: targetID,
+				Score:    score,
+				State:    gtsmodel.TrendStatePending,
+			}
+
+			if err := p.state.DB.PutTrend(ctx, trend); err != nil {
+				return gtserror.Newf("error putting %s trend for %s: %w", trendType, targetID, err)
+			}
+
+			continue
+		}
+
+		trend.Score = score
+		if err := p.state.DB.UpdateTrend(ctx, trend, "score"); err != nil {
+			return gtserror.Newf("error updating %s trend %s: %w", trendType, trend.ID, err)
+		}
+	}
+
+	return nil
+}
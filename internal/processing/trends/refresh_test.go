@@ -0,0 +1,137 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package trends_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type RefreshTestSuite struct {
+	TrendsStandardTestSuite
+}
+
+// fave puts a fresh fave of the given status by the given account.
+func (suite *RefreshTestSuite) fave(accountKey string, status *gtsmodel.Status) {
+	account := suite.testAccounts[accountKey]
+	faveID := id.NewULID()
+	if err := suite.db.PutStatusFave(context.Background(), &gtsmodel.StatusFave{
+		ID:              faveID,
+		AccountID:       account.ID,
+		TargetAccountID: status.AccountID,
+		StatusID:        status.ID,
+		URI:             account.URI + "/faves/" + faveID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *RefreshTestSuite) TestRefreshStatus() {
+	ctx := context.Background()
+	status := suite.testStatuses["admin_account_status_1"]
+
+	// One recent fave isn't enough to trend.
+	suite.fave("local_account_2", status)
+	suite.NoError(suite.trends.Refresh(ctx))
+
+	_, err := suite.db.GetTrendByTarget(ctx, gtsmodel.TrendTypeStatus, status.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Two is.
+	suite.fave("remote_account_1", status)
+	suite.NoError(suite.trends.Refresh(ctx))
+
+	trend, err := suite.db.GetTrendByTarget(ctx, gtsmodel.TrendTypeStatus, status.ID)
+	suite.NoError(err)
+	suite.Equal(gtsmodel.TrendStatePending, trend.State)
+
+	// Scored by interactions per hour since posting.
+	hours := time.Since(status.CreatedAt).Hours()
+	suite.InDelta(2/hours, trend.Score, 2/hours*0.01)
+
+	// Pending trends aren't shown.
+	apiStatuses, errWithCode := suite.trends.StatusesGet(ctx, nil, 10, 0)
+	suite.NoError(errWithCode)
+	suite.Empty(apiStatuses)
+
+	// Once approved, they are.
+	trend.State = gtsmodel.TrendStateApproved
+	if err := suite.db.UpdateTrend(ctx, trend, "state"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiStatuses, errWithCode = suite.trends.StatusesGet(ctx, nil, 10, 0)
+	suite.NoError(errWithCode)
+	suite.Len(apiStatuses, 1)
+	suite.Equal(status.ID, apiStatuses[0].ID)
+
+	// Refreshing again keeps the review state.
+	suite.NoError(suite.trends.Refresh(ctx))
+	trend, err = suite.db.GetTrendByID(ctx, trend.ID)
+	suite.NoError(err)
+	suite.Equal(gtsmodel.TrendStateApproved, trend.State)
+	suite.Greater(trend.Score, 0.0)
+}
+
+func (suite *RefreshTestSuite) TestRefreshZeroesAndDropsStale() {
+	ctx := context.Background()
+	longAgo := time.Now().Add(-30 * 24 * time.Hour)
+
+	var (
+		// Was trending, but isn't any more.
+		noLongerTrending = &gtsmodel.Trend{ID: id.NewULID(), Type: gtsmodel.TrendTypeTag, TargetID: suite.testTags["welcome"].ID, Score: 3, State: gtsmodel.TrendStateApproved}
+
+		// Stopped trending a long time ago, never reviewed.
+		stalePending = &gtsmodel.Trend{ID: id.NewULID(), Type: gtsmodel.TrendTypeTag, TargetID: suite.testTags["Hashtag"].ID, State: gtsmodel.TrendStatePending, CreatedAt: longAgo, UpdatedAt: longAgo}
+
+		// Stopped trending a long time ago, but was reviewed.
+		staleRejected = &gtsmodel.Trend{ID: id.NewULID(), Type: gtsmodel.TrendTypeStatus, TargetID: suite.testStatuses["admin_account_status_2"].ID, State: gtsmodel.TrendStateRejected, CreatedAt: longAgo, UpdatedAt: longAgo}
+	)
+
+	for _, trend := range []*gtsmodel.Trend{noLongerTrending, stalePending, staleRejected} {
+		if err := suite.db.PutTrend(ctx, trend); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	suite.NoError(suite.trends.Refresh(ctx))
+
+	// No longer trending, but kept.
+	trend, err := suite.db.GetTrendByID(ctx, noLongerTrending.ID)
+	suite.NoError(err)
+	suite.Zero(trend.Score)
+	suite.Equal(gtsmodel.TrendStateApproved, trend.State)
+
+	// Stale and never reviewed, so dropped.
+	_, err = suite.db.GetTrendByID(ctx, stalePending.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Stale but reviewed, so kept.
+	_, err = suite.db.GetTrendByID(ctx, staleRejected.ID)
+	suite.NoError(err)
+}
+
+func TestRefreshTestSuite(t *testing.T) {
+	suite.Run(t, new(RefreshTestSuite))
+}
@@ -0,0 +1,67 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package trends
+
+import (
+	"time"
+
+	"codeberg.org/gruf/go-runners"
+	"codeberg.org/gruf/go-sched"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/visibility"
+)
+
+// refreshFreq is how often trend
+// scores are recalculated.
+const refreshFreq = 15 * time.Minute
+
+type Processor struct {
+	state  *state.State
+	tc     typeutils.TypeConverter
+	filter *visibility.Filter
+}
+
+// New returns a new trends processor, and schedules
+// periodic recalculation of trend scores.
+func New(state *state.State, tc typeutils.TypeConverter, filter *visibility.Filter) Processor {
+	p := Processor{
+		state:  state,
+		tc:     tc,
+		filter: filter,
+	}
+	scheduleJobs(&p)
+	return p
+}
+
+func scheduleJobs(p *Processor) {
+	// Get ctx associated with scheduler run state.
+	done := p.state.Workers.Scheduler.Done()
+	doneCtx := runners.CancelCtx(done)
+
+	// Schedule trend scores to be recalculated every refreshFreq.
+	p.state.Workers.Scheduler.Schedule(sched.NewJob(func(start time.Time) {
+		log.Debug(nil, "starting trends refresh")
+		if err := p.Refresh(doneCtx); err != nil {
+			log.Errorf(nil, "error refreshing trends: %v", err)
+			return
+		}
+		log.Debugf(nil, "finished trends refresh after %s", time.Since(start))
+	}).Every(refreshFreq))
+}
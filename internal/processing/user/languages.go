@@ -0,0 +1,64 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"golang.org/x/text/language"
+)
+
+// LanguagesGet returns the language preferences of the given user.
+func (p *Processor) LanguagesGet(user *gtsmodel.User) *apimodel.UserLanguages {
+	chosen := user.ChosenLanguages
+	if chosen == nil {
+		chosen = []string{}
+	}
+
+	return &apimodel.UserLanguages{
+		ChosenLanguages: chosen,
+	}
+}
+
+// LanguagesUpdate sets the languages that the given user wants to see
+// in public timelines. Passing no languages clears the preference.
+func (p *Processor) LanguagesUpdate(ctx context.Context, user *gtsmodel.User, chosenLanguages []string) (*apimodel.UserLanguages, gtserror.WithCode) {
+	chosen := make([]string, 0, len(chosenLanguages))
+	for _, lang := range chosenLanguages {
+		base, err := language.ParseBase(lang)
+		if err != nil {
+			err := gtserror.Newf("invalid language %s: %w", lang, err)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		// Store the canonical form so that it can be
+		// compared directly against status languages.
+		chosen = append(chosen, base.String())
+	}
+
+	user.ChosenLanguages = chosen
+	if err := p.state.DB.UpdateUser(ctx, user, "chosen_languages"); err != nil {
+		err := gtserror.Newf("db error updating user: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.LanguagesGet(user), nil
+}
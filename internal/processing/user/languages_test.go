@@ -0,0 +1,60 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LanguagesTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *LanguagesTestSuite) TestLanguagesUpdate() {
+	ctx := context.Background()
+	user := suite.testUsers["local_account_1"]
+
+	languages, errWithCode := suite.user.LanguagesUpdate(ctx, user, []string{"en", "deu"})
+	suite.NoError(errWithCode)
+	suite.Equal([]string{"en", "de"}, languages.ChosenLanguages)
+
+	dbUser, err := suite.db.GetUserByID(ctx, user.ID)
+	suite.NoError(err)
+	suite.Equal([]string{"en", "de"}, dbUser.ChosenLanguages)
+
+	// Clear the preference again.
+	languages, errWithCode = suite.user.LanguagesUpdate(ctx, user, nil)
+	suite.NoError(errWithCode)
+	suite.Empty(languages.ChosenLanguages)
+}
+
+func (suite *LanguagesTestSuite) TestLanguagesUpdateInvalid() {
+	ctx := context.Background()
+	user := suite.testUsers["local_account_1"]
+
+	_, errWithCode := suite.user.LanguagesUpdate(ctx, user, []string{"en", "not a language"})
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func TestLanguagesTestSuite(t *testing.T) {
+	suite.Run(t, &LanguagesTestSuite{})
+}
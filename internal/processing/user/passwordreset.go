@@ -0,0 +1,216 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/email"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// resetPasswordTokenTTL is how long a
+	// reset password token remains valid for.
+	resetPasswordTokenTTL = 1 * time.Hour
+
+	// resetPasswordCooldown is the minimum amount of
+	// time that must elapse before another reset email
+	// will be sent to the same user.
+	resetPasswordCooldown = 10 * time.Minute
+)
+
+// PasswordResetRequest processes a 'forgot password' request for the
+// given email address, sending a single-use reset link to that address
+// if it belongs to a confirmed, active user.
+//
+// To avoid leaking which email addresses are registered on this instance,
+// no error is returned when the address is unknown, or when the user is
+// not eligible for a reset; callers should always show the same response.
+func (p *Processor) PasswordResetRequest(ctx context.Context, emailAddress string) gtserror.WithCode {
+	if emailAddress == "" {
+		const help = "no email address provided"
+		return gtserror.NewErrorBadRequest(errors.New(help), help)
+	}
+
+	user, err := p.state.DB.GetUserByEmailAddress(ctx, emailAddress)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			log.Debugf(ctx, "no user found with email address %s", emailAddress)
+			return nil
+		}
+		err := gtserror.Newf("db error getting user: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if user.ConfirmedAt.IsZero() ||
+		!*user.Approved ||
+		*user.Disabled {
+		log.Debugf(ctx, "user %s is not eligible for a password reset", user.ID)
+		return nil
+	}
+
+	if user.Account == nil {
+		user.Account, err = p.state.DB.GetAccountByID(ctx, user.AccountID)
+		if err != nil {
+			err := gtserror.Newf("db error getting account: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if !user.Account.SuspendedAt.IsZero() {
+		log.Debugf(ctx, "account %s is suspended, not sending password reset", user.AccountID)
+		return nil
+	}
+
+	if !user.ResetPasswordSentAt.IsZero() &&
+		time.Since(user.ResetPasswordSentAt) < resetPasswordCooldown {
+		// Don't let someone spam this user's inbox.
+		log.Debugf(ctx, "password reset for user %s already sent recently", user.ID)
+		return nil
+	}
+
+	instance, err := p.state.DB.GetInstance(ctx, config.GetHost())
+	if err != nil {
+		err := gtserror.Newf("db error getting instance: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	// Use a uuid as our token, as with email
+	// confirmation, since it's basically impossible to guess.
+	token := uuid.NewString()
+
+	resetData := email.ResetData{
+		Username:     user.Account.Username,
+		InstanceURL:  instance.URI,
+		InstanceName: instance.Title,
+		ResetLink:    uris.GenerateURIForPasswordReset(token),
+	}
+
+	// Store the token on the user before sending
+	// it, so that the link is valid by the time
+	// the email arrives in their inbox.
+	now := time.Now()
+	user.ResetPasswordToken = token
+	user.ResetPasswordSentAt = now
+	user.LastEmailedAt = now
+
+	if err := p.state.DB.UpdateUser(
+		ctx, user,
+		"reset_password_token",
+		"reset_password_sent_at",
+		"last_emailed_at",
+	); err != nil {
+		err := gtserror.Newf("db error updating user: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.emailSender.SendResetEmail(user.Email, resetData); err != nil {
+		err := gtserror.Newf("error sending reset email to user %s: %w", user.ID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+// PasswordReset sets a new password for the user that owns the given
+// reset password token, usually sent to them by PasswordResetRequest.
+//
+// The token is single-use, and is cleared once the password has been
+// reset. Any existing OAuth tokens belonging to the user are revoked,
+// so that all sessions have to sign in again with the new password.
+func (p *Processor) PasswordReset(ctx context.Context, token string, newPassword string) (*gtsmodel.User, gtserror.WithCode) {
+	if token == "" {
+		return nil, gtserror.NewErrorNotFound(errors.New("no token provided"))
+	}
+
+	user, err := p.state.DB.GetUserByResetPasswordToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		err := gtserror.Newf("db error getting user: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if time.Since(user.ResetPasswordSentAt) > resetPasswordTokenTTL {
+		const help = "password reset link has expired, please request a new one"
+		err := gtserror.New("reset password token expired")
+		return nil, gtserror.NewErrorForbidden(err, help)
+	}
+
+	// Ensure new password is strong enough.
+	if err := validate.Password(newPassword); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// Hash the new password.
+	encryptedPassword, err := bcrypt.GenerateFromPassword(
+		[]byte(newPassword),
+		bcrypt.DefaultCost,
+	)
+	if err != nil {
+		err := gtserror.Newf("%w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Set new password on user and
+	// clear the now-used reset token.
+	user.EncryptedPassword = string(encryptedPassword)
+	user.ResetPasswordToken = ""
+	user.ResetPasswordSentAt = time.Time{}
+
+	if err := p.state.DB.UpdateUser(
+		ctx, user,
+		"encrypted_password",
+		"reset_password_token",
+		"reset_password_sent_at",
+	); err != nil {
+		err := gtserror.Newf("db error updating user: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Revoke all existing tokens for this user,
+	// since whoever holds them may not be the user.
+	if err := p.state.DB.DeleteWhere(
+		ctx,
+		[]db.Where{{Key: "user_id", Value: user.ID}},
+		&[]*gtsmodel.Token{},
+	); err != nil {
+		err := gtserror.Newf("db error revoking tokens for user %s: %w", user.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Every web push subscription was made with one
+	// of those tokens, so they're all unusable now.
+	if err := p.state.DB.DeleteWebPushSubscriptionsByAccountID(ctx, user.AccountID); err != nil {
+		err := gtserror.Newf("db error deleting web push subscriptions for user %s: %w", user.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return user, nil
+}
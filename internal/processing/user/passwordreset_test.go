@@ -0,0 +1,154 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package user_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type PasswordResetTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *PasswordResetTestSuite) TestPasswordResetRequest() {
+	ctx := context.Background()
+
+	errWithCode := suite.user.PasswordResetRequest(ctx, "zork@example.org")
+	suite.NoError(errWithCode)
+
+	// zork should have a reset email.
+	suite.Len(suite.sentEmails, 1)
+	email, ok := suite.sentEmails["zork@example.org"]
+	suite.True(ok)
+
+	// a token should be set on zork.
+	user, err := suite.db.GetUserByEmailAddress(ctx, "zork@example.org")
+	suite.NoError(err)
+	token := user.ResetPasswordToken
+	suite.NotEmpty(token)
+	suite.WithinDuration(time.Now(), user.ResetPasswordSentAt, 1*time.Minute)
+
+	// email should contain the link with the token.
+	suite.Contains(email, "Subject: GoToSocial Password Reset\r\n")
+	suite.Contains(email, fmt.Sprintf("http://localhost:8080/auth/reset_password?token=%s", token))
+
+	// asking again straight away shouldn't send another email.
+	delete(suite.sentEmails, "zork@example.org")
+	errWithCode = suite.user.PasswordResetRequest(ctx, "zork@example.org")
+	suite.NoError(errWithCode)
+	suite.Empty(suite.sentEmails)
+}
+
+func (suite *PasswordResetTestSuite) TestPasswordResetRequestUnknownEmail() {
+	errWithCode := suite.user.PasswordResetRequest(context.Background(), "nobody@example.org")
+	suite.NoError(errWithCode)
+	suite.Empty(suite.sentEmails)
+}
+
+func (suite *PasswordResetTestSuite) TestPasswordResetRequestUnconfirmed() {
+	// weed_lord420 never confirmed their email address.
+	errWithCode := suite.user.PasswordResetRequest(context.Background(), "weed_lord420@example.org")
+	suite.NoError(errWithCode)
+	suite.Empty(suite.sentEmails)
+}
+
+func (suite *PasswordResetTestSuite) setResetToken(user *gtsmodel.User, token string, sentAt time.Time) {
+	user.ResetPasswordToken = token
+	user.ResetPasswordSentAt = sentAt
+	if err := suite.db.UpdateUser(
+		context.Background(), user,
+		"reset_password_token",
+		"reset_password_sent_at",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *PasswordResetTestSuite) TestPasswordReset() {
+	ctx := context.Background()
+	user := suite.testUsers["local_account_1"]
+	suite.setResetToken(user, "c4d41e8b-8c8d-4b7e-a0a8-a0c5b0a5e6d2", time.Now().Add(-5*time.Minute))
+
+	// Subscribe to web push with one of zork's tokens.
+	sub := &gtsmodel.WebPushSubscription{
+		ID:        "01HA3BSGQWB6NBWF4SXKJ3WRZN",
+		AccountID: user.AccountID,
+		TokenID:   "01F8MGTQW4DKTDF8SW5CT9HYGA",
+		Endpoint:  "https://push.example.org/push/zork",
+		Auth:      "ZnJlc2hseSBnZW5lcmF0ZWQ",
+		P256dh:    "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4",
+		Policy:    gtsmodel.WebPushNotificationPolicyAll,
+	}
+	if err := suite.db.PutWebPushSubscription(ctx, sub); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	newPassword := "verygoodnewpassword!!!!"
+	updatedUser, errWithCode := suite.user.PasswordReset(ctx, "c4d41e8b-8c8d-4b7e-a0a8-a0c5b0a5e6d2", newPassword)
+	suite.NoError(errWithCode)
+
+	// password should be changed and token cleared.
+	dbUser, err := suite.db.GetUserByID(ctx, updatedUser.ID)
+	suite.NoError(err)
+	suite.NoError(bcrypt.CompareHashAndPassword([]byte(dbUser.EncryptedPassword), []byte(newPassword)))
+	suite.Empty(dbUser.ResetPasswordToken)
+	suite.True(dbUser.ResetPasswordSentAt.IsZero())
+
+	// zork's tokens should all be revoked.
+	tokens := []*gtsmodel.Token{}
+	err = suite.db.GetWhere(ctx, []db.Where{{Key: "user_id", Value: user.ID}}, &tokens)
+	suite.NoError(err)
+	suite.Empty(tokens)
+
+	// and so should the web push subscription.
+	_, err = suite.db.GetWebPushSubscriptionByID(ctx, sub.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// the token can't be used again.
+	_, errWithCode = suite.user.PasswordReset(ctx, "c4d41e8b-8c8d-4b7e-a0a8-a0c5b0a5e6d2", "anothernewpassword!!!!")
+	suite.Error(errWithCode)
+}
+
+func (suite *PasswordResetTestSuite) TestPasswordResetExpiredToken() {
+	user := suite.testUsers["local_account_1"]
+	suite.setResetToken(user, "c4d41e8b-8c8d-4b7e-a0a8-a0c5b0a5e6d2", time.Now().Add(-2*time.Hour))
+
+	updatedUser, errWithCode := suite.user.PasswordReset(context.Background(), "c4d41e8b-8c8d-4b7e-a0a8-a0c5b0a5e6d2", "verygoodnewpassword!!!!")
+	suite.Nil(updatedUser)
+	suite.EqualError(errWithCode, "PasswordReset: reset password token expired")
+}
+
+func (suite *PasswordResetTestSuite) TestPasswordResetWeakPassword() {
+	user := suite.testUsers["local_account_1"]
+	suite.setResetToken(user, "c4d41e8b-8c8d-4b7e-a0a8-a0c5b0a5e6d2", time.Now())
+
+	updatedUser, errWithCode := suite.user.PasswordReset(context.Background(), "c4d41e8b-8c8d-4b7e-a0a8-a0c5b0a5e6d2", "password")
+	suite.Nil(updatedUser)
+	suite.Error(errWithCode)
+}
+
+func TestPasswordResetTestSuite(t *testing.T) {
+	suite.Run(t, &PasswordResetTestSuite{})
+}
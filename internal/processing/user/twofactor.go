@@ -0,0 +1,302 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/totp"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// recoveryCodeCount is the number of one-time recovery
+	// codes generated when two-factor auth is enabled.
+	recoveryCodeCount = 10
+
+	// twoFactorMaxFailures is the number of incorrect
+	// two-factor codes, across all sign in attempts,
+	// after which the user is locked out for a while.
+	twoFactorMaxFailures = 10
+
+	// twoFactorLockout is how long a user
+	// is locked out for after too many
+	// incorrect two-factor codes.
+	twoFactorLockout = 15 * time.Minute
+)
+
+// TwoFactorSetup generates a new TOTP secret for the given user,
+// to be entered into an authenticator app. Two-factor auth is not
+// enabled until a code from that app is passed to TwoFactorEnable.
+func (p *Processor) TwoFactorSetup(ctx context.Context, user *gtsmodel.User) (*apimodel.TwoFactorSetup, gtserror.WithCode) {
+	if config.GetOIDCEnabled() {
+		const help = "two-factor authentication is handled by your identity provider"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(help), help)
+	}
+
+	if user.TwoFactorEnabled() {
+		const help = "two-factor authentication is already enabled; disable it first to set it up again"
+		return nil, gtserror.NewErrorConflict(errors.New(help), help)
+	}
+
+	account := user.Account
+	if account == nil {
+		var err error
+		account, err = p.state.DB.GetAccountByID(ctx, user.AccountID)
+		if err != nil {
+			err := gtserror.Newf("db error getting account: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		err := gtserror.Newf("error generating secret: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	user.TwoFactorSecret = secret
+	if err := p.state.DB.UpdateUser(ctx, user, "two_factor_secret"); err != nil {
+		err := gtserror.Newf("db error updating user: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	host := config.GetHost()
+	return &apimodel.TwoFactorSetup{
+		Secret: secret,
+		URI:    totp.URI(host, account.Username+"@"+host, secret),
+	}, nil
+}
+
+// TwoFactorEnable enables two-factor auth for the given user, if
+// code is valid for the secret generated by TwoFactorSetup. It returns
+// a fresh set of recovery codes, which are not retrievable afterwards.
+func (p *Processor) TwoFactorEnable(ctx context.Context, user *gtsmodel.User, code string) (*apimodel.TwoFactorRecoveryCodes, gtserror.WithCode) {
+	if user.TwoFactorEnabled() {
+		const help = "two-factor authentication is already enabled"
+		return nil, gtserror.NewErrorConflict(errors.New(help), help)
+	}
+
+	if user.TwoFactorSecret == "" {
+		const help = "two-factor authentication has not been set up yet"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(help), help)
+	}
+
+	counter, ok := totp.ValidateCounter(user.TwoFactorSecret, code, time.Now(), 0)
+	if !ok {
+		const help = "code was incorrect, check the time on your device and try again"
+		return nil, gtserror.NewErrorBadRequest(errors.New(help), help)
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		err := gtserror.Newf("error generating recovery codes: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	user.TwoFactorEnabledAt = time.Now()
+	user.TwoFactorBackups = hashes
+	user.TwoFactorLastCounter = counter
+	if err := p.state.DB.UpdateUser(
+		ctx, user,
+		"two_factor_enabled_at",
+		"two_factor_backups",
+		"two_factor_last_counter",
+	); err != nil {
+		err := gtserror.Newf("db error updating user: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return &apimodel.TwoFactorRecoveryCodes{RecoveryCodes: codes}, nil
+}
+
+// TwoFactorDisable disables two-factor auth for the given user,
+// after checking that password is their current password.
+func (p *Processor) TwoFactorDisable(ctx context.Context, user *gtsmodel.User, password string) gtserror.WithCode {
+	if err := bcrypt.CompareHashAndPassword([]byte(user.EncryptedPassword), []byte(password)); err != nil {
+		err := gtserror.Newf("%w", err)
+		return gtserror.NewErrorUnauthorized(err, "password was incorrect")
+	}
+
+	if user.TwoFactorSecret == "" && !user.TwoFactorEnabled() {
+		// Nothing to do.
+		return nil
+	}
+
+	user.TwoFactorSecret = ""
+	user.TwoFactorEnabledAt = time.Time{}
+	user.TwoFactorBackups = nil
+	if err := p.state.DB.UpdateUser(
+		ctx, user,
+		"two_factor_secret",
+		"two_factor_enabled_at",
+		"two_factor_backups",
+	); err != nil {
+		err := gtserror.Newf("db error updating user: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+// TwoFactorCheck checks the second step of a sign in for a user with
+// two-factor auth enabled. The given code may be either a current TOTP
+// code, or one of the user's recovery codes, which is then used up.
+//
+// TOTP codes can only be used once, and after twoFactorMaxFailures
+// incorrect codes the user is locked out for twoFactorLockout,
+// regardless of how many sessions the codes were entered from.
+func (p *Processor) TwoFactorCheck(ctx context.Context, user *gtsmodel.User, code string) gtserror.WithCode {
+	if !user.TwoFactorEnabled() {
+		err := gtserror.Newf("user %s does not have two-factor authentication enabled", user.ID)
+		return gtserror.NewErrorBadRequest(err)
+	}
+
+	now := time.Now()
+	if now.Before(user.TwoFactorLockedUntil) {
+		err := gtserror.Newf("user %s is locked out of two-factor authentication until %s", user.ID, user.TwoFactorLockedUntil)
+		return gtserror.NewErrorTooManyRequests(err, "too many incorrect two-factor codes, please try again later")
+	}
+
+	if counter, ok := totp.ValidateCounter(
+		user.TwoFactorSecret,
+		code,
+		now,
+		user.TwoFactorLastCounter,
+	); ok {
+		// Store the counter so
+		// this code can't be reused.
+		user.TwoFactorLastCounter = counter
+		user.TwoFactorFailures = 0
+		if err := p.state.DB.UpdateUser(
+			ctx, user,
+			"two_factor_last_counter",
+			"two_factor_failures",
+		); err != nil {
+			err := gtserror.Newf("db error updating user: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		return nil
+	}
+
+	code = normalizeRecoveryCode(code)
+	if len(code) == totp.Digits {
+		// Looks like a TOTP code, don't
+		// bother checking recovery codes.
+		return p.twoFactorIncorrect(ctx, user, now)
+	}
+
+	for i, hash := range user.TwoFactorBackups {
+		if bcrypt.CompareHashAndPassword([]byte(hash), []byte(code)) != nil {
+			continue
+		}
+
+		// Match; use up this recovery code.
+		backups := make([]string, 0, len(user.TwoFactorBackups)-1)
+		backups = append(backups, user.TwoFactorBackups[:i]...)
+		backups = append(backups, user.TwoFactorBackups[i+1:]...)
+		user.TwoFactorBackups = backups
+		user.TwoFactorFailures = 0
+
+		if err := p.state.DB.UpdateUser(
+			ctx, user,
+			"two_factor_backups",
+			"two_factor_failures",
+		); err != nil {
+			err := gtserror.Newf("db error updating user: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		return nil
+	}
+
+	return p.twoFactorIncorrect(ctx, user, now)
+}
+
+// twoFactorIncorrect records an incorrect two-factor code
+// for the given user, locking them out if they've now had
+// too many, and returns an appropriate error.
+func (p *Processor) twoFactorIncorrect(ctx context.Context, user *gtsmodel.User, now time.Time) gtserror.WithCode {
+	user.TwoFactorFailures++
+	if user.TwoFactorFailures >= twoFactorMaxFailures {
+		// Too many, lock them out for a
+		// while and start counting again.
+		user.TwoFactorFailures = 0
+		user.TwoFactorLockedUntil = now.Add(twoFactorLockout)
+	}
+
+	if err := p.state.DB.UpdateUser(
+		ctx, user,
+		"two_factor_failures",
+		"two_factor_locked_until",
+	); err != nil {
+		err := gtserror.Newf("db error updating user: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	err := gtserror.Newf("incorrect two-factor code for user %s", user.ID)
+	return gtserror.NewErrorUnauthorized(err, "two-factor code was incorrect")
+}
+
+// generateRecoveryCodes returns recoveryCodeCount random
+// recovery codes, and the bcrypt hashes of them for storage.
+func generateRecoveryCodes() ([]string, []string, error) {
+	var (
+		codes  = make([]string, 0, recoveryCodeCount)
+		hashes = make([]string, 0, recoveryCodeCount)
+	)
+
+	for i := 0; i < recoveryCodeCount; i++ {
+		b := make([]byte, 5)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		code := hex.EncodeToString(b)
+
+		hash, err := bcrypt.GenerateFromPassword([]byte(code), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		codes = append(codes, code)
+		hashes = append(hashes, string(hash))
+	}
+
+	return codes, hashes, nil
+}
+
+// normalizeRecoveryCode tidies up a user-entered recovery
+// code, which may have been copied with spaces or dashes.
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' {
+			return -1
+		}
+		return r
+	}, code)
+}
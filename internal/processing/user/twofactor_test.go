@@ -0,0 +1,153 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package user_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/totp"
+)
+
+type TwoFactorTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *TwoFactorTestSuite) TestTwoFactorEnrollment() {
+	ctx := context.Background()
+	user := suite.testUsers["local_account_1"]
+
+	setup, errWithCode := suite.user.TwoFactorSetup(ctx, user)
+	suite.NoError(errWithCode)
+	suite.NotEmpty(setup.Secret)
+	suite.Contains(setup.URI, "otpauth://totp/localhost:8080:the_mighty_zork@localhost:8080?")
+	suite.False(user.TwoFactorEnabled())
+
+	// Wrong code shouldn't enable it.
+	_, errWithCode = suite.user.TwoFactorEnable(ctx, user, "000000")
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+	suite.False(user.TwoFactorEnabled())
+
+	code, err := totp.Code(setup.Secret, time.Now())
+	suite.NoError(err)
+
+	recovery, errWithCode := suite.user.TwoFactorEnable(ctx, user, code)
+	suite.NoError(errWithCode)
+	suite.Len(recovery.RecoveryCodes, 10)
+
+	dbUser, err := suite.db.GetUserByID(ctx, user.ID)
+	suite.NoError(err)
+	suite.True(dbUser.TwoFactorEnabled())
+	suite.Len(dbUser.TwoFactorBackups, 10)
+
+	// Can't set up again while enabled.
+	_, errWithCode = suite.user.TwoFactorSetup(ctx, dbUser)
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+
+	// Disabling needs the right password.
+	errWithCode = suite.user.TwoFactorDisable(ctx, dbUser, "not the password")
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+
+	errWithCode = suite.user.TwoFactorDisable(ctx, dbUser, "password")
+	suite.NoError(errWithCode)
+
+	dbUser, err = suite.db.GetUserByID(ctx, user.ID)
+	suite.NoError(err)
+	suite.False(dbUser.TwoFactorEnabled())
+	suite.Empty(dbUser.TwoFactorSecret)
+	suite.Empty(dbUser.TwoFactorBackups)
+}
+
+func (suite *TwoFactorTestSuite) TestTwoFactorCheck() {
+	ctx := context.Background()
+	user := suite.testUsers["local_account_1"]
+
+	setup, errWithCode := suite.user.TwoFactorSetup(ctx, user)
+	suite.NoError(errWithCode)
+	code, err := totp.Code(setup.Secret, time.Now())
+	suite.NoError(err)
+	recovery, errWithCode := suite.user.TwoFactorEnable(ctx, user, code)
+	suite.NoError(errWithCode)
+
+	// The code used to enable 2fa is used up.
+	errWithCode = suite.user.TwoFactorCheck(ctx, user, code)
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+
+	// The next code is fine, but only once.
+	code, err = totp.Code(setup.Secret, time.Now().Add(totp.Period))
+	suite.NoError(err)
+	suite.NoError(suite.user.TwoFactorCheck(ctx, user, code))
+	errWithCode = suite.user.TwoFactorCheck(ctx, user, code)
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+
+	// Wrong code is not.
+	errWithCode = suite.user.TwoFactorCheck(ctx, user, "not a code")
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+
+	// A recovery code works exactly once.
+	suite.NoError(suite.user.TwoFactorCheck(ctx, user, recovery.RecoveryCodes[3]))
+	suite.Len(user.TwoFactorBackups, 9)
+	errWithCode = suite.user.TwoFactorCheck(ctx, user, recovery.RecoveryCodes[3])
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+}
+
+func (suite *TwoFactorTestSuite) TestTwoFactorCheckLockout() {
+	ctx := context.Background()
+	user := new(gtsmodel.User)
+	*user = *suite.testUsers["local_account_1"]
+
+	setup, errWithCode := suite.user.TwoFactorSetup(ctx, user)
+	suite.NoError(errWithCode)
+	code, err := totp.Code(setup.Secret, time.Now().Add(-totp.Period))
+	suite.NoError(err)
+	_, errWithCode = suite.user.TwoFactorEnable(ctx, user, code)
+	suite.NoError(errWithCode)
+
+	// Wrong codes count up until the user is locked out.
+	for i := 0; i < 9; i++ {
+		errWithCode = suite.user.TwoFactorCheck(ctx, user, "000000")
+		suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+	}
+	suite.Equal(9, user.TwoFactorFailures)
+	suite.Zero(user.TwoFactorLockedUntil)
+
+	errWithCode = suite.user.TwoFactorCheck(ctx, user, "000000")
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+
+	// Lockout should be stored, not just on this session.
+	dbUser, err := suite.db.GetUserByID(ctx, user.ID)
+	suite.NoError(err)
+	suite.True(dbUser.TwoFactorLockedUntil.After(time.Now()))
+
+	// Correct code doesn't work while locked out.
+	code, err = totp.Code(setup.Secret, time.Now())
+	suite.NoError(err)
+	errWithCode = suite.user.TwoFactorCheck(ctx, dbUser, code)
+	suite.Equal(http.StatusTooManyRequests, errWithCode.Code())
+
+	// But does once the lockout has passed.
+	dbUser.TwoFactorLockedUntil = time.Now().Add(-time.Second)
+	suite.NoError(suite.user.TwoFactorCheck(ctx, dbUser, code))
+}
+
+func TestTwoFactorTestSuite(t *testing.T) {
+	suite.Run(t, &TwoFactorTestSuite{})
+}
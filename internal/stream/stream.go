@@ -26,6 +26,12 @@ const (
 	EventTypeUpdate string = "update"
 	// EventTypeDelete -- something should be deleted from a user
 	EventTypeDelete string = "delete"
+	// EventTypeAnnouncement -- a user should be shown a new or updated announcement
+	EventTypeAnnouncement string = "announcement"
+	// EventTypeAnnouncementReaction -- the reactions to an announcement have changed
+	EventTypeAnnouncementReaction string = "announcement.reaction"
+	// EventTypeAnnouncementDelete -- an announcement should be removed from a user
+	EventTypeAnnouncementDelete string = "announcement.delete"
 )
 
 const (
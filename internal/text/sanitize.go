@@ -48,6 +48,15 @@ var regular *bluemonday.Policy = bluemonday.UGCPolicy().
 // Source: https://github.com/microcosm-cc/bluemonday#usage
 var strict *bluemonday.Policy = bluemonday.StrictPolicy()
 
+// Allows only a single https iframe and its sizing attributes,
+// as used by oEmbed "video" and "rich" type preview card embeds.
+var embed *bluemonday.Policy = bluemonday.NewPolicy().
+	RequireParseableURLs(true).
+	AllowURLSchemes("https").
+	AllowAttrs("src").OnElements("iframe").
+	AllowAttrs("width", "height").Matching(bluemonday.Integer).OnElements("iframe").
+	AllowAttrs("allowfullscreen", "frameborder").OnElements("iframe")
+
 // removeHTML strictly removes *all* recognized HTML elements from the given string.
 func removeHTML(in string) string {
 	return strict.Sanitize(in)
@@ -58,6 +67,11 @@ func SanitizeHTML(in string) string {
 	return regular.Sanitize(in)
 }
 
+// SanitizeEmbedHTML sanitizes the given oEmbed html string, allowing only https iframes through.
+func SanitizeEmbedHTML(in string) string {
+	return embed.Sanitize(in)
+}
+
 // SanitizePlaintext runs text through basic sanitization. This removes
 // any html elements that were in the string, and returns clean plaintext.
 func SanitizePlaintext(in string) string {
@@ -0,0 +1,164 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+// Package totp implements time-based one-time
+// passwords as described in RFC 6238, using the
+// defaults understood by common authenticator apps:
+// HMAC-SHA1, 6 digits, and a 30 second time step.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // SHA1 is what RFC 6238 and authenticator apps use.
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Digits is the number of digits in a generated code.
+	Digits = 6
+
+	// Period is the time step between codes.
+	Period = 30 * time.Second
+
+	// Skew is the number of time steps either side
+	// of the current one for which a code is still
+	// accepted, to allow for clock drift + slow typing.
+	Skew = 1
+
+	// secretSize is the size in bytes of generated secrets,
+	// as recommended by RFC 4226 for HMAC-SHA1.
+	secretSize = 20
+)
+
+// encoding is unpadded base32, the format
+// in which authenticator apps expect secrets.
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a new random, base32-encoded secret.
+func GenerateSecret() (string, error) {
+	b := make([]byte, secretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return encoding.EncodeToString(b), nil
+}
+
+// Code returns the code for the given base32-encoded secret at time t.
+func Code(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return code(key, counter(t)), nil
+}
+
+// Validate returns true if the given code is valid for
+// the given base32-encoded secret at time t, allowing for
+// Skew time steps either side.
+func Validate(secret string, passcode string, t time.Time) bool {
+	_, ok := ValidateCounter(secret, passcode, t, 0)
+	return ok
+}
+
+// ValidateCounter is like Validate, but only accepts codes for
+// time steps after the given last used counter, so that a code
+// can't be replayed once it's been used. It returns the counter
+// of the matched code, which the caller should store and pass
+// in as last on the next call.
+func ValidateCounter(secret string, passcode string, t time.Time, last uint64) (uint64, bool) {
+	passcode = strings.TrimSpace(passcode)
+	if len(passcode) != Digits {
+		return 0, false
+	}
+
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, false
+	}
+
+	c := counter(t)
+	for i := -Skew; i <= Skew; i++ {
+		step := c + uint64(i)
+		if step <= last {
+			// Already used (or older).
+			continue
+		}
+
+		expect := code(key, step)
+		if subtle.ConstantTimeCompare([]byte(expect), []byte(passcode)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// URI returns an otpauth:// key URI for the given secret, which
+// can be entered into authenticator apps or shown as a QR code.
+//
+// See: https://github.com/google/google-authenticator/wiki/Key-Uri-Format
+func URI(issuer string, accountName string, secret string) string {
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(accountName)
+
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(Digits))
+	params.Set("period", fmt.Sprint(int(Period/time.Second)))
+
+	return "otpauth://totp/" + label + "?" + params.Encode()
+}
+
+// counter returns the number of time steps since the unix epoch.
+func counter(t time.Time) uint64 {
+	return uint64(t.Unix()) / uint64(Period/time.Second)
+}
+
+// code derives a code for key + counter as per RFC 4226 section 5.3.
+func code(key []byte, counter uint64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation.
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", Digits, value%1_000_000)
+}
+
+// decodeSecret decodes a base32 secret, tolerating
+// lowercase letters, spaces and trailing padding.
+func decodeSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	secret = strings.TrimRight(secret, "=")
+	key, err := encoding.DecodeString(secret)
+	if err != nil {
+		return nil, fmt.Errorf("invalid totp secret: %w", err)
+	}
+	return key, nil
+}
@@ -0,0 +1,217 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package translate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+// libreTranslateTimeout is the maximum time to wait
+// for a LibreTranslate API to respond to one request.
+const libreTranslateTimeout = 30 * time.Second
+
+// maxResponseSize is the maximum size of
+// a translation response body to read.
+const maxResponseSize = 1 << 20 // 1MiB
+
+// NewLibreTranslate returns a Translator which uses the
+// LibreTranslate-compatible API at the given base URL.
+func NewLibreTranslate(baseURL string, apiKey string) Translator {
+	return &libreTranslate{
+		endpoint: strings.TrimSuffix(baseURL, "/") + "/translate",
+		apiKey:   apiKey,
+		client: &http.Client{
+			// The API is configured by the admin, and is typically
+			// running locally, so we use a plain client rather than
+			// our federating client, which would block private IPs.
+			Timeout: libreTranslateTimeout,
+		},
+	}
+}
+
+type libreTranslate struct {
+	endpoint string
+	apiKey   string
+	client   *http.Client
+}
+
+type libreTranslateRequest struct {
+	Q      []string `json:"q"`
+	Source string   `json:"source"`
+	Target string   `json:"target"`
+	Format string   `json:"format"`
+	APIKey string   `json:"api_key,omitempty"`
+}
+
+type libreTranslateDetectedLanguage struct {
+	Confidence float64 `json:"confidence"`
+	Language   string  `json:"language"`
+}
+
+type libreTranslateResponse struct {
+	TranslatedText []string `json:"translatedText"`
+
+	// Given an array of texts to translate with source "auto",
+	// LibreTranslate returns one detected language per text.
+	DetectedLanguage []libreTranslateDetectedLanguage `json:"detectedLanguage"`
+
+	Error string `json:"error"`
+}
+
+func (l *libreTranslate) Enabled() bool {
+	return true
+}
+
+func (l *libreTranslate) Translate(ctx context.Context, texts []Text, source string, target string) (*Translation, error) {
+	if source == "" {
+		source = "auto"
+	}
+
+	// LibreTranslate takes one format per request,
+	// so group texts by format, keeping track of
+	// where each one came from in the given slice.
+	var (
+		htmlQ, textQ     []string
+		htmlIdx, textIdx []int
+	)
+
+	for i, t := range texts {
+		if t.HTML {
+			htmlQ = append(htmlQ, t.Text)
+			htmlIdx = append(htmlIdx, i)
+		} else {
+			textQ = append(textQ, t.Text)
+			textIdx = append(textIdx, i)
+		}
+	}
+
+	var (
+		translated = make([]string, len(texts))
+		detected   string
+	)
+
+	for _, group := range []struct {
+		q      []string
+		idx    []int
+		format string
+	}{
+		{htmlQ, htmlIdx, "html"},
+		{textQ, textIdx, "text"},
+	} {
+		if len(group.q) == 0 {
+			continue
+		}
+
+		results, lang, err := l.translate(ctx, group.q, group.format, source, target)
+		if err != nil {
+			return nil, err
+		}
+
+		if detected == "" {
+			detected = lang
+		}
+
+		for i, result := range results {
+			translated[group.idx[i]] = result
+		}
+	}
+
+	sourceLanguage := source
+	if sourceLanguage == "auto" {
+		sourceLanguage = detected
+	}
+
+	return &Translation{
+		Texts:          translated,
+		SourceLanguage: sourceLanguage,
+		Provider:       "LibreTranslate",
+	}, nil
+}
+
+// translate does one translate request for the given texts of the given
+// format, returning the translated texts and detected language, if any.
+func (l *libreTranslate) translate(ctx context.Context, q []string, format string, source string, target string) ([]string, string, error) {
+	b, err := json.Marshal(libreTranslateRequest{
+		Q:      q,
+		Source: BaseLanguage(source),
+		Target: BaseLanguage(target),
+		Format: format,
+		APIKey: l.apiKey,
+	})
+	if err != nil {
+		return nil, "", gtserror.Newf("error marshaling request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, l.endpoint, bytes.NewReader(b))
+	if err != nil {
+		return nil, "", gtserror.Newf("error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	rsp, err := l.client.Do(req)
+	if err != nil {
+		return nil, "", gtserror.Newf("error doing request: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	b, err = io.ReadAll(io.LimitReader(rsp.Body, maxResponseSize))
+	if err != nil {
+		return nil, "", gtserror.Newf("error reading response: %w", err)
+	}
+
+	var result libreTranslateResponse
+	if err := json.Unmarshal(b, &result); err != nil {
+		return nil, "", gtserror.Newf("error unmarshaling response (status %s): %w", rsp.Status, err)
+	}
+
+	if rsp.StatusCode != http.StatusOK {
+		return nil, "", gtserror.Newf("translation failed (status %s): %s", rsp.Status, result.Error)
+	}
+
+	if len(result.TranslatedText) != len(q) {
+		return nil, "", gtserror.Newf("expected %d translated texts, got %d", len(q), len(result.TranslatedText))
+	}
+
+	return result.TranslatedText, detectedLanguage(result.DetectedLanguage), nil
+}
+
+// detectedLanguage returns the detected language
+// with the highest confidence, or "" if none.
+func detectedLanguage(detected []libreTranslateDetectedLanguage) string {
+	var best *libreTranslateDetectedLanguage
+	for i := range detected {
+		if best == nil || detected[i].Confidence > best.Confidence {
+			best = &detected[i]
+		}
+	}
+
+	if best == nil {
+		return ""
+	}
+
+	return best.Language
+}
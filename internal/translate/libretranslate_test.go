@@ -0,0 +1,110 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package translate_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/translate"
+)
+
+type LibreTranslateTestSuite struct {
+	suite.Suite
+}
+
+func (suite *LibreTranslateTestSuite) TestTranslate() {
+	got := make(map[string]map[string]interface{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal("/translate", r.URL.Path)
+
+		var req map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		format, _ := req["format"].(string)
+		got[format] = req
+
+		w.Header().Set("Content-Type", "application/json")
+		switch format {
+		case "html":
+			_, _ = w.Write([]byte(`{"detectedLanguage":[{"confidence":90,"language":"de"},{"confidence":40,"language":"nl"}],"translatedText":["<p>hello world</p>"]}`))
+		case "text":
+			_, _ = w.Write([]byte(`{"detectedLanguage":[{"confidence":90,"language":"nl"}],"translatedText":["cw","a picture"]}`))
+		}
+	}))
+	defer server.Close()
+
+	translator := translate.NewLibreTranslate(server.URL+"/", "some-key")
+	suite.True(translator.Enabled())
+
+	translation, err := translator.Translate(context.Background(), []translate.Text{
+		{Text: "<p>hallo welt</p>", HTML: true},
+		{Text: "cw"},
+		{Text: "ein bild"},
+	}, "", "en-GB")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Html and plain text should be sent
+	// separately, with the right format.
+	suite.Len(got, 2)
+	suite.Equal([]interface{}{"<p>hallo welt</p>"}, got["html"]["q"])
+	suite.Equal([]interface{}{"cw", "ein bild"}, got["text"]["q"])
+
+	for _, req := range got {
+		suite.Equal("auto", req["source"])
+		suite.Equal("en", req["target"])
+		suite.Equal("some-key", req["api_key"])
+	}
+
+	suite.Equal([]string{"<p>hello world</p>", "cw", "a picture"}, translation.Texts)
+	suite.Equal("de", translation.SourceLanguage)
+	suite.Equal("LibreTranslate", translation.Provider)
+}
+
+func (suite *LibreTranslateTestSuite) TestTranslateError() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"xx is not supported"}`))
+	}))
+	defer server.Close()
+
+	translator := translate.NewLibreTranslate(server.URL, "")
+	_, err := translator.Translate(context.Background(), []translate.Text{{Text: "hello"}}, "en", "xx")
+	suite.ErrorContains(err, "xx is not supported")
+}
+
+func (suite *LibreTranslateTestSuite) TestNoop() {
+	translator := translate.NewNoop()
+	suite.False(translator.Enabled())
+
+	_, err := translator.Translate(context.Background(), []translate.Text{{Text: "hello"}}, "en", "de")
+	suite.ErrorIs(err, translate.ErrNotEnabled)
+}
+
+func TestLibreTranslateTestSuite(t *testing.T) {
+	suite.Run(t, new(LibreTranslateTestSuite))
+}
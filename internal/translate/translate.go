@@ -0,0 +1,97 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package translate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+)
+
+// ErrNotEnabled is returned by Translate when
+// no translation backend has been configured.
+var ErrNotEnabled = errors.New("translation not enabled")
+
+// Translator translates texts between languages.
+type Translator interface {
+	// Enabled returns whether this translator is
+	// able to translate anything at all.
+	Enabled() bool
+
+	// Translate translates each of the given texts into the target
+	// language, which should be a BCP 47 language tag. Source is a hint
+	// for the language of the texts; if it's empty, the backend will try
+	// to detect the language itself. Returned texts are in the same order
+	// as the given texts.
+	Translate(ctx context.Context, texts []Text, source string, target string) (*Translation, error)
+}
+
+// Text is one text to be translated.
+type Text struct {
+	// Text is the text itself.
+	Text string
+
+	// HTML is whether Text is html,
+	// rather than just plain text.
+	HTML bool
+}
+
+// Translation is the result of
+// translating a slice of texts.
+type Translation struct {
+	// Texts contains the translated
+	// texts, in their original order.
+	Texts []string
+
+	// SourceLanguage is the language that the texts were translated
+	// from, either the given source hint or as detected by the backend.
+	SourceLanguage string
+
+	// Provider is the name of the translation
+	// backend service that did the translation.
+	Provider string
+}
+
+// New returns a new Translator using the
+// translation backend set in the config.
+func New() (Translator, error) {
+	switch backend := config.GetTranslationBackend(); backend {
+	case "":
+		return NewNoop(), nil
+	case "libretranslate":
+		url := config.GetTranslationLibreTranslateURL()
+		if url == "" {
+			return nil, fmt.Errorf("%s must be set for translation backend %s", config.TranslationLibreTranslateURLFlag(), backend)
+		}
+		return NewLibreTranslate(url, config.GetTranslationLibreTranslateAPIKey()), nil
+	default:
+		return nil, fmt.Errorf("translation backend %s not recognised", backend)
+	}
+}
+
+// BaseLanguage returns the primary language subtag of the given
+// BCP 47 language tag (eg., "en" for "en-GB"). LibreTranslate only
+// deals in ISO 639 language codes, and regional variants don't
+// matter when deciding whether something needs translating.
+func BaseLanguage(tag string) string {
+	base, _, _ := strings.Cut(tag, "-")
+	return strings.ToLower(base)
+}
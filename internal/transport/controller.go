@@ -43,6 +43,10 @@ type Controller interface {
 
 	// NewTransportForUsername searches for account with username, and returns result of .NewTransport().
 	NewTransportForUsername(ctx context.Context, username string) (Transport, error)
+
+	// DereferenceLink fetches the web page at the given link IRI with accept header, for generating a preview card,
+	// returning the (size-limited) body and its content-type. Unlike transport GETs, the request is not signed.
+	DereferenceLink(ctx context.Context, iri *url.URL, accept string) ([]byte, string, error)
 }
 
 type controller struct {
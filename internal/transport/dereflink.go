@@ -0,0 +1,67 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+// maxLinkSize is the maximum number of bytes read
+// from a link document when generating a preview card;
+// the metadata we need is (almost) always in the head.
+const maxLinkSize = 1 << 20 // 1MiB
+
+func (c *controller) DereferenceLink(ctx context.Context, iri *url.URL, accept string) ([]byte, string, error) {
+	// Build IRI just once
+	iriStr := iri.String()
+
+	// Prepare HTTP request to this link's IRI
+	req, err := http.NewRequestWithContext(ctx, "GET", iriStr, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	req.Header.Add("Accept", accept)
+	req.Header.Add("Accept-Charset", "utf-8")
+	req.Header.Set("Host", iri.Host)
+	req.Header.Set("User-Agent", c.userAgent)
+
+	// Perform the HTTP request; this is an ordinary web
+	// page, not an AP resource, so no need to sign it.
+	rsp, err := c.client.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rsp.Body.Close()
+
+	// Check for an expected status code
+	if rsp.StatusCode != http.StatusOK {
+		return nil, "", gtserror.NewFromResponse(rsp)
+	}
+
+	b, err := io.ReadAll(io.LimitReader(rsp.Body, maxLinkSize))
+	if err != nil {
+		return nil, "", err
+	}
+
+	return b, rsp.Header.Get("Content-Type"), nil
+}
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/miekg/dns"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
@@ -29,8 +30,11 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/langdetect"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
 )
 
 func (c *converter) ASRepresentationToAccount(ctx context.Context, accountable ap.Accountable, accountDomain string) (*gtsmodel.Account, error) {
@@ -408,8 +412,15 @@ func (c *converter) ASStatusToStatus(ctx context.Context, statusable ap.Statusab
 		return &s
 	}()
 
-	// language
-	// TODO: we might be able to extract this from the contentMap field
+	// status.Language
+	//
+	// TODO: we might be able to extract this from the contentMap field;
+	// until then, try to detect it from the text of the status itself.
+	if status.Language == "" {
+		status.Language = langdetect.Detect(
+			text.SanitizePlaintext(status.ContentWarning + "\n" + status.Content),
+		)
+	}
 
 	// ActivityStreamsType
 	status.ActivityStreamsType = statusable.GetTypeName()
@@ -533,6 +544,63 @@ func (c *converter) ASLikeToFave(ctx context.Context, likeable ap.Likeable) (*gt
 	}, nil
 }
 
+func (c *converter) ASLikeToStatusReaction(ctx context.Context, reactable ap.Reactable) (*gtsmodel.StatusReaction, error) {
+	// Account and status are resolved just like a fave.
+	fave, err := c.ASLikeToFave(ctx, reactable)
+	if err != nil {
+		return nil, err
+	}
+
+	reaction := &gtsmodel.StatusReaction{
+		AccountID:       fave.AccountID,
+		Account:         fave.Account,
+		TargetAccountID: fave.TargetAccountID,
+		TargetAccount:   fave.TargetAccount,
+		StatusID:        fave.StatusID,
+		Status:          fave.Status,
+		URI:             fave.URI,
+	}
+
+	content := strings.TrimSpace(ap.ExtractContent(reactable))
+	if len(content) < 3 || !strings.HasPrefix(content, ":") || !strings.HasSuffix(content, ":") {
+		// Not a :shortcode:, so this should be a unicode emoji.
+		if err := validate.UnicodeEmoji(content); err != nil {
+			return nil, fmt.Errorf("invalid reaction content %q: %w", content, err)
+		}
+
+		reaction.Name = content
+		return reaction, nil
+	}
+
+	// Misskey may qualify the shortcode
+	// of an emoji with its domain; drop it.
+	shortcode, _, _ := strings.Cut(strings.Trim(content, ":"), "@")
+
+	emojis, err := ap.ExtractEmojis(reactable)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting reaction emoji: %w", err)
+	}
+
+	for _, emoji := range emojis {
+		if emoji.Shortcode != shortcode {
+			continue
+		}
+
+		if emoji.Domain == config.GetHost() {
+			// Reaction with one of our own emojis.
+			emoji.Domain = ""
+			reaction.Name = shortcode
+		} else {
+			reaction.Name = shortcode + "@" + emoji.Domain
+		}
+
+		reaction.Emoji = emoji
+		return reaction, nil
+	}
+
+	return nil, fmt.Errorf("no emoji tag found for reaction %s", content)
+}
+
 func (c *converter) ASBlockToBlock(ctx context.Context, blockable ap.Blockable) (*gtsmodel.Block, error) {
 	idProp := blockable.GetJSONLDId()
 	if idProp == nil || !idProp.IsIRI() {
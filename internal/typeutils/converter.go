@@ -62,6 +62,10 @@ type TypeConverter interface {
 	AppToAPIAppPublic(ctx context.Context, application *gtsmodel.Application) (*apimodel.Application, error)
 	// AttachmentToAPIAttachment converts a gts model media attacahment into its api representation for serialization on the API.
 	AttachmentToAPIAttachment(ctx context.Context, attachment *gtsmodel.MediaAttachment) (apimodel.Attachment, error)
+	// CardToAPICard converts a gts model preview card into its api representation for serialization on the API.
+	CardToAPICard(ctx context.Context, card *gtsmodel.Card) (*apimodel.Card, error)
+	// CardToAPITrendsLink converts a gts model preview card into a trending link, with usage history, for serialization on the API.
+	CardToAPITrendsLink(ctx context.Context, card *gtsmodel.Card) (*apimodel.TrendsLink, error)
 	// MentionToAPIMention converts a gts model mention into its api (frontend) representation for serialization on the API.
 	MentionToAPIMention(ctx context.Context, m *gtsmodel.Mention) (apimodel.Mention, error)
 	// EmojiToAPIEmoji converts a gts model emoji into its api (frontend) representation for serialization on the API.
@@ -71,8 +75,8 @@ type TypeConverter interface {
 	// EmojiCategoryToAPIEmojiCategory converts a gts model emoji category into its api (frontend) representation.
 	EmojiCategoryToAPIEmojiCategory(ctx context.Context, category *gtsmodel.EmojiCategory) (*apimodel.EmojiCategory, error)
 	// TagToAPITag converts a gts model tag into its api (frontend) representation for serialization on the API.
-	// If stubHistory is set to 'true', then the 'history' field of the tag will be populated with a pointer to an empty slice, for API compatibility reasons.
-	TagToAPITag(ctx context.Context, t *gtsmodel.Tag, stubHistory bool) (apimodel.Tag, error)
+	// If withHistory is set to 'true', then the 'history' field of the tag will be populated with per-day usage of the tag over the last week.
+	TagToAPITag(ctx context.Context, t *gtsmodel.Tag, withHistory bool) (apimodel.Tag, error)
 	// StatusToAPIStatus converts a gts model status into its api (frontend) representation for serialization on the API.
 	//
 	// Requesting account can be nil.
@@ -89,14 +93,36 @@ type TypeConverter interface {
 	NotificationToAPINotification(ctx context.Context, n *gtsmodel.Notification) (*apimodel.Notification, error)
 	// DomainBlockToAPIDomainBlock converts a gts model domin block into a api domain block, for serving at /api/v1/admin/domain_blocks
 	DomainBlockToAPIDomainBlock(ctx context.Context, b *gtsmodel.DomainBlock, export bool) (*apimodel.DomainBlock, error)
+	// EmailDomainBlockToAPIEmailDomainBlock converts a gts model email domain block into an api email domain block, for serving at /api/v1/admin/email_domain_blocks
+	EmailDomainBlockToAPIEmailDomainBlock(ctx context.Context, b *gtsmodel.EmailDomainBlock) (*apimodel.EmailDomainBlock, error)
+	// IPBlockToAPIIPBlock converts a gts model IP block into an api IP block, for serving at /api/v1/admin/ip_blocks
+	IPBlockToAPIIPBlock(b *gtsmodel.IPBlock) *apimodel.IPBlock
+	// InviteToAPIInvite converts a gts model invite into an api invite, for serving at /api/v1/invites
+	InviteToAPIInvite(i *gtsmodel.Invite) *apimodel.Invite
 	// ReportToAPIReport converts a gts model report into an api model report, for serving at /api/v1/reports
 	ReportToAPIReport(ctx context.Context, r *gtsmodel.Report) (*apimodel.Report, error)
 	// ReportToAdminAPIReport converts a gts model report into an admin view report, for serving at /api/v1/admin/reports
 	ReportToAdminAPIReport(ctx context.Context, r *gtsmodel.Report, requestingAccount *gtsmodel.Account) (*apimodel.AdminReport, error)
+	// TrendToAdminAPITrend converts a gts model trend into an admin view trend, for serving at /api/v1/admin/trends
+	TrendToAdminAPITrend(ctx context.Context, t *gtsmodel.Trend, requestingAccount *gtsmodel.Account) (*apimodel.AdminTrend, error)
+	// AdminAccountActionToAPIAccountWarning converts a gts model admin account action into an api model account warning, for serving to the target of the action.
+	AdminAccountActionToAPIAccountWarning(ctx context.Context, a *gtsmodel.AdminAccountAction) (*apimodel.AccountWarning, error)
 	// ListToAPIList converts one gts model list into an api model list, for serving at /api/v1/lists/{id}
 	ListToAPIList(ctx context.Context, l *gtsmodel.List) (*apimodel.List, error)
 	// MarkersToAPIMarker converts several gts model markers into an api marker, for serving at /api/v1/markers
 	MarkersToAPIMarker(ctx context.Context, markers []*gtsmodel.Marker) (*apimodel.Marker, error)
+	// WebPushSubscriptionToAPIWebPushSubscription converts a gts model web push subscription into an api model web push subscription, for serving at /api/v1/push/subscription
+	WebPushSubscriptionToAPIWebPushSubscription(ctx context.Context, sub *gtsmodel.WebPushSubscription) (*apimodel.WebPushSubscription, error)
+	// RuleToAPIRule converts one gts model instance rule into an api model instance rule, for serving at /api/v1/instance/rules
+	RuleToAPIRule(r *gtsmodel.Rule) apimodel.InstanceRule
+	// RulesToAPIRules converts several gts model instance rules into api model instance rules.
+	RulesToAPIRules(rules []*gtsmodel.Rule) []apimodel.InstanceRule
+	// AnnouncementToAPIAnnouncement converts a gts model announcement into an api model announcement, for serving at /api/v1/announcements.
+	// If requestingAccount is set, the announcement's read state and reactions will be shown from the perspective of that account.
+	AnnouncementToAPIAnnouncement(ctx context.Context, a *gtsmodel.Announcement, requestingAccount *gtsmodel.Account) (*apimodel.Announcement, error)
+	// NotificationRequestToAPINotificationRequest converts a gts model notification request into an api model notification request,
+	// with its last status shown from the perspective of the account the request was made to.
+	NotificationRequestToAPINotificationRequest(ctx context.Context, r *gtsmodel.NotificationRequest) (*apimodel.NotificationRequest, error)
 
 	/*
 		INTERNAL (gts) MODEL TO FRONTEND (rss) MODEL
@@ -120,6 +146,9 @@ type TypeConverter interface {
 	ASFollowToFollow(ctx context.Context, followable ap.Followable) (*gtsmodel.Follow, error)
 	// ASLikeToFave converts a remote activitystreams 'like' representation into a gts model status fave.
 	ASLikeToFave(ctx context.Context, likeable ap.Likeable) (*gtsmodel.StatusFave, error)
+	// ASLikeToStatusReaction converts a remote activitystreams 'like' with content set into a gts model status reaction.
+	// The Emoji of a custom emoji reaction will be the minimal emoji extracted from the like's tags, not yet in the database.
+	ASLikeToStatusReaction(ctx context.Context, reactable ap.Reactable) (*gtsmodel.StatusReaction, error)
 	// ASBlockToBlock converts a remote activity streams 'block' representation into a gts model block.
 	ASBlockToBlock(ctx context.Context, blockable ap.Blockable) (*gtsmodel.Block, error)
 	// ASAnnounceToStatus converts an activitystreams 'announce' into a status.
@@ -167,6 +196,8 @@ type TypeConverter interface {
 	AttachmentToAS(ctx context.Context, a *gtsmodel.MediaAttachment) (vocab.ActivityStreamsDocument, error)
 	// FaveToAS converts a gts model status fave into an activityStreams LIKE, suitable for federation.
 	FaveToAS(ctx context.Context, f *gtsmodel.StatusFave) (vocab.ActivityStreamsLike, error)
+	// StatusReactionToAS converts a gts model status reaction into an activityStreams LIKE with the emoji as content, suitable for federation.
+	StatusReactionToAS(ctx context.Context, r *gtsmodel.StatusReaction) (vocab.ActivityStreamsLike, error)
 	// BoostToAS converts a gts model boost into an activityStreams ANNOUNCE, suitable for federation
 	BoostToAS(ctx context.Context, boostWrapperStatus *gtsmodel.Status, boostingAccount *gtsmodel.Account, boostedAccount *gtsmodel.Account) (vocab.ActivityStreamsAnnounce, error)
 	// BlockToAS converts a gts model block into an activityStreams BLOCK, suitable for federation.
@@ -187,6 +218,16 @@ type TypeConverter interface {
 	//
 	// Appropriate 'next' and 'prev' fields will be created based on the highest and lowest IDs present in the statuses slice.
 	StatusesToASOutboxPage(ctx context.Context, outboxID string, maxID string, minID string, statuses []*gtsmodel.Status) (vocab.ActivityStreamsOrderedCollectionPage, error)
+	// AccountsToASCollection returns an ordered collection of accounts (eg., followers or following)
+	// with appropriate id, totalItems, and first fields. The returned collection won't have any actual
+	// entries; just a link to where entries can be obtained. If hidden is true, the 'first' field is
+	// omitted, so that only the total number of items in the collection is exposed.
+	AccountsToASCollection(ctx context.Context, collectionID string, totalItems int, hidden bool) (vocab.ActivityStreamsOrderedCollection, error)
+	// AccountsToASCollectionPage returns an ordered collection page with the URIs of the given accounts
+	// as contents. CollectionID is used to create the 'partOf' field in the collection page, and maxID
+	// to create its 'id'. If nextMaxID is set, a 'next' field will be created pointing to the page
+	// of the collection starting after nextMaxID.
+	AccountsToASCollectionPage(ctx context.Context, collectionID string, maxID string, nextMaxID string, totalItems int, accounts []*gtsmodel.Account) (vocab.ActivityStreamsOrderedCollectionPage, error)
 	// StatusesToASFeaturedCollection converts a slice of statuses into an ordered collection
 	// of URIs, suitable for serializing and serving via the activitypub API.
 	StatusesToASFeaturedCollection(ctx context.Context, featuredCollectionID string, statuses []*gtsmodel.Status) (vocab.ActivityStreamsOrderedCollection, error)
@@ -214,6 +255,9 @@ type TypeConverter interface {
 	// but just the AP URI of the note. This is useful in cases where you want to give a remote server something to dereference,
 	// and still have control over whether or not they're allowed to actually see the contents.
 	WrapNoteInCreate(note vocab.ActivityStreamsNote, objectIRIOnly bool) (vocab.ActivityStreamsCreate, error)
+	// WrapNoteInUpdate wraps a Note with an Update activity from originAccount,
+	// addressed to the same audience as the Note itself.
+	WrapNoteInUpdate(note vocab.ActivityStreamsNote, originAccount *gtsmodel.Account) (vocab.ActivityStreamsUpdate, error)
 }
 
 type converter struct {
@@ -29,6 +29,7 @@ import (
 	"github.com/superseriousbusiness/activity/pub"
 	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
@@ -591,6 +592,12 @@ func (c *converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (vocab.A
 	status.SetActivityStreamsTo(toProp)
 	status.SetActivityStreamsCc(ccProp)
 
+	// interactionPolicy -- only advertised if
+	// the status restricts interactions at all.
+	if policy := interactionPolicyToAS(s, mentions); policy != nil {
+		status.GetUnknownProperties()[ap.PropInteractionPolicy] = policy
+	}
+
 	// conversation
 	// TODO
 
@@ -1058,6 +1065,56 @@ func (c *converter) FaveToAS(ctx context.Context, f *gtsmodel.StatusFave) (vocab
 	return like, nil
 }
 
+func (c *converter) StatusReactionToAS(ctx context.Context, r *gtsmodel.StatusReaction) (vocab.ActivityStreamsLike, error) {
+	// A reaction is a Like with some extra bits.
+	like, err := c.FaveToAS(ctx, &gtsmodel.StatusFave{
+		AccountID:       r.AccountID,
+		Account:         r.Account,
+		TargetAccountID: r.TargetAccountID,
+		TargetAccount:   r.TargetAccount,
+		StatusID:        r.StatusID,
+		Status:          r.Status,
+		URI:             r.URI,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("StatusReactionToAS: %w", err)
+	}
+
+	if r.EmojiID == "" {
+		// Unicode emoji goes in as-is.
+		contentProp := streams.NewActivityStreamsContentProperty()
+		contentProp.AppendXMLSchemaString(r.Name)
+		like.SetActivityStreamsContent(contentProp)
+		return like, nil
+	}
+
+	// check if the emoji is already pinned to this reaction, and fetch it if not
+	if r.Emoji == nil {
+		e, err := c.db.GetEmojiByID(ctx, r.EmojiID)
+		if err != nil {
+			return nil, fmt.Errorf("StatusReactionToAS: error fetching emoji from database: %s", err)
+		}
+		r.Emoji = e
+	}
+
+	// set the content property to the :shortcode: of the
+	// custom emoji, and include the emoji itself as a tag
+	contentProp := streams.NewActivityStreamsContentProperty()
+	contentProp.AppendXMLSchemaString(":" + r.Emoji.Shortcode + ":")
+	like.SetActivityStreamsContent(contentProp)
+
+	asEmoji, err := c.EmojiToAS(ctx, r.Emoji)
+	if err != nil {
+		return nil, fmt.Errorf("StatusReactionToAS: error converting emoji to AS emoji: %s", err)
+	}
+
+	tagProp := streams.NewActivityStreamsTagProperty()
+	tagProp.AppendTootEmoji(asEmoji)
+	like.SetActivityStreamsTag(tagProp)
+
+	return like, nil
+}
+
 func (c *converter) BoostToAS(ctx context.Context, boostWrapperStatus *gtsmodel.Status, boostingAccount *gtsmodel.Account, boostedAccount *gtsmodel.Account) (vocab.ActivityStreamsAnnounce, error) {
 	// the boosted status is probably pinned to the boostWrapperStatus but double check to make sure
 	if boostWrapperStatus.BoostOf == nil {
@@ -1479,6 +1536,95 @@ func (c *converter) OutboxToASCollection(ctx context.Context, outboxID string) (
 	return collection, nil
 }
 
+func (c *converter) AccountsToASCollection(ctx context.Context, collectionID string, totalItems int, hidden bool) (vocab.ActivityStreamsOrderedCollection, error) {
+	collection := streams.NewActivityStreamsOrderedCollection()
+
+	collectionIDProp := streams.NewJSONLDIdProperty()
+	collectionIDURI, err := url.Parse(collectionID)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing url %s", collectionID)
+	}
+	collectionIDProp.SetIRI(collectionIDURI)
+	collection.SetJSONLDId(collectionIDProp)
+
+	totalItemsProp := streams.NewActivityStreamsTotalItemsProperty()
+	totalItemsProp.Set(totalItems)
+	collection.SetActivityStreamsTotalItems(totalItemsProp)
+
+	if hidden {
+		// Don't link to
+		// any entries.
+		return collection, nil
+	}
+
+	collectionFirstProp := streams.NewActivityStreamsFirstProperty()
+	collectionFirstPropID := fmt.Sprintf("%s?page=true", collectionID)
+	collectionFirstPropIDURI, err := url.Parse(collectionFirstPropID)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing url %s", collectionFirstPropID)
+	}
+	collectionFirstProp.SetIRI(collectionFirstPropIDURI)
+	collection.SetActivityStreamsFirst(collectionFirstProp)
+
+	return collection, nil
+}
+
+func (c *converter) AccountsToASCollectionPage(ctx context.Context, collectionID string, maxID string, nextMaxID string, totalItems int, accounts []*gtsmodel.Account) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	page := streams.NewActivityStreamsOrderedCollectionPage()
+
+	// .id
+	pageIDProp := streams.NewJSONLDIdProperty()
+	pageID := fmt.Sprintf("%s?page=true", collectionID)
+	if maxID != "" {
+		pageID = fmt.Sprintf("%s&max_id=%s", pageID, maxID)
+	}
+	pageIDURI, err := url.Parse(pageID)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing url %s", pageID)
+	}
+	pageIDProp.SetIRI(pageIDURI)
+	page.SetJSONLDId(pageIDProp)
+
+	// .partOf
+	collectionIDURI, err := url.Parse(collectionID)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing url %s", collectionID)
+	}
+	partOfProp := streams.NewActivityStreamsPartOfProperty()
+	partOfProp.SetIRI(collectionIDURI)
+	page.SetActivityStreamsPartOf(partOfProp)
+
+	// .totalItems
+	totalItemsProp := streams.NewActivityStreamsTotalItemsProperty()
+	totalItemsProp.Set(totalItems)
+	page.SetActivityStreamsTotalItems(totalItemsProp)
+
+	// .orderedItems
+	itemsProp := streams.NewActivityStreamsOrderedItemsProperty()
+	for _, a := range accounts {
+		uri, err := url.Parse(a.URI)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing url %s", a.URI)
+		}
+		itemsProp.AppendIRI(uri)
+	}
+	page.SetActivityStreamsOrderedItems(itemsProp)
+
+	// .next
+	if nextMaxID != "" {
+		nextProp := streams.NewActivityStreamsNextProperty()
+		nextPropIDString := fmt.Sprintf("%s?page=true&max_id=%s", collectionID, nextMaxID)
+		nextPropIDURI, err := url.Parse(nextPropIDString)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing url %s", nextPropIDString)
+		}
+		nextProp.SetIRI(nextPropIDURI)
+		page.SetActivityStreamsNext(nextProp)
+	}
+
+	return page, nil
+}
+
 func (c *converter) StatusesToASFeaturedCollection(ctx context.Context, featuredCollectionID string, statuses []*gtsmodel.Status) (vocab.ActivityStreamsOrderedCollection, error) {
 	collection := streams.NewActivityStreamsOrderedCollection()
 
@@ -1533,8 +1679,34 @@ func (c *converter) ReportToASFlag(ctx context.Context, r *gtsmodel.Report) (voc
 	flag.SetActivityStreamsActor(flagActorProp)
 
 	// content should be the comment submitted when the report was created
+	content := r.Comment
+
+	// Other servers don't know about our instance
+	// rules, so the best we can do is to append the
+	// text of any broken rules to the content.
+	if len(r.RuleIDs) != 0 && len(r.Rules) == 0 {
+		r.Rules, err = c.db.GetRulesByIDs(ctx, r.RuleIDs)
+		if err != nil {
+			return nil, fmt.Errorf("error getting report rules: %w", err)
+		}
+	}
+
+	if len(r.Rules) != 0 {
+		var b strings.Builder
+		b.WriteString(content)
+		if content != "" {
+			b.WriteString("\n\n")
+		}
+		b.WriteString("Broken rules:")
+		for _, rule := range r.Rules {
+			b.WriteString("\n- ")
+			b.WriteString(rule.Text)
+		}
+		content = b.String()
+	}
+
 	contentProp := streams.NewActivityStreamsContentProperty()
-	contentProp.AppendXMLSchemaString(r.Comment)
+	contentProp.AppendXMLSchemaString(content)
 	flag.SetActivityStreamsContent(contentProp)
 
 	// set at least the target account uri as the object of the flag
@@ -1556,3 +1728,58 @@ func (c *converter) ReportToASFlag(ctx context.Context, r *gtsmodel.Report) (voc
 
 	return flag, nil
 }
+
+// interactionPolicyToAS returns the interactionPolicy
+// of the given status as a json-ld compatible map, or
+// nil if the status permits anyone to interact with it.
+//
+// For each of canReply, canLike and canAnnounce, the
+// 'always' entry lists the IRIs of actors (or collections
+// of actors) permitted to perform that interaction.
+func interactionPolicyToAS(s *gtsmodel.Status, mentions []*gtsmodel.Mention) map[string]interface{} {
+	var (
+		reply = s.EffectiveReplyPolicy()
+		boost = s.EffectiveBoostPolicy()
+		like  = s.EffectiveLikePolicy()
+	)
+
+	// Non-public statuses are never boostable by others
+	// anyway, so don't advertise a policy just for that.
+	publicish := s.Visibility == gtsmodel.VisibilityPublic ||
+		s.Visibility == gtsmodel.VisibilityUnlocked
+
+	if !reply.Restricted() &&
+		!like.Restricted() &&
+		!(publicish && boost.Restricted()) {
+		return nil
+	}
+
+	always := func(policy gtsmodel.InteractionPolicy) map[string]interface{} {
+		// Author may always interact.
+		iris := []interface{}{s.Account.URI}
+
+		switch policy {
+		case gtsmodel.InteractionPolicyAnyone:
+			iris = append(iris, pub.PublicActivityPubIRI)
+		case gtsmodel.InteractionPolicyFollowers:
+			iris = append(iris, s.Account.FollowersURI)
+			fallthrough
+		case gtsmodel.InteractionPolicyMentioned:
+			for _, m := range mentions {
+				if m.TargetAccount != nil {
+					iris = append(iris, m.TargetAccount.URI)
+				}
+			}
+		}
+
+		return map[string]interface{}{
+			ap.PropAlways: iris,
+		}
+	}
+
+	return map[string]interface{}{
+		ap.PropCanReply:    always(reply),
+		ap.PropCanLike:     always(like),
+		ap.PropCanAnnounce: always(boost),
+	}
+}
@@ -361,6 +361,93 @@ func (suite *InternalToASTestSuite) TestStatusToAS() {
 }`, string(bytes))
 }
 
+func (suite *InternalToASTestSuite) TestStatusToASWithInteractionPolicy() {
+	testStatus := new(gtsmodel.Status)
+	*testStatus = *suite.testStatuses["local_account_1_status_1"]
+	testStatus.ReplyPolicy = gtsmodel.InteractionPolicyFollowers
+	testStatus.LikePolicy = gtsmodel.InteractionPolicyNobody
+	ctx := context.Background()
+
+	asStatus, err := suite.typeconverter.StatusToAS(ctx, testStatus)
+	suite.NoError(err)
+
+	ser, err := ap.Serialize(asStatus)
+	suite.NoError(err)
+
+	bytes, err := json.MarshalIndent(ser, "", "  ")
+	suite.NoError(err)
+
+	suite.Equal(`{
+  "@context": [
+    "https://www.w3.org/ns/activitystreams",
+    {
+      "always": {
+        "@id": "gts:always",
+        "@type": "@id"
+      },
+      "canAnnounce": {
+        "@id": "gts:canAnnounce",
+        "@type": "@id"
+      },
+      "canLike": {
+        "@id": "gts:canLike",
+        "@type": "@id"
+      },
+      "canReply": {
+        "@id": "gts:canReply",
+        "@type": "@id"
+      },
+      "gts": "https://gotosocial.org/ns#",
+      "interactionPolicy": {
+        "@id": "gts:interactionPolicy",
+        "@type": "@id"
+      }
+    }
+  ],
+  "attachment": [],
+  "attributedTo": "http://localhost:8080/users/the_mighty_zork",
+  "cc": "http://localhost:8080/users/the_mighty_zork/followers",
+  "content": "hello everyone!",
+  "id": "http://localhost:8080/users/the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY",
+  "interactionPolicy": {
+    "canAnnounce": {
+      "always": [
+        "http://localhost:8080/users/the_mighty_zork",
+        "https://www.w3.org/ns/activitystreams#Public"
+      ]
+    },
+    "canLike": {
+      "always": [
+        "http://localhost:8080/users/the_mighty_zork"
+      ]
+    },
+    "canReply": {
+      "always": [
+        "http://localhost:8080/users/the_mighty_zork",
+        "http://localhost:8080/users/the_mighty_zork/followers"
+      ]
+    }
+  },
+  "published": "2021-10-20T12:40:37+02:00",
+  "replies": {
+    "first": {
+      "id": "http://localhost:8080/users/the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY/replies?page=true",
+      "next": "http://localhost:8080/users/the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY/replies?only_other_accounts=false\u0026page=true",
+      "partOf": "http://localhost:8080/users/the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY/replies",
+      "type": "CollectionPage"
+    },
+    "id": "http://localhost:8080/users/the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY/replies",
+    "type": "Collection"
+  },
+  "sensitive": true,
+  "summary": "introduction post",
+  "tag": [],
+  "to": "https://www.w3.org/ns/activitystreams#Public",
+  "type": "Note",
+  "url": "http://localhost:8080/@the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY"
+}`, string(bytes))
+}
+
 func (suite *InternalToASTestSuite) TestStatusWithTagsToASWithIDs() {
 	// use the status with just IDs of attachments and emojis pinned on it
 	testStatus := suite.testStatuses["admin_account_status_1"]
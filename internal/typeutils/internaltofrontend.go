@@ -24,6 +24,7 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
@@ -112,6 +113,18 @@ func (c *converter) AccountToAPIAccountPublic(ctx context.Context, a *gtsmodel.A
 		return nil, fmt.Errorf("AccountToAPIAccountPublic: error counting following: %w", err)
 	}
 
+	// We only know about remote follows that involve
+	// local accounts, so prefer the counts given by
+	// the remote instance when we have them.
+	if a.IsRemote() {
+		if a.FollowersCount != nil {
+			followersCount = *a.FollowersCount
+		}
+		if a.FollowingCount != nil {
+			followingCount = *a.FollowingCount
+		}
+	}
+
 	statusesCount, err := c.db.CountAccountStatuses(ctx, a.ID)
 	if err != nil && !errors.Is(err, db.ErrNoEntries) {
 		return nil, fmt.Errorf("AccountToAPIAccountPublic: error counting statuses: %w", err)
@@ -479,6 +492,58 @@ func (c *converter) AttachmentToAPIAttachment(ctx context.Context, a *gtsmodel.M
 	return apiAttachment, nil
 }
 
+func (c *converter) CardToAPICard(ctx context.Context, card *gtsmodel.Card) (*apimodel.Card, error) {
+	apiCard := &apimodel.Card{
+		URL:          card.URL,
+		Title:        card.Title,
+		Description:  card.Description,
+		Type:         string(card.Type),
+		AuthorName:   card.AuthorName,
+		AuthorURL:    card.AuthorURL,
+		ProviderName: card.ProviderName,
+		ProviderURL:  card.ProviderURL,
+		HTML:         card.HTML,
+		Width:        card.Width,
+		Height:       card.Height,
+		EmbedURL:     card.EmbedURL,
+	}
+
+	if card.ImageID != "" {
+		if card.Image == nil {
+			// Card thumbnail is not set, fetch from database.
+			image, err := c.db.GetAttachmentByID(ctx, card.ImageID)
+			if err != nil {
+				return nil, fmt.Errorf("error getting card image: %w", err)
+			}
+			card.Image = image
+		}
+
+		apiCard.Image = card.Image.URL
+		apiCard.Blurhash = card.Image.Blurhash
+	}
+
+	return apiCard, nil
+}
+
+func (c *converter) CardToAPITrendsLink(ctx context.Context, card *gtsmodel.Card) (*apimodel.TrendsLink, error) {
+	apiCard, err := c.CardToAPICard(ctx, card)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	uses, err := c.db.GetCardUses(ctx, card.ID, trendHistorySince(now))
+	if err != nil {
+		return nil, fmt.Errorf("error getting card uses: %w", err)
+	}
+
+	return &apimodel.TrendsLink{
+		Card:    *apiCard,
+		History: trendHistory(uses, now),
+	}, nil
+}
+
 func (c *converter) MentionToAPIMention(ctx context.Context, m *gtsmodel.Mention) (apimodel.Mention, error) {
 	if m.TargetAccount == nil {
 		targetAccount, err := c.db.GetAccountByID(ctx, m.TargetAccountID)
@@ -569,19 +634,25 @@ func (c *converter) EmojiCategoryToAPIEmojiCategory(ctx context.Context, categor
 	}, nil
 }
 
-func (c *converter) TagToAPITag(ctx context.Context, t *gtsmodel.Tag, stubHistory bool) (apimodel.Tag, error) {
-	return apimodel.Tag{
+func (c *converter) TagToAPITag(ctx context.Context, t *gtsmodel.Tag, withHistory bool) (apimodel.Tag, error) {
+	apiTag := apimodel.Tag{
 		Name: strings.ToLower(t.Name),
 		URL:  uris.GenerateURIForTag(t.Name),
-		History: func() *[]any {
-			if !stubHistory {
-				return nil
-			}
+	}
 
-			h := make([]any, 0)
-			return &h
-		}(),
-	}, nil
+	if withHistory {
+		now := time.Now()
+
+		uses, err := c.db.GetTagUses(ctx, t.ID, trendHistorySince(now))
+		if err != nil {
+			return apimodel.Tag{}, fmt.Errorf("error getting tag uses: %w", err)
+		}
+
+		history := trendHistory(uses, now)
+		apiTag.History = &history
+	}
+
+	return apiTag, nil
 }
 
 func (c *converter) StatusToAPIStatus(ctx context.Context, s *gtsmodel.Status, requestingAccount *gtsmodel.Account) (*apimodel.Status, error) {
@@ -643,6 +714,19 @@ func (c *converter) StatusToAPIStatus(ctx context.Context, s *gtsmodel.Status, r
 		log.Errorf(ctx, "error converting status emojis: %v", err)
 	}
 
+	var apiCard *apimodel.Card
+	if s.Card != nil {
+		apiCard, err = c.CardToAPICard(ctx, s.Card)
+		if err != nil {
+			log.Errorf(ctx, "error converting status card: %v", err)
+		}
+	}
+
+	apiReactions, err := c.statusReactionsToAPIEmojiReactions(ctx, s.ID, requestingAccount)
+	if err != nil {
+		log.Errorf(ctx, "error converting status reactions: %v", err)
+	}
+
 	apiStatus := &apimodel.Status{
 		ID:                 s.ID,
 		CreatedAt:          util.FormatISO8601(s.CreatedAt),
@@ -670,9 +754,16 @@ func (c *converter) StatusToAPIStatus(ctx context.Context, s *gtsmodel.Status, r
 		Mentions:           apiMentions,
 		Tags:               apiTags,
 		Emojis:             apiEmojis,
-		Card:               nil, // TODO: implement cards
+		Card:               apiCard,
 		Poll:               nil, // TODO: implement polls
 		Text:               s.Text,
+		LocalOnly:          s.IsLocalOnly(),
+		InteractionPolicy: apimodel.StatusInteractionPolicy{
+			CanReply:     string(s.EffectiveReplyPolicy()),
+			CanReblog:    string(s.EffectiveBoostPolicy()),
+			CanFavourite: string(s.EffectiveLikePolicy()),
+		},
+		EmojiReactions: apiReactions,
 	}
 
 	// Nullable fields.
@@ -723,6 +814,41 @@ func (c *converter) StatusToAPIStatus(ctx context.Context, s *gtsmodel.Status, r
 	return apiStatus, nil
 }
 
+// statusReactionsToAPIEmojiReactions groups the reactions to the given
+// status by emoji, in the order in which each emoji was first used.
+// The returned slice is never nil, even if an error is returned.
+func (c *converter) statusReactionsToAPIEmojiReactions(ctx context.Context, statusID string, requestingAccount *gtsmodel.Account) ([]apimodel.EmojiReaction, error) {
+	apiReactions := []apimodel.EmojiReaction{}
+
+	reactions, err := c.db.GetStatusReactions(ctx, statusID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return apiReactions, fmt.Errorf("error getting reactions for status %s: %w", statusID, err)
+	}
+
+	byName := make(map[string]int, len(reactions))
+	for _, reaction := range reactions {
+		i, ok := byName[reaction.Name]
+		if !ok {
+			i = len(apiReactions)
+			byName[reaction.Name] = i
+
+			apiReaction := apimodel.EmojiReaction{Name: reaction.Name}
+			if reaction.Emoji != nil {
+				apiReaction.URL = reaction.Emoji.ImageURL
+				apiReaction.StaticURL = reaction.Emoji.ImageStaticURL
+			}
+			apiReactions = append(apiReactions, apiReaction)
+		}
+
+		apiReactions[i].Count++
+		if requestingAccount != nil && reaction.AccountID == requestingAccount.ID {
+			apiReactions[i].Me = true
+		}
+	}
+
+	return apiReactions, nil
+}
+
 // VisToapi converts a gts visibility into its api equivalent
 func (c *converter) VisToAPIVis(ctx context.Context, m gtsmodel.Visibility) apimodel.Visibility {
 	switch m {
@@ -750,7 +876,7 @@ func (c *converter) InstanceToAPIV1Instance(ctx context.Context, i *gtsmodel.Ins
 		Languages:        []string{}, // todo: not supported yet
 		Registrations:    config.GetAccountsRegistrationOpen(),
 		ApprovalRequired: config.GetAccountsApprovalRequired(),
-		InvitesEnabled:   false, // todo: not supported yet
+		InvitesEnabled:   config.GetAccountsAllowUserInvites(),
 		MaxTootChars:     uint(config.GetStatusesMaxChars()),
 	}
 
@@ -781,6 +907,13 @@ func (c *converter) InstanceToAPIV1Instance(ctx context.Context, i *gtsmodel.Ins
 	// URLs
 	instance.URLs.StreamingAPI = "wss://" + i.Domain
 
+	// rules
+	rules, err := c.db.GetActiveRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("InstanceToAPIV1Instance: db error getting instance rules: %w", err)
+	}
+	instance.Rules = c.RulesToAPIRules(rules)
+
 	// statistics
 	stats := make(map[string]int, 3)
 	userCount, err := c.db.CountInstanceUsers(ctx, i.Domain)
@@ -854,7 +987,6 @@ func (c *converter) InstanceToAPIV2Instance(ctx context.Context, i *gtsmodel.Ins
 		Description:   i.Description,
 		Usage:         apimodel.InstanceV2Usage{}, // todo: not implemented
 		Languages:     []string{},                 // todo: not implemented
-		Rules:         []interface{}{},            // todo: not implemented
 	}
 
 	if config.GetInstanceInjectMastodonVersion() {
@@ -907,8 +1039,16 @@ func (c *converter) InstanceToAPIV2Instance(ctx context.Context, i *gtsmodel.Ins
 	instance.Configuration.Accounts.AllowCustomCSS = config.GetAccountsAllowCustomCSS()
 	instance.Configuration.Accounts.MaxFeaturedTags = instanceAccountsMaxFeaturedTags
 	instance.Configuration.Accounts.MaxProfileFields = instanceAccountsMaxProfileFields
+	instance.Configuration.Translation.Enabled = config.GetTranslationBackend() != ""
 	instance.Configuration.Emojis.EmojiSizeLimit = int(config.GetMediaEmojiLocalMaxSize())
 
+	// rules
+	rules, err := c.db.GetActiveRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("InstanceToAPIV2Instance: db error getting instance rules: %w", err)
+	}
+	instance.Rules = c.RulesToAPIRules(rules)
+
 	// registrations
 	instance.Registrations.Enabled = config.GetAccountsRegistrationOpen()
 	instance.Registrations.ApprovalRequired = config.GetAccountsApprovalRequired()
@@ -1005,12 +1145,100 @@ func (c *converter) NotificationToAPINotification(ctx context.Context, n *gtsmod
 		apiStatus = apiStatus.Reblog.Status
 	}
 
-	return &apimodel.Notification{
-		ID:        n.ID,
-		Type:      string(n.NotificationType),
-		CreatedAt: util.FormatISO8601(n.CreatedAt),
-		Account:   apiAccount,
-		Status:    apiStatus,
+	var apiWarning *apimodel.AccountWarning
+	if n.AdminActionID != "" {
+		if n.AdminAction == nil {
+			adminAction := &gtsmodel.AdminAccountAction{}
+			if err := c.db.GetByID(ctx, n.AdminActionID, adminAction); err != nil {
+				return nil, fmt.Errorf("NotificationToapi: error getting admin action with id %s from the db: %s", n.AdminActionID, err)
+			}
+			n.AdminAction = adminAction
+		}
+
+		if n.AdminAction.TargetAccountID == n.TargetAccount.ID {
+			n.AdminAction.TargetAccount = n.TargetAccount
+		}
+
+		var err error
+		apiWarning, err = c.AdminAccountActionToAPIAccountWarning(ctx, n.AdminAction)
+		if err != nil {
+			return nil, fmt.Errorf("NotificationToapi: error converting admin action to api: %s", err)
+		}
+	}
+
+	var apiReport *apimodel.Report
+	if n.ReportID != "" {
+		if n.Report == nil {
+			report, err := c.db.GetReportByID(ctx, n.ReportID)
+			if err != nil {
+				return nil, fmt.Errorf("NotificationToapi: error getting report with id %s from the db: %s", n.ReportID, err)
+			}
+			n.Report = report
+		}
+
+		var err error
+		apiReport, err = c.ReportToAPIReport(ctx, n.Report)
+		if err != nil {
+			return nil, fmt.Errorf("NotificationToapi: error converting report to api: %s", err)
+		}
+	}
+
+	apiNotif := &apimodel.Notification{
+		ID:                n.ID,
+		Type:              string(n.NotificationType),
+		CreatedAt:         util.FormatISO8601(n.CreatedAt),
+		Account:           apiAccount,
+		Status:            apiStatus,
+		ModerationWarning: apiWarning,
+		Report:            apiReport,
+	}
+
+	if n.NotificationType == gtsmodel.NotificationReaction {
+		// Show the latest emoji the origin account reacted with;
+		// the reaction may have been undone since, that's fine.
+		reaction, err := c.db.GetLatestStatusReaction(ctx, n.StatusID, n.OriginAccountID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, fmt.Errorf("NotificationToapi: error getting reaction from the db: %w", err)
+		}
+
+		if reaction != nil {
+			apiNotif.Emoji = reaction.Name
+			if reaction.Emoji != nil {
+				apiNotif.EmojiURL = reaction.Emoji.ImageURL
+			}
+		}
+	}
+
+	return apiNotif, nil
+}
+
+func (c *converter) AdminAccountActionToAPIAccountWarning(ctx context.Context, a *gtsmodel.AdminAccountAction) (*apimodel.AccountWarning, error) {
+	if a.TargetAccount == nil {
+		tAccount, err := c.db.GetAccountByID(ctx, a.TargetAccountID)
+		if err != nil {
+			return nil, fmt.Errorf("AdminAccountActionToAPIAccountWarning: error getting target account with id %s from the db: %w", a.TargetAccountID, err)
+		}
+		a.TargetAccount = tAccount
+	}
+
+	apiTargetAccount, err := c.AccountToAPIAccountPublic(ctx, a.TargetAccount)
+	if err != nil {
+		return nil, fmt.Errorf("AdminAccountActionToAPIAccountWarning: error converting target account to api: %w", err)
+	}
+
+	statusIDs := a.StatusIDs
+	if statusIDs == nil {
+		// Always serialize as an array.
+		statusIDs = []string{}
+	}
+
+	return &apimodel.AccountWarning{
+		ID:            a.ID,
+		Action:        string(a.Type),
+		Text:          a.Text,
+		StatusIDs:     statusIDs,
+		TargetAccount: apiTargetAccount,
+		CreatedAt:     util.FormatISO8601(a.CreatedAt),
 	}, nil
 }
 
@@ -1042,16 +1270,76 @@ func (c *converter) DomainBlockToAPIDomainBlock(ctx context.Context, b *gtsmodel
 	return domainBlock, nil
 }
 
+func (c *converter) EmailDomainBlockToAPIEmailDomainBlock(ctx context.Context, b *gtsmodel.EmailDomainBlock) (*apimodel.EmailDomainBlock, error) {
+	// Domain may be in Punycode,
+	// de-punify it just in case.
+	d, err := util.DePunify(b.Domain)
+	if err != nil {
+		return nil, fmt.Errorf("EmailDomainBlockToAPIEmailDomainBlock: error de-punifying domain %s: %w", b.Domain, err)
+	}
+
+	return &apimodel.EmailDomainBlock{
+		ID:        b.ID,
+		Domain:    d,
+		CreatedBy: b.CreatedByAccountID,
+		CreatedAt: util.FormatISO8601(b.CreatedAt),
+	}, nil
+}
+
+func (c *converter) IPBlockToAPIIPBlock(b *gtsmodel.IPBlock) *apimodel.IPBlock {
+	ipBlock := &apimodel.IPBlock{
+		ID:        b.ID,
+		IP:        b.IP,
+		Severity:  string(b.Severity),
+		Comment:   b.Comment,
+		CreatedAt: util.FormatISO8601(b.CreatedAt),
+	}
+
+	if !b.ExpiresAt.IsZero() {
+		expiresAt := util.FormatISO8601(b.ExpiresAt)
+		ipBlock.ExpiresAt = &expiresAt
+	}
+
+	return ipBlock
+}
+
+func (c *converter) InviteToAPIInvite(i *gtsmodel.Invite) *apimodel.Invite {
+	invite := &apimodel.Invite{
+		ID:        i.ID,
+		Code:      i.Code,
+		URL:       uris.GenerateURIForInvite(i.Code),
+		MaxUses:   i.MaxUses,
+		Uses:      i.Uses,
+		Valid:     i.Valid(time.Now()),
+		CreatedAt: util.FormatISO8601(i.CreatedAt),
+	}
+
+	if !i.ExpiresAt.IsZero() {
+		expiresAt := util.FormatISO8601(i.ExpiresAt)
+		invite.ExpiresAt = &expiresAt
+	}
+
+	return invite
+}
+
 func (c *converter) ReportToAPIReport(ctx context.Context, r *gtsmodel.Report) (*apimodel.Report, error) {
 	report := &apimodel.Report{
 		ID:          r.ID,
 		CreatedAt:   util.FormatISO8601(r.CreatedAt),
 		ActionTaken: !r.ActionTakenAt.IsZero(),
-		Category:    "other", // todo: only support default 'other' category right now
+		Category:    reportCategory(r.Category),
 		Comment:     r.Comment,
 		Forwarded:   *r.Forwarded,
 		StatusIDs:   r.StatusIDs,
-		RuleIDs:     []int{}, // todo: not supported yet
+		RuleIDs:     r.RuleIDs,
+	}
+
+	if report.StatusIDs == nil {
+		report.StatusIDs = []string{}
+	}
+
+	if report.RuleIDs == nil {
+		report.RuleIDs = []string{}
 	}
 
 	if !r.ActionTakenAt.IsZero() {
@@ -1080,6 +1368,58 @@ func (c *converter) ReportToAPIReport(ctx context.Context, r *gtsmodel.Report) (
 	return report, nil
 }
 
+func (c *converter) TrendToAdminAPITrend(ctx context.Context, t *gtsmodel.Trend, requestingAccount *gtsmodel.Account) (*apimodel.AdminTrend, error) {
+	apiTrend := &apimodel.AdminTrend{
+		ID:    t.ID,
+		Type:  string(t.Type),
+		Score: t.Score,
+		State: string(t.State),
+	}
+
+	if !t.ReviewedAt.IsZero() {
+		reviewedAt := util.FormatISO8601(t.ReviewedAt)
+		apiTrend.ReviewedAt = &reviewedAt
+	}
+
+	switch t.Type {
+	case gtsmodel.TrendTypeTag:
+		tag, err := c.db.GetTag(ctx, t.TargetID)
+		if err != nil {
+			return nil, fmt.Errorf("error getting trending tag %s: %w", t.TargetID, err)
+		}
+
+		apiTag, err := c.TagToAPITag(ctx, tag, true)
+		if err != nil {
+			return nil, err
+		}
+		apiTrend.Tag = &apiTag
+
+	case gtsmodel.TrendTypeStatus:
+		status, err := c.db.GetStatusByID(ctx, t.TargetID)
+		if err != nil {
+			return nil, fmt.Errorf("error getting trending status %s: %w", t.TargetID, err)
+		}
+
+		apiTrend.Status, err = c.StatusToAPIStatus(ctx, status, requestingAccount)
+		if err != nil {
+			return nil, err
+		}
+
+	case gtsmodel.TrendTypeLink:
+		card, err := c.db.GetCardByID(ctx, t.TargetID)
+		if err != nil {
+			return nil, fmt.Errorf("error getting trending link %s: %w", t.TargetID, err)
+		}
+
+		apiTrend.Link, err = c.CardToAPITrendsLink(ctx, card)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return apiTrend, nil
+}
+
 func (c *converter) ReportToAdminAPIReport(ctx context.Context, r *gtsmodel.Report, requestingAccount *gtsmodel.Account) (*apimodel.AdminReport, error) {
 	var (
 		err                  error
@@ -1144,6 +1484,18 @@ func (c *converter) ReportToAdminAPIReport(ctx context.Context, r *gtsmodel.Repo
 		statuses = append(statuses, status)
 	}
 
+	if len(r.RuleIDs) != 0 && len(r.Rules) == 0 {
+		r.Rules, err = c.db.GetRulesByIDs(ctx, r.RuleIDs)
+		if err != nil {
+			return nil, fmt.Errorf("ReportToAdminAPIReport: error getting rules from the db: %w", err)
+		}
+	}
+	rules := make([]*apimodel.InstanceRule, 0, len(r.Rules))
+	for _, rule := range r.Rules {
+		apiRule := c.RuleToAPIRule(rule)
+		rules = append(rules, &apiRule)
+	}
+
 	if ac := r.ActionTaken; ac != "" {
 		actionTakenComment = &ac
 	}
@@ -1152,7 +1504,7 @@ func (c *converter) ReportToAdminAPIReport(ctx context.Context, r *gtsmodel.Repo
 		ID:                   r.ID,
 		ActionTaken:          !r.ActionTakenAt.IsZero(),
 		ActionTakenAt:        actionTakenAt,
-		Category:             "other", // todo: only support default 'other' category right now
+		Category:             reportCategory(r.Category),
 		Comment:              r.Comment,
 		Forwarded:            *r.Forwarded,
 		CreatedAt:            util.FormatISO8601(r.CreatedAt),
@@ -1163,7 +1515,7 @@ func (c *converter) ReportToAdminAPIReport(ctx context.Context, r *gtsmodel.Repo
 		ActionTakenByAccount: actionTakenByAccount,
 		ActionTakenComment:   actionTakenComment,
 		Statuses:             statuses,
-		Rules:                []interface{}{}, // not implemented
+		Rules:                rules,
 	}, nil
 }
 
@@ -1195,6 +1547,127 @@ func (c *converter) MarkersToAPIMarker(ctx context.Context, markers []*gtsmodel.
 	return apiMarker, nil
 }
 
+func (c *converter) WebPushSubscriptionToAPIWebPushSubscription(ctx context.Context, sub *gtsmodel.WebPushSubscription) (*apimodel.WebPushSubscription, error) {
+	keyPair, err := c.db.GetVAPIDKeyPair(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("WebPushSubscriptionToAPIWebPushSubscription: error getting VAPID key pair: %w", err)
+	}
+
+	return &apimodel.WebPushSubscription{
+		ID:       sub.ID,
+		Endpoint: sub.Endpoint,
+		Alerts: apimodel.WebPushSubscriptionAlerts{
+			Follow:        *sub.AlertFollow,
+			FollowRequest: *sub.AlertFollowRequest,
+			Favourite:     *sub.AlertFavourite,
+			Mention:       *sub.AlertMention,
+			Reblog:        *sub.AlertReblog,
+			Poll:          *sub.AlertPoll,
+			Status:        *sub.AlertStatus,
+			AdminReport:   *sub.AlertAdminReport,
+		},
+		ServerKey: keyPair.Public,
+		Policy:    string(sub.Policy),
+	}, nil
+}
+
+func (c *converter) RuleToAPIRule(r *gtsmodel.Rule) apimodel.InstanceRule {
+	return apimodel.InstanceRule{
+		ID:   r.ID,
+		Text: r.Text,
+	}
+}
+
+func (c *converter) RulesToAPIRules(rules []*gtsmodel.Rule) []apimodel.InstanceRule {
+	apiRules := make([]apimodel.InstanceRule, len(rules))
+	for i, r := range rules {
+		apiRules[i] = c.RuleToAPIRule(r)
+	}
+	return apiRules
+}
+
+func (c *converter) AnnouncementToAPIAnnouncement(ctx context.Context, a *gtsmodel.Announcement, requestingAccount *gtsmodel.Account) (*apimodel.Announcement, error) {
+	apiAnnouncement := &apimodel.Announcement{
+		ID:        a.ID,
+		Content:   a.Content,
+		AllDay:    *a.AllDay,
+		UpdatedAt: util.FormatISO8601(a.UpdatedAt),
+		Published: *a.Published,
+		Mentions:  []apimodel.Mention{},
+		Statuses:  []apimodel.Status{},
+		Reactions: []apimodel.AnnouncementReaction{},
+	}
+
+	if !a.StartsAt.IsZero() {
+		apiAnnouncement.StartsAt = util.FormatISO8601(a.StartsAt)
+	}
+
+	if !a.EndsAt.IsZero() {
+		apiAnnouncement.EndsAt = util.FormatISO8601(a.EndsAt)
+	}
+
+	if !a.PublishedAt.IsZero() {
+		apiAnnouncement.PublishedAt = util.FormatISO8601(a.PublishedAt)
+	}
+
+	var err error
+
+	apiAnnouncement.Tags, err = c.convertTagsToAPITags(ctx, a.Tags, a.TagIDs)
+	if err != nil {
+		log.Errorf(ctx, "error converting announcement tags: %v", err)
+	}
+
+	apiAnnouncement.Emojis, err = c.convertEmojisToAPIEmojis(ctx, a.Emojis, a.EmojiIDs)
+	if err != nil {
+		log.Errorf(ctx, "error converting announcement emojis: %v", err)
+	}
+
+	reactions, err := c.db.GetAnnouncementReactions(ctx, a.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, fmt.Errorf("error getting reactions for announcement %s: %w", a.ID, err)
+	}
+
+	// Group reactions by name, keeping
+	// the order in which each name was
+	// first used to react.
+	byName := make(map[string]int, len(reactions))
+	for _, reaction := range reactions {
+		i, ok := byName[reaction.Name]
+		if !ok {
+			i = len(apiAnnouncement.Reactions)
+			byName[reaction.Name] = i
+
+			apiReaction := apimodel.AnnouncementReaction{Name: reaction.Name}
+			if reaction.Emoji != nil {
+				apiReaction.URL = reaction.Emoji.ImageURL
+				apiReaction.StaticURL = reaction.Emoji.ImageStaticURL
+			}
+			apiAnnouncement.Reactions = append(apiAnnouncement.Reactions, apiReaction)
+		}
+
+		apiAnnouncement.Reactions[i].Count++
+		if requestingAccount != nil && reaction.AccountID == requestingAccount.ID {
+			apiAnnouncement.Reactions[i].Me = true
+		}
+	}
+
+	if requestingAccount != nil {
+		readIDs, err := c.db.GetReadAnnouncementIDs(ctx, requestingAccount.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, fmt.Errorf("error getting read announcements for account %s: %w", requestingAccount.ID, err)
+		}
+
+		for _, id := range readIDs {
+			if id == a.ID {
+				apiAnnouncement.Read = true
+				break
+			}
+		}
+	}
+
+	return apiAnnouncement, nil
+}
+
 // convertAttachmentsToAPIAttachments will convert a slice of GTS model attachments to frontend API model attachments, falling back to IDs if no GTS models supplied.
 func (c *converter) convertAttachmentsToAPIAttachments(ctx context.Context, attachments []*gtsmodel.MediaAttachment, attachmentIDs []string) ([]apimodel.Attachment, error) {
 	var errs gtserror.MultiError
@@ -1329,3 +1802,48 @@ func (c *converter) convertTagsToAPITags(ctx context.Context, tags []*gtsmodel.T
 
 	return apiTags, errs.Combine()
 }
+
+func (c *converter) NotificationRequestToAPINotificationRequest(ctx context.Context, r *gtsmodel.NotificationRequest) (*apimodel.NotificationRequest, error) {
+	if r.Account == nil {
+		account, err := c.db.GetAccountByID(ctx, r.AccountID)
+		if err != nil {
+			return nil, fmt.Errorf("NotificationRequestToAPINotificationRequest: error getting account with id %s from the db: %w", r.AccountID, err)
+		}
+		r.Account = account
+	}
+
+	if r.FromAccount == nil {
+		fromAccount, err := c.db.GetAccountByID(ctx, r.FromAccountID)
+		if err != nil {
+			return nil, fmt.Errorf("NotificationRequestToAPINotificationRequest: error getting from account with id %s from the db: %w", r.FromAccountID, err)
+		}
+		r.FromAccount = fromAccount
+	}
+
+	apiAccount, err := c.AccountToAPIAccountPublic(ctx, r.FromAccount)
+	if err != nil {
+		return nil, fmt.Errorf("NotificationRequestToAPINotificationRequest: error converting account to api: %w", err)
+	}
+
+	var apiStatus *apimodel.Status
+	if r.LastStatus != nil {
+		apiStatus, err = c.StatusToAPIStatus(ctx, r.LastStatus, r.Account)
+		if err != nil {
+			return nil, fmt.Errorf("NotificationRequestToAPINotificationRequest: error converting status to api: %w", err)
+		}
+
+		if apiStatus.Reblog != nil {
+			// Show the boosted status, as for notifications.
+			apiStatus = apiStatus.Reblog.Status
+		}
+	}
+
+	return &apimodel.NotificationRequest{
+		ID:                 r.ID,
+		CreatedAt:          util.FormatISO8601(r.CreatedAt),
+		UpdatedAt:          util.FormatISO8601(r.UpdatedAt),
+		Account:            apiAccount,
+		NotificationsCount: r.NotificationsCount,
+		LastStatus:         apiStatus,
+	}, nil
+}
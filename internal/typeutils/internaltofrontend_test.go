@@ -248,6 +248,21 @@ func (suite *InternalToFrontendTestSuite) TestAccountToFrontendPublicPunycode()
 }`, string(b))
 }
 
+func (suite *InternalToFrontendTestSuite) TestAccountToFrontendPublicRemoteCounts() {
+	testAccount := new(gtsmodel.Account)
+	*testAccount = *suite.testAccounts["remote_account_4"]
+
+	followersCount := 420
+	followingCount := 69
+	testAccount.FollowersCount = &followersCount
+	testAccount.FollowingCount = &followingCount
+
+	apiAccount, err := suite.typeconverter.AccountToAPIAccountPublic(context.Background(), testAccount)
+	suite.NoError(err)
+	suite.Equal(420, apiAccount.FollowersCount)
+	suite.Equal(69, apiAccount.FollowingCount)
+}
+
 func (suite *InternalToFrontendTestSuite) TestLocalInstanceAccountToFrontendPublic() {
 	ctx := context.Background()
 	testAccount, err := suite.db.GetInstanceAccount(ctx, "")
@@ -433,7 +448,14 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontend() {
   ],
   "card": null,
   "poll": null,
-  "text": "hello world! #welcome ! first post on the instance :rainbow: !"
+  "text": "hello world! #welcome ! first post on the instance :rainbow: !",
+  "local_only": false,
+  "interaction_policy": {
+    "can_reply": "anyone",
+    "can_reblog": "anyone",
+    "can_favourite": "anyone"
+  },
+  "emoji_reactions": []
 }`, string(b))
 }
 
@@ -548,7 +570,14 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontendUnknownLanguage()
   ],
   "card": null,
   "poll": null,
-  "text": "hello world! #welcome ! first post on the instance :rainbow: !"
+  "text": "hello world! #welcome ! first post on the instance :rainbow: !",
+  "local_only": false,
+  "interaction_policy": {
+    "can_reply": "anyone",
+    "can_reblog": "anyone",
+    "can_favourite": "anyone"
+  },
+  "emoji_reactions": []
 }`, string(b))
 }
 
@@ -689,7 +718,17 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV1ToFrontend() {
       "name": "admin"
     }
   },
-  "max_toot_chars": 5000
+  "max_toot_chars": 5000,
+  "rules": [
+    {
+      "id": "01GVTXNXMXKXBXBYKCMJAQJZJY",
+      "text": "Be gay"
+    },
+    {
+      "id": "01GVTXP8RE6YMA3DMTDC9TAZ8R",
+      "text": "Do crime"
+    }
+  ]
 }`, string(b))
 }
 
@@ -804,7 +843,16 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV2ToFrontend() {
       }
     }
   },
-  "rules": []
+  "rules": [
+    {
+      "id": "01GVTXNXMXKXBXBYKCMJAQJZJY",
+      "text": "Be gay"
+    },
+    {
+      "id": "01GVTXP8RE6YMA3DMTDC9TAZ8R",
+      "text": "Do crime"
+    }
+  ]
 }`, string(b))
 }
 
@@ -1177,7 +1225,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
     "created_by_application_id": "01F8MGXQRHYF5QPMTMXP78QC2F"
   },
   "statuses": [],
-  "rule_ids": [],
+  "rules": [],
   "action_taken_comment": "user was warned not to be a turtle anymore"
 }`, string(b))
 }
@@ -1377,10 +1425,17 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend2() {
       "tags": [],
       "emojis": [],
       "card": null,
-      "poll": null
+      "poll": null,
+      "local_only": false,
+      "interaction_policy": {
+        "can_reply": "anyone",
+        "can_reblog": "anyone",
+        "can_favourite": "anyone"
+      },
+      "emoji_reactions": []
     }
   ],
-  "rule_ids": [],
+  "rules": [],
   "action_taken_comment": null
 }`, string(b))
 }
@@ -1603,7 +1658,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontendSuspendedLoca
     "created_by_application_id": "01F8MGXQRHYF5QPMTMXP78QC2F"
   },
   "statuses": [],
-  "rule_ids": [],
+  "rules": [],
   "action_taken_comment": "user was warned not to be a turtle anymore"
 }`, string(b))
 }
@@ -22,8 +22,11 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/regexes"
 )
@@ -100,3 +103,61 @@ func getURI(withID ap.WithJSONLDId) (*url.URL, string, error) {
 	id := idProp.Get()
 	return id, id.String(), nil
 }
+
+// reportCategory returns the api representation of the
+// given report category, defaulting to 'other' if unset.
+func reportCategory(category gtsmodel.ReportCategory) string {
+	if category == "" {
+		return string(gtsmodel.ReportCategoryOther)
+	}
+	return string(category)
+}
+
+// trendHistoryDays is the number of days of usage
+// history provided for hashtags and trending links.
+const trendHistoryDays = 7
+
+// trendHistorySince returns the start of the
+// usage history period ending on the day of now.
+func trendHistorySince(now time.Time) time.Time {
+	today := now.UTC().Truncate(24 * time.Hour)
+	return today.AddDate(0, 0, -(trendHistoryDays - 1))
+}
+
+// trendHistory buckets the given uses into per-day usage history for
+// the period ending on the day of now (UTC), most recent day first.
+func trendHistory(uses []gtsmodel.TrendUse, now time.Time) []apimodel.TagHistory {
+	type day struct {
+		uses     int
+		accounts map[string]struct{}
+	}
+
+	today := now.UTC().Truncate(24 * time.Hour)
+	days := make([]day, trendHistoryDays)
+
+	for _, use := range uses {
+		// Get index of the day of this use, counting back from today.
+		i := int(today.Sub(use.CreatedAt.UTC().Truncate(24*time.Hour)) / (24 * time.Hour))
+		if i < 0 || i >= trendHistoryDays {
+			continue
+		}
+
+		if days[i].accounts == nil {
+			days[i].accounts = make(map[string]struct{})
+		}
+
+		days[i].uses++
+		days[i].accounts[use.AccountID] = struct{}{}
+	}
+
+	history := make([]apimodel.TagHistory, trendHistoryDays)
+	for i, d := range days {
+		history[i] = apimodel.TagHistory{
+			Day:      strconv.FormatInt(today.AddDate(0, 0, -i).Unix(), 10),
+			Uses:     strconv.Itoa(d.uses),
+			Accounts: strconv.Itoa(len(d.accounts)),
+		}
+	}
+
+	return history
+}
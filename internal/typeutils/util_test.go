@@ -19,6 +19,9 @@ package typeutils
 
 import (
 	"testing"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
 func TestMisskeyReportContentURLs1(t *testing.T) {
@@ -44,3 +47,36 @@ misskey-formatted`
 		t.Fatalf("wanted 0 urls, got %d", l)
 	}
 }
+
+func TestTrendHistory(t *testing.T) {
+	now := time.Date(2023, 8, 11, 15, 30, 0, 0, time.UTC)
+
+	uses := []gtsmodel.TrendUse{
+		{AccountID: "a", CreatedAt: now.Add(-1 * time.Hour)},
+		{AccountID: "a", CreatedAt: now.Add(-2 * time.Hour)},
+		{AccountID: "b", CreatedAt: now.Add(-3 * time.Hour)},
+		{AccountID: "a", CreatedAt: now.Add(-24 * time.Hour)},
+		{AccountID: "c", CreatedAt: now.AddDate(0, 0, -6)},
+		{AccountID: "c", CreatedAt: now.AddDate(0, 0, -7)}, // too old
+	}
+
+	history := trendHistory(uses, now)
+	if l := len(history); l != 7 {
+		t.Fatalf("wanted 7 days, got %d", l)
+	}
+
+	for i, expect := range []struct{ day, uses, accounts string }{
+		{"1691712000", "3", "2"},
+		{"1691625600", "1", "1"},
+		{"1691539200", "0", "0"},
+		{"1691452800", "0", "0"},
+		{"1691366400", "0", "0"},
+		{"1691280000", "0", "0"},
+		{"1691193600", "1", "1"},
+	} {
+		day := history[i]
+		if day.Day != expect.day || day.Uses != expect.uses || day.Accounts != expect.accounts {
+			t.Errorf("day %d: wanted %+v, got %+v", i, expect, day)
+		}
+	}
+}
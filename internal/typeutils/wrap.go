@@ -144,3 +144,56 @@ func (c *converter) WrapNoteInCreate(note vocab.ActivityStreamsNote, objectIRIOn
 
 	return create, nil
 }
+
+func (c *converter) WrapNoteInUpdate(note vocab.ActivityStreamsNote, originAccount *gtsmodel.Account) (vocab.ActivityStreamsUpdate, error) {
+	update := streams.NewActivityStreamsUpdate()
+
+	// set the actor
+	actorURI, err := url.Parse(originAccount.URI)
+	if err != nil {
+		return nil, gtserror.Newf("error parsing url %s: %w", originAccount.URI, err)
+	}
+	actorProp := streams.NewActivityStreamsActorProperty()
+	actorProp.AppendIRI(actorURI)
+	update.SetActivityStreamsActor(actorProp)
+
+	// set the ID
+	newID, err := id.NewRandomULID()
+	if err != nil {
+		return nil, err
+	}
+
+	idString := uris.GenerateURIForUpdate(originAccount.Username, newID)
+	idURI, err := url.Parse(idString)
+	if err != nil {
+		return nil, gtserror.Newf("error parsing url %s: %w", idString, err)
+	}
+	idProp := streams.NewJSONLDIdProperty()
+	idProp.SetIRI(idURI)
+	update.SetJSONLDId(idProp)
+
+	// set the note as the object here
+	objectProp := streams.NewActivityStreamsObjectProperty()
+	objectProp.AppendActivityStreamsNote(note)
+	update.SetActivityStreamsObject(objectProp)
+
+	// To Property
+	toProp := streams.NewActivityStreamsToProperty()
+	if toURIs := ap.ExtractToURIs(note); len(toURIs) != 0 {
+		for _, toURI := range toURIs {
+			toProp.AppendIRI(toURI)
+		}
+		update.SetActivityStreamsTo(toProp)
+	}
+
+	// Cc Property
+	ccProp := streams.NewActivityStreamsCcProperty()
+	if ccURIs := ap.ExtractCcURIs(note); len(ccURIs) != 0 {
+		for _, ccURI := range ccURIs {
+			ccProp.AppendIRI(ccURI)
+		}
+		update.SetActivityStreamsCc(ccProp)
+	}
+
+	return update, nil
+}
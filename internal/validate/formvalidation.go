@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"net/mail"
+	"unicode"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
@@ -44,6 +45,9 @@ const (
 	maximumProfileFieldLength     = 255
 	maximumProfileFields          = 6
 	maximumListTitleLength        = 200
+	maximumInstanceRuleLength     = 500
+	maximumAnnouncementLength     = 5000
+	maximumUnicodeEmojiLength     = 16 // Long enough for zero-width-joined sequences like families and flags.
 )
 
 // Password returns a helpful error if the given password
@@ -236,6 +240,69 @@ func SiteTerms(t string) error {
 	return nil
 }
 
+// InstanceRule ensures that the given instance rule text is within spec.
+func InstanceRule(text string) error {
+	if text == "" {
+		return errors.New("instance rule text must be provided")
+	}
+
+	if length := len([]rune(text)); length > maximumInstanceRuleLength {
+		return fmt.Errorf("instance rule text should be no more than %d chars but given text was %d", maximumInstanceRuleLength, length)
+	}
+
+	return nil
+}
+
+// Announcement ensures that the given announcement text is within spec.
+func Announcement(text string) error {
+	if text == "" {
+		return errors.New("announcement text must be provided")
+	}
+
+	if length := len([]rune(text)); length > maximumAnnouncementLength {
+		return fmt.Errorf("announcement text should be no more than %d chars but given text was %d", maximumAnnouncementLength, length)
+	}
+
+	return nil
+}
+
+// UnicodeEmoji checks that the given string is one unicode
+// emoji, including any modifiers, variation selectors, and
+// zero-width joiners that make up the emoji.
+func UnicodeEmoji(emoji string) error {
+	if emoji == "" {
+		return errors.New("emoji must be provided")
+	}
+
+	if length := len([]rune(emoji)); length > maximumUnicodeEmojiLength {
+		return fmt.Errorf("emoji %s should be no more than %d chars but was %d", emoji, maximumUnicodeEmojiLength, length)
+	}
+
+	var symbol bool
+	for _, r := range emoji {
+		switch {
+		case unicode.Is(unicode.So, r), r == '\u20e3':
+			// Emoji proper, or keycap.
+			symbol = true
+		case r == '\u200d',
+			r >= '\ufe00' && r <= '\ufe0f',
+			r >= 0x1f3fb && r <= 0x1f3ff,
+			r >= 0xe0020 && r <= 0xe007f,
+			r == '#', r == '*', r >= '0' && r <= '9':
+			// Zero-width joiner, variation selector,
+			// skin tone modifier, tag, or keycap base.
+		default:
+			return fmt.Errorf("%s is not a valid unicode emoji", emoji)
+		}
+	}
+
+	if !symbol {
+		return fmt.Errorf("%s is not a valid unicode emoji", emoji)
+	}
+
+	return nil
+}
+
 // ULID returns true if the passed string is a valid ULID.
 func ULID(i string) bool {
 	return regexes.ULID.MatchString(i)
@@ -290,6 +357,18 @@ func ListRepliesPolicy(repliesPolicy gtsmodel.RepliesPolicy) error {
 	}
 }
 
+// InteractionPolicy validates the reply, boost, or like policy of a new status.
+func InteractionPolicy(policy gtsmodel.InteractionPolicy) error {
+	switch policy {
+	case "", gtsmodel.InteractionPolicyAnyone, gtsmodel.InteractionPolicyFollowers, gtsmodel.InteractionPolicyMentioned, gtsmodel.InteractionPolicyNobody:
+		// No problem.
+		return nil
+	default:
+		// Uh oh.
+		return fmt.Errorf("interaction policy must be either empty or one of 'anyone', 'followers', 'mentioned', 'nobody'")
+	}
+}
+
 // MarkerName checks that the desired marker timeline name is valid.
 func MarkerName(name string) error {
 	if name == "" {
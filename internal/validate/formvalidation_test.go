@@ -342,6 +342,32 @@ func (suite *ValidationTestSuite) TestValidateCustomCSSTooLongUnicode() {
 	suite.EqualError(err, "custom_css must be less than 5 characters, but submitted custom_css was 10 characters")
 }
 
+func (suite *ValidationTestSuite) TestValidateUnicodeEmoji() {
+	for _, emoji := range []string{
+		"👍",
+		"❤️",
+		"👍🏽",
+		"👨\u200d👩\u200d👧\u200d👦",
+		"🏳️\u200d🌈",
+		"🇳🇱",
+		"1️⃣",
+	} {
+		suite.NoError(validate.UnicodeEmoji(emoji), emoji)
+	}
+
+	for _, emoji := range []string{
+		"",
+		"a",
+		"blobcat",
+		":blobcat:",
+		"1",
+		"👍 lol",
+		"\u200d", // lone zero-width joiner
+	} {
+		suite.Error(validate.UnicodeEmoji(emoji), emoji)
+	}
+}
+
 func TestValidationTestSuite(t *testing.T) {
 	suite.Run(t, new(ValidationTestSuite))
 }
@@ -105,6 +105,30 @@ func (f *Filter) isAccountVisibleTo(ctx context.Context, requester *gtsmodel.Acc
 		return false, nil
 	}
 
+	// Check whether either blocks the other's domain. Only
+	// local accounts can create domain blocks, so only check
+	// where one account is local and the other is remote.
+	switch {
+	case requester.IsLocal() && account.IsRemote():
+		blocked, err = f.state.DB.IsAccountDomainBlocked(ctx,
+			requester.ID,
+			account.Domain,
+		)
+	case account.IsLocal() && requester.IsRemote():
+		blocked, err = f.state.DB.IsAccountDomainBlocked(ctx,
+			account.ID,
+			requester.Domain,
+		)
+	}
+	if err != nil {
+		return false, fmt.Errorf("isAccountVisibleTo: error checking account domain blocks: %w", err)
+	}
+
+	if blocked {
+		log.Trace(ctx, "domain block exists between accounts")
+		return false, nil
+	}
+
 	return true, nil
 }
 
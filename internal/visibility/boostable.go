@@ -24,7 +24,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 )
 
-// StatusBoostable checks if given status is boostable by requester, checking boolean status visibility to requester and ultimately the AP status visibility setting.
+// StatusBoostable checks if given status is boostable by requester, checking boolean status visibility to requester, the AP status visibility setting, and ultimately the status boost policy.
 func (f *Filter) StatusBoostable(ctx context.Context, requester *gtsmodel.Account, status *gtsmodel.Status) (bool, error) {
 	if status.Visibility == gtsmodel.VisibilityDirect {
 		log.Trace(ctx, "direct statuses are not boostable")
@@ -58,5 +58,5 @@ func (f *Filter) StatusBoostable(ctx context.Context, requester *gtsmodel.Accoun
 		return false, nil
 	}
 
-	return true, nil
+	return f.StatusInteractionPermitted(ctx, requester, status, status.BoostPolicy)
 }
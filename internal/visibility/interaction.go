@@ -0,0 +1,108 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package visibility
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// StatusReplyable checks if given status can be replied to by requester, checking the status replyable flag and its reply policy.
+// It does not check whether the status is visible to requester; callers should do that separately where relevant.
+func (f *Filter) StatusReplyable(ctx context.Context, requester *gtsmodel.Account, status *gtsmodel.Status) (bool, error) {
+	if requester.ID == status.AccountID {
+		// Status author can always reply.
+		return true, nil
+	}
+
+	if status.Replyable != nil && !*status.Replyable {
+		log.Trace(ctx, "status marked not replyable")
+		return false, nil
+	}
+
+	return f.StatusInteractionPermitted(ctx, requester, status, status.ReplyPolicy)
+}
+
+// StatusLikeable checks if given status can be liked by requester, checking the status likeable flag and its like policy.
+// It does not check whether the status is visible to requester; callers should do that separately where relevant.
+func (f *Filter) StatusLikeable(ctx context.Context, requester *gtsmodel.Account, status *gtsmodel.Status) (bool, error) {
+	if requester.ID == status.AccountID {
+		// Status author can always like.
+		return true, nil
+	}
+
+	if status.Likeable != nil && !*status.Likeable {
+		log.Trace(ctx, "status marked not likeable")
+		return false, nil
+	}
+
+	return f.StatusInteractionPermitted(ctx, requester, status, status.LikePolicy)
+}
+
+// StatusInteractionPermitted checks whether the given interaction policy of status permits requester to interact with it.
+func (f *Filter) StatusInteractionPermitted(ctx context.Context, requester *gtsmodel.Account, status *gtsmodel.Status, policy gtsmodel.InteractionPolicy) (bool, error) {
+	if !policy.Restricted() {
+		// Anyone may interact.
+		return true, nil
+	}
+
+	if requester.ID == status.AccountID {
+		// Status author can always interact.
+		return true, nil
+	}
+
+	if policy == gtsmodel.InteractionPolicyNobody {
+		log.Tracef(ctx, "status interaction policy %s", policy)
+		return false, nil
+	}
+
+	if !status.MentionsPopulated() {
+		// Status needs its mentions populating, fetch these from database.
+		var err error
+		status.Mentions, err = f.state.DB.GetMentions(ctx, status.MentionIDs)
+		if err != nil {
+			return false, fmt.Errorf("StatusInteractionPermitted: error populating status %s mentions: %w", status.ID, err)
+		}
+	}
+
+	if status.MentionsAccount(requester.ID) {
+		// Mentioned accounts are permitted
+		// by both followers + mentioned.
+		return true, nil
+	}
+
+	if policy == gtsmodel.InteractionPolicyFollowers {
+		// Check requester follows status author.
+		follows, err := f.state.DB.IsFollowing(ctx,
+			requester.ID,
+			status.AccountID,
+		)
+		if err != nil {
+			return false, fmt.Errorf("StatusInteractionPermitted: error checking follow %s->%s: %w", requester.ID, status.AccountID, err)
+		}
+
+		if follows {
+			return true, nil
+		}
+	}
+
+	log.Tracef(ctx, "status interaction policy %s does not permit requester", policy)
+	return false, nil
+}
@@ -0,0 +1,148 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package visibility_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type StatusInteractionTestSuite struct {
+	FilterStandardTestSuite
+}
+
+type interactionCheck func(ctx context.Context, requester *gtsmodel.Account, status *gtsmodel.Status) (bool, error)
+
+// checks returns the filter function for each kind of
+// interaction, along with a func to set the policy for
+// that kind of interaction on the given status.
+func (suite *StatusInteractionTestSuite) checks() map[string]struct {
+	check     interactionCheck
+	setPolicy func(*gtsmodel.Status, gtsmodel.InteractionPolicy)
+} {
+	return map[string]struct {
+		check     interactionCheck
+		setPolicy func(*gtsmodel.Status, gtsmodel.InteractionPolicy)
+	}{
+		"reply": {
+			check:     suite.filter.StatusReplyable,
+			setPolicy: func(s *gtsmodel.Status, p gtsmodel.InteractionPolicy) { s.ReplyPolicy = p },
+		},
+		"boost": {
+			check:     suite.filter.StatusBoostable,
+			setPolicy: func(s *gtsmodel.Status, p gtsmodel.InteractionPolicy) { s.BoostPolicy = p },
+		},
+		"like": {
+			check:     suite.filter.StatusLikeable,
+			setPolicy: func(s *gtsmodel.Status, p gtsmodel.InteractionPolicy) { s.LikePolicy = p },
+		},
+	}
+}
+
+func (suite *StatusInteractionTestSuite) TestInteractionPolicies() {
+	var (
+		ctx = context.Background()
+
+		// Public status by local_account_1, with no mentions.
+		unmentioning = suite.testStatuses["local_account_1_status_1"]
+		author       = suite.testAccounts["local_account_1"]
+		follower     = suite.testAccounts["local_account_2"] // follows local_account_1
+		stranger     = suite.testAccounts["remote_account_1"]
+
+		// Public status by local_account_2, mentioning local_account_1.
+		mentioning = suite.testStatuses["local_account_2_status_5"]
+		mentioned  = suite.testAccounts["local_account_1"]
+		nobody     = suite.testAccounts["admin_account"] // doesn't follow local_account_2
+	)
+
+	type testCase struct {
+		policy    gtsmodel.InteractionPolicy
+		status    *gtsmodel.Status
+		requester *gtsmodel.Account
+		expect    bool
+	}
+
+	testCases := []testCase{
+		{"", unmentioning, stranger, true},
+		{gtsmodel.InteractionPolicyAnyone, unmentioning, follower, true},
+		{gtsmodel.InteractionPolicyAnyone, unmentioning, stranger, true},
+		{gtsmodel.InteractionPolicyFollowers, unmentioning, author, true},
+		{gtsmodel.InteractionPolicyFollowers, unmentioning, follower, true},
+		{gtsmodel.InteractionPolicyFollowers, unmentioning, stranger, false},
+		{gtsmodel.InteractionPolicyFollowers, mentioning, mentioned, true},
+		{gtsmodel.InteractionPolicyFollowers, mentioning, nobody, false},
+		{gtsmodel.InteractionPolicyMentioned, unmentioning, author, true},
+		{gtsmodel.InteractionPolicyMentioned, unmentioning, follower, false},
+		{gtsmodel.InteractionPolicyMentioned, unmentioning, stranger, false},
+		{gtsmodel.InteractionPolicyMentioned, mentioning, mentioned, true},
+		{gtsmodel.InteractionPolicyMentioned, mentioning, nobody, false},
+		{gtsmodel.InteractionPolicyNobody, unmentioning, author, true},
+		{gtsmodel.InteractionPolicyNobody, unmentioning, follower, false},
+		{gtsmodel.InteractionPolicyNobody, unmentioning, stranger, false},
+		{gtsmodel.InteractionPolicyNobody, mentioning, mentioned, false},
+	}
+
+	for kind, c := range suite.checks() {
+		for _, tc := range testCases {
+			// Copy status so we don't
+			// alter the test model.
+			status := new(gtsmodel.Status)
+			*status = *tc.status
+			c.setPolicy(status, tc.policy)
+
+			permitted, err := c.check(ctx, tc.requester, status)
+			suite.NoError(err)
+			suite.Equal(tc.expect, permitted,
+				"%s policy %q, requester %s", kind, tc.policy, tc.requester.Username,
+			)
+		}
+	}
+}
+
+func (suite *StatusInteractionTestSuite) TestFlagsOverridePolicy() {
+	var (
+		ctx       = context.Background()
+		status    = new(gtsmodel.Status)
+		requester = suite.testAccounts["local_account_2"]
+		f         = false
+	)
+
+	*status = *suite.testStatuses["local_account_1_status_1"]
+	status.ReplyPolicy = gtsmodel.InteractionPolicyAnyone
+	status.LikePolicy = gtsmodel.InteractionPolicyAnyone
+	status.Replyable = &f
+	status.Likeable = &f
+
+	replyable, err := suite.filter.StatusReplyable(ctx, requester, status)
+	suite.NoError(err)
+	suite.False(replyable)
+
+	likeable, err := suite.filter.StatusLikeable(ctx, requester, status)
+	suite.NoError(err)
+	suite.False(likeable)
+
+	suite.Equal(gtsmodel.InteractionPolicyNobody, status.EffectiveReplyPolicy())
+	suite.Equal(gtsmodel.InteractionPolicyNobody, status.EffectiveLikePolicy())
+	suite.Equal(gtsmodel.InteractionPolicyAnyone, status.EffectiveBoostPolicy())
+}
+
+func TestStatusInteractionTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusInteractionTestSuite))
+}
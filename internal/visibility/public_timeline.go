@@ -40,6 +40,16 @@ func (f *Filter) StatusPublicTimelineable(ctx context.Context, requester *gtsmod
 		requesterID = requester.ID
 	}
 
+	// Don't show statuses of silenced accounts on timeline,
+	// except to the account itself. This is checked outside
+	// of the visibility cache, as silencing an account doesn't
+	// invalidate cached visibility of its statuses.
+	if status.Account != nil &&
+		!status.Account.SilencedAt.IsZero() &&
+		requesterID != status.AccountID {
+		return false, nil
+	}
+
 	visibility, err := f.state.Caches.Visibility.Load("Type.RequesterID.ItemID", func() (*cache.CachedVisibility, error) {
 		// Visibility not yet cached, perform timeline visibility lookup.
 		visible, err := f.isStatusPublicTimelineable(ctx, requester, status)
@@ -96,6 +96,14 @@ func (f *Filter) isStatusVisible(ctx context.Context, requester *gtsmodel.Accoun
 		return false, nil
 	}
 
+	if requester != nil && requester.IsRemote() &&
+		(status.IsLocalOnly() || (status.BoostOf != nil && status.BoostOf.IsLocalOnly())) {
+		// Local-only statuses (and boosts
+		// of them) never leave this instance.
+		log.Trace(ctx, "local-only status not visible to remote requester")
+		return false, nil
+	}
+
 	if status.Visibility == gtsmodel.VisibilityPublic {
 		// This status will be visible to all.
 		return true, nil
@@ -156,6 +156,60 @@ func (suite *StatusVisibleTestSuite) TestStatusNotVisibleIfNotFollowingCached()
 	suite.False(visible)
 }
 
+func (suite *StatusVisibleTestSuite) TestLocalOnlyStatusVisibleToLocal() {
+	ctx := context.Background()
+
+	testStatusID := suite.testStatuses["local_account_1_status_2"].ID
+	testStatus, err := suite.db.GetStatusByID(ctx, testStatusID)
+	suite.NoError(err)
+	testAccount := suite.testAccounts["local_account_2"]
+
+	visible, err := suite.filter.StatusVisible(ctx, testAccount, testStatus)
+	suite.NoError(err)
+
+	suite.True(visible)
+}
+
+func (suite *StatusVisibleTestSuite) TestLocalOnlyStatusNotVisibleToRemote() {
+	ctx := context.Background()
+
+	testStatusID := suite.testStatuses["local_account_1_status_2"].ID
+	testStatus, err := suite.db.GetStatusByID(ctx, testStatusID)
+	suite.NoError(err)
+	testAccount := suite.testAccounts["remote_account_1"]
+
+	visible, err := suite.filter.StatusVisible(ctx, testAccount, testStatus)
+	suite.NoError(err)
+
+	suite.False(visible)
+}
+
+func (suite *StatusVisibleTestSuite) TestStatusNotVisibleIfDomainBlockedCached() {
+	ctx := context.Background()
+
+	testStatusID := suite.testStatuses["remote_account_1_status_1"].ID
+	testStatus, err := suite.db.GetStatusByID(ctx, testStatusID)
+	suite.NoError(err)
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// Perform a status visibility check before domain block, this should be true.
+	visible, err := suite.filter.StatusVisible(ctx, testAccount, testStatus)
+	suite.NoError(err)
+	suite.True(visible)
+
+	err = suite.db.PutAccountDomainBlock(ctx, &gtsmodel.AccountDomainBlock{
+		ID:        "01H7A4X5GC2BSB0S3QVXFZ5EGP",
+		AccountID: testAccount.ID,
+		Domain:    testStatus.Account.Domain,
+	})
+	suite.NoError(err)
+
+	// Perform a status visibility check after domain block, this should be false.
+	visible, err = suite.filter.StatusVisible(ctx, testAccount, testStatus)
+	suite.NoError(err)
+	suite.False(visible)
+}
+
 func TestStatusVisibleTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusVisibleTestSuite))
 }
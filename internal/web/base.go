@@ -25,6 +25,7 @@ import (
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 )
 
 func (m *Module) baseHandler(c *gin.Context) {
@@ -40,9 +41,18 @@ func (m *Module) baseHandler(c *gin.Context) {
 		return
 	}
 
+	// The announcements banner is optional,
+	// so render the page without it on error.
+	announcements, errWithCode := m.processor.Announcements().GetAll(c.Request.Context(), nil)
+	if errWithCode != nil {
+		log.Errorf(c.Request.Context(), "error getting announcements: %v", errWithCode)
+		announcements = nil
+	}
+
 	c.HTML(http.StatusOK, "index.tmpl", gin.H{
-		"instance": instance,
-		"ogMeta":   ogBase(instance),
+		"instance":      instance,
+		"announcements": announcements,
+		"ogMeta":        ogBase(instance),
 		"stylesheets": []string{
 			distPathPrefix + "/index.css",
 		},
@@ -0,0 +1,156 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package webpush
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// recordSize is the aes128gcm record size advertised
+// in the content coding header. Payloads are always
+// written as a single record, so this is an upper limit.
+const recordSize = 4096
+
+// maxPayloadSize is the largest plaintext that fits into a
+// single record: record size, minus the 16 byte AEAD tag and
+// the 1 byte padding delimiter.
+const maxPayloadSize = recordSize - 16 - 1
+
+// encrypt encrypts the given plaintext payload for the user agent with
+// the given P-256 public key and authentication secret, as per RFC 8291,
+// using the "aes128gcm" content coding from RFC 8188. The returned bytes
+// are the full request body, including the content coding header.
+func encrypt(plaintext []byte, uaPublicBytes []byte, authSecret []byte) ([]byte, error) {
+	// Generate a new application server key
+	// pair for each message, as per RFC 8291.
+	asPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("error generating ephemeral key: %w", err)
+	}
+
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("error generating salt: %w", err)
+	}
+
+	return encryptWith(plaintext, uaPublicBytes, authSecret, asPrivate, salt)
+}
+
+// encryptWith is like encrypt, but uses the given
+// application server key pair and salt.
+func encryptWith(
+	plaintext []byte,
+	uaPublicBytes []byte,
+	authSecret []byte,
+	asPrivate *ecdh.PrivateKey,
+	salt []byte,
+) ([]byte, error) {
+	if len(plaintext) > maxPayloadSize {
+		return nil, fmt.Errorf("payload too large: %d bytes", len(plaintext))
+	}
+
+	if len(authSecret) != 16 {
+		return nil, errors.New("auth secret must be 16 bytes")
+	}
+
+	uaPublic, err := ecdh.P256().NewPublicKey(uaPublicBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user agent public key: %w", err)
+	}
+
+	cek, nonce, err := deriveKeys(asPrivate, uaPublic, authSecret, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := newGCM(cek)
+	if err != nil {
+		return nil, err
+	}
+
+	// Single (last) record: plaintext followed
+	// by the 0x02 padding delimiter, no padding.
+	record := make([]byte, 0, len(plaintext)+1)
+	record = append(record, plaintext...)
+	record = append(record, 0x02)
+
+	// Content coding header: salt || rs || idlen || keyid.
+	asPublicBytes := asPrivate.PublicKey().Bytes()
+	body := make([]byte, 0, 16+4+1+len(asPublicBytes)+len(record)+gcm.Overhead())
+	body = append(body, salt...)
+	body = binary.BigEndian.AppendUint32(body, recordSize)
+	body = append(body, byte(len(asPublicBytes)))
+	body = append(body, asPublicBytes...)
+
+	return gcm.Seal(body, nonce, record, nil), nil
+}
+
+// deriveKeys derives the content encryption key and nonce
+// from the ECDH shared secret between the given key pair,
+// the user agent auth secret and the message salt.
+func deriveKeys(
+	asPrivate *ecdh.PrivateKey,
+	uaPublic *ecdh.PublicKey,
+	authSecret []byte,
+	salt []byte,
+) ([]byte, []byte, error) {
+	ecdhSecret, err := asPrivate.ECDH(uaPublic)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error computing shared secret: %w", err)
+	}
+
+	// key_info = "WebPush: info" || 0x00 || ua_public || as_public
+	keyInfo := []byte("WebPush: info\x00")
+	keyInfo = append(keyInfo, uaPublic.Bytes()...)
+	keyInfo = append(keyInfo, asPrivate.PublicKey().Bytes()...)
+
+	ikm := hkdf(authSecret, ecdhSecret, keyInfo, 32)
+	cek := hkdf(salt, ikm, []byte("Content-Encoding: aes128gcm\x00"), 16)
+	nonce := hkdf(salt, ikm, []byte("Content-Encoding: nonce\x00"), 12)
+
+	return cek, nonce, nil
+}
+
+// hkdf performs HKDF-SHA-256 (RFC 5869) extract and expand,
+// for output lengths of up to one hash length (32 bytes).
+func hkdf(salt, ikm, info []byte, length int) []byte {
+	extract := hmac.New(sha256.New, salt)
+	extract.Write(ikm)
+	prk := extract.Sum(nil)
+
+	expand := hmac.New(sha256.New, prk)
+	expand.Write(info)
+	expand.Write([]byte{0x01})
+	return expand.Sum(nil)[:length]
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("error creating cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,158 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package webpush
+
+import (
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type EncryptTestSuite struct {
+	suite.Suite
+}
+
+func decodeB64(s string) []byte {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func (suite *EncryptTestSuite) TestEncryptRFC8291Example() {
+	// Example from RFC 8291, Appendix A.
+	asPrivate, err := ecdh.P256().NewPrivateKey(decodeB64("yfWPiYE-n46HLnH0KqZOF1fJJU3MYrct3AELtAQ-oRw"))
+	suite.NoError(err)
+
+	body, err := encryptWith(
+		[]byte("When I grow up, I want to be a watermelon"),
+		decodeB64("BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4"),
+		decodeB64("BTBZMqHH6r4Tts7J_aSIgg"),
+		asPrivate,
+		decodeB64("DGv6ra1nlYgDCS1FRnbzlw"),
+	)
+	suite.NoError(err)
+
+	suite.Equal(
+		"DGv6ra1nlYgDCS1FRnbzlwAAEABBBP4z9KsN6nGRTbVYI_c7VJSPQTBtkgcy27mlmlMoZIIgDll6e3vCYLocInmYWAmS6TlzAC8wEqKK6PBru3jl7A_yl95bQpu6cVPTpK4Mqgkf1CXztLVBSt2Ks3oZwbuwXPXLWyouBWLVWGNWQexSgSxsj_Qulcy4a-fN",
+		base64.RawURLEncoding.EncodeToString(body),
+	)
+}
+
+func (suite *EncryptTestSuite) TestEncryptRoundTrip() {
+	uaPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	suite.NoError(err)
+
+	authSecret := make([]byte, 16)
+	_, err = rand.Read(authSecret)
+	suite.NoError(err)
+
+	plaintext := []byte(`{"title":"hello"}`)
+	body, err := encrypt(plaintext, uaPrivate.PublicKey().Bytes(), authSecret)
+	suite.NoError(err)
+
+	// Parse the content coding header as the user agent would.
+	salt := body[:16]
+	idlen := int(body[20])
+	asPublic, err := ecdh.P256().NewPublicKey(body[21 : 21+idlen])
+	suite.NoError(err)
+	ciphertext := body[21+idlen:]
+
+	// Derive keys from the user agent's side of the exchange.
+	secret, err := uaPrivate.ECDH(asPublic)
+	suite.NoError(err)
+
+	keyInfo := []byte("WebPush: info\x00")
+	keyInfo = append(keyInfo, uaPrivate.PublicKey().Bytes()...)
+	keyInfo = append(keyInfo, asPublic.Bytes()...)
+	ikm := hkdf(authSecret, secret, keyInfo, 32)
+
+	gcm, err := newGCM(hkdf(salt, ikm, []byte("Content-Encoding: aes128gcm\x00"), 16))
+	suite.NoError(err)
+
+	record, err := gcm.Open(nil, hkdf(salt, ikm, []byte("Content-Encoding: nonce\x00"), 12), ciphertext, nil)
+	suite.NoError(err)
+
+	suite.Equal(append(plaintext, 0x02), record)
+}
+
+func (suite *EncryptTestSuite) TestEncryptPayloadTooLarge() {
+	uaPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	suite.NoError(err)
+
+	_, err = encrypt(make([]byte, maxPayloadSize+1), uaPrivate.PublicKey().Bytes(), make([]byte, 16))
+	suite.EqualError(err, "payload too large: 4080 bytes")
+}
+
+func (suite *EncryptTestSuite) TestVAPIDAuthorization() {
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	suite.NoError(err)
+
+	vapid, err := parseVAPIDKeyPair(&gtsmodel.VAPIDKeyPair{
+		Public:  base64.RawURLEncoding.EncodeToString(key.PublicKey().Bytes()),
+		Private: base64.RawURLEncoding.EncodeToString(key.Bytes()),
+	})
+	suite.NoError(err)
+
+	endpoint, err := url.Parse("https://push.example.org/wpush/v2/some_subscription")
+	suite.NoError(err)
+
+	now := time.Date(2023, 8, 9, 12, 0, 0, 0, time.UTC)
+	authorization, err := vapid.authorization(endpoint, "https://localhost:8080", now)
+	suite.NoError(err)
+
+	token, k, ok := strings.Cut(strings.TrimPrefix(authorization, "vapid t="), ", k=")
+	suite.True(ok)
+	suite.Equal(base64.RawURLEncoding.EncodeToString(key.PublicKey().Bytes()), k)
+
+	parts := strings.Split(token, ".")
+	suite.Len(parts, 3)
+
+	claims := make(map[string]any)
+	suite.NoError(json.Unmarshal(decodeB64(parts[1]), &claims))
+	suite.Equal("https://push.example.org", claims["aud"])
+	suite.Equal("https://localhost:8080", claims["sub"])
+	suite.EqualValues(now.Add(vapidTokenTTL).Unix(), claims["exp"])
+
+	// Signature must verify against the public key.
+	sig := decodeB64(parts[2])
+	suite.Len(sig, 64)
+	hash := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	suite.True(ecdsa.Verify(
+		&vapid.private.PublicKey,
+		hash[:],
+		new(big.Int).SetBytes(sig[:32]),
+		new(big.Int).SetBytes(sig[32:]),
+	))
+}
+
+func TestEncryptTestSuite(t *testing.T) {
+	suite.Run(t, new(EncryptTestSuite))
+}
@@ -0,0 +1,402 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package webpush
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdh"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+// pushTTL is how long push services should
+// retain undelivered push messages for.
+const pushTTL = 48 * time.Hour
+
+// maxBodyRunes is the maximum length of the notification
+// body, to keep payloads well within one encrypted record.
+const maxBodyRunes = 256
+
+// ErrGone is returned by DeliverFunc implementations when the
+// push service reports that a subscription no longer exists.
+var ErrGone = errors.New("push subscription gone")
+
+// Sender pushes notifications to
+// local accounts' Web Push subscriptions.
+type Sender interface {
+	// Send pushes the given notification to each of the target account's
+	// Web Push subscriptions that have the notification type enabled and
+	// whose policy allows notifications from the origin account. Pushes
+	// are delivered asynchronously, and any subscriptions that no longer
+	// exist at the push service are removed.
+	Send(ctx context.Context, notification *gtsmodel.Notification, apiNotif *apimodel.Notification) error
+}
+
+// DeliverFunc delivers the given plaintext payload to the given subscription.
+type DeliverFunc func(ctx context.Context, sub *gtsmodel.WebPushSubscription, payload []byte) error
+
+// NewSender returns a new Sender which encrypts payloads and
+// delivers them to push service endpoints using the given client.
+func NewSender(state *state.State, client *httpclient.Client) Sender {
+	d := &deliverer{
+		state:  state,
+		client: client,
+	}
+
+	return &sender{
+		state:   state,
+		deliver: d.deliver,
+	}
+}
+
+// NewNoopSender returns a Sender which doesn't make any network
+// requests, and instead just calls the given deliver function with
+// the plaintext payload of each push. Passing a nil function is also
+// acceptable, in which case pushes are dropped.
+func NewNoopSender(state *state.State, deliver DeliverFunc) Sender {
+	if deliver == nil {
+		deliver = func(context.Context, *gtsmodel.WebPushSubscription, []byte) error {
+			return nil
+		}
+	}
+
+	return &sender{
+		state:   state,
+		deliver: deliver,
+	}
+}
+
+type sender struct {
+	state   *state.State
+	deliver DeliverFunc
+}
+
+func (s *sender) Send(ctx context.Context, notification *gtsmodel.Notification, apiNotif *apimodel.Notification) error {
+	subs, err := s.state.DB.GetWebPushSubscriptionsByAccountID(ctx, notification.TargetAccountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting web push subscriptions: %w", err)
+	}
+
+	for _, sub := range subs {
+		if !sub.AlertEnabled(notification.NotificationType) {
+			continue
+		}
+
+		allowed, err := s.policyAllows(ctx, sub, notification)
+		if err != nil {
+			return gtserror.Newf("error checking web push policy: %w", err)
+		}
+
+		if !allowed {
+			continue
+		}
+
+		payload, err := s.payload(ctx, sub, apiNotif)
+		if err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				// The subscription's token no longer exists,
+				// so the subscription is no longer usable.
+				s.deleteSubscription(ctx, sub)
+				continue
+			}
+			return gtserror.Newf("error creating web push payload: %w", err)
+		}
+
+		// Deliver in the background, so that a slow
+		// push service doesn't hold up the caller.
+		sub := sub
+		s.state.Workers.ClientAPI.MustEnqueueCtx(ctx, func(ctx context.Context) {
+			s.deliverTo(ctx, sub, payload)
+		})
+	}
+
+	return nil
+}
+
+// deliverTo delivers the given payload to the given subscription,
+// removing the subscription if the push service reports it gone.
+func (s *sender) deliverTo(ctx context.Context, sub *gtsmodel.WebPushSubscription, payload []byte) {
+	if err := s.deliver(ctx, sub, payload); err != nil {
+		if errors.Is(err, ErrGone) {
+			// Push service has dropped this
+			// subscription, so we drop it too.
+			s.deleteSubscription(ctx, sub)
+			return
+		}
+
+		log.Errorf(ctx, "error delivering web push to %s: %v", sub.Endpoint, err)
+	}
+}
+
+// policyAllows returns whether the given subscription's policy allows
+// pushes of notifications caused by the notification origin account.
+func (s *sender) policyAllows(ctx context.Context, sub *gtsmodel.WebPushSubscription, notification *gtsmodel.Notification) (bool, error) {
+	switch sub.Policy {
+	case gtsmodel.WebPushNotificationPolicyNone:
+		return false, nil
+	case gtsmodel.WebPushNotificationPolicyFollowed:
+		return s.state.DB.IsFollowing(ctx, sub.AccountID, notification.OriginAccountID)
+	case gtsmodel.WebPushNotificationPolicyFollower:
+		return s.state.DB.IsFollowing(ctx, notification.OriginAccountID, sub.AccountID)
+	default:
+		return true, nil
+	}
+}
+
+// payload returns the JSON payload pushed to the given subscription
+// for the given notification. The payload includes the subscription's
+// access token, so the client can fetch the full notification.
+func (s *sender) payload(ctx context.Context, sub *gtsmodel.WebPushSubscription, apiNotif *apimodel.Notification) ([]byte, error) {
+	token := &gtsmodel.Token{}
+	if err := s.state.DB.GetByID(ctx, sub.TokenID, token); err != nil {
+		return nil, err
+	}
+
+	var locale string
+	user, err := s.state.DB.GetUserByAccountID(gtscontext.SetBarebones(ctx), sub.AccountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting user: %w", err)
+	}
+	if user != nil {
+		locale = user.Locale
+	}
+
+	var icon, name string
+	if apiNotif.Account != nil {
+		icon = apiNotif.Account.Avatar
+		name = apiNotif.Account.DisplayName
+		if name == "" {
+			name = apiNotif.Account.Username
+		}
+	}
+
+	var body string
+	if apiNotif.Status != nil {
+		body = apiNotif.Status.SpoilerText
+		if body == "" {
+			body = text.SanitizePlaintext(apiNotif.Status.Content)
+		}
+	}
+
+	return json.Marshal(&apimodel.WebPushNotification{
+		AccessToken:      token.Access,
+		PreferredLocale:  locale,
+		NotificationID:   apiNotif.ID,
+		NotificationType: apiNotif.Type,
+		Icon:             icon,
+		Title:            title(gtsmodel.NotificationType(apiNotif.Type), name),
+		Body:             truncate(body, maxBodyRunes),
+	})
+}
+
+func (s *sender) deleteSubscription(ctx context.Context, sub *gtsmodel.WebPushSubscription) {
+	if err := s.state.DB.DeleteWebPushSubscriptionByID(ctx, sub.ID); err != nil {
+		log.Errorf(ctx, "error deleting web push subscription %s: %v", sub.ID, err)
+	}
+}
+
+// title returns a human readable title for a push
+// notification of the given type caused by name.
+func title(notificationType gtsmodel.NotificationType, name string) string {
+	switch notificationType {
+	case gtsmodel.NotificationFollow:
+		return name + " followed you"
+	case gtsmodel.NotificationFollowRequest:
+		return name + " requested to follow you"
+	case gtsmodel.NotificationFave:
+		return name + " favourited your post"
+	case gtsmodel.NotificationReaction:
+		return name + " reacted to your post"
+	case gtsmodel.NotificationMention:
+		return name + " mentioned you"
+	case gtsmodel.NotificationReblog:
+		return name + " boosted your post"
+	case gtsmodel.NotificationPoll:
+		return "A poll has ended"
+	case gtsmodel.NotificationStatus:
+		return name + " just posted"
+	case gtsmodel.NotificationAdminReport:
+		return name + " submitted a report"
+	case gtsmodel.NotificationModerationWarning:
+		return "A moderator has taken action on your account"
+	default:
+		return "New notification"
+	}
+}
+
+// truncate shortens the given string
+// to at most n runes, adding an ellipsis.
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "…"
+}
+
+// deliverer encrypts and delivers
+// payloads to push service endpoints.
+type deliverer struct {
+	state  *state.State
+	client *httpclient.Client
+
+	// VAPID key, loaded on first use.
+	key   *vapidKey
+	keyMu sync.Mutex
+}
+
+func (d *deliverer) deliver(ctx context.Context, sub *gtsmodel.WebPushSubscription, payload []byte) error {
+	key, err := d.vapidKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	endpoint, err := url.Parse(sub.Endpoint)
+	if err != nil {
+		return gtserror.Newf("invalid endpoint: %w", err)
+	}
+
+	uaPublic, err := decodeKey(sub.P256dh)
+	if err != nil {
+		return gtserror.Newf("invalid p256dh key: %w", err)
+	}
+
+	authSecret, err := decodeKey(sub.Auth)
+	if err != nil {
+		return gtserror.Newf("invalid auth secret: %w", err)
+	}
+
+	body, err := encrypt(payload, uaPublic, authSecret)
+	if err != nil {
+		return gtserror.Newf("error encrypting payload: %w", err)
+	}
+
+	subject := config.GetProtocol() + "://" + config.GetHost()
+	authorization, err := key.authorization(endpoint, subject, time.Now())
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), bytes.NewReader(body))
+	if err != nil {
+		return gtserror.Newf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", authorization)
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("TTL", strconv.Itoa(int(pushTTL.Seconds())))
+	req.Header.Set("Urgency", "normal")
+
+	rsp, err := d.client.Do(req)
+	if err != nil {
+		return gtserror.Newf("error doing request: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	// Push services may include a useful error message.
+	_, _ = io.Copy(io.Discard, io.LimitReader(rsp.Body, 1024))
+
+	switch {
+	case rsp.StatusCode == http.StatusNotFound ||
+		rsp.StatusCode == http.StatusGone:
+		return ErrGone
+	case rsp.StatusCode < 200 || rsp.StatusCode > 299:
+		return fmt.Errorf("push service returned %s", rsp.Status)
+	}
+
+	return nil
+}
+
+// vapidKey returns the instance VAPID
+// key, loading it from the db if needed.
+func (d *deliverer) vapidKey(ctx context.Context) (*vapidKey, error) {
+	d.keyMu.Lock()
+	defer d.keyMu.Unlock()
+
+	if d.key != nil {
+		return d.key, nil
+	}
+
+	keyPair, err := d.state.DB.GetVAPIDKeyPair(ctx)
+	if err != nil {
+		return nil, gtserror.Newf("db error getting VAPID key pair: %w", err)
+	}
+
+	key, err := parseVAPIDKeyPair(keyPair)
+	if err != nil {
+		return nil, err
+	}
+
+	d.key = key
+	return key, nil
+}
+
+// decodeKey decodes a base64 encoded subscription key. Clients
+// variously use the standard or URL alphabet, with or without
+// padding, so accept all of them.
+func decodeKey(s string) ([]byte, error) {
+	s = strings.TrimRight(s, "=")
+	s = strings.NewReplacer("+", "-", "/", "_").Replace(s)
+	return base64.RawURLEncoding.DecodeString(s)
+}
+
+// ValidateKeys checks that the given base64 encoded user
+// agent public key and auth secret can be used for pushes.
+func ValidateKeys(p256dh string, auth string) error {
+	uaPublic, err := decodeKey(p256dh)
+	if err != nil {
+		return fmt.Errorf("invalid p256dh key: %w", err)
+	}
+
+	if _, err := ecdh.P256().NewPublicKey(uaPublic); err != nil {
+		return fmt.Errorf("invalid p256dh key: %w", err)
+	}
+
+	authSecret, err := decodeKey(auth)
+	if err != nil {
+		return fmt.Errorf("invalid auth secret: %w", err)
+	}
+
+	if len(authSecret) != 16 {
+		return errors.New("invalid auth secret: must be 16 bytes")
+	}
+
+	return nil
+}
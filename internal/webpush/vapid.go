@@ -0,0 +1,123 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package webpush
+
+import (
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// vapidTokenTTL is how long signed VAPID tokens are valid for. RFC 8292
+// says this must be no more than 24 hours from the time of the request.
+const vapidTokenTTL = 12 * time.Hour
+
+// vapidKey wraps the instance VAPID key pair in usable form.
+type vapidKey struct {
+	private *ecdsa.PrivateKey
+	public  string // base64 URL encoded, as sent in the "k" parameter.
+}
+
+// parseVAPIDKeyPair parses the given stored
+// VAPID key pair into a usable signing key.
+func parseVAPIDKeyPair(keyPair *gtsmodel.VAPIDKeyPair) (*vapidKey, error) {
+	privateBytes, err := base64.RawURLEncoding.DecodeString(keyPair.Private)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding VAPID private key: %w", err)
+	}
+
+	ecdhKey, err := ecdh.P256().NewPrivateKey(privateBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid VAPID private key: %w", err)
+	}
+
+	// Round trip through PKCS #8 to get the
+	// equivalent ECDSA key for signing with.
+	der, err := x509.MarshalPKCS8PrivateKey(ecdhKey)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling VAPID private key: %w", err)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing VAPID private key: %w", err)
+	}
+
+	ecdsaKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("VAPID private key was not an ECDSA key")
+	}
+
+	return &vapidKey{
+		private: ecdsaKey,
+		public:  base64.RawURLEncoding.EncodeToString(ecdhKey.PublicKey().Bytes()),
+	}, nil
+}
+
+// authorization returns a VAPID Authorization header value
+// (RFC 8292) for a push message to the given endpoint.
+func (k *vapidKey) authorization(endpoint *url.URL, subject string, now time.Time) (string, error) {
+	header := map[string]string{
+		"typ": "JWT",
+		"alg": "ES256",
+	}
+
+	claims := map[string]any{
+		"aud": endpoint.Scheme + "://" + endpoint.Host,
+		"exp": now.Add(vapidTokenTTL).Unix(),
+		"sub": subject,
+	}
+
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return "", err
+	}
+
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJSON) +
+		"." + base64.RawURLEncoding.EncodeToString(claimsJSON)
+
+	hash := sha256.Sum256([]byte(signingInput))
+	r, s, err := ecdsa.Sign(rand.Reader, k.private, hash[:])
+	if err != nil {
+		return "", fmt.Errorf("error signing VAPID token: %w", err)
+	}
+
+	// JWS ES256 signatures are the fixed
+	// width concatenation of R and S.
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+
+	token := signingInput + "." + base64.RawURLEncoding.EncodeToString(sig)
+	return "vapid t=" + token + ", k=" + k.public, nil
+}
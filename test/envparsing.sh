@@ -6,6 +6,7 @@ EXPECT=$(cat <<"EOF"
 {
     "account-domain": "peepee",
     "accounts-allow-custom-css": true,
+    "accounts-allow-user-invites": true,
     "accounts-approval-required": false,
     "accounts-custom-css-length": 5000,
     "accounts-reason-required": false,
@@ -19,6 +20,12 @@ EXPECT=$(cat <<"EOF"
     "bind-address": "127.0.0.1",
     "cache": {
         "gts": {
+            "account-domain-block-ids-max-size": 500,
+            "account-domain-block-ids-sweep-freq": 60000000000,
+            "account-domain-block-ids-ttl": 1800000000000,
+            "account-domain-block-max-size": 1000,
+            "account-domain-block-sweep-freq": 60000000000,
+            "account-domain-block-ttl": 1800000000000,
             "account-max-size": 99,
             "account-note-max-size": 1000,
             "account-note-sweep-freq": 60000000000,
@@ -28,6 +35,9 @@ EXPECT=$(cat <<"EOF"
             "block-ids-max-size": 500,
             "block-ids-sweep-freq": 60000000000,
             "block-ids-ttl": 1800000000000,
+            "card-max-size": 1000,
+            "card-sweep-freq": 60000000000,
+            "card-ttl": 1800000000000,
             "block-max-size": 1000,
             "block-sweep-freq": 60000000000,
             "block-ttl": 1800000000000,
@@ -88,9 +98,18 @@ EXPECT=$(cat <<"EOF"
             "tombstone-max-size": 500,
             "tombstone-sweep-freq": 60000000000,
             "tombstone-ttl": 1800000000000,
+            "translation-max-size": 1000,
+            "translation-sweep-freq": 900000000000,
+            "translation-ttl": 86400000000000,
             "user-max-size": 500,
             "user-sweep-freq": 60000000000,
             "user-ttl": 1800000000000,
+            "web-push-subscription-ids-max-size": 500,
+            "web-push-subscription-ids-sweep-freq": 60000000000,
+            "web-push-subscription-ids-ttl": 1800000000000,
+            "web-push-subscription-max-size": 500,
+            "web-push-subscription-sweep-freq": 60000000000,
+            "web-push-subscription-ttl": 1800000000000,
             "webfinger-max-size": 250,
             "webfinger-sweep-freq": 900000000000,
             "webfinger-ttl": 86400000000000
@@ -115,12 +134,14 @@ EXPECT=$(cat <<"EOF"
     "db-user": "sex-haver",
     "dry-run": true,
     "email": "",
+    "expires-in": 604800000000000,
     "host": "example.com",
     "http-client": {
         "allow-ips": [],
         "block-ips": [],
         "timeout": 10000000000
     },
+    "instance-auto-close-suspended-reports": true,
     "instance-deliver-to-shared-inboxes": false,
     "instance-expose-peers": true,
     "instance-expose-public-timeline": true,
@@ -135,6 +156,7 @@ EXPECT=$(cat <<"EOF"
     "log-client-ip": false,
     "log-db-queries": true,
     "log-level": "info",
+    "max-uses": 1,
     "media-description-max-chars": 5000,
     "media-description-min-chars": 69,
     "media-emoji-local-max-size": 420,
@@ -142,6 +164,9 @@ EXPECT=$(cat <<"EOF"
     "media-image-max-size": 420,
     "media-remote-cache-days": 30,
     "media-video-max-size": 420,
+    "oauth-access-token-expiry": 0,
+    "oauth-refresh-token-expiry": 0,
+    "oauth-refresh-tokens": false,
     "oidc-admin-groups": [
         "steamy"
     ],
@@ -190,6 +215,9 @@ EXPECT=$(cat <<"EOF"
     "tracing-endpoint": "localhost:4317",
     "tracing-insecure": false,
     "tracing-transport": "grpc",
+    "translation-backend": "libretranslate",
+    "translation-libretranslate-api-key": "",
+    "translation-libretranslate-url": "http://localhost:5000",
     "trusted-proxies": [
         "127.0.0.1/32",
         "docker.host.local"
@@ -233,9 +261,11 @@ GTS_INSTANCE_EXPOSE_PEERS=true \
 GTS_INSTANCE_EXPOSE_SUSPENDED=true \
 GTS_INSTANCE_EXPOSE_SUSPENDED_WEB=true \
 GTS_INSTANCE_EXPOSE_PUBLIC_TIMELINE=true \
+GTS_INSTANCE_AUTO_CLOSE_SUSPENDED_REPORTS=true \
 GTS_INSTANCE_DELIVER_TO_SHARED_INBOXES=false \
 GTS_INSTANCE_INJECT_MASTODON_VERSION=true \
 GTS_ACCOUNTS_ALLOW_CUSTOM_CSS=true \
+GTS_ACCOUNTS_ALLOW_USER_INVITES=true \
 GTS_ACCOUNTS_CUSTOM_CSS_LENGTH=5000 \
 GTS_ACCOUNTS_REGISTRATION_OPEN=true \
 GTS_ACCOUNTS_APPROVAL_REQUIRED=false \
@@ -283,6 +313,8 @@ GTS_SYSLOG_ENABLED=true \
 GTS_SYSLOG_PROTOCOL='udp' \
 GTS_SYSLOG_ADDRESS='127.0.0.1:6969' \
 GTS_TRACING_ENDPOINT='localhost:4317' \
+GTS_TRANSLATION_BACKEND='libretranslate' \
+GTS_TRANSLATION_LIBRETRANSLATE_URL='http://localhost:5000' \
 GTS_ADVANCED_COOKIES_SAMESITE='strict' \
 GTS_ADVANCED_RATE_LIMIT_REQUESTS=6969 \
 GTS_ADVANCED_SENDER_MULTIPLIER=-1 \
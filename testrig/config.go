@@ -62,15 +62,17 @@ var testDefaults = config.Configuration{
 	WebTemplateBaseDir: "./web/template/",
 	WebAssetBaseDir:    "./web/assets/",
 
-	InstanceExposePeers:            true,
-	InstanceExposeSuspended:        true,
-	InstanceExposeSuspendedWeb:     true,
-	InstanceDeliverToSharedInboxes: true,
+	InstanceExposePeers:               true,
+	InstanceExposeSuspended:           true,
+	InstanceExposeSuspendedWeb:        true,
+	InstanceDeliverToSharedInboxes:    true,
+	InstanceAutoCloseSuspendedReports: false,
 
 	AccountsRegistrationOpen: true,
 	AccountsApprovalRequired: true,
 	AccountsReasonRequired:   true,
 	AccountsAllowCustomCSS:   true,
+	AccountsAllowUserInvites: false,
 	AccountsCustomCSSLength:  10000,
 
 	MediaImageMaxSize:        10485760, // 10mb
@@ -98,6 +100,10 @@ var testDefaults = config.Configuration{
 	LetsEncryptCertDir:      "",
 	LetsEncryptEmailAddress: "",
 
+	OAuthAccessTokenExpiry:  0,
+	OAuthRefreshTokens:      false,
+	OAuthRefreshTokenExpiry: 0,
+
 	OIDCEnabled:          false,
 	OIDCIdpName:          "",
 	OIDCSkipVerification: false,
@@ -123,6 +129,8 @@ var testDefaults = config.Configuration{
 	SyslogProtocol: "udp",
 	SyslogAddress:  "localhost:514",
 
+	TranslationBackend: "",
+
 	AdvancedCookiesSamesite:      "lax",
 	AdvancedRateLimitRequests:    0, // disabled
 	AdvancedThrottlingMultiplier: 0, // disabled
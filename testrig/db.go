@@ -48,6 +48,7 @@ var testModels = []interface{}{
 	&gtsmodel.StatusToEmoji{},
 	&gtsmodel.StatusToTag{},
 	&gtsmodel.StatusFave{},
+	&gtsmodel.StatusReaction{},
 	&gtsmodel.StatusBookmark{},
 	&gtsmodel.StatusMute{},
 	&gtsmodel.Tag{},
@@ -61,7 +62,22 @@ var testModels = []interface{}{
 	&gtsmodel.EmojiCategory{},
 	&gtsmodel.Tombstone{},
 	&gtsmodel.Report{},
+	&gtsmodel.Rule{},
+	&gtsmodel.IPBlock{},
+	&gtsmodel.Invite{},
+	&gtsmodel.AccountDomainBlock{},
 	&gtsmodel.AccountNote{},
+	&gtsmodel.WebPushSubscription{},
+	&gtsmodel.VAPIDKeyPair{},
+	&gtsmodel.Card{},
+	&gtsmodel.Trend{},
+	&gtsmodel.PinnedSuggestion{},
+	&gtsmodel.SuggestionDismissal{},
+	&gtsmodel.Announcement{},
+	&gtsmodel.AnnouncementRead{},
+	&gtsmodel.AnnouncementReaction{},
+	&gtsmodel.NotificationPolicy{},
+	&gtsmodel.NotificationRequest{},
 }
 
 // NewTestDB returns a new initialized, empty database for testing.
@@ -160,6 +176,12 @@ func StandardDBSetup(db db.DB, accounts map[string]*gtsmodel.Account) {
 		}
 	}
 
+	for _, v := range NewTestRules() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(nil, err)
+		}
+	}
+
 	for _, v := range NewTestDomainBlocks() {
 		if err := db.Put(ctx, v); err != nil {
 			log.Panic(nil, err)
@@ -302,6 +324,10 @@ func StandardDBSetup(db db.DB, accounts map[string]*gtsmodel.Account) {
 		log.Panic(nil, err)
 	}
 
+	if err := db.CreateVAPIDKeyPair(ctx); err != nil {
+		log.Panic(nil, err)
+	}
+
 	log.Debug(nil, "testing db setup complete")
 }
 
@@ -67,6 +67,10 @@ func Uint64Ptr(in uint64) *uint64 {
 	return &in
 }
 
+func UintPtr(in uint) *uint {
+	return &in
+}
+
 // NewTestTokens returns a map of tokens keyed according to which account the token belongs to.
 func NewTestTokens() map[string]*gtsmodel.Token {
 	tokens := map[string]*gtsmodel.Token{
@@ -2063,6 +2067,35 @@ func NewTestReports() map[string]*gtsmodel.Report {
 	}
 }
 
+func NewTestRules() map[string]*gtsmodel.Rule {
+	return map[string]*gtsmodel.Rule{
+		"rule1": {
+			ID:        "01GVTXNXMXKXBXBYKCMJAQJZJY",
+			CreatedAt: TimeMustParse("2022-05-14T12:20:03+02:00"),
+			UpdatedAt: TimeMustParse("2022-05-14T12:20:03+02:00"),
+			Text:      "Be gay",
+			Order:     UintPtr(0),
+			Deleted:   FalseBool(),
+		},
+		"rule2": {
+			ID:        "01GVTXP8RE6YMA3DMTDC9TAZ8R",
+			CreatedAt: TimeMustParse("2022-05-14T12:20:03+02:00"),
+			UpdatedAt: TimeMustParse("2022-05-14T12:20:03+02:00"),
+			Text:      "Do crime",
+			Order:     UintPtr(1),
+			Deleted:   FalseBool(),
+		},
+		"rule3": {
+			ID:        "01GVTXPF2XYK5S49D3QD2ZYGGA",
+			CreatedAt: TimeMustParse("2022-05-14T12:20:03+02:00"),
+			UpdatedAt: TimeMustParse("2022-05-15T12:20:03+02:00"),
+			Text:      "No spam please",
+			Order:     UintPtr(2),
+			Deleted:   TrueBool(),
+		},
+	}
+}
+
 // ActivityWithSignature wraps a pub.Activity along with its signature headers, for testing.
 type ActivityWithSignature struct {
 	Activity        pub.Activity
@@ -2653,6 +2686,14 @@ func NewTestDereferenceRequests(accounts map[string]*gtsmodel.Account) map[strin
 		DateHeader:      date,
 	}
 
+	target = URLMustParse(statuses["local_account_1_status_2"].URI)
+	sig, digest, date = GetSignatureForDereference(accounts["remote_account_1"].PublicKeyURI, accounts["remote_account_1"].PrivateKey, target)
+	fossSatanDereferenceLocalAccount1Status2 := ActivityWithSignature{
+		SignatureHeader: sig,
+		DigestHeader:    digest,
+		DateHeader:      date,
+	}
+
 	target = URLMustParse(statuses["local_account_1_status_1"].URI + "/replies")
 	sig, digest, date = GetSignatureForDereference(accounts["remote_account_1"].PublicKeyURI, accounts["remote_account_1"].PrivateKey, target)
 	fossSatanDereferenceLocalAccount1Status1Replies := ActivityWithSignature{
@@ -2701,6 +2742,30 @@ func NewTestDereferenceRequests(accounts map[string]*gtsmodel.Account) map[strin
 		DateHeader:      date,
 	}
 
+	target = URLMustParse(accounts["local_account_1"].FollowersURI)
+	sig, digest, date = GetSignatureForDereference(accounts["remote_account_1"].PublicKeyURI, accounts["remote_account_1"].PrivateKey, target)
+	fossSatanDereferenceZorkFollowers := ActivityWithSignature{
+		SignatureHeader: sig,
+		DigestHeader:    digest,
+		DateHeader:      date,
+	}
+
+	target = URLMustParse(accounts["local_account_1"].FollowersURI + "?page=true")
+	sig, digest, date = GetSignatureForDereference(accounts["remote_account_1"].PublicKeyURI, accounts["remote_account_1"].PrivateKey, target)
+	fossSatanDereferenceZorkFollowersFirst := ActivityWithSignature{
+		SignatureHeader: sig,
+		DigestHeader:    digest,
+		DateHeader:      date,
+	}
+
+	target = URLMustParse(accounts["local_account_1"].FollowingURI + "?page=true")
+	sig, digest, date = GetSignatureForDereference(accounts["remote_account_1"].PublicKeyURI, accounts["remote_account_1"].PrivateKey, target)
+	fossSatanDereferenceZorkFollowingFirst := ActivityWithSignature{
+		SignatureHeader: sig,
+		DigestHeader:    digest,
+		DateHeader:      date,
+	}
+
 	target = URLMustParse(emojis["rainbow"].URI)
 	sig, digest, date = GetSignatureForDereference(accounts["remote_account_1"].PublicKeyURI, accounts["remote_account_1"].PrivateKey, target)
 	fossSatanDereferenceEmoji := ActivityWithSignature{
@@ -2714,12 +2779,16 @@ func NewTestDereferenceRequests(accounts map[string]*gtsmodel.Account) map[strin
 		"foss_satan_dereference_zork_public_key":                       fossSatanDereferenceZorkPublicKey,
 		"foss_satan_dereference_local_account_1_status_1":              fossSatanDereferenceLocalAccount1Status1,
 		"foss_satan_dereference_local_account_1_status_1_lowercase":    fossSatanDereferenceLocalAccount1Status1Lowercase,
+		"foss_satan_dereference_local_account_1_status_2":              fossSatanDereferenceLocalAccount1Status2,
 		"foss_satan_dereference_local_account_1_status_1_replies":      fossSatanDereferenceLocalAccount1Status1Replies,
 		"foss_satan_dereference_local_account_1_status_1_replies_next": fossSatanDereferenceLocalAccount1Status1RepliesNext,
 		"foss_satan_dereference_local_account_1_status_1_replies_last": fossSatanDereferenceLocalAccount1Status1RepliesLast,
 		"foss_satan_dereference_zork_outbox":                           fossSatanDereferenceZorkOutbox,
 		"foss_satan_dereference_zork_outbox_first":                     fossSatanDereferenceZorkOutboxFirst,
 		"foss_satan_dereference_zork_outbox_next":                      fossSatanDereferenceZorkOutboxNext,
+		"foss_satan_dereference_zork_followers":                        fossSatanDereferenceZorkFollowers,
+		"foss_satan_dereference_zork_followers_first":                  fossSatanDereferenceZorkFollowersFirst,
+		"foss_satan_dereference_zork_following_first":                  fossSatanDereferenceZorkFollowingFirst,
 		"foss_satan_dereference_emoji":                                 fossSatanDereferenceEmoji,
 	}
 }
@@ -91,6 +91,17 @@ function ReportDetailForm({ data: report }) {
 						: <i className="no-comment">none provided</i>
 					}
 
+					{report.rules?.length > 0 &&
+						<>
+							<b>Broken rules: </b>
+							<ol className="rules">
+								{report.rules.map((rule) => (
+									<li key={rule.id}>{rule.text}</li>
+								))}
+							</ol>
+						</>
+					}
+
 				</div>
 			</div>
 